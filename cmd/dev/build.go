@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"time"
+)
+
+// generateSteps mirror task go:gen for the outputs a source edit can affect.
+// The CMS schema and GraphQL client are left to the full task.
+var generateSteps = [][]string{
+	{"go", "tool", "no-js", "gen", "assets", "-root", "."},
+	{"go", "generate", "./web"},
+}
+
+const (
+	stopTimeout  = 5 * time.Second
+	readyTimeout = 15 * time.Second
+)
+
+// buildApp regenerates code and assets and compiles the server to binary.
+func buildApp(ctx context.Context, binary string, output io.Writer) error {
+	steps := append(slices.Clone(generateSteps), []string{"go", "build", "-o", binary, "./cmd/server"})
+	for _, step := range steps {
+		cmd := exec.CommandContext(ctx, step[0], step[1:]...)
+		cmd.Stdout = output
+		cmd.Stderr = output
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("%s: %w", strings.Join(step, " "), err)
+		}
+	}
+	return nil
+}
+
+// appProcess is the server built by buildApp, restarted after each build.
+type appProcess struct {
+	binary string
+	addr   string
+	stdout io.Writer
+	stderr io.Writer
+
+	cmd  *exec.Cmd
+	done chan struct{}
+}
+
+func (app *appProcess) restart(ctx context.Context) error {
+	app.stop()
+
+	cmd := exec.Command(app.binary)
+	cmd.Env = append(os.Environ(), "BLOG_LISTEN_ADDR="+app.addr)
+	cmd.Stdout = app.stdout
+	cmd.Stderr = app.stderr
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start server: %w", err)
+	}
+	done := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(done)
+	}()
+	app.cmd = cmd
+	app.done = done
+
+	return waitReady(ctx, "http://"+app.addr+"/healthz", done)
+}
+
+// stop interrupts the running server and kills it if it does not exit in
+// time.
+func (app *appProcess) stop() {
+	if app.cmd == nil {
+		return
+	}
+
+	_ = app.cmd.Process.Signal(os.Interrupt)
+	select {
+	case <-app.done:
+	case <-time.After(stopTimeout):
+		_ = app.cmd.Process.Kill()
+		<-app.done
+	}
+	app.cmd = nil
+}
+
+// waitReady polls the health endpoint so the browser reloads only once the new
+// server answers.
+func waitReady(ctx context.Context, url string, exited <-chan struct{}) error {
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		if resp, err := http.DefaultClient.Do(req); err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+
+		select {
+		case <-exited:
+			return errors.New("server exited during startup")
+		case <-ctx.Done():
+			return fmt.Errorf("server not ready: %w", ctx.Err())
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
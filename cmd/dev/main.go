@@ -0,0 +1,134 @@
+// Command dev runs the blog for local development. It regenerates routes,
+// templ output and static assets when a source file changes, rebuilds and
+// restarts the server, and reloads open browser tabs through a websocket that
+// its proxy injects into every HTML page.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if err := run(ctx, os.Args[1:], os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "dev: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stderr io.Writer) error {
+	var addr string
+	var appAddr string
+	var interval time.Duration
+
+	flags := flag.NewFlagSet("dev", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&addr, "addr", "localhost:8080", "address the reloading proxy listens on")
+	flags.StringVar(&appAddr, "app-addr", "127.0.0.1:8081", "address the rebuilt server listens on")
+	flags.DurationVar(&interval, "interval", 200*time.Millisecond, "how often source files are checked for changes")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat("go.mod"); err != nil {
+		return errors.New("run from the module root, next to go.mod")
+	}
+
+	logger := log.New(stderr, "dev: ", log.Ltime)
+	binDir, err := os.MkdirTemp("", "blog-dev-*")
+	if err != nil {
+		return fmt.Errorf("create build directory: %w", err)
+	}
+	defer os.RemoveAll(binDir)
+
+	reloads := newReloadHub()
+	proxy, err := newProxy(appAddr, reloads)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{Addr: addr, Handler: proxy, ReadHeaderTimeout: 10 * time.Second}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+	defer server.Close()
+	logger.Printf("serving on http://%s, app on %s", addr, appAddr)
+
+	app := &appProcess{
+		binary: filepath.Join(binDir, "server"),
+		addr:   appAddr,
+		stdout: os.Stdout,
+		stderr: stderr,
+	}
+	defer app.stop()
+
+	rebuild := func() {
+		started := time.Now()
+		if err := buildApp(ctx, app.binary, stderr); err != nil {
+			logger.Printf("build failed, keeping the previous server: %v", err)
+			return
+		}
+		if err := app.restart(ctx); err != nil {
+			logger.Printf("restart failed: %v", err)
+			return
+		}
+		logger.Printf("reloaded in %s", time.Since(started).Round(time.Millisecond))
+		reloads.broadcast()
+	}
+
+	rebuild()
+	baseline, err := snapshotSources(".")
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-serveErr:
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return fmt.Errorf("serve: %w", err)
+		case <-ticker.C:
+		}
+
+		current, err := snapshotSources(".")
+		if err != nil {
+			logger.Printf("scan sources: %v", err)
+			continue
+		}
+		changed := current.changedSince(baseline)
+		if changed == "" {
+			continue
+		}
+
+		// Editors often write several files per save; wait for them to settle.
+		time.Sleep(interval)
+		logger.Printf("%s changed, rebuilding", changed)
+		rebuild()
+		// Generation rewrites files inside the tree, so compare against the
+		// state after the rebuild, not before it.
+		if next, err := snapshotSources("."); err == nil {
+			baseline = next
+		} else {
+			logger.Printf("scan sources: %v", err)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+func TestProxyInjectsReloadScriptIntoHTML(t *testing.T) {
+	t.Parallel()
+
+	app := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "identity" {
+			http.Error(w, "compressed response requested", http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path == "/feed.xml" {
+			w.Header().Set("Content-Type", "application/rss+xml")
+			_, _ = io.WriteString(w, "<rss></rss>")
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Length", "40")
+		_, _ = io.WriteString(w, "<html><body><h1>Hello</h1></body></html>")
+	}))
+	defer app.Close()
+
+	proxy, err := newProxy(strings.TrimPrefix(app.URL, "http://"), newReloadHub())
+	require.NoError(t, err)
+	front := httptest.NewServer(proxy)
+	defer front.Close()
+
+	body, header := get(t, front.URL+"/note/hello-world")
+	require.Contains(t, body, "<h1>Hello</h1>"+reloadScript+"</body>")
+	require.Equal(t, header.Get("Content-Length"), strconv.Itoa(len(body)))
+
+	body, _ = get(t, front.URL+"/feed.xml")
+	require.Equal(t, "<rss></rss>", body)
+}
+
+func TestReloadHubNotifiesConnectedTabs(t *testing.T) {
+	t.Parallel()
+
+	hub := newReloadHub()
+	proxy, err := newProxy("127.0.0.1:1", hub)
+	require.NoError(t, err)
+	front := httptest.NewServer(proxy)
+	defer front.Close()
+
+	conn, err := websocket.Dial("ws"+strings.TrimPrefix(front.URL, "http")+reloadPath, "", front.URL)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.Eventually(t, func() bool {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		return len(hub.conns) == 1
+	}, time.Second, 10*time.Millisecond)
+	hub.broadcast()
+
+	var message string
+	require.NoError(t, websocket.Message.Receive(conn, &message))
+	require.Equal(t, "reload", message)
+
+	body, _ := get(t, front.URL+"/")
+	require.Contains(t, body, reloadScript, "the rebuilding page reloads itself once the app is up")
+}
+
+func TestSnapshotSourcesSkipsGeneratedOutput(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	write := func(path string, content string) {
+		full := filepath.Join(root, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o755))
+		require.NoError(t, os.WriteFile(full, []byte(content), 0o644))
+	}
+	write("web/components/card.templ", "templ Card() {}")
+	write("web/components/card_templ.go", "package components")
+	write("web/generated/routes.go", "package generated")
+	write("web/static/assets-build/tui.css", "body{}")
+	write("README.md", "notes")
+
+	before, err := snapshotSources(root)
+	require.NoError(t, err)
+	require.Equal(t, []string{"web/components/card.templ"}, keys(before))
+
+	write("web/generated/routes.go", "package generated // regenerated")
+	after, err := snapshotSources(root)
+	require.NoError(t, err)
+	require.Empty(t, after.changedSince(before))
+
+	write("web/components/card.templ", "templ Card() { <p></p> }")
+	after, err = snapshotSources(root)
+	require.NoError(t, err)
+	require.Equal(t, "web/components/card.templ", after.changedSince(before))
+
+	require.NoError(t, os.Remove(filepath.Join(root, "web", "components", "card.templ")))
+	after, err = snapshotSources(root)
+	require.NoError(t, err)
+	require.Equal(t, "web/components/card.templ", after.changedSince(before))
+}
+
+func get(t *testing.T, url string) (string, http.Header) {
+	t.Helper()
+
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body), resp.Header
+}
+
+func keys(snapshot sourceSnapshot) []string {
+	paths := make([]string, 0, len(snapshot))
+	for path := range snapshot {
+		paths = append(paths, path)
+	}
+	return paths
+}
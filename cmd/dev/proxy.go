@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/websocket"
+)
+
+// reloadPath is served by the proxy itself, never forwarded to the app.
+const reloadPath = "/.dev/reload"
+
+// reloadScript reconnects after the proxy restarts, so a tab left open keeps
+// reloading across dev sessions.
+const reloadScript = `<script>(function () {
+  var url = (location.protocol === "https:" ? "wss://" : "ws://") + location.host + "` + reloadPath + `";
+  function connect() {
+    var socket = new WebSocket(url);
+    socket.onmessage = function () { location.reload(); };
+    socket.onclose = function () { setTimeout(connect, 500); };
+  }
+  connect();
+})();</script>`
+
+// reloadHub tracks the browser tabs connected to the reload socket.
+type reloadHub struct {
+	mu    sync.Mutex
+	conns map[*websocket.Conn]struct{}
+}
+
+func newReloadHub() *reloadHub {
+	return &reloadHub{conns: map[*websocket.Conn]struct{}{}}
+}
+
+func (hub *reloadHub) serve(conn *websocket.Conn) {
+	hub.mu.Lock()
+	hub.conns[conn] = struct{}{}
+	hub.mu.Unlock()
+
+	// Browsers never send anything; reading only detects the closed tab.
+	_, _ = io.Copy(io.Discard, conn)
+
+	hub.mu.Lock()
+	delete(hub.conns, conn)
+	hub.mu.Unlock()
+	_ = conn.Close()
+}
+
+// broadcast tells every connected tab to reload.
+func (hub *reloadHub) broadcast() {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+
+	for conn := range hub.conns {
+		if err := websocket.Message.Send(conn, "reload"); err != nil {
+			_ = conn.Close()
+			delete(hub.conns, conn)
+		}
+	}
+}
+
+// newProxy forwards everything but the reload socket to the app and injects
+// the reload script into HTML responses.
+func newProxy(appAddr string, hub *reloadHub) (http.Handler, error) {
+	target, err := url.Parse("http://" + appAddr)
+	if err != nil {
+		return nil, fmt.Errorf("parse app address: %w", err)
+	}
+
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+			r.SetXForwarded()
+			// Ask for plain bodies so HTML can be rewritten. Without the
+			// header the transport would request gzip on its own.
+			r.Out.Header.Set("Accept-Encoding", "identity")
+		},
+		ModifyResponse: injectReloadScript,
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, err error) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = fmt.Fprintf(w, "<!doctype html><title>Rebuilding</title><body><p>The server is not up yet: %s</p>%s</body>",
+				strings.ReplaceAll(err.Error(), "<", "&lt;"), reloadScript)
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(reloadPath, websocket.Handler(hub.serve))
+	mux.Handle("/", proxy)
+	return mux, nil
+}
+
+func injectReloadScript(resp *http.Response) error {
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "text/html") || resp.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if index := bytes.LastIndex(body, []byte("</body>")); index >= 0 {
+		body = append(body[:index], append([]byte(reloadScript), body[index:]...)...)
+	} else {
+		body = append(body, reloadScript...)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	resp.Header.Del("ETag")
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// sourceExtensions are the files that feed the build: Go and templ code,
+// styles and scripts, translations, route config and GraphQL operations.
+var sourceExtensions = []string{".go", ".templ", ".css", ".js", ".json", ".yaml", ".yml", ".graphql"}
+
+// skippedDirs hold generated output or nothing the server is built from.
+var skippedDirs = []string{".git", "node_modules", "docs", "web/generated", "web/static/assets-build"}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+type sourceSnapshot map[string]fileState
+
+// snapshotSources records the modification time and size of every source
+// file under root. Polling keeps the command free of platform watchers.
+func snapshotSources(root string) (sourceSnapshot, error) {
+	snapshot := sourceSnapshot{}
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if entry.IsDir() {
+			if slices.Contains(skippedDirs, rel) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !isSourceFile(rel) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		snapshot[rel] = fileState{modTime: info.ModTime(), size: info.Size()}
+		return nil
+	})
+
+	return snapshot, err
+}
+
+func isSourceFile(path string) bool {
+	if strings.HasSuffix(path, "_templ.go") || strings.HasSuffix(path, "_test.go") {
+		return false
+	}
+	return slices.Contains(sourceExtensions, filepath.Ext(path))
+}
+
+// changedSince returns one path that was added, removed or modified since
+// previous, or "" when nothing changed.
+func (snapshot sourceSnapshot) changedSince(previous sourceSnapshot) string {
+	for path, state := range snapshot {
+		if before, ok := previous[path]; !ok || !before.modTime.Equal(state.modTime) || before.size != state.size {
+			return path
+		}
+	}
+	for path := range previous {
+		if _, ok := snapshot[path]; !ok {
+			return path
+		}
+	}
+	return ""
+}
@@ -1,30 +1,65 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"syscall"
+	"time"
 
+	"blog/internal/analytics"
 	"blog/internal/cmsgraphql"
+	"blog/internal/cmshooks"
+	"blog/internal/comments"
 	"blog/internal/config"
+	"blog/internal/discovery"
 	"blog/internal/imageloader"
+	"blog/internal/images"
+	"blog/internal/markdown"
+	"blog/internal/middleware"
+	"blog/internal/navigation"
 	"blog/internal/notes"
+	"blog/internal/preview"
+	"blog/internal/readiness"
 	"blog/internal/site"
+	"blog/internal/staticfs"
+	"blog/internal/theme"
+	"blog/web"
 	generated "blog/web/generated"
+	messages "blog/web/generated/i18n/messages"
+	"blog/web/static"
 	runtime "blog/web/view"
 	"github.com/RevoTale/no-js/framework/httpserver"
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
 )
 
 const immutableStaticCachePolicy = "public, max-age=31536000, immutable"
 const blogLiveNavigationCachePolicy = "public, max-age=3600, s-maxage=3600"
+const staticURLPrefix = "/_assets/"
+const aggregateRouteTimeoutFactor = 3
+
+// shutdownTimeout bounds how long in-flight requests may finish after an
+// interrupt.
+const shutdownTimeout = 10 * time.Second
 
 func main() {
-	if err := run(); err != nil {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := run(ctx)
+	stop()
+	if err != nil {
 		log.Fatalf("server stopped: %v", err)
 	}
 }
 
-func run() error {
+// run serves until ctx is cancelled, then shuts the server down gracefully.
+func run(ctx context.Context) error {
 	cfg := config.Load()
 	siteResolver, err := site.NewResolver(cfg)
 	if err != nil {
@@ -32,20 +67,91 @@ func run() error {
 	}
 
 	imageLoader := imageloader.New(cfg.EnableImageLoader)
+	var imageProxy *images.Proxy
+	if cfg.EnableImageProxy {
+		imageProxy, err = images.New(images.Config{
+			SourceURL:         imageProxySourceURL(cfg),
+			SourceQueryParams: cfg.ImageProxyQueryParams,
+			CacheDir:          cfg.ImageProxyCacheDir,
+			CacheMaxBytes:     int64(cfg.ImageProxyCacheMaxMB) << 20,
+			MaxRenders:        cfg.ImageProxyMaxRenders,
+		})
+		if err != nil {
+			return fmt.Errorf("build image proxy: %w", err)
+		}
+		imageLoader = imageloader.New(true).
+			WithPathPrefix(images.PathPrefix).
+			WithDimensions(imageProxy.Dimensions)
+	}
 
 	graphqlClient := gql.NewClient(cfg)
+	codeHighlight := markdown.CodeHighlight{
+		LightStyle:  cfg.CodeLightStyle,
+		DarkStyle:   cfg.CodeDarkStyle,
+		LineNumbers: cfg.CodeLineNumbers,
+	}
+	markdownSettings := notes.MarkdownSettings{
+		CodeHighlight: codeHighlight,
+		RawHTML:       rawHTMLMode(cfg),
+		Typographer:   cfg.SmartTypography,
+	}
 	noteService := notes.NewService(
 		graphqlClient,
 		cfg.PageSize,
 		imageLoader,
-	)
+	).WithMarkdownSettings(markdownSettings).WithScheduledPreview(cfg.PreviewScheduled)
+	noteSource, err := contentSource(cfg, noteService, imageLoader, markdownSettings)
+	if err != nil {
+		return fmt.Errorf("build content source: %w", err)
+	}
+
+	var commentService *comments.Service
+	if cfg.EnableComments {
+		commentService = comments.NewService(graphqlClient, 0)
+	}
+
+	var previewSigner *preview.Signer
+	if cfg.PreviewSecret != "" {
+		previewSigner, err = preview.NewSigner(cfg.PreviewSecret, cfg.PreviewTTL)
+		if err != nil {
+			return fmt.Errorf("build preview signer: %w", err)
+		}
+	}
+
+	var viewCounter *analytics.ViewCounter
+	viewCountsStopped := make(chan struct{})
+	if cfg.EnableViewCounts {
+		viewCounter = analytics.NewViewCounter(analytics.NewMemoryRecorder(), logViewCountsError)
+		go func() {
+			defer close(viewCountsStopped)
+			viewCounter.Run(ctx, cfg.ViewCountsFlushInterval)
+		}()
+	} else {
+		close(viewCountsStopped)
+	}
+
+	navigationModel, err := navigation.Load(cfg.NavigationFile)
+	if err != nil {
+		return fmt.Errorf("load navigation: %w", err)
+	}
 
 	appContext, err := runtime.NewContext(runtime.Config{
-		Notes:              noteService,
+		Notes:              noteSource,
+		Comments:           commentService,
 		SiteResolver:       siteResolver,
 		ImageLoader:        imageLoader,
 		LovelyEyeScriptURL: cfg.LovelyEyeScriptURL,
 		LovelyEyeSiteID:    cfg.LovelyEyeSiteID,
+		MermaidScriptURL:   cfg.MermaidScriptURL,
+		CodeHighlight:      codeHighlight,
+		Navigation:         &navigationModel,
+		Preview:            previewSigner,
+		Views:              viewCounter,
+		Robots: discovery.RobotsConfig{
+			Allow:    cfg.RobotsAllow,
+			Disallow: cfg.RobotsDisallow,
+			NoIndex:  cfg.NoIndex,
+		},
 	})
 	if err != nil {
 		return fmt.Errorf("build app context: %w", err)
@@ -55,27 +161,269 @@ func run() error {
 	cachePolicies.Static = immutableStaticCachePolicy
 	cachePolicies.LiveNavigation = blogLiveNavigationCachePolicy
 
+	staticFiles := os.DirFS(static.BuildDir)
+	staticAssets := &httpserver.StaticAssetsConfig{
+		ManifestPath: path.Join(static.BuildDir, "manifest.json"),
+		URLPrefix:    staticURLPrefix,
+	}
+	var routeMounts []func(*http.ServeMux) error
+	if cfg.EmbedStatic {
+		embedded, err := static.Assets()
+		if err != nil {
+			return fmt.Errorf("open embedded static assets: %w", err)
+		}
+		mount, err := staticfs.Load(embedded, staticURLPrefix)
+		if err != nil {
+			return fmt.Errorf("load embedded static assets: %w", err)
+		}
+		staticFiles = embedded
+		staticAssets = &httpserver.StaticAssetsConfig{URLPrefix: mount.URLPrefix}
+		routeMounts = append(routeMounts, func(mux *http.ServeMux) error {
+			return mount.Register(mux, cachePolicies.Static)
+		})
+	}
+	if imageProxy != nil {
+		routeMounts = append(routeMounts, func(mux *http.ServeMux) error {
+			imageProxy.Register(mux)
+			return nil
+		})
+	}
+	var readinessProbes []readiness.Probe
+	if cfg.ContentSource == config.ContentSourceGraphQL {
+		readinessProbes = append(readinessProbes, readiness.Probe{
+			Name: "cms",
+			Check: func(ctx context.Context) error {
+				return gql.Ping(ctx, graphqlClient)
+			},
+		})
+	}
+	readinessProbe := readiness.NewHandler(cfg.ReadinessTimeout, readinessProbes...).WithErrorLog(func(err error) {
+		log.Printf("blog readiness: %v", err)
+	})
+	routeMounts = append(routeMounts, func(mux *http.ServeMux) error {
+		readinessProbe.Register(mux)
+		return nil
+	})
+	routeMounts = append(routeMounts, func(mux *http.ServeMux) error {
+		theme.NewHandler().Register(mux)
+		return nil
+	})
+	i18nConfig, err := frameworki18n.NormalizeConfig(messages.Config())
+	if err != nil {
+		return fmt.Errorf("load i18n config: %w", err)
+	}
+	channelsOPML := discovery.NewOPMLHandler(noteSource, siteResolver, i18nConfig).WithErrorLog(func(err error) {
+		log.Printf("blog channels opml: %v", err)
+	})
+	routeMounts = append(routeMounts, func(mux *http.ServeMux) error {
+		channelsOPML.Register(mux)
+		return nil
+	})
+	if cfg.PayloadWebhookSecret != "" {
+		invalidators := []cmshooks.Invalidator{cmshooks.NotesCacheInvalidator(noteService)}
+		if cfg.CDNPurgeURL != "" {
+			purge, err := cmshooks.NewPurgeInvalidator(cfg.CDNPurgeURL, cfg.CDNPurgeToken, nil)
+			if err != nil {
+				return fmt.Errorf("build cdn purge: %w", err)
+			}
+			invalidators = append(invalidators, purge)
+		}
+		invalidators = append(invalidators, logInvalidatedKeys)
+		hooks, err := cmshooks.NewHandler(cfg.PayloadWebhookSecret, invalidators...)
+		if err != nil {
+			return fmt.Errorf("build payload webhook: %w", err)
+		}
+		routeMounts = append(routeMounts, func(mux *http.ServeMux) error {
+			hooks.Register(mux)
+			return nil
+		})
+	}
+
+	logServerError := func(err error) {
+		if errors.Is(err, notes.ErrUpstream) {
+			log.Printf("blog upstream error: %v", err)
+			return
+		}
+		log.Printf("blog server error: %v", err)
+	}
+
+	liveConnections := middleware.NewLiveConnections(middleware.LiveConnectionConfig{
+		MaxConnections: cfg.LiveMaxConnections,
+		IdleTimeout:    cfg.LiveIdleTimeout,
+	})
+	// Each entry wraps the ones before it, so the live limiter sees the
+	// framework's writes before WithETag buffers them.
+	mainMiddlewares := []func(http.Handler) http.Handler{
+		middleware.WithErrorStatus,
+		middleware.WithUpstreamErrors(web.RenderUpstreamErrorPage(appContext)),
+		middleware.WithRequestTimeout(middleware.RequestTimeoutConfig{
+			Default: cfg.RequestTimeout,
+			Routes: map[string]time.Duration{
+				// The archive and tag indexes scan every note when their cache is cold.
+				"/archive": aggregateRouteTimeoutFactor * cfg.RequestTimeout,
+				"/tags":    aggregateRouteTimeoutFactor * cfg.RequestTimeout,
+			},
+		}),
+		middleware.WithLiveConnectionLimit(liveConnections),
+		middleware.WithETag,
+		middleware.WithSurrogateKeys,
+		middleware.WithLinkHeader,
+		runtime.WithCanonicalNotesRedirects,
+		middleware.WithAdminAuth(cfg.AdminToken),
+		middleware.WithPrivateQuery(preview.QueryKey),
+		theme.WithPreference,
+	}
+	if cfg.AnalyticsEventsURL != "" {
+		emitter, err := analytics.NewHTTPEmitter(cfg.AnalyticsEventsURL, cfg.LovelyEyeSiteID)
+		if err != nil {
+			return fmt.Errorf("build analytics emitter: %w", err)
+		}
+		mainMiddlewares = append(mainMiddlewares, analytics.WithSoftNavigationPageviews(emitter, logServerError))
+	}
+
 	handler, err := httpserver.NewApp(httpserver.Config[*runtime.Context]{
 		App: generated.Bundle(appContext),
 		Custom: httpserver.CustomConfig{
-			MainMiddlewares: []func(http.Handler) http.Handler{
-				runtime.WithCanonicalNotesRedirects,
-			},
-			CachePolicies: cachePolicies,
-			LogServerError: func(err error) {
-				log.Printf("blog server error: %v", err)
-			},
+			ExtraRoutes:         mountRoutes(routeMounts),
+			MainMiddlewares:     mainMiddlewares,
+			StaticAssets:        staticAssets,
+			CachePolicies:       cachePolicies,
+			LogServerError:      logServerError,
 			EnableResolverDebug: cfg.EnableResolverDebug,
 		},
 	})
 	if err != nil {
 		return fmt.Errorf("handler setup failed: %w", err)
 	}
+	handler = middleware.WithCompression(middleware.CompressionConfig{
+		StaticFiles: staticFiles,
+		StaticPathPrefix: func() string {
+			return runtime.StaticAssetURL("")
+		},
+	})(handler)
+	handler = middleware.WithMaintenance(middleware.MaintenanceConfig{
+		Enabled:      cfg.Maintenance,
+		SentinelFile: cfg.MaintenanceFile,
+		RetryAfter:   cfg.MaintenanceRetryAfter,
+		OpenPrefixes: []string{"/healthz", runtime.StaticAssetURL("")},
+		RenderPage:   web.RenderMaintenancePage(appContext),
+	})(handler)
+	handler = middleware.WithSiteAuth(
+		cfg.SitePassword,
+		"/healthz",
+		readiness.Path,
+		cmshooks.PayloadPath,
+		runtime.StaticAssetURL(""),
+		// Admin pages have their own token, sent in the same Authorization
+		// header, so they cannot sit behind the site password too.
+		middleware.AdminPathPrefix,
+	)(handler)
+	handler = middleware.WithNoIndex(cfg.NoIndex)(handler)
+	handler = middleware.WithRecovery(logServerError)(handler)
 
+	server := &http.Server{Addr: cfg.ListenAddr, Handler: handler}
+	served := make(chan error, 1)
+	go func() {
+		served <- server.ListenAndServe()
+	}()
 	log.Printf("blog server listening on %s", cfg.ListenAddr)
-	if err := http.ListenAndServe(cfg.ListenAddr, handler); err != nil {
+
+	select {
+	case err := <-served:
 		return err
+	case <-ctx.Done():
+	}
+
+	log.Printf("blog server shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shut down server: %w", err)
+	}
+	// The flusher wrote the buffer when ctx was cancelled; requests that were
+	// still in flight may have counted views since.
+	<-viewCountsStopped
+	if err := viewCounter.Flush(shutdownCtx); err != nil {
+		logViewCountsError(err)
 	}
 
 	return nil
 }
+
+func logViewCountsError(err error) {
+	log.Printf("blog view counts: %v", err)
+}
+
+func mountRoutes(mounts []func(*http.ServeMux) error) func(*http.ServeMux) error {
+	if len(mounts) == 0 {
+		return nil
+	}
+
+	return func(mux *http.ServeMux) error {
+		for _, mount := range mounts {
+			if err := mount(mux); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// logInvalidatedKeys records what a CMS change affected once the notes caches
+// and the CDN have dropped it.
+func logInvalidatedKeys(_ context.Context, keys []string) error {
+	log.Printf("cms change invalidates: %s", strings.Join(keys, " "))
+	return nil
+}
+
+// contentSource picks where notes are read from. The CMS service is built
+// either way, since webhooks invalidate its caches.
+func contentSource(
+	cfg config.Config,
+	cms *notes.Service,
+	imageLoader imageloader.Loader,
+	settings notes.MarkdownSettings,
+) (notes.Source, error) {
+	switch cfg.ContentSource {
+	case config.ContentSourceGraphQL:
+		return cms, nil
+	case config.ContentSourceFiles:
+		if cfg.ContentDir == "" {
+			return nil, fmt.Errorf("BLOG_CONTENT_DIR is required by the %q content source", cfg.ContentSource)
+		}
+		source, err := notes.NewFileSource(os.DirFS(cfg.ContentDir), cfg.PageSize, imageLoader)
+		if err != nil {
+			return nil, err
+		}
+		return source.WithMarkdownSettings(settings), nil
+	case config.ContentSourceFixtures:
+		source, err := notes.NewFixtureSource(cfg.PageSize, imageLoader)
+		if err != nil {
+			return nil, err
+		}
+		return source.WithMarkdownSettings(settings), nil
+	default:
+		return nil, fmt.Errorf("unknown content source %q", cfg.ContentSource)
+	}
+}
+
+func rawHTMLMode(cfg config.Config) markdown.RawHTMLMode {
+	if cfg.SanitizeRawHTML {
+		return markdown.RawHTMLSanitize
+	}
+	return markdown.RawHTMLSkip
+}
+
+// imageProxySourceURL defaults to the CMS origin serving GraphQL, which is where
+// relative attachment URLs point.
+func imageProxySourceURL(cfg config.Config) string {
+	if cfg.ImageProxySourceURL != "" {
+		return cfg.ImageProxySourceURL
+	}
+
+	endpoint, err := url.Parse(cfg.GraphQLEndpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return ""
+	}
+	return endpoint.Scheme + "://" + endpoint.Host
+}
@@ -7,17 +7,27 @@ require (
 	github.com/RevoTale/no-js v1.0.0
 	github.com/a-h/templ v0.3.1001
 	github.com/alecthomas/chroma/v2 v2.23.1
+	github.com/andybalholm/brotli v1.2.5
+	github.com/gen2brain/avif v0.6.0
+	github.com/gen2brain/webp v0.6.4
 	github.com/gomarkdown/markdown v0.0.0-20260417124207-7d523f7318df
+	github.com/microcosm-cc/bluemonday v1.0.27
 	github.com/stretchr/testify v1.11.1
+	golang.org/x/image v0.34.0
 	golang.org/x/mod v0.35.0
+	golang.org/x/net v0.42.0
+	golang.org/x/text v0.36.0
 )
 
 require (
 	github.com/a-h/parse v0.0.0-20250122154542-74294addb73e // indirect
+	github.com/aymerick/douceur v0.2.0 // indirect
+	github.com/ebitengine/purego v0.10.1 // indirect
 	github.com/evanw/esbuild v0.28.0 // indirect
+	github.com/gorilla/css v1.0.1 // indirect
 	github.com/nicksnyder/go-i18n/v2 v2.6.1 // indirect
-	golang.org/x/sys v0.43.0 // indirect
-	golang.org/x/text v0.36.0 // indirect
+	github.com/tetratelabs/wazero v1.12.0 // indirect
+	golang.org/x/sys v0.44.0 // indirect
 )
 
 require (
@@ -26,7 +36,7 @@ require (
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/suessflorian/gqlfetch v0.7.0
-	github.com/vektah/gqlparser/v2 v2.5.32 // indirect
+	github.com/vektah/gqlparser/v2 v2.5.32
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
@@ -0,0 +1,93 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const PageviewEventName = "pageview"
+
+type Event struct {
+	Name      string    `json:"name"`
+	SiteID    string    `json:"siteId,omitempty"`
+	URL       string    `json:"url"`
+	Referrer  string    `json:"referrer,omitempty"`
+	UserAgent string    `json:"userAgent,omitempty"`
+	Locale    string    `json:"locale,omitempty"`
+	Virtual   bool      `json:"virtual"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type Emitter interface {
+	Emit(ctx context.Context, event Event) error
+}
+
+type httpEmitter struct {
+	endpoint string
+	siteID   string
+	client   *http.Client
+}
+
+func NewHTTPEmitter(endpoint string, siteID string) (Emitter, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	if endpoint == "" {
+		return nil, fmt.Errorf("analytics events endpoint is required")
+	}
+
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parse analytics events endpoint %q: %w", endpoint, err)
+	}
+	if !parsed.IsAbs() || strings.TrimSpace(parsed.Host) == "" {
+		return nil, fmt.Errorf("analytics events endpoint %q must be an absolute URL", endpoint)
+	}
+
+	return &httpEmitter{
+		endpoint: parsed.String(),
+		siteID:   strings.TrimSpace(siteID),
+		client:   &http.Client{Timeout: 5 * time.Second},
+	}, nil
+}
+
+func (emitter *httpEmitter) Emit(ctx context.Context, event Event) error {
+	if emitter == nil {
+		return nil
+	}
+	if strings.TrimSpace(event.SiteID) == "" {
+		event.SiteID = emitter.siteID
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encode analytics event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, emitter.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build analytics event request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if strings.TrimSpace(event.UserAgent) != "" {
+		req.Header.Set("User-Agent", event.UserAgent)
+	}
+
+	resp, err := emitter.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send analytics event: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("send analytics event: unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package analytics
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// memoryRetention is how long MemoryRecorder keeps daily counts: the popular
+// window plus the partial day it starts in.
+const memoryRetention = PopularWindow + 24*time.Hour
+
+type noteKey struct {
+	locale string
+	slug   string
+}
+
+type dayCounts struct {
+	views  map[noteKey]int
+	titles map[noteKey]string
+}
+
+// MemoryRecorder keeps view counts in process memory. The counts are lost on
+// restart and are not shared between instances.
+type MemoryRecorder struct {
+	mu   sync.Mutex
+	days map[time.Time]*dayCounts
+}
+
+func NewMemoryRecorder() *MemoryRecorder {
+	return &MemoryRecorder{days: make(map[time.Time]*dayCounts)}
+}
+
+func (recorder *MemoryRecorder) RecordViews(_ context.Context, day time.Time, views []ViewCount) error {
+	day = startOfDay(day)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	counts := recorder.days[day]
+	if counts == nil {
+		counts = &dayCounts{views: make(map[noteKey]int), titles: make(map[noteKey]string)}
+		recorder.days[day] = counts
+	}
+	for _, view := range views {
+		key := noteKey{locale: view.Locale, slug: view.Slug}
+		counts.views[key] += view.Count
+		if view.Title != "" {
+			counts.titles[key] = view.Title
+		}
+	}
+
+	cutoff := day.Add(-memoryRetention)
+	for stored := range recorder.days {
+		if stored.Before(cutoff) {
+			delete(recorder.days, stored)
+		}
+	}
+
+	return nil
+}
+
+func (recorder *MemoryRecorder) PopularNotes(
+	_ context.Context,
+	locale string,
+	since time.Time,
+	limit int,
+) ([]NoteViews, error) {
+	since = startOfDay(since)
+
+	recorder.mu.Lock()
+	totals := make(map[string]*NoteViews)
+	titleDays := make(map[string]time.Time)
+	for day, counts := range recorder.days {
+		if day.Before(since) {
+			continue
+		}
+		for key, views := range counts.views {
+			if key.locale != locale {
+				continue
+			}
+			total := totals[key.slug]
+			if total == nil {
+				total = &NoteViews{Slug: key.slug}
+				totals[key.slug] = total
+			}
+			total.Views += views
+			// The newest day's title wins, so a renamed note shows its new title.
+			if title := counts.titles[key]; title != "" && !day.Before(titleDays[key.slug]) {
+				total.Title = title
+				titleDays[key.slug] = day
+			}
+		}
+	}
+	recorder.mu.Unlock()
+
+	popular := make([]NoteViews, 0, len(totals))
+	for _, total := range totals {
+		if total.Title == "" {
+			total.Title = total.Slug
+		}
+		popular = append(popular, *total)
+	}
+	slices.SortFunc(popular, func(a, b NoteViews) int {
+		return cmp.Or(cmp.Compare(b.Views, a.Views), cmp.Compare(a.Slug, b.Slug))
+	})
+
+	return popular[:min(limit, len(popular))], nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,128 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
+)
+
+const liveNavigationQueryKey = "__live"
+const liveNavigationQueryValue = "navigation"
+const liveFilterQueryValue = "filter"
+const emitTimeout = 5 * time.Second
+
+func WithSoftNavigationPageviews(
+	emitter Emitter,
+	logError func(err error),
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if next == nil {
+				return
+			}
+			if emitter == nil || !isSoftNavigationRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			writer := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(writer, r)
+			if !countsAsPageview(writer.statusCode) {
+				return
+			}
+
+			event := virtualPageview(r)
+			ctx := context.WithoutCancel(r.Context())
+			go func() {
+				emitCtx, cancel := context.WithTimeout(ctx, emitTimeout)
+				defer cancel()
+
+				if err := emitter.Emit(emitCtx, event); err != nil && logError != nil {
+					logError(fmt.Errorf("emit soft navigation pageview: %w", err))
+				}
+			}()
+		})
+	}
+}
+
+func isSoftNavigationRequest(r *http.Request) bool {
+	if r == nil || r.URL == nil || r.Method != http.MethodGet {
+		return false
+	}
+	if !strings.EqualFold(strings.TrimSpace(r.Header.Get("HX-Request")), "true") {
+		return false
+	}
+
+	switch strings.TrimSpace(r.URL.Query().Get(liveNavigationQueryKey)) {
+	case liveNavigationQueryValue, liveFilterQueryValue:
+		return true
+	default:
+		return false
+	}
+}
+
+func countsAsPageview(statusCode int) bool {
+	if statusCode == http.StatusNotModified {
+		return true
+	}
+	return statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices
+}
+
+func virtualPageview(r *http.Request) Event {
+	locale := ""
+	pagePath := r.URL.Path
+	if info, ok := frameworki18n.RequestInfoFromContext(r.Context()); ok {
+		locale = info.Locale
+		if strings.TrimSpace(info.OriginalPath) != "" {
+			pagePath = info.OriginalPath
+		}
+	}
+
+	query := r.URL.Query()
+	query.Del(liveNavigationQueryKey)
+	pageURL := pagePath
+	if encoded := query.Encode(); encoded != "" {
+		pageURL += "?" + encoded
+	}
+
+	return Event{
+		Name:      PageviewEventName,
+		URL:       pageURL,
+		Referrer:  strings.TrimSpace(r.Header.Get("HX-Current-URL")),
+		UserAgent: strings.TrimSpace(r.UserAgent()),
+		Locale:    locale,
+		Virtual:   true,
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+}
+
+func (w *statusRecorder) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.statusCode = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *statusRecorder) Write(content []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(content)
+}
+
+func (w *statusRecorder) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
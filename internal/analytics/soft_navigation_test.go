@@ -0,0 +1,115 @@
+package analytics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingEmitter struct {
+	events chan Event
+}
+
+func newRecordingEmitter() *recordingEmitter {
+	return &recordingEmitter{events: make(chan Event, 4)}
+}
+
+func (emitter *recordingEmitter) Emit(_ context.Context, event Event) error {
+	emitter.events <- event
+	return nil
+}
+
+func okHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
+func TestSoftNavigationPageviewIsEmittedForLiveNavigation(t *testing.T) {
+	t.Parallel()
+
+	emitter := newRecordingEmitter()
+	handler := WithSoftNavigationPageviews(emitter, nil)(okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/tag/go?page=2&__live=navigation", nil)
+	req.Header.Set("HX-Request", "true")
+	req.Header.Set("HX-Current-URL", "https://example.com/tag/go")
+	req.Header.Set("User-Agent", "test-agent")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	select {
+	case event := <-emitter.events:
+		require.Equal(t, PageviewEventName, event.Name)
+		require.Equal(t, "/tag/go?page=2", event.URL)
+		require.Equal(t, "https://example.com/tag/go", event.Referrer)
+		require.Equal(t, "test-agent", event.UserAgent)
+		require.True(t, event.Virtual)
+	case <-time.After(time.Second):
+		t.Fatal("expected a virtual pageview event")
+	}
+}
+
+func TestSoftNavigationPageviewIsEmittedForLiveFilterToggle(t *testing.T) {
+	t.Parallel()
+
+	emitter := newRecordingEmitter()
+	handler := WithSoftNavigationPageviews(emitter, nil)(okHandler())
+
+	req := httptest.NewRequest(http.MethodGet, "/tag/go?__live=filter", nil)
+	req.Header.Set("HX-Request", "true")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	select {
+	case event := <-emitter.events:
+		require.Equal(t, "/tag/go", event.URL)
+	case <-time.After(time.Second):
+		t.Fatal("expected a virtual pageview event")
+	}
+}
+
+func TestSoftNavigationPageviewSkipsRegularAppendAndFailedRequests(t *testing.T) {
+	t.Parallel()
+
+	emitter := newRecordingEmitter()
+	handler := WithSoftNavigationPageviews(emitter, nil)(okHandler())
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/tag/go", nil))
+
+	partial := httptest.NewRequest(http.MethodGet, "/tag/go", nil)
+	partial.Header.Set("HX-Request", "true")
+	handler.ServeHTTP(httptest.NewRecorder(), partial)
+
+	appended := httptest.NewRequest(http.MethodGet, "/tag/go?page=2&__live=append", nil)
+	appended.Header.Set("HX-Request", "true")
+	handler.ServeHTTP(httptest.NewRecorder(), appended)
+
+	failing := WithSoftNavigationPageviews(emitter, nil)(http.NotFoundHandler())
+	missing := httptest.NewRequest(http.MethodGet, "/tag/missing?__live=navigation", nil)
+	missing.Header.Set("HX-Request", "true")
+	failing.ServeHTTP(httptest.NewRecorder(), missing)
+
+	select {
+	case event := <-emitter.events:
+		t.Fatalf("unexpected event: %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestNewHTTPEmitterRequiresAbsoluteEndpoint(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewHTTPEmitter("", "site")
+	require.Error(t, err)
+
+	_, err = NewHTTPEmitter("/events", "site")
+	require.Error(t, err)
+
+	emitter, err := NewHTTPEmitter("https://analytics.example/api/events", "site")
+	require.NoError(t, err)
+	require.NotNil(t, emitter)
+}
@@ -0,0 +1,150 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PopularWindow is how far back PopularNotes looks: a rolling week.
+const PopularWindow = 7 * 24 * time.Hour
+
+// maxPendingViews bounds the distinct notes buffered between flushes; views of
+// further notes are dropped until the next flush.
+const maxPendingViews = 10_000
+
+// View is one read of a note in a locale. The title is the one the reader saw,
+// so a popular list can name the note without loading it.
+type View struct {
+	Locale string
+	Slug   string
+	Title  string
+}
+
+// ViewCount is the number of times a note was read on one day.
+type ViewCount struct {
+	View
+	Count int
+}
+
+// NoteViews is a note with the views it got in a window.
+type NoteViews struct {
+	Slug  string
+	Title string
+	Views int
+}
+
+// Recorder stores note view counts by day. Implementations may keep them in
+// memory, Redis or the CMS; ViewCounter batches the writes in front of them.
+type Recorder interface {
+	RecordViews(ctx context.Context, day time.Time, views []ViewCount) error
+	// PopularNotes returns the notes read most in locale since the day of
+	// since, most read first.
+	PopularNotes(ctx context.Context, locale string, since time.Time, limit int) ([]NoteViews, error)
+}
+
+// ViewCounter counts note views without blocking the request that served
+// them. Views are buffered in memory and written to the recorder by Flush,
+// which Run calls periodically. A nil counter counts nothing.
+type ViewCounter struct {
+	recorder Recorder
+	logError func(err error)
+
+	mu      sync.Mutex
+	pending map[View]int
+}
+
+func NewViewCounter(recorder Recorder, logError func(err error)) *ViewCounter {
+	return &ViewCounter{
+		recorder: recorder,
+		logError: logError,
+		pending:  make(map[View]int),
+	}
+}
+
+// Count buffers one view of a note.
+func (counter *ViewCounter) Count(view View) {
+	if counter == nil {
+		return
+	}
+	view.Locale = strings.TrimSpace(view.Locale)
+	view.Slug = strings.TrimSpace(view.Slug)
+	view.Title = strings.TrimSpace(view.Title)
+	if view.Slug == "" {
+		return
+	}
+
+	counter.mu.Lock()
+	defer counter.mu.Unlock()
+	if _, ok := counter.pending[view]; !ok && len(counter.pending) >= maxPendingViews {
+		return
+	}
+	counter.pending[view]++
+}
+
+// Flush writes the buffered views to the recorder as views of today. Views
+// the recorder rejects are lost rather than retried, so a failing store
+// cannot grow the buffer.
+func (counter *ViewCounter) Flush(ctx context.Context) error {
+	if counter == nil {
+		return nil
+	}
+
+	counter.mu.Lock()
+	pending := counter.pending
+	counter.pending = make(map[View]int)
+	counter.mu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	views := make([]ViewCount, 0, len(pending))
+	for view, count := range pending {
+		views = append(views, ViewCount{View: view, Count: count})
+	}
+	if err := counter.recorder.RecordViews(ctx, time.Now().UTC(), views); err != nil {
+		return fmt.Errorf("record note views: %w", err)
+	}
+
+	return nil
+}
+
+// Run flushes every interval until ctx is done, then flushes once more.
+func (counter *ViewCounter) Run(ctx context.Context, interval time.Duration) {
+	if counter == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			counter.flushAndLog(context.WithoutCancel(ctx))
+			return
+		case <-ticker.C:
+			counter.flushAndLog(ctx)
+		}
+	}
+}
+
+func (counter *ViewCounter) flushAndLog(ctx context.Context) {
+	flushCtx, cancel := context.WithTimeout(ctx, emitTimeout)
+	defer cancel()
+
+	if err := counter.Flush(flushCtx); err != nil && counter.logError != nil {
+		counter.logError(err)
+	}
+}
+
+// PopularThisWeek returns up to limit notes read most in locale over the
+// last PopularWindow. Views still buffered are not included.
+func (counter *ViewCounter) PopularThisWeek(ctx context.Context, locale string, limit int) ([]NoteViews, error) {
+	if counter == nil || limit < 1 {
+		return nil, nil
+	}
+
+	return counter.recorder.PopularNotes(ctx, strings.TrimSpace(locale), time.Now().Add(-PopularWindow), limit)
+}
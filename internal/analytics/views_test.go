@@ -0,0 +1,113 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type failingRecorder struct {
+	*MemoryRecorder
+}
+
+func (failingRecorder) RecordViews(context.Context, time.Time, []ViewCount) error {
+	return errors.New("store down")
+}
+
+func TestViewCounterBuffersViewsUntilFlush(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	counter := NewViewCounter(NewMemoryRecorder(), nil)
+	for range 3 {
+		counter.Count(View{Locale: "en", Slug: "hello", Title: "Hello"})
+	}
+	counter.Count(View{Locale: "en", Slug: "second", Title: "Second"})
+	counter.Count(View{Locale: "de", Slug: "second", Title: "Zweite"})
+	counter.Count(View{Locale: "en", Slug: " "})
+
+	popular, err := counter.PopularThisWeek(ctx, "en", 5)
+	require.NoError(t, err)
+	require.Empty(t, popular, "buffered views are not visible before a flush")
+
+	require.NoError(t, counter.Flush(ctx))
+	popular, err = counter.PopularThisWeek(ctx, "en", 5)
+	require.NoError(t, err)
+	require.Equal(t, []NoteViews{
+		{Slug: "hello", Title: "Hello", Views: 3},
+		{Slug: "second", Title: "Second", Views: 1},
+	}, popular)
+
+	popular, err = counter.PopularThisWeek(ctx, "de", 1)
+	require.NoError(t, err)
+	require.Equal(t, []NoteViews{{Slug: "second", Title: "Zweite", Views: 1}}, popular)
+}
+
+func TestViewCounterDropsViewsTheRecorderRejects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	counter := NewViewCounter(failingRecorder{NewMemoryRecorder()}, nil)
+	counter.Count(View{Locale: "en", Slug: "hello"})
+	require.ErrorContains(t, counter.Flush(ctx), "store down")
+	require.Empty(t, counter.pending)
+
+	var nilCounter *ViewCounter
+	nilCounter.Count(View{Slug: "hello"})
+	require.NoError(t, nilCounter.Flush(ctx))
+	popular, err := nilCounter.PopularThisWeek(ctx, "en", 5)
+	require.NoError(t, err)
+	require.Empty(t, popular)
+}
+
+func TestViewCounterRunFlushesPeriodically(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	recorder := NewMemoryRecorder()
+	counter := NewViewCounter(recorder, nil)
+	done := make(chan struct{})
+	go func() {
+		counter.Run(ctx, 10*time.Millisecond)
+		close(done)
+	}()
+
+	counter.Count(View{Locale: "en", Slug: "hello", Title: "Hello"})
+	require.Eventually(t, func() bool {
+		popular, err := counter.PopularThisWeek(context.Background(), "en", 1)
+		return err == nil && len(popular) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+func TestMemoryRecorderCountsTheLastWeek(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	recorder := NewMemoryRecorder()
+	today := time.Date(2024, time.March, 10, 15, 0, 0, 0, time.UTC)
+	record := func(day time.Time, slug string, title string, count int) {
+		t.Helper()
+		views := []ViewCount{{View: View{Locale: "en", Slug: slug, Title: title}, Count: count}}
+		require.NoError(t, recorder.RecordViews(ctx, day, views))
+	}
+	record(today.AddDate(0, 0, -30), "old", "Old", 50)
+	record(today.AddDate(0, 0, -8), "hello", "Hello", 40)
+	record(today.AddDate(0, 0, -2), "hello", "Hello", 2)
+	record(today.AddDate(0, 0, -1), "renamed", "Before", 2)
+	record(today, "renamed", "After", 1)
+	record(today, "hello", "", 1)
+
+	popular, err := recorder.PopularNotes(ctx, "en", today.Add(-PopularWindow), 5)
+	require.NoError(t, err)
+	require.Equal(t, []NoteViews{
+		{Slug: "hello", Title: "Hello", Views: 3},
+		{Slug: "renamed", Title: "After", Views: 3},
+	}, popular)
+	require.Len(t, recorder.days, 4, "days past the retention are dropped")
+}
@@ -17,7 +17,11 @@ func NewClient(cfg config.Config) genqlientgraphql.Client {
 		},
 	}
 
-	return genqlientgraphql.NewClient(cfg.GraphQLEndpoint, client)
+	return WithRetry(genqlientgraphql.NewClient(cfg.GraphQLEndpoint, client), RetryConfig{
+		MaxAttempts:      cfg.GraphQLMaxAttempts,
+		BreakerThreshold: cfg.GraphQLBreakerThreshold,
+		BreakerCooldown:  cfg.GraphQLBreakerCooldown,
+	})
 }
 
 type authTransport struct {
@@ -9,6 +9,134 @@ import (
 	"github.com/Khan/genqlient/graphql"
 )
 
+// AdjacentNotesNewerMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type AdjacentNotesNewerMicro_posts struct {
+	Docs []AdjacentNotesNewerMicro_postsDocsMicro_post `json:"docs"`
+}
+
+// GetDocs returns AdjacentNotesNewerMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *AdjacentNotesNewerMicro_posts) GetDocs() []AdjacentNotesNewerMicro_postsDocsMicro_post {
+	return v.Docs
+}
+
+// AdjacentNotesNewerMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type AdjacentNotesNewerMicro_postsDocsMicro_post struct {
+	Id    string  `json:"id"`
+	Slug  *string `json:"slug"`
+	Title *string `json:"title"`
+}
+
+// GetId returns AdjacentNotesNewerMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *AdjacentNotesNewerMicro_postsDocsMicro_post) GetId() string { return v.Id }
+
+// GetSlug returns AdjacentNotesNewerMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *AdjacentNotesNewerMicro_postsDocsMicro_post) GetSlug() *string { return v.Slug }
+
+// GetTitle returns AdjacentNotesNewerMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *AdjacentNotesNewerMicro_postsDocsMicro_post) GetTitle() *string { return v.Title }
+
+// AdjacentNotesOlderMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type AdjacentNotesOlderMicro_posts struct {
+	Docs []AdjacentNotesOlderMicro_postsDocsMicro_post `json:"docs"`
+}
+
+// GetDocs returns AdjacentNotesOlderMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *AdjacentNotesOlderMicro_posts) GetDocs() []AdjacentNotesOlderMicro_postsDocsMicro_post {
+	return v.Docs
+}
+
+// AdjacentNotesOlderMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type AdjacentNotesOlderMicro_postsDocsMicro_post struct {
+	Id    string  `json:"id"`
+	Slug  *string `json:"slug"`
+	Title *string `json:"title"`
+}
+
+// GetId returns AdjacentNotesOlderMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *AdjacentNotesOlderMicro_postsDocsMicro_post) GetId() string { return v.Id }
+
+// GetSlug returns AdjacentNotesOlderMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *AdjacentNotesOlderMicro_postsDocsMicro_post) GetSlug() *string { return v.Slug }
+
+// GetTitle returns AdjacentNotesOlderMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *AdjacentNotesOlderMicro_postsDocsMicro_post) GetTitle() *string { return v.Title }
+
+// AdjacentNotesResponse is returned by AdjacentNotes on success.
+type AdjacentNotesResponse struct {
+	Newer *AdjacentNotesNewerMicro_posts `json:"newer"`
+	Older *AdjacentNotesOlderMicro_posts `json:"older"`
+}
+
+// GetNewer returns AdjacentNotesResponse.Newer, and is useful for accessing the field via an interface.
+func (v *AdjacentNotesResponse) GetNewer() *AdjacentNotesNewerMicro_posts { return v.Newer }
+
+// GetOlder returns AdjacentNotesResponse.Older, and is useful for accessing the field via an interface.
+func (v *AdjacentNotesResponse) GetOlder() *AdjacentNotesOlderMicro_posts { return v.Older }
+
+// ApprovedCommentsComments includes the requested fields of the GraphQL type Comments.
+type ApprovedCommentsComments struct {
+	Docs []ApprovedCommentsCommentsDocsComment `json:"docs"`
+}
+
+// GetDocs returns ApprovedCommentsComments.Docs, and is useful for accessing the field via an interface.
+func (v *ApprovedCommentsComments) GetDocs() []ApprovedCommentsCommentsDocsComment { return v.Docs }
+
+// ApprovedCommentsCommentsDocsComment includes the requested fields of the GraphQL type Comment.
+type ApprovedCommentsCommentsDocsComment struct {
+	Id         string  `json:"id"`
+	AuthorName *string `json:"authorName"`
+	Body       *string `json:"body"`
+	CreatedAt  *string `json:"createdAt"`
+}
+
+// GetId returns ApprovedCommentsCommentsDocsComment.Id, and is useful for accessing the field via an interface.
+func (v *ApprovedCommentsCommentsDocsComment) GetId() string { return v.Id }
+
+// GetAuthorName returns ApprovedCommentsCommentsDocsComment.AuthorName, and is useful for accessing the field via an interface.
+func (v *ApprovedCommentsCommentsDocsComment) GetAuthorName() *string { return v.AuthorName }
+
+// GetBody returns ApprovedCommentsCommentsDocsComment.Body, and is useful for accessing the field via an interface.
+func (v *ApprovedCommentsCommentsDocsComment) GetBody() *string { return v.Body }
+
+// GetCreatedAt returns ApprovedCommentsCommentsDocsComment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ApprovedCommentsCommentsDocsComment) GetCreatedAt() *string { return v.CreatedAt }
+
+// ApprovedCommentsResponse is returned by ApprovedComments on success.
+type ApprovedCommentsResponse struct {
+	Comments *ApprovedCommentsComments `json:"Comments"`
+}
+
+// GetComments returns ApprovedCommentsResponse.Comments, and is useful for accessing the field via an interface.
+func (v *ApprovedCommentsResponse) GetComments() *ApprovedCommentsComments { return v.Comments }
+
+// ArchiveDatesMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type ArchiveDatesMicro_posts struct {
+	TotalPages int                                     `json:"totalPages"`
+	Docs       []ArchiveDatesMicro_postsDocsMicro_post `json:"docs"`
+}
+
+// GetTotalPages returns ArchiveDatesMicro_posts.TotalPages, and is useful for accessing the field via an interface.
+func (v *ArchiveDatesMicro_posts) GetTotalPages() int { return v.TotalPages }
+
+// GetDocs returns ArchiveDatesMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *ArchiveDatesMicro_posts) GetDocs() []ArchiveDatesMicro_postsDocsMicro_post { return v.Docs }
+
+// ArchiveDatesMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type ArchiveDatesMicro_postsDocsMicro_post struct {
+	PublishedAt *string `json:"publishedAt"`
+}
+
+// GetPublishedAt returns ArchiveDatesMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
+func (v *ArchiveDatesMicro_postsDocsMicro_post) GetPublishedAt() *string { return v.PublishedAt }
+
+// ArchiveDatesResponse is returned by ArchiveDates on success.
+type ArchiveDatesResponse struct {
+	Micro_posts *ArchiveDatesMicro_posts `json:"Micro_posts"`
+}
+
+// GetMicro_posts returns ArchiveDatesResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *ArchiveDatesResponse) GetMicro_posts() *ArchiveDatesMicro_posts { return v.Micro_posts }
+
 // AuthorBySlugAuthors includes the requested fields of the GraphQL type Authors.
 type AuthorBySlugAuthors struct {
 	Docs []AuthorBySlugAuthorsDocsAuthor `json:"docs"`
@@ -19,11 +147,14 @@ func (v *AuthorBySlugAuthors) GetDocs() []AuthorBySlugAuthorsDocsAuthor { return
 
 // AuthorBySlugAuthorsDocsAuthor includes the requested fields of the GraphQL type Author.
 type AuthorBySlugAuthorsDocsAuthor struct {
-	Id     string                                    `json:"id"`
-	Name   *string                                   `json:"name"`
-	Slug   string                                    `json:"slug"`
-	Bio    *string                                   `json:"bio"`
-	Avatar *AuthorBySlugAuthorsDocsAuthorAvatarMedia `json:"avatar"`
+	Id       string                                               `json:"id"`
+	Name     *string                                              `json:"name"`
+	Slug     string                                               `json:"slug"`
+	Bio      *string                                              `json:"bio"`
+	Avatar   *AuthorBySlugAuthorsDocsAuthorAvatarMedia            `json:"avatar"`
+	Website  *string                                              `json:"website"`
+	Location *string                                              `json:"location"`
+	Socials  []AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials `json:"socials"`
 }
 
 // GetId returns AuthorBySlugAuthorsDocsAuthor.Id, and is useful for accessing the field via an interface.
@@ -43,6 +174,17 @@ func (v *AuthorBySlugAuthorsDocsAuthor) GetAvatar() *AuthorBySlugAuthorsDocsAuth
 	return v.Avatar
 }
 
+// GetWebsite returns AuthorBySlugAuthorsDocsAuthor.Website, and is useful for accessing the field via an interface.
+func (v *AuthorBySlugAuthorsDocsAuthor) GetWebsite() *string { return v.Website }
+
+// GetLocation returns AuthorBySlugAuthorsDocsAuthor.Location, and is useful for accessing the field via an interface.
+func (v *AuthorBySlugAuthorsDocsAuthor) GetLocation() *string { return v.Location }
+
+// GetSocials returns AuthorBySlugAuthorsDocsAuthor.Socials, and is useful for accessing the field via an interface.
+func (v *AuthorBySlugAuthorsDocsAuthor) GetSocials() []AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials {
+	return v.Socials
+}
+
 // AuthorBySlugAuthorsDocsAuthorAvatarMedia includes the requested fields of the GraphQL type Media.
 type AuthorBySlugAuthorsDocsAuthorAvatarMedia struct {
 	Url    *string  `json:"url"`
@@ -63,6 +205,26 @@ func (v *AuthorBySlugAuthorsDocsAuthorAvatarMedia) GetWidth() *float64 { return
 // GetHeight returns AuthorBySlugAuthorsDocsAuthorAvatarMedia.Height, and is useful for accessing the field via an interface.
 func (v *AuthorBySlugAuthorsDocsAuthorAvatarMedia) GetHeight() *float64 { return v.Height }
 
+// AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials includes the requested fields of the GraphQL type Author_Socials.
+type AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials struct {
+	Id       *string `json:"id"`
+	Platform *string `json:"platform"`
+	Handle   *string `json:"handle"`
+	Url      *string `json:"url"`
+}
+
+// GetId returns AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials.Id, and is useful for accessing the field via an interface.
+func (v *AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials) GetId() *string { return v.Id }
+
+// GetPlatform returns AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials.Platform, and is useful for accessing the field via an interface.
+func (v *AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials) GetPlatform() *string { return v.Platform }
+
+// GetHandle returns AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials.Handle, and is useful for accessing the field via an interface.
+func (v *AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials) GetHandle() *string { return v.Handle }
+
+// GetUrl returns AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials.Url, and is useful for accessing the field via an interface.
+func (v *AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials) GetUrl() *string { return v.Url }
+
 // AuthorBySlugResponse is returned by AuthorBySlug on success.
 type AuthorBySlugResponse struct {
 	Authors *AuthorBySlugAuthors `json:"Authors"`
@@ -159,6 +321,102 @@ func (v *AvailableTagsByPostTypeResponse) GetAvailableTagsByMicroPostType() []Av
 	return v.AvailableTagsByMicroPostType
 }
 
+// ChannelCountsMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type ChannelCountsMicro_posts struct {
+	TotalPages int                                      `json:"totalPages"`
+	Docs       []ChannelCountsMicro_postsDocsMicro_post `json:"docs"`
+}
+
+// GetTotalPages returns ChannelCountsMicro_posts.TotalPages, and is useful for accessing the field via an interface.
+func (v *ChannelCountsMicro_posts) GetTotalPages() int { return v.TotalPages }
+
+// GetDocs returns ChannelCountsMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *ChannelCountsMicro_posts) GetDocs() []ChannelCountsMicro_postsDocsMicro_post { return v.Docs }
+
+// ChannelCountsMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type ChannelCountsMicro_postsDocsMicro_post struct {
+	Post_type *Micro_post_post_type                                 `json:"post_type"`
+	Authors   []ChannelCountsMicro_postsDocsMicro_postAuthorsAuthor `json:"authors"`
+	Tags      []ChannelCountsMicro_postsDocsMicro_postTagsTag       `json:"tags"`
+}
+
+// GetPost_type returns ChannelCountsMicro_postsDocsMicro_post.Post_type, and is useful for accessing the field via an interface.
+func (v *ChannelCountsMicro_postsDocsMicro_post) GetPost_type() *Micro_post_post_type {
+	return v.Post_type
+}
+
+// GetAuthors returns ChannelCountsMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *ChannelCountsMicro_postsDocsMicro_post) GetAuthors() []ChannelCountsMicro_postsDocsMicro_postAuthorsAuthor {
+	return v.Authors
+}
+
+// GetTags returns ChannelCountsMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *ChannelCountsMicro_postsDocsMicro_post) GetTags() []ChannelCountsMicro_postsDocsMicro_postTagsTag {
+	return v.Tags
+}
+
+// ChannelCountsMicro_postsDocsMicro_postAuthorsAuthor includes the requested fields of the GraphQL type Author.
+type ChannelCountsMicro_postsDocsMicro_postAuthorsAuthor struct {
+	Slug string `json:"slug"`
+}
+
+// GetSlug returns ChannelCountsMicro_postsDocsMicro_postAuthorsAuthor.Slug, and is useful for accessing the field via an interface.
+func (v *ChannelCountsMicro_postsDocsMicro_postAuthorsAuthor) GetSlug() string { return v.Slug }
+
+// ChannelCountsMicro_postsDocsMicro_postTagsTag includes the requested fields of the GraphQL type Tag.
+type ChannelCountsMicro_postsDocsMicro_postTagsTag struct {
+	Name string `json:"name"`
+}
+
+// GetName returns ChannelCountsMicro_postsDocsMicro_postTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *ChannelCountsMicro_postsDocsMicro_postTagsTag) GetName() string { return v.Name }
+
+// ChannelCountsResponse is returned by ChannelCounts on success.
+type ChannelCountsResponse struct {
+	Micro_posts *ChannelCountsMicro_posts `json:"Micro_posts"`
+}
+
+// GetMicro_posts returns ChannelCountsResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *ChannelCountsResponse) GetMicro_posts() *ChannelCountsMicro_posts { return v.Micro_posts }
+
+type Comment_status string
+
+const (
+	Comment_statusPending  Comment_status = "pending"
+	Comment_statusApproved Comment_status = "approved"
+	Comment_statusRejected Comment_status = "rejected"
+	Comment_statusSpam     Comment_status = "spam"
+)
+
+var AllComment_status = []Comment_status{
+	Comment_statusPending,
+	Comment_statusApproved,
+	Comment_statusRejected,
+	Comment_statusSpam,
+}
+
+// CreateCommentCreateComment includes the requested fields of the GraphQL type Comment.
+type CreateCommentCreateComment struct {
+	Id     string          `json:"id"`
+	Status *Comment_status `json:"status"`
+}
+
+// GetId returns CreateCommentCreateComment.Id, and is useful for accessing the field via an interface.
+func (v *CreateCommentCreateComment) GetId() string { return v.Id }
+
+// GetStatus returns CreateCommentCreateComment.Status, and is useful for accessing the field via an interface.
+func (v *CreateCommentCreateComment) GetStatus() *Comment_status { return v.Status }
+
+// CreateCommentResponse is returned by CreateComment on success.
+type CreateCommentResponse struct {
+	CreateComment *CreateCommentCreateComment `json:"createComment"`
+}
+
+// GetCreateComment returns CreateCommentResponse.CreateComment, and is useful for accessing the field via an interface.
+func (v *CreateCommentResponse) GetCreateComment() *CreateCommentCreateComment {
+	return v.CreateComment
+}
+
 type FallbackLocaleInputType string
 
 const (
@@ -185,89 +443,86 @@ var AllFallbackLocaleInputType = []FallbackLocaleInputType{
 	FallbackLocaleInputTypeNone,
 }
 
-// ListNotesByAuthorAndTagIDsMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type ListNotesByAuthorAndTagIDsMicro_posts struct {
-	TotalPages int                                                   `json:"totalPages"`
-	Docs       []ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post `json:"docs"`
+// ListFeaturedNotesMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type ListFeaturedNotesMicro_posts struct {
+	Docs []ListFeaturedNotesMicro_postsDocsMicro_post `json:"docs"`
 }
 
-// GetTotalPages returns ListNotesByAuthorAndTagIDsMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_posts) GetTotalPages() int { return v.TotalPages }
-
-// GetDocs returns ListNotesByAuthorAndTagIDsMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_posts) GetDocs() []ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post {
+// GetDocs returns ListFeaturedNotesMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_posts) GetDocs() []ListFeaturedNotesMicro_postsDocsMicro_post {
 	return v.Docs
 }
 
-// ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post struct {
+// ListFeaturedNotesMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type ListFeaturedNotesMicro_postsDocsMicro_post struct {
 	NoteListDoc `json:"-"`
 }
 
-// GetId returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+// GetId returns ListFeaturedNotesMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
 
-// GetSlug returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetSlug() *string {
-	return v.NoteListDoc.Slug
-}
+// GetSlug returns ListFeaturedNotesMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
 
-// GetTitle returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetTitle() *string {
-	return v.NoteListDoc.Title
-}
+// GetTitle returns ListFeaturedNotesMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
 
-// GetContent returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetContent() *string {
+// GetContent returns ListFeaturedNotesMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetContent() *string {
 	return v.NoteListDoc.Content
 }
 
-// GetPublishedAt returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetPublishedAt() *string {
+// GetPublishedAt returns ListFeaturedNotesMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetPublishedAt() *string {
 	return v.NoteListDoc.PublishedAt
 }
 
-// GetAuthors returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
+// GetFeatured returns ListFeaturedNotesMicro_postsDocsMicro_post.Featured, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetFeatured() *bool {
+	return v.NoteListDoc.Featured
+}
+
+// GetAuthors returns ListFeaturedNotesMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
 	return v.NoteListDoc.Authors
 }
 
-// GetTags returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
+// GetTags returns ListFeaturedNotesMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
 	return v.NoteListDoc.Tags
 }
 
-// GetAttachment returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
+// GetAttachment returns ListFeaturedNotesMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
 	return v.NoteListDoc.Attachment
 }
 
-// GetExternalLinks returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
+// GetExternalLinks returns ListFeaturedNotesMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
 	return v.NoteListDoc.ExternalLinks
 }
 
-// GetLinkedMicroPosts returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
+// GetLinkedMicroPosts returns ListFeaturedNotesMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
 	return v.NoteListDoc.LinkedMicroPosts
 }
 
-// GetMeta returns ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
+// GetMeta returns ListFeaturedNotesMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
 	return v.NoteListDoc.Meta
 }
 
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post
+		*ListFeaturedNotesMicro_postsDocsMicro_post
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post = v
+	firstPass.ListFeaturedNotesMicro_postsDocsMicro_post = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -282,7 +537,7 @@ func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) UnmarshalJSON(b []
 	return nil
 }
 
-type __premarshalListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post struct {
+type __premarshalListFeaturedNotesMicro_postsDocsMicro_post struct {
 	Id string `json:"id"`
 
 	Slug *string `json:"slug"`
@@ -293,6 +548,8 @@ type __premarshalListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post struct {
 
 	PublishedAt *string `json:"publishedAt"`
 
+	Featured *bool `json:"featured"`
+
 	Authors []NoteListDocAuthorsAuthor `json:"authors"`
 
 	Tags []NoteListDocTagsTag `json:"tags"`
@@ -306,7 +563,7 @@ type __premarshalListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post struct {
 	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
 }
 
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -314,14 +571,15 @@ func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) MarshalJSON() ([]b
 	return json.Marshal(premarshaled)
 }
 
-func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post, error) {
-	var retval __premarshalListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post
+func (v *ListFeaturedNotesMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalListFeaturedNotesMicro_postsDocsMicro_post, error) {
+	var retval __premarshalListFeaturedNotesMicro_postsDocsMicro_post
 
 	retval.Id = v.NoteListDoc.Id
 	retval.Slug = v.NoteListDoc.Slug
 	retval.Title = v.NoteListDoc.Title
 	retval.Content = v.NoteListDoc.Content
 	retval.PublishedAt = v.NoteListDoc.PublishedAt
+	retval.Featured = v.NoteListDoc.Featured
 	retval.Authors = v.NoteListDoc.Authors
 	retval.Tags = v.NoteListDoc.Tags
 	retval.Attachment = v.NoteListDoc.Attachment
@@ -331,101 +589,94 @@ func (v *ListNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) __premarshalJSON()
 	return &retval, nil
 }
 
-// ListNotesByAuthorAndTagIDsResponse is returned by ListNotesByAuthorAndTagIDs on success.
-type ListNotesByAuthorAndTagIDsResponse struct {
-	Micro_posts *ListNotesByAuthorAndTagIDsMicro_posts `json:"Micro_posts"`
+// ListFeaturedNotesResponse is returned by ListFeaturedNotes on success.
+type ListFeaturedNotesResponse struct {
+	Micro_posts *ListFeaturedNotesMicro_posts `json:"Micro_posts"`
 }
 
-// GetMicro_posts returns ListNotesByAuthorAndTagIDsResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorAndTagIDsResponse) GetMicro_posts() *ListNotesByAuthorAndTagIDsMicro_posts {
+// GetMicro_posts returns ListFeaturedNotesResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *ListFeaturedNotesResponse) GetMicro_posts() *ListFeaturedNotesMicro_posts {
 	return v.Micro_posts
 }
 
-// ListNotesByAuthorTagIDsAndTypeMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type ListNotesByAuthorTagIDsAndTypeMicro_posts struct {
-	TotalPages int                                                       `json:"totalPages"`
-	Docs       []ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post `json:"docs"`
+// ListNotesMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type ListNotesMicro_posts struct {
+	TotalPages int                                  `json:"totalPages"`
+	Docs       []ListNotesMicro_postsDocsMicro_post `json:"docs"`
 }
 
-// GetTotalPages returns ListNotesByAuthorTagIDsAndTypeMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_posts) GetTotalPages() int { return v.TotalPages }
+// GetTotalPages returns ListNotesMicro_posts.TotalPages, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_posts) GetTotalPages() int { return v.TotalPages }
 
-// GetDocs returns ListNotesByAuthorTagIDsAndTypeMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_posts) GetDocs() []ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post {
-	return v.Docs
-}
+// GetDocs returns ListNotesMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_posts) GetDocs() []ListNotesMicro_postsDocsMicro_post { return v.Docs }
 
-// ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post struct {
+// ListNotesMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type ListNotesMicro_postsDocsMicro_post struct {
 	NoteListDoc `json:"-"`
 }
 
-// GetId returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetId() string {
-	return v.NoteListDoc.Id
-}
+// GetId returns ListNotesMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
 
-// GetSlug returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetSlug() *string {
-	return v.NoteListDoc.Slug
-}
+// GetSlug returns ListNotesMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
 
-// GetTitle returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetTitle() *string {
-	return v.NoteListDoc.Title
-}
+// GetTitle returns ListNotesMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
 
-// GetContent returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetContent() *string {
-	return v.NoteListDoc.Content
-}
+// GetContent returns ListNotesMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetContent() *string { return v.NoteListDoc.Content }
 
-// GetPublishedAt returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetPublishedAt() *string {
+// GetPublishedAt returns ListNotesMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetPublishedAt() *string {
 	return v.NoteListDoc.PublishedAt
 }
 
-// GetAuthors returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
+// GetFeatured returns ListNotesMicro_postsDocsMicro_post.Featured, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetFeatured() *bool { return v.NoteListDoc.Featured }
+
+// GetAuthors returns ListNotesMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
 	return v.NoteListDoc.Authors
 }
 
-// GetTags returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
+// GetTags returns ListNotesMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
 	return v.NoteListDoc.Tags
 }
 
-// GetAttachment returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
+// GetAttachment returns ListNotesMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
 	return v.NoteListDoc.Attachment
 }
 
-// GetExternalLinks returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
+// GetExternalLinks returns ListNotesMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
 	return v.NoteListDoc.ExternalLinks
 }
 
-// GetLinkedMicroPosts returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
+// GetLinkedMicroPosts returns ListNotesMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
 	return v.NoteListDoc.LinkedMicroPosts
 }
 
-// GetMeta returns ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
+// GetMeta returns ListNotesMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
+func (v *ListNotesMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
 	return v.NoteListDoc.Meta
 }
 
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+func (v *ListNotesMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post
+		*ListNotesMicro_postsDocsMicro_post
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post = v
+	firstPass.ListNotesMicro_postsDocsMicro_post = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -440,7 +691,7 @@ func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) UnmarshalJSON(
 	return nil
 }
 
-type __premarshalListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post struct {
+type __premarshalListNotesMicro_postsDocsMicro_post struct {
 	Id string `json:"id"`
 
 	Slug *string `json:"slug"`
@@ -451,6 +702,8 @@ type __premarshalListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post struct
 
 	PublishedAt *string `json:"publishedAt"`
 
+	Featured *bool `json:"featured"`
+
 	Authors []NoteListDocAuthorsAuthor `json:"authors"`
 
 	Tags []NoteListDocTagsTag `json:"tags"`
@@ -464,7 +717,7 @@ type __premarshalListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post struct
 	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
 }
 
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
+func (v *ListNotesMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -472,14 +725,15 @@ func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) MarshalJSON()
 	return json.Marshal(premarshaled)
 }
 
-func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post, error) {
-	var retval __premarshalListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post
+func (v *ListNotesMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalListNotesMicro_postsDocsMicro_post, error) {
+	var retval __premarshalListNotesMicro_postsDocsMicro_post
 
 	retval.Id = v.NoteListDoc.Id
 	retval.Slug = v.NoteListDoc.Slug
 	retval.Title = v.NoteListDoc.Title
 	retval.Content = v.NoteListDoc.Content
 	retval.PublishedAt = v.NoteListDoc.PublishedAt
+	retval.Featured = v.NoteListDoc.Featured
 	retval.Authors = v.NoteListDoc.Authors
 	retval.Tags = v.NoteListDoc.Tags
 	retval.Attachment = v.NoteListDoc.Attachment
@@ -489,99 +743,154 @@ func (v *ListNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) __premarshalJS
 	return &retval, nil
 }
 
-// ListNotesByAuthorTagIDsAndTypeResponse is returned by ListNotesByAuthorTagIDsAndType on success.
-type ListNotesByAuthorTagIDsAndTypeResponse struct {
-	Micro_posts *ListNotesByAuthorTagIDsAndTypeMicro_posts `json:"Micro_posts"`
+// ListNotesPageAuthors includes the requested fields of the GraphQL type Authors.
+type ListNotesPageAuthors struct {
+	Docs []ListNotesPageAuthorsDocsAuthor `json:"docs"`
 }
 
-// GetMicro_posts returns ListNotesByAuthorTagIDsAndTypeResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *ListNotesByAuthorTagIDsAndTypeResponse) GetMicro_posts() *ListNotesByAuthorTagIDsAndTypeMicro_posts {
-	return v.Micro_posts
-}
+// GetDocs returns ListNotesPageAuthors.Docs, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAuthors) GetDocs() []ListNotesPageAuthorsDocsAuthor { return v.Docs }
 
-// ListNotesByTagIDsAndTypeMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type ListNotesByTagIDsAndTypeMicro_posts struct {
-	TotalPages int                                                 `json:"totalPages"`
-	Docs       []ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post `json:"docs"`
+// ListNotesPageAuthorsDocsAuthor includes the requested fields of the GraphQL type Author.
+type ListNotesPageAuthorsDocsAuthor struct {
+	Id     string                                     `json:"id"`
+	Name   *string                                    `json:"name"`
+	Slug   string                                     `json:"slug"`
+	Bio    *string                                    `json:"bio"`
+	Avatar *ListNotesPageAuthorsDocsAuthorAvatarMedia `json:"avatar"`
 }
 
-// GetTotalPages returns ListNotesByTagIDsAndTypeMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_posts) GetTotalPages() int { return v.TotalPages }
+// GetId returns ListNotesPageAuthorsDocsAuthor.Id, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAuthorsDocsAuthor) GetId() string { return v.Id }
 
-// GetDocs returns ListNotesByTagIDsAndTypeMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_posts) GetDocs() []ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post {
-	return v.Docs
+// GetName returns ListNotesPageAuthorsDocsAuthor.Name, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAuthorsDocsAuthor) GetName() *string { return v.Name }
+
+// GetSlug returns ListNotesPageAuthorsDocsAuthor.Slug, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAuthorsDocsAuthor) GetSlug() string { return v.Slug }
+
+// GetBio returns ListNotesPageAuthorsDocsAuthor.Bio, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAuthorsDocsAuthor) GetBio() *string { return v.Bio }
+
+// GetAvatar returns ListNotesPageAuthorsDocsAuthor.Avatar, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAuthorsDocsAuthor) GetAvatar() *ListNotesPageAuthorsDocsAuthorAvatarMedia {
+	return v.Avatar
 }
 
-// ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
+// ListNotesPageAuthorsDocsAuthorAvatarMedia includes the requested fields of the GraphQL type Media.
+type ListNotesPageAuthorsDocsAuthorAvatarMedia struct {
+	Url    *string  `json:"url"`
+	Alt    *string  `json:"alt"`
+	Width  *float64 `json:"width"`
+	Height *float64 `json:"height"`
 }
 
-// GetId returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+// GetUrl returns ListNotesPageAuthorsDocsAuthorAvatarMedia.Url, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAuthorsDocsAuthorAvatarMedia) GetUrl() *string { return v.Url }
+
+// GetAlt returns ListNotesPageAuthorsDocsAuthorAvatarMedia.Alt, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAuthorsDocsAuthorAvatarMedia) GetAlt() *string { return v.Alt }
+
+// GetWidth returns ListNotesPageAuthorsDocsAuthorAvatarMedia.Width, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAuthorsDocsAuthorAvatarMedia) GetWidth() *float64 { return v.Width }
 
-// GetSlug returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetSlug() *string {
-	return v.NoteListDoc.Slug
+// GetHeight returns ListNotesPageAuthorsDocsAuthorAvatarMedia.Height, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAuthorsDocsAuthorAvatarMedia) GetHeight() *float64 { return v.Height }
+
+// ListNotesPageAvailableTagsByMicroPostTypeTag includes the requested fields of the GraphQL type Tag.
+type ListNotesPageAvailableTagsByMicroPostTypeTag struct {
+	Id    string  `json:"id"`
+	Name  string  `json:"name"`
+	Title *string `json:"title"`
 }
 
-// GetTitle returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetTitle() *string {
-	return v.NoteListDoc.Title
+// GetId returns ListNotesPageAvailableTagsByMicroPostTypeTag.Id, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAvailableTagsByMicroPostTypeTag) GetId() string { return v.Id }
+
+// GetName returns ListNotesPageAvailableTagsByMicroPostTypeTag.Name, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAvailableTagsByMicroPostTypeTag) GetName() string { return v.Name }
+
+// GetTitle returns ListNotesPageAvailableTagsByMicroPostTypeTag.Title, and is useful for accessing the field via an interface.
+func (v *ListNotesPageAvailableTagsByMicroPostTypeTag) GetTitle() *string { return v.Title }
+
+// ListNotesPageMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type ListNotesPageMicro_posts struct {
+	TotalPages int                                      `json:"totalPages"`
+	Docs       []ListNotesPageMicro_postsDocsMicro_post `json:"docs"`
 }
 
-// GetContent returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetContent() *string {
-	return v.NoteListDoc.Content
+// GetTotalPages returns ListNotesPageMicro_posts.TotalPages, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_posts) GetTotalPages() int { return v.TotalPages }
+
+// GetDocs returns ListNotesPageMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_posts) GetDocs() []ListNotesPageMicro_postsDocsMicro_post { return v.Docs }
+
+// ListNotesPageMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type ListNotesPageMicro_postsDocsMicro_post struct {
+	NoteListDoc `json:"-"`
 }
 
-// GetPublishedAt returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetPublishedAt() *string {
+// GetId returns ListNotesPageMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+
+// GetSlug returns ListNotesPageMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
+
+// GetTitle returns ListNotesPageMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
+
+// GetContent returns ListNotesPageMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetContent() *string { return v.NoteListDoc.Content }
+
+// GetPublishedAt returns ListNotesPageMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetPublishedAt() *string {
 	return v.NoteListDoc.PublishedAt
 }
 
-// GetAuthors returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
+// GetFeatured returns ListNotesPageMicro_postsDocsMicro_post.Featured, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetFeatured() *bool { return v.NoteListDoc.Featured }
+
+// GetAuthors returns ListNotesPageMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
 	return v.NoteListDoc.Authors
 }
 
-// GetTags returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
+// GetTags returns ListNotesPageMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
 	return v.NoteListDoc.Tags
 }
 
-// GetAttachment returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
+// GetAttachment returns ListNotesPageMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
 	return v.NoteListDoc.Attachment
 }
 
-// GetExternalLinks returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
+// GetExternalLinks returns ListNotesPageMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
 	return v.NoteListDoc.ExternalLinks
 }
 
-// GetLinkedMicroPosts returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
+// GetLinkedMicroPosts returns ListNotesPageMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
 	return v.NoteListDoc.LinkedMicroPosts
 }
 
-// GetMeta returns ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
+// GetMeta returns ListNotesPageMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
+func (v *ListNotesPageMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
 	return v.NoteListDoc.Meta
 }
 
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+func (v *ListNotesPageMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post
+		*ListNotesPageMicro_postsDocsMicro_post
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post = v
+	firstPass.ListNotesPageMicro_postsDocsMicro_post = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -596,7 +905,7 @@ func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []by
 	return nil
 }
 
-type __premarshalListNotesByTagIDsAndTypeMicro_postsDocsMicro_post struct {
+type __premarshalListNotesPageMicro_postsDocsMicro_post struct {
 	Id string `json:"id"`
 
 	Slug *string `json:"slug"`
@@ -607,6 +916,8 @@ type __premarshalListNotesByTagIDsAndTypeMicro_postsDocsMicro_post struct {
 
 	PublishedAt *string `json:"publishedAt"`
 
+	Featured *bool `json:"featured"`
+
 	Authors []NoteListDocAuthorsAuthor `json:"authors"`
 
 	Tags []NoteListDocTagsTag `json:"tags"`
@@ -620,7 +931,7 @@ type __premarshalListNotesByTagIDsAndTypeMicro_postsDocsMicro_post struct {
 	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
 }
 
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
+func (v *ListNotesPageMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -628,14 +939,15 @@ func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byt
 	return json.Marshal(premarshaled)
 }
 
-func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalListNotesByTagIDsAndTypeMicro_postsDocsMicro_post, error) {
-	var retval __premarshalListNotesByTagIDsAndTypeMicro_postsDocsMicro_post
+func (v *ListNotesPageMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalListNotesPageMicro_postsDocsMicro_post, error) {
+	var retval __premarshalListNotesPageMicro_postsDocsMicro_post
 
 	retval.Id = v.NoteListDoc.Id
 	retval.Slug = v.NoteListDoc.Slug
 	retval.Title = v.NoteListDoc.Title
 	retval.Content = v.NoteListDoc.Content
 	retval.PublishedAt = v.NoteListDoc.PublishedAt
+	retval.Featured = v.NoteListDoc.Featured
 	retval.Authors = v.NoteListDoc.Authors
 	retval.Tags = v.NoteListDoc.Tags
 	retval.Attachment = v.NoteListDoc.Attachment
@@ -645,245 +957,400 @@ func (v *ListNotesByTagIDsAndTypeMicro_postsDocsMicro_post) __premarshalJSON() (
 	return &retval, nil
 }
 
-// ListNotesByTagIDsAndTypeResponse is returned by ListNotesByTagIDsAndType on success.
-type ListNotesByTagIDsAndTypeResponse struct {
-	Micro_posts *ListNotesByTagIDsAndTypeMicro_posts `json:"Micro_posts"`
-}
-
-// GetMicro_posts returns ListNotesByTagIDsAndTypeResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsAndTypeResponse) GetMicro_posts() *ListNotesByTagIDsAndTypeMicro_posts {
-	return v.Micro_posts
+// ListNotesPageResponse is returned by ListNotesPage on success.
+type ListNotesPageResponse struct {
+	Micro_posts                  *ListNotesPageMicro_posts                      `json:"Micro_posts"`
+	Authors                      *ListNotesPageAuthors                          `json:"Authors"`
+	AvailableTagsByMicroPostType []ListNotesPageAvailableTagsByMicroPostTypeTag `json:"availableTagsByMicroPostType"`
 }
 
-// ListNotesByTagIDsMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type ListNotesByTagIDsMicro_posts struct {
-	TotalPages int                                          `json:"totalPages"`
-	Docs       []ListNotesByTagIDsMicro_postsDocsMicro_post `json:"docs"`
-}
+// GetMicro_posts returns ListNotesPageResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *ListNotesPageResponse) GetMicro_posts() *ListNotesPageMicro_posts { return v.Micro_posts }
 
-// GetTotalPages returns ListNotesByTagIDsMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_posts) GetTotalPages() int { return v.TotalPages }
+// GetAuthors returns ListNotesPageResponse.Authors, and is useful for accessing the field via an interface.
+func (v *ListNotesPageResponse) GetAuthors() *ListNotesPageAuthors { return v.Authors }
 
-// GetDocs returns ListNotesByTagIDsMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_posts) GetDocs() []ListNotesByTagIDsMicro_postsDocsMicro_post {
-	return v.Docs
+// GetAvailableTagsByMicroPostType returns ListNotesPageResponse.AvailableTagsByMicroPostType, and is useful for accessing the field via an interface.
+func (v *ListNotesPageResponse) GetAvailableTagsByMicroPostType() []ListNotesPageAvailableTagsByMicroPostTypeTag {
+	return v.AvailableTagsByMicroPostType
 }
 
-// ListNotesByTagIDsMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type ListNotesByTagIDsMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
+// ListNotesResponse is returned by ListNotes on success.
+type ListNotesResponse struct {
+	Micro_posts *ListNotesMicro_posts `json:"Micro_posts"`
 }
 
-// GetId returns ListNotesByTagIDsMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+// GetMicro_posts returns ListNotesResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *ListNotesResponse) GetMicro_posts() *ListNotesMicro_posts { return v.Micro_posts }
 
-// GetSlug returns ListNotesByTagIDsMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
+type LocaleInputType string
 
-// GetTitle returns ListNotesByTagIDsMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
+const (
+	LocaleInputTypeEnUs LocaleInputType = "en_US"
+	LocaleInputTypeUkUa LocaleInputType = "uk_UA"
+	LocaleInputTypeDeDe LocaleInputType = "de_DE"
+	LocaleInputTypeHiIn LocaleInputType = "hi_IN"
+	LocaleInputTypeJaJp LocaleInputType = "ja_JP"
+	LocaleInputTypeRuRu LocaleInputType = "ru_RU"
+	LocaleInputTypeFrFr LocaleInputType = "fr_FR"
+	LocaleInputTypeEsEs LocaleInputType = "es_ES"
+)
 
-// GetContent returns ListNotesByTagIDsMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetContent() *string {
-	return v.NoteListDoc.Content
+var AllLocaleInputType = []LocaleInputType{
+	LocaleInputTypeEnUs,
+	LocaleInputTypeUkUa,
+	LocaleInputTypeDeDe,
+	LocaleInputTypeHiIn,
+	LocaleInputTypeJaJp,
+	LocaleInputTypeRuRu,
+	LocaleInputTypeFrFr,
+	LocaleInputTypeEsEs,
 }
 
-// GetPublishedAt returns ListNotesByTagIDsMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetPublishedAt() *string {
-	return v.NoteListDoc.PublishedAt
-}
+type Micro_post_post_type string
 
-// GetAuthors returns ListNotesByTagIDsMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
-	return v.NoteListDoc.Authors
-}
+const (
+	Micro_post_post_typeShort Micro_post_post_type = "short"
+	Micro_post_post_typeLong  Micro_post_post_type = "long"
+)
 
-// GetTags returns ListNotesByTagIDsMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
-	return v.NoteListDoc.Tags
+var AllMicro_post_post_type = []Micro_post_post_type{
+	Micro_post_post_typeShort,
+	Micro_post_post_typeLong,
 }
 
-// GetAttachment returns ListNotesByTagIDsMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
-	return v.NoteListDoc.Attachment
-}
+type Micro_post_post_type_Input string
 
-// GetExternalLinks returns ListNotesByTagIDsMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
-	return v.NoteListDoc.ExternalLinks
-}
+const (
+	Micro_post_post_type_InputShort Micro_post_post_type_Input = "short"
+	Micro_post_post_type_InputLong  Micro_post_post_type_Input = "long"
+)
 
-// GetLinkedMicroPosts returns ListNotesByTagIDsMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
-	return v.NoteListDoc.LinkedMicroPosts
+var AllMicro_post_post_type_Input = []Micro_post_post_type_Input{
+	Micro_post_post_type_InputShort,
+	Micro_post_post_type_InputLong,
 }
 
-// GetMeta returns ListNotesByTagIDsMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
-	return v.NoteListDoc.Meta
+// NoteBySlugMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type NoteBySlugMicro_posts struct {
+	Docs []NoteBySlugMicro_postsDocsMicro_post `json:"docs"`
 }
 
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*ListNotesByTagIDsMicro_postsDocsMicro_post
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.ListNotesByTagIDsMicro_postsDocsMicro_post = v
+// GetDocs returns NoteBySlugMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_posts) GetDocs() []NoteBySlugMicro_postsDocsMicro_post { return v.Docs }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// NoteBySlugMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type NoteBySlugMicro_postsDocsMicro_post struct {
+	Id               string                                                                     `json:"id"`
+	Slug             *string                                                                    `json:"slug"`
+	Title            *string                                                                    `json:"title"`
+	Content          *string                                                                    `json:"content"`
+	PublishedAt      *string                                                                    `json:"publishedAt"`
+	Authors          []NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor                         `json:"authors"`
+	Tags             []NoteBySlugMicro_postsDocsMicro_postTagsTag                               `json:"tags"`
+	Attachment       *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia                        `json:"attachment"`
+	ExternalLinks    []NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link `json:"externalLinks"`
+	LinkedMicroPosts []NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post            `json:"linkedMicroPosts"`
+	Meta             *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta                    `json:"meta"`
+}
 
-	err = json.Unmarshal(
-		b, &v.NoteListDoc)
-	if err != nil {
-		return err
-	}
-	return nil
+// GetId returns NoteBySlugMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetId() string { return v.Id }
+
+// GetSlug returns NoteBySlugMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetSlug() *string { return v.Slug }
+
+// GetTitle returns NoteBySlugMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetTitle() *string { return v.Title }
+
+// GetContent returns NoteBySlugMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetContent() *string { return v.Content }
+
+// GetPublishedAt returns NoteBySlugMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetPublishedAt() *string { return v.PublishedAt }
+
+// GetAuthors returns NoteBySlugMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetAuthors() []NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor {
+	return v.Authors
 }
 
-type __premarshalListNotesByTagIDsMicro_postsDocsMicro_post struct {
-	Id string `json:"id"`
+// GetTags returns NoteBySlugMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetTags() []NoteBySlugMicro_postsDocsMicro_postTagsTag {
+	return v.Tags
+}
 
-	Slug *string `json:"slug"`
+// GetAttachment returns NoteBySlugMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetAttachment() *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia {
+	return v.Attachment
+}
 
-	Title *string `json:"title"`
+// GetExternalLinks returns NoteBySlugMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetExternalLinks() []NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link {
+	return v.ExternalLinks
+}
 
-	Content *string `json:"content"`
+// GetLinkedMicroPosts returns NoteBySlugMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post {
+	return v.LinkedMicroPosts
+}
 
-	PublishedAt *string `json:"publishedAt"`
+// GetMeta returns NoteBySlugMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_post) GetMeta() *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta {
+	return v.Meta
+}
 
-	Authors []NoteListDocAuthorsAuthor `json:"authors"`
+// NoteBySlugMicro_postsDocsMicro_postAttachmentMedia includes the requested fields of the GraphQL type Media.
+type NoteBySlugMicro_postsDocsMicro_postAttachmentMedia struct {
+	Url      *string  `json:"url"`
+	Alt      *string  `json:"alt"`
+	Width    *float64 `json:"width"`
+	Height   *float64 `json:"height"`
+	Filename *string  `json:"filename"`
+	MimeType *string  `json:"mimeType"`
+}
 
-	Tags []NoteListDocTagsTag `json:"tags"`
+// GetUrl returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.Url, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetUrl() *string { return v.Url }
 
-	Attachment *NoteListDocAttachmentMedia `json:"attachment"`
+// GetAlt returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.Alt, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetAlt() *string { return v.Alt }
 
-	ExternalLinks []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
+// GetWidth returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.Width, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetWidth() *float64 { return v.Width }
 
-	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post `json:"linkedMicroPosts"`
+// GetHeight returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.Height, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetHeight() *float64 { return v.Height }
 
-	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
+// GetFilename returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.Filename, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetFilename() *string { return v.Filename }
+
+// GetMimeType returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.MimeType, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetMimeType() *string { return v.MimeType }
+
+// NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor includes the requested fields of the GraphQL type Author.
+type NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor struct {
+	Name   *string                                                      `json:"name"`
+	Slug   string                                                       `json:"slug"`
+	Bio    *string                                                      `json:"bio"`
+	Avatar *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia `json:"avatar"`
 }
 
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetName returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor.Name, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor) GetName() *string { return v.Name }
+
+// GetSlug returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor.Slug, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor) GetSlug() string { return v.Slug }
+
+// GetBio returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor.Bio, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor) GetBio() *string { return v.Bio }
+
+// GetAvatar returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor.Avatar, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor) GetAvatar() *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia {
+	return v.Avatar
 }
 
-func (v *ListNotesByTagIDsMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalListNotesByTagIDsMicro_postsDocsMicro_post, error) {
-	var retval __premarshalListNotesByTagIDsMicro_postsDocsMicro_post
+// NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia includes the requested fields of the GraphQL type Media.
+type NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia struct {
+	Url    *string  `json:"url"`
+	Alt    *string  `json:"alt"`
+	Width  *float64 `json:"width"`
+	Height *float64 `json:"height"`
+}
 
-	retval.Id = v.NoteListDoc.Id
-	retval.Slug = v.NoteListDoc.Slug
-	retval.Title = v.NoteListDoc.Title
-	retval.Content = v.NoteListDoc.Content
-	retval.PublishedAt = v.NoteListDoc.PublishedAt
-	retval.Authors = v.NoteListDoc.Authors
-	retval.Tags = v.NoteListDoc.Tags
-	retval.Attachment = v.NoteListDoc.Attachment
-	retval.ExternalLinks = v.NoteListDoc.ExternalLinks
-	retval.LinkedMicroPosts = v.NoteListDoc.LinkedMicroPosts
-	retval.Meta = v.NoteListDoc.Meta
-	return &retval, nil
+// GetUrl returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia.Url, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia) GetUrl() *string { return v.Url }
+
+// GetAlt returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia.Alt, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia) GetAlt() *string { return v.Alt }
+
+// GetWidth returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia.Width, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia) GetWidth() *float64 {
+	return v.Width
 }
 
-// ListNotesByTagIDsResponse is returned by ListNotesByTagIDs on success.
-type ListNotesByTagIDsResponse struct {
-	Micro_posts *ListNotesByTagIDsMicro_posts `json:"Micro_posts"`
+// GetHeight returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia.Height, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia) GetHeight() *float64 {
+	return v.Height
 }
 
-// GetMicro_posts returns ListNotesByTagIDsResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *ListNotesByTagIDsResponse) GetMicro_posts() *ListNotesByTagIDsMicro_posts {
-	return v.Micro_posts
+// NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link includes the requested fields of the GraphQL type Micro_post_external_link.
+type NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link struct {
+	Id         string `json:"id"`
+	Target_url string `json:"target_url"`
+}
+
+// GetId returns NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link.Id, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link) GetId() string {
+	return v.Id
+}
+
+// GetTarget_url returns NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link.Target_url, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link) GetTarget_url() string {
+	return v.Target_url
+}
+
+// NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post struct {
+	Id   string  `json:"id"`
+	Slug *string `json:"slug"`
+}
+
+// GetId returns NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post) GetId() string { return v.Id }
+
+// GetSlug returns NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post) GetSlug() *string {
+	return v.Slug
+}
+
+// NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta includes the requested fields of the GraphQL type Micro_post_Meta.
+type NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta struct {
+	Title       *string                                                           `json:"title"`
+	Description *string                                                           `json:"description"`
+	Image       *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia `json:"image"`
+}
+
+// GetTitle returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta.Title, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta) GetTitle() *string { return v.Title }
+
+// GetDescription returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta.Description, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta) GetDescription() *string {
+	return v.Description
+}
+
+// GetImage returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta.Image, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta) GetImage() *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia {
+	return v.Image
+}
+
+// NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia includes the requested fields of the GraphQL type Media.
+type NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia struct {
+	Url         *string  `json:"url"`
+	Description *string  `json:"description"`
+	Width       *float64 `json:"width"`
+	Height      *float64 `json:"height"`
+}
+
+// GetUrl returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia.Url, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia) GetUrl() *string {
+	return v.Url
+}
+
+// GetDescription returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia.Description, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia) GetDescription() *string {
+	return v.Description
+}
+
+// GetWidth returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia.Width, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia) GetWidth() *float64 {
+	return v.Width
+}
+
+// GetHeight returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia.Height, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia) GetHeight() *float64 {
+	return v.Height
 }
 
-// ListNotesByTypeMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type ListNotesByTypeMicro_posts struct {
-	TotalPages int                                        `json:"totalPages"`
-	Docs       []ListNotesByTypeMicro_postsDocsMicro_post `json:"docs"`
+// NoteBySlugMicro_postsDocsMicro_postTagsTag includes the requested fields of the GraphQL type Tag.
+type NoteBySlugMicro_postsDocsMicro_postTagsTag struct {
+	Id    string  `json:"id"`
+	Name  string  `json:"name"`
+	Title *string `json:"title"`
+}
+
+// GetId returns NoteBySlugMicro_postsDocsMicro_postTagsTag.Id, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postTagsTag) GetId() string { return v.Id }
+
+// GetName returns NoteBySlugMicro_postsDocsMicro_postTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postTagsTag) GetName() string { return v.Name }
+
+// GetTitle returns NoteBySlugMicro_postsDocsMicro_postTagsTag.Title, and is useful for accessing the field via an interface.
+func (v *NoteBySlugMicro_postsDocsMicro_postTagsTag) GetTitle() *string { return v.Title }
+
+// NoteBySlugResponse is returned by NoteBySlug on success.
+type NoteBySlugResponse struct {
+	Micro_posts *NoteBySlugMicro_posts `json:"Micro_posts"`
 }
 
-// GetTotalPages returns ListNotesByTypeMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_posts) GetTotalPages() int { return v.TotalPages }
+// GetMicro_posts returns NoteBySlugResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *NoteBySlugResponse) GetMicro_posts() *NoteBySlugMicro_posts { return v.Micro_posts }
+
+// NoteDraftBySlugMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type NoteDraftBySlugMicro_posts struct {
+	Docs []NoteDraftBySlugMicro_postsDocsMicro_post `json:"docs"`
+}
 
-// GetDocs returns ListNotesByTypeMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_posts) GetDocs() []ListNotesByTypeMicro_postsDocsMicro_post {
+// GetDocs returns NoteDraftBySlugMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_posts) GetDocs() []NoteDraftBySlugMicro_postsDocsMicro_post {
 	return v.Docs
 }
 
-// ListNotesByTypeMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type ListNotesByTypeMicro_postsDocsMicro_post struct {
+// NoteDraftBySlugMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type NoteDraftBySlugMicro_postsDocsMicro_post struct {
 	NoteListDoc `json:"-"`
 }
 
-// GetId returns ListNotesByTypeMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+// GetId returns NoteDraftBySlugMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
 
-// GetSlug returns ListNotesByTypeMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
+// GetSlug returns NoteDraftBySlugMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
 
-// GetTitle returns ListNotesByTypeMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
+// GetTitle returns NoteDraftBySlugMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
 
-// GetContent returns ListNotesByTypeMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetContent() *string { return v.NoteListDoc.Content }
+// GetContent returns NoteDraftBySlugMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetContent() *string { return v.NoteListDoc.Content }
 
-// GetPublishedAt returns ListNotesByTypeMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetPublishedAt() *string {
+// GetPublishedAt returns NoteDraftBySlugMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetPublishedAt() *string {
 	return v.NoteListDoc.PublishedAt
 }
 
-// GetAuthors returns ListNotesByTypeMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
+// GetFeatured returns NoteDraftBySlugMicro_postsDocsMicro_post.Featured, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetFeatured() *bool { return v.NoteListDoc.Featured }
+
+// GetAuthors returns NoteDraftBySlugMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
 	return v.NoteListDoc.Authors
 }
 
-// GetTags returns ListNotesByTypeMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
+// GetTags returns NoteDraftBySlugMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
 	return v.NoteListDoc.Tags
 }
 
-// GetAttachment returns ListNotesByTypeMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
+// GetAttachment returns NoteDraftBySlugMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
 	return v.NoteListDoc.Attachment
 }
 
-// GetExternalLinks returns ListNotesByTypeMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
+// GetExternalLinks returns NoteDraftBySlugMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
 	return v.NoteListDoc.ExternalLinks
 }
 
-// GetLinkedMicroPosts returns ListNotesByTypeMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
+// GetLinkedMicroPosts returns NoteDraftBySlugMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
 	return v.NoteListDoc.LinkedMicroPosts
 }
 
-// GetMeta returns ListNotesByTypeMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
+// GetMeta returns NoteDraftBySlugMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
 	return v.NoteListDoc.Meta
 }
 
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*ListNotesByTypeMicro_postsDocsMicro_post
+		*NoteDraftBySlugMicro_postsDocsMicro_post
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.ListNotesByTypeMicro_postsDocsMicro_post = v
+	firstPass.NoteDraftBySlugMicro_postsDocsMicro_post = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -898,7 +1365,7 @@ func (v *ListNotesByTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error
 	return nil
 }
 
-type __premarshalListNotesByTypeMicro_postsDocsMicro_post struct {
+type __premarshalNoteDraftBySlugMicro_postsDocsMicro_post struct {
 	Id string `json:"id"`
 
 	Slug *string `json:"slug"`
@@ -909,6 +1376,8 @@ type __premarshalListNotesByTypeMicro_postsDocsMicro_post struct {
 
 	PublishedAt *string `json:"publishedAt"`
 
+	Featured *bool `json:"featured"`
+
 	Authors []NoteListDocAuthorsAuthor `json:"authors"`
 
 	Tags []NoteListDocTagsTag `json:"tags"`
@@ -922,7 +1391,7 @@ type __premarshalListNotesByTypeMicro_postsDocsMicro_post struct {
 	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
 }
 
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -930,14 +1399,15 @@ func (v *ListNotesByTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error)
 	return json.Marshal(premarshaled)
 }
 
-func (v *ListNotesByTypeMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalListNotesByTypeMicro_postsDocsMicro_post, error) {
-	var retval __premarshalListNotesByTypeMicro_postsDocsMicro_post
+func (v *NoteDraftBySlugMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalNoteDraftBySlugMicro_postsDocsMicro_post, error) {
+	var retval __premarshalNoteDraftBySlugMicro_postsDocsMicro_post
 
 	retval.Id = v.NoteListDoc.Id
 	retval.Slug = v.NoteListDoc.Slug
 	retval.Title = v.NoteListDoc.Title
 	retval.Content = v.NoteListDoc.Content
 	retval.PublishedAt = v.NoteListDoc.PublishedAt
+	retval.Featured = v.NoteListDoc.Featured
 	retval.Authors = v.NoteListDoc.Authors
 	retval.Tags = v.NoteListDoc.Tags
 	retval.Attachment = v.NoteListDoc.Attachment
@@ -947,723 +1417,560 @@ func (v *ListNotesByTypeMicro_postsDocsMicro_post) __premarshalJSON() (*__premar
 	return &retval, nil
 }
 
-// ListNotesByTypeResponse is returned by ListNotesByType on success.
-type ListNotesByTypeResponse struct {
-	Micro_posts *ListNotesByTypeMicro_posts `json:"Micro_posts"`
+// NoteDraftBySlugResponse is returned by NoteDraftBySlug on success.
+type NoteDraftBySlugResponse struct {
+	Micro_posts *NoteDraftBySlugMicro_posts `json:"Micro_posts"`
 }
 
-// GetMicro_posts returns ListNotesByTypeResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *ListNotesByTypeResponse) GetMicro_posts() *ListNotesByTypeMicro_posts { return v.Micro_posts }
-
-// ListNotesMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type ListNotesMicro_posts struct {
-	TotalPages int                                  `json:"totalPages"`
-	Docs       []ListNotesMicro_postsDocsMicro_post `json:"docs"`
-}
+// GetMicro_posts returns NoteDraftBySlugResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *NoteDraftBySlugResponse) GetMicro_posts() *NoteDraftBySlugMicro_posts { return v.Micro_posts }
 
-// GetTotalPages returns ListNotesMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_posts) GetTotalPages() int { return v.TotalPages }
+// NoteListDoc includes the GraphQL fields of Micro_post requested by the fragment NoteListDoc.
+type NoteListDoc struct {
+	Id               string                                             `json:"id"`
+	Slug             *string                                            `json:"slug"`
+	Title            *string                                            `json:"title"`
+	Content          *string                                            `json:"content"`
+	PublishedAt      *string                                            `json:"publishedAt"`
+	Featured         *bool                                              `json:"featured"`
+	Authors          []NoteListDocAuthorsAuthor                         `json:"authors"`
+	Tags             []NoteListDocTagsTag                               `json:"tags"`
+	Attachment       *NoteListDocAttachmentMedia                        `json:"attachment"`
+	ExternalLinks    []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
+	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post            `json:"linkedMicroPosts"`
+	Meta             *NoteListDocMetaMicro_post_Meta                    `json:"meta"`
+}
 
-// GetDocs returns ListNotesMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_posts) GetDocs() []ListNotesMicro_postsDocsMicro_post { return v.Docs }
+// GetId returns NoteListDoc.Id, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetId() string { return v.Id }
 
-// ListNotesMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type ListNotesMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
-}
+// GetSlug returns NoteListDoc.Slug, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetSlug() *string { return v.Slug }
 
-// GetId returns ListNotesMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+// GetTitle returns NoteListDoc.Title, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetTitle() *string { return v.Title }
 
-// GetSlug returns ListNotesMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
+// GetContent returns NoteListDoc.Content, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetContent() *string { return v.Content }
 
-// GetTitle returns ListNotesMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
+// GetPublishedAt returns NoteListDoc.PublishedAt, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetPublishedAt() *string { return v.PublishedAt }
 
-// GetContent returns ListNotesMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetContent() *string { return v.NoteListDoc.Content }
+// GetFeatured returns NoteListDoc.Featured, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetFeatured() *bool { return v.Featured }
 
-// GetPublishedAt returns ListNotesMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetPublishedAt() *string {
-	return v.NoteListDoc.PublishedAt
-}
+// GetAuthors returns NoteListDoc.Authors, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetAuthors() []NoteListDocAuthorsAuthor { return v.Authors }
 
-// GetAuthors returns ListNotesMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
-	return v.NoteListDoc.Authors
-}
+// GetTags returns NoteListDoc.Tags, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetTags() []NoteListDocTagsTag { return v.Tags }
 
-// GetTags returns ListNotesMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
-	return v.NoteListDoc.Tags
-}
+// GetAttachment returns NoteListDoc.Attachment, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetAttachment() *NoteListDocAttachmentMedia { return v.Attachment }
 
-// GetAttachment returns ListNotesMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
-	return v.NoteListDoc.Attachment
+// GetExternalLinks returns NoteListDoc.ExternalLinks, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
+	return v.ExternalLinks
 }
 
-// GetExternalLinks returns ListNotesMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
-	return v.NoteListDoc.ExternalLinks
+// GetLinkedMicroPosts returns NoteListDoc.LinkedMicroPosts, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
+	return v.LinkedMicroPosts
 }
 
-// GetLinkedMicroPosts returns ListNotesMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
-	return v.NoteListDoc.LinkedMicroPosts
-}
+// GetMeta returns NoteListDoc.Meta, and is useful for accessing the field via an interface.
+func (v *NoteListDoc) GetMeta() *NoteListDocMetaMicro_post_Meta { return v.Meta }
 
-// GetMeta returns ListNotesMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *ListNotesMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
-	return v.NoteListDoc.Meta
+// NoteListDocAttachmentMedia includes the requested fields of the GraphQL type Media.
+type NoteListDocAttachmentMedia struct {
+	Url      *string  `json:"url"`
+	Alt      *string  `json:"alt"`
+	Width    *float64 `json:"width"`
+	Height   *float64 `json:"height"`
+	Filename *string  `json:"filename"`
+	MimeType *string  `json:"mimeType"`
 }
 
-func (v *ListNotesMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+// GetUrl returns NoteListDocAttachmentMedia.Url, and is useful for accessing the field via an interface.
+func (v *NoteListDocAttachmentMedia) GetUrl() *string { return v.Url }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetAlt returns NoteListDocAttachmentMedia.Alt, and is useful for accessing the field via an interface.
+func (v *NoteListDocAttachmentMedia) GetAlt() *string { return v.Alt }
 
-	var firstPass struct {
-		*ListNotesMicro_postsDocsMicro_post
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.ListNotesMicro_postsDocsMicro_post = v
+// GetWidth returns NoteListDocAttachmentMedia.Width, and is useful for accessing the field via an interface.
+func (v *NoteListDocAttachmentMedia) GetWidth() *float64 { return v.Width }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetHeight returns NoteListDocAttachmentMedia.Height, and is useful for accessing the field via an interface.
+func (v *NoteListDocAttachmentMedia) GetHeight() *float64 { return v.Height }
 
-	err = json.Unmarshal(
-		b, &v.NoteListDoc)
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// GetFilename returns NoteListDocAttachmentMedia.Filename, and is useful for accessing the field via an interface.
+func (v *NoteListDocAttachmentMedia) GetFilename() *string { return v.Filename }
 
-type __premarshalListNotesMicro_postsDocsMicro_post struct {
-	Id string `json:"id"`
+// GetMimeType returns NoteListDocAttachmentMedia.MimeType, and is useful for accessing the field via an interface.
+func (v *NoteListDocAttachmentMedia) GetMimeType() *string { return v.MimeType }
 
-	Slug *string `json:"slug"`
+// NoteListDocAuthorsAuthor includes the requested fields of the GraphQL type Author.
+type NoteListDocAuthorsAuthor struct {
+	Name   *string                              `json:"name"`
+	Slug   string                               `json:"slug"`
+	Bio    *string                              `json:"bio"`
+	Avatar *NoteListDocAuthorsAuthorAvatarMedia `json:"avatar"`
+}
 
-	Title *string `json:"title"`
+// GetName returns NoteListDocAuthorsAuthor.Name, and is useful for accessing the field via an interface.
+func (v *NoteListDocAuthorsAuthor) GetName() *string { return v.Name }
 
-	Content *string `json:"content"`
+// GetSlug returns NoteListDocAuthorsAuthor.Slug, and is useful for accessing the field via an interface.
+func (v *NoteListDocAuthorsAuthor) GetSlug() string { return v.Slug }
 
-	PublishedAt *string `json:"publishedAt"`
+// GetBio returns NoteListDocAuthorsAuthor.Bio, and is useful for accessing the field via an interface.
+func (v *NoteListDocAuthorsAuthor) GetBio() *string { return v.Bio }
 
-	Authors []NoteListDocAuthorsAuthor `json:"authors"`
+// GetAvatar returns NoteListDocAuthorsAuthor.Avatar, and is useful for accessing the field via an interface.
+func (v *NoteListDocAuthorsAuthor) GetAvatar() *NoteListDocAuthorsAuthorAvatarMedia { return v.Avatar }
 
-	Tags []NoteListDocTagsTag `json:"tags"`
+// NoteListDocAuthorsAuthorAvatarMedia includes the requested fields of the GraphQL type Media.
+type NoteListDocAuthorsAuthorAvatarMedia struct {
+	Url    *string  `json:"url"`
+	Alt    *string  `json:"alt"`
+	Width  *float64 `json:"width"`
+	Height *float64 `json:"height"`
+}
 
-	Attachment *NoteListDocAttachmentMedia `json:"attachment"`
+// GetUrl returns NoteListDocAuthorsAuthorAvatarMedia.Url, and is useful for accessing the field via an interface.
+func (v *NoteListDocAuthorsAuthorAvatarMedia) GetUrl() *string { return v.Url }
 
-	ExternalLinks []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
+// GetAlt returns NoteListDocAuthorsAuthorAvatarMedia.Alt, and is useful for accessing the field via an interface.
+func (v *NoteListDocAuthorsAuthorAvatarMedia) GetAlt() *string { return v.Alt }
 
-	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post `json:"linkedMicroPosts"`
+// GetWidth returns NoteListDocAuthorsAuthorAvatarMedia.Width, and is useful for accessing the field via an interface.
+func (v *NoteListDocAuthorsAuthorAvatarMedia) GetWidth() *float64 { return v.Width }
 
-	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
-}
+// GetHeight returns NoteListDocAuthorsAuthorAvatarMedia.Height, and is useful for accessing the field via an interface.
+func (v *NoteListDocAuthorsAuthorAvatarMedia) GetHeight() *float64 { return v.Height }
 
-func (v *ListNotesMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// NoteListDocExternalLinksMicro_post_external_link includes the requested fields of the GraphQL type Micro_post_external_link.
+type NoteListDocExternalLinksMicro_post_external_link struct {
+	Id         string `json:"id"`
+	Target_url string `json:"target_url"`
 }
 
-func (v *ListNotesMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalListNotesMicro_postsDocsMicro_post, error) {
-	var retval __premarshalListNotesMicro_postsDocsMicro_post
+// GetId returns NoteListDocExternalLinksMicro_post_external_link.Id, and is useful for accessing the field via an interface.
+func (v *NoteListDocExternalLinksMicro_post_external_link) GetId() string { return v.Id }
 
-	retval.Id = v.NoteListDoc.Id
-	retval.Slug = v.NoteListDoc.Slug
-	retval.Title = v.NoteListDoc.Title
-	retval.Content = v.NoteListDoc.Content
-	retval.PublishedAt = v.NoteListDoc.PublishedAt
-	retval.Authors = v.NoteListDoc.Authors
-	retval.Tags = v.NoteListDoc.Tags
-	retval.Attachment = v.NoteListDoc.Attachment
-	retval.ExternalLinks = v.NoteListDoc.ExternalLinks
-	retval.LinkedMicroPosts = v.NoteListDoc.LinkedMicroPosts
-	retval.Meta = v.NoteListDoc.Meta
-	return &retval, nil
+// GetTarget_url returns NoteListDocExternalLinksMicro_post_external_link.Target_url, and is useful for accessing the field via an interface.
+func (v *NoteListDocExternalLinksMicro_post_external_link) GetTarget_url() string {
+	return v.Target_url
 }
 
-// ListNotesResponse is returned by ListNotes on success.
-type ListNotesResponse struct {
-	Micro_posts *ListNotesMicro_posts `json:"Micro_posts"`
+// NoteListDocLinkedMicroPostsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type NoteListDocLinkedMicroPostsMicro_post struct {
+	Id   string  `json:"id"`
+	Slug *string `json:"slug"`
 }
 
-// GetMicro_posts returns ListNotesResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *ListNotesResponse) GetMicro_posts() *ListNotesMicro_posts { return v.Micro_posts }
-
-type LocaleInputType string
+// GetId returns NoteListDocLinkedMicroPostsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *NoteListDocLinkedMicroPostsMicro_post) GetId() string { return v.Id }
 
-const (
-	LocaleInputTypeEnUs LocaleInputType = "en_US"
-	LocaleInputTypeUkUa LocaleInputType = "uk_UA"
-	LocaleInputTypeDeDe LocaleInputType = "de_DE"
-	LocaleInputTypeHiIn LocaleInputType = "hi_IN"
-	LocaleInputTypeJaJp LocaleInputType = "ja_JP"
-	LocaleInputTypeRuRu LocaleInputType = "ru_RU"
-	LocaleInputTypeFrFr LocaleInputType = "fr_FR"
-	LocaleInputTypeEsEs LocaleInputType = "es_ES"
-)
+// GetSlug returns NoteListDocLinkedMicroPostsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *NoteListDocLinkedMicroPostsMicro_post) GetSlug() *string { return v.Slug }
 
-var AllLocaleInputType = []LocaleInputType{
-	LocaleInputTypeEnUs,
-	LocaleInputTypeUkUa,
-	LocaleInputTypeDeDe,
-	LocaleInputTypeHiIn,
-	LocaleInputTypeJaJp,
-	LocaleInputTypeRuRu,
-	LocaleInputTypeFrFr,
-	LocaleInputTypeEsEs,
+// NoteListDocMetaMicro_post_Meta includes the requested fields of the GraphQL type Micro_post_Meta.
+type NoteListDocMetaMicro_post_Meta struct {
+	Title       *string                                   `json:"title"`
+	Description *string                                   `json:"description"`
+	Image       *NoteListDocMetaMicro_post_MetaImageMedia `json:"image"`
 }
 
-type Micro_post_post_type_Input string
+// GetTitle returns NoteListDocMetaMicro_post_Meta.Title, and is useful for accessing the field via an interface.
+func (v *NoteListDocMetaMicro_post_Meta) GetTitle() *string { return v.Title }
 
-const (
-	Micro_post_post_type_InputShort Micro_post_post_type_Input = "short"
-	Micro_post_post_type_InputLong  Micro_post_post_type_Input = "long"
-)
+// GetDescription returns NoteListDocMetaMicro_post_Meta.Description, and is useful for accessing the field via an interface.
+func (v *NoteListDocMetaMicro_post_Meta) GetDescription() *string { return v.Description }
 
-var AllMicro_post_post_type_Input = []Micro_post_post_type_Input{
-	Micro_post_post_type_InputShort,
-	Micro_post_post_type_InputLong,
+// GetImage returns NoteListDocMetaMicro_post_Meta.Image, and is useful for accessing the field via an interface.
+func (v *NoteListDocMetaMicro_post_Meta) GetImage() *NoteListDocMetaMicro_post_MetaImageMedia {
+	return v.Image
 }
 
-// NoteBySlugMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type NoteBySlugMicro_posts struct {
-	Docs []NoteBySlugMicro_postsDocsMicro_post `json:"docs"`
+// NoteListDocMetaMicro_post_MetaImageMedia includes the requested fields of the GraphQL type Media.
+type NoteListDocMetaMicro_post_MetaImageMedia struct {
+	Url         *string  `json:"url"`
+	Description *string  `json:"description"`
+	Width       *float64 `json:"width"`
+	Height      *float64 `json:"height"`
 }
 
-// GetDocs returns NoteBySlugMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_posts) GetDocs() []NoteBySlugMicro_postsDocsMicro_post { return v.Docs }
-
-// NoteBySlugMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type NoteBySlugMicro_postsDocsMicro_post struct {
-	Id               string                                                                     `json:"id"`
-	Slug             *string                                                                    `json:"slug"`
-	Title            *string                                                                    `json:"title"`
-	Content          *string                                                                    `json:"content"`
-	PublishedAt      *string                                                                    `json:"publishedAt"`
-	Authors          []NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor                         `json:"authors"`
-	Tags             []NoteBySlugMicro_postsDocsMicro_postTagsTag                               `json:"tags"`
-	Attachment       *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia                        `json:"attachment"`
-	ExternalLinks    []NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link `json:"externalLinks"`
-	LinkedMicroPosts []NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post            `json:"linkedMicroPosts"`
-	Meta             *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta                    `json:"meta"`
-}
+// GetUrl returns NoteListDocMetaMicro_post_MetaImageMedia.Url, and is useful for accessing the field via an interface.
+func (v *NoteListDocMetaMicro_post_MetaImageMedia) GetUrl() *string { return v.Url }
 
-// GetId returns NoteBySlugMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetId() string { return v.Id }
+// GetDescription returns NoteListDocMetaMicro_post_MetaImageMedia.Description, and is useful for accessing the field via an interface.
+func (v *NoteListDocMetaMicro_post_MetaImageMedia) GetDescription() *string { return v.Description }
 
-// GetSlug returns NoteBySlugMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetSlug() *string { return v.Slug }
+// GetWidth returns NoteListDocMetaMicro_post_MetaImageMedia.Width, and is useful for accessing the field via an interface.
+func (v *NoteListDocMetaMicro_post_MetaImageMedia) GetWidth() *float64 { return v.Width }
 
-// GetTitle returns NoteBySlugMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetTitle() *string { return v.Title }
+// GetHeight returns NoteListDocMetaMicro_post_MetaImageMedia.Height, and is useful for accessing the field via an interface.
+func (v *NoteListDocMetaMicro_post_MetaImageMedia) GetHeight() *float64 { return v.Height }
 
-// GetContent returns NoteBySlugMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetContent() *string { return v.Content }
+// NoteListDocTagsTag includes the requested fields of the GraphQL type Tag.
+type NoteListDocTagsTag struct {
+	Id    string  `json:"id"`
+	Name  string  `json:"name"`
+	Title *string `json:"title"`
+}
 
-// GetPublishedAt returns NoteBySlugMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetPublishedAt() *string { return v.PublishedAt }
+// GetId returns NoteListDocTagsTag.Id, and is useful for accessing the field via an interface.
+func (v *NoteListDocTagsTag) GetId() string { return v.Id }
 
-// GetAuthors returns NoteBySlugMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetAuthors() []NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor {
-	return v.Authors
-}
+// GetName returns NoteListDocTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *NoteListDocTagsTag) GetName() string { return v.Name }
 
-// GetTags returns NoteBySlugMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetTags() []NoteBySlugMicro_postsDocsMicro_postTagsTag {
-	return v.Tags
-}
+// GetTitle returns NoteListDocTagsTag.Title, and is useful for accessing the field via an interface.
+func (v *NoteListDocTagsTag) GetTitle() *string { return v.Title }
 
-// GetAttachment returns NoteBySlugMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetAttachment() *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia {
-	return v.Attachment
+// NoteRelationsMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type NoteRelationsMicro_posts struct {
+	Docs []NoteRelationsMicro_postsDocsMicro_post `json:"docs"`
 }
 
-// GetExternalLinks returns NoteBySlugMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetExternalLinks() []NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link {
-	return v.ExternalLinks
-}
+// GetDocs returns NoteRelationsMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *NoteRelationsMicro_posts) GetDocs() []NoteRelationsMicro_postsDocsMicro_post { return v.Docs }
 
-// GetLinkedMicroPosts returns NoteBySlugMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post {
-	return v.LinkedMicroPosts
+// NoteRelationsMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type NoteRelationsMicro_postsDocsMicro_post struct {
+	Id      string                                                `json:"id"`
+	Authors []NoteRelationsMicro_postsDocsMicro_postAuthorsAuthor `json:"authors"`
+	Tags    []NoteRelationsMicro_postsDocsMicro_postTagsTag       `json:"tags"`
 }
 
-// GetMeta returns NoteBySlugMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_post) GetMeta() *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta {
-	return v.Meta
+// GetId returns NoteRelationsMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *NoteRelationsMicro_postsDocsMicro_post) GetId() string { return v.Id }
+
+// GetAuthors returns NoteRelationsMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *NoteRelationsMicro_postsDocsMicro_post) GetAuthors() []NoteRelationsMicro_postsDocsMicro_postAuthorsAuthor {
+	return v.Authors
 }
 
-// NoteBySlugMicro_postsDocsMicro_postAttachmentMedia includes the requested fields of the GraphQL type Media.
-type NoteBySlugMicro_postsDocsMicro_postAttachmentMedia struct {
-	Url      *string  `json:"url"`
-	Alt      *string  `json:"alt"`
-	Width    *float64 `json:"width"`
-	Height   *float64 `json:"height"`
-	Filename *string  `json:"filename"`
-	MimeType *string  `json:"mimeType"`
+// GetTags returns NoteRelationsMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *NoteRelationsMicro_postsDocsMicro_post) GetTags() []NoteRelationsMicro_postsDocsMicro_postTagsTag {
+	return v.Tags
 }
 
-// GetUrl returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.Url, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetUrl() *string { return v.Url }
+// NoteRelationsMicro_postsDocsMicro_postAuthorsAuthor includes the requested fields of the GraphQL type Author.
+type NoteRelationsMicro_postsDocsMicro_postAuthorsAuthor struct {
+	Slug string `json:"slug"`
+}
 
-// GetAlt returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.Alt, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetAlt() *string { return v.Alt }
+// GetSlug returns NoteRelationsMicro_postsDocsMicro_postAuthorsAuthor.Slug, and is useful for accessing the field via an interface.
+func (v *NoteRelationsMicro_postsDocsMicro_postAuthorsAuthor) GetSlug() string { return v.Slug }
 
-// GetWidth returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.Width, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetWidth() *float64 { return v.Width }
+// NoteRelationsMicro_postsDocsMicro_postTagsTag includes the requested fields of the GraphQL type Tag.
+type NoteRelationsMicro_postsDocsMicro_postTagsTag struct {
+	Id string `json:"id"`
+}
 
-// GetHeight returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.Height, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetHeight() *float64 { return v.Height }
+// GetId returns NoteRelationsMicro_postsDocsMicro_postTagsTag.Id, and is useful for accessing the field via an interface.
+func (v *NoteRelationsMicro_postsDocsMicro_postTagsTag) GetId() string { return v.Id }
 
-// GetFilename returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.Filename, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetFilename() *string { return v.Filename }
+// NoteRelationsResponse is returned by NoteRelations on success.
+type NoteRelationsResponse struct {
+	Micro_posts *NoteRelationsMicro_posts `json:"Micro_posts"`
+}
 
-// GetMimeType returns NoteBySlugMicro_postsDocsMicro_postAttachmentMedia.MimeType, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) GetMimeType() *string { return v.MimeType }
+// GetMicro_posts returns NoteRelationsResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *NoteRelationsResponse) GetMicro_posts() *NoteRelationsMicro_posts { return v.Micro_posts }
 
-// NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor includes the requested fields of the GraphQL type Author.
-type NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor struct {
-	Name   *string                                                      `json:"name"`
-	Slug   string                                                       `json:"slug"`
-	Bio    *string                                                      `json:"bio"`
-	Avatar *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia `json:"avatar"`
+// NoteSeriesMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type NoteSeriesMicro_posts struct {
+	Docs []NoteSeriesMicro_postsDocsMicro_post `json:"docs"`
 }
 
-// GetName returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor.Name, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor) GetName() *string { return v.Name }
+// GetDocs returns NoteSeriesMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *NoteSeriesMicro_posts) GetDocs() []NoteSeriesMicro_postsDocsMicro_post { return v.Docs }
 
-// GetSlug returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor.Slug, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor) GetSlug() string { return v.Slug }
+// NoteSeriesMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type NoteSeriesMicro_postsDocsMicro_post struct {
+	Id     string                                     `json:"id"`
+	Series *NoteSeriesMicro_postsDocsMicro_postSeries `json:"series"`
+}
 
-// GetBio returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor.Bio, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor) GetBio() *string { return v.Bio }
+// GetId returns NoteSeriesMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *NoteSeriesMicro_postsDocsMicro_post) GetId() string { return v.Id }
 
-// GetAvatar returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor.Avatar, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthor) GetAvatar() *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia {
-	return v.Avatar
+// GetSeries returns NoteSeriesMicro_postsDocsMicro_post.Series, and is useful for accessing the field via an interface.
+func (v *NoteSeriesMicro_postsDocsMicro_post) GetSeries() *NoteSeriesMicro_postsDocsMicro_postSeries {
+	return v.Series
 }
 
-// NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia includes the requested fields of the GraphQL type Media.
-type NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia struct {
-	Url    *string  `json:"url"`
-	Alt    *string  `json:"alt"`
-	Width  *float64 `json:"width"`
-	Height *float64 `json:"height"`
+// NoteSeriesMicro_postsDocsMicro_postSeries includes the requested fields of the GraphQL type Series.
+type NoteSeriesMicro_postsDocsMicro_postSeries struct {
+	Id    string  `json:"id"`
+	Slug  string  `json:"slug"`
+	Title *string `json:"title"`
 }
 
-// GetUrl returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia.Url, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia) GetUrl() *string { return v.Url }
+// GetId returns NoteSeriesMicro_postsDocsMicro_postSeries.Id, and is useful for accessing the field via an interface.
+func (v *NoteSeriesMicro_postsDocsMicro_postSeries) GetId() string { return v.Id }
 
-// GetAlt returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia.Alt, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia) GetAlt() *string { return v.Alt }
+// GetSlug returns NoteSeriesMicro_postsDocsMicro_postSeries.Slug, and is useful for accessing the field via an interface.
+func (v *NoteSeriesMicro_postsDocsMicro_postSeries) GetSlug() string { return v.Slug }
 
-// GetWidth returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia.Width, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia) GetWidth() *float64 {
-	return v.Width
-}
+// GetTitle returns NoteSeriesMicro_postsDocsMicro_postSeries.Title, and is useful for accessing the field via an interface.
+func (v *NoteSeriesMicro_postsDocsMicro_postSeries) GetTitle() *string { return v.Title }
 
-// GetHeight returns NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia.Height, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postAuthorsAuthorAvatarMedia) GetHeight() *float64 {
-	return v.Height
+// NoteSeriesResponse is returned by NoteSeries on success.
+type NoteSeriesResponse struct {
+	Micro_posts *NoteSeriesMicro_posts `json:"Micro_posts"`
 }
 
-// NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link includes the requested fields of the GraphQL type Micro_post_external_link.
-type NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link struct {
-	Id         string `json:"id"`
-	Target_url string `json:"target_url"`
-}
+// GetMicro_posts returns NoteSeriesResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *NoteSeriesResponse) GetMicro_posts() *NoteSeriesMicro_posts { return v.Micro_posts }
 
-// GetId returns NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link.Id, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link) GetId() string {
-	return v.Id
+// NotesPublishedBetweenMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type NotesPublishedBetweenMicro_posts struct {
+	TotalPages int                                              `json:"totalPages"`
+	Docs       []NotesPublishedBetweenMicro_postsDocsMicro_post `json:"docs"`
 }
 
-// GetTarget_url returns NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link.Target_url, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postExternalLinksMicro_post_external_link) GetTarget_url() string {
-	return v.Target_url
+// GetTotalPages returns NotesPublishedBetweenMicro_posts.TotalPages, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_posts) GetTotalPages() int { return v.TotalPages }
+
+// GetDocs returns NotesPublishedBetweenMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_posts) GetDocs() []NotesPublishedBetweenMicro_postsDocsMicro_post {
+	return v.Docs
 }
 
-// NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post struct {
-	Id   string  `json:"id"`
-	Slug *string `json:"slug"`
+// NotesPublishedBetweenMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type NotesPublishedBetweenMicro_postsDocsMicro_post struct {
+	NoteListDoc `json:"-"`
 }
 
-// GetId returns NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post) GetId() string { return v.Id }
+// GetId returns NotesPublishedBetweenMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
 
-// GetSlug returns NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postLinkedMicroPostsMicro_post) GetSlug() *string {
-	return v.Slug
-}
+// GetSlug returns NotesPublishedBetweenMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
 
-// NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta includes the requested fields of the GraphQL type Micro_post_Meta.
-type NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta struct {
-	Title       *string                                                           `json:"title"`
-	Description *string                                                           `json:"description"`
-	Image       *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia `json:"image"`
+// GetTitle returns NotesPublishedBetweenMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetTitle() *string {
+	return v.NoteListDoc.Title
 }
 
-// GetTitle returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta.Title, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta) GetTitle() *string { return v.Title }
-
-// GetDescription returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta.Description, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta) GetDescription() *string {
-	return v.Description
+// GetContent returns NotesPublishedBetweenMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetContent() *string {
+	return v.NoteListDoc.Content
 }
 
-// GetImage returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta.Image, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_Meta) GetImage() *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia {
-	return v.Image
+// GetPublishedAt returns NotesPublishedBetweenMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetPublishedAt() *string {
+	return v.NoteListDoc.PublishedAt
 }
 
-// NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia includes the requested fields of the GraphQL type Media.
-type NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia struct {
-	Url         *string  `json:"url"`
-	Description *string  `json:"description"`
-	Width       *float64 `json:"width"`
-	Height      *float64 `json:"height"`
+// GetFeatured returns NotesPublishedBetweenMicro_postsDocsMicro_post.Featured, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetFeatured() *bool {
+	return v.NoteListDoc.Featured
 }
 
-// GetUrl returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia.Url, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia) GetUrl() *string {
-	return v.Url
+// GetAuthors returns NotesPublishedBetweenMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
+	return v.NoteListDoc.Authors
 }
 
-// GetDescription returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia.Description, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia) GetDescription() *string {
-	return v.Description
+// GetTags returns NotesPublishedBetweenMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
+	return v.NoteListDoc.Tags
 }
 
-// GetWidth returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia.Width, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia) GetWidth() *float64 {
-	return v.Width
+// GetAttachment returns NotesPublishedBetweenMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
+	return v.NoteListDoc.Attachment
 }
 
-// GetHeight returns NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia.Height, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postMetaMicro_post_MetaImageMedia) GetHeight() *float64 {
-	return v.Height
+// GetExternalLinks returns NotesPublishedBetweenMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
+	return v.NoteListDoc.ExternalLinks
 }
 
-// NoteBySlugMicro_postsDocsMicro_postTagsTag includes the requested fields of the GraphQL type Tag.
-type NoteBySlugMicro_postsDocsMicro_postTagsTag struct {
-	Id    string  `json:"id"`
-	Name  string  `json:"name"`
-	Title *string `json:"title"`
+// GetLinkedMicroPosts returns NotesPublishedBetweenMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
+	return v.NoteListDoc.LinkedMicroPosts
 }
 
-// GetId returns NoteBySlugMicro_postsDocsMicro_postTagsTag.Id, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postTagsTag) GetId() string { return v.Id }
+// GetMeta returns NotesPublishedBetweenMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
+	return v.NoteListDoc.Meta
+}
 
-// GetName returns NoteBySlugMicro_postsDocsMicro_postTagsTag.Name, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postTagsTag) GetName() string { return v.Name }
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
 
-// GetTitle returns NoteBySlugMicro_postsDocsMicro_postTagsTag.Title, and is useful for accessing the field via an interface.
-func (v *NoteBySlugMicro_postsDocsMicro_postTagsTag) GetTitle() *string { return v.Title }
+	if string(b) == "null" {
+		return nil
+	}
 
-// NoteBySlugResponse is returned by NoteBySlug on success.
-type NoteBySlugResponse struct {
-	Micro_posts *NoteBySlugMicro_posts `json:"Micro_posts"`
-}
+	var firstPass struct {
+		*NotesPublishedBetweenMicro_postsDocsMicro_post
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.NotesPublishedBetweenMicro_postsDocsMicro_post = v
 
-// GetMicro_posts returns NoteBySlugResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *NoteBySlugResponse) GetMicro_posts() *NoteBySlugMicro_posts { return v.Micro_posts }
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-// NoteListDoc includes the GraphQL fields of Micro_post requested by the fragment NoteListDoc.
-type NoteListDoc struct {
-	Id               string                                             `json:"id"`
-	Slug             *string                                            `json:"slug"`
-	Title            *string                                            `json:"title"`
-	Content          *string                                            `json:"content"`
-	PublishedAt      *string                                            `json:"publishedAt"`
-	Authors          []NoteListDocAuthorsAuthor                         `json:"authors"`
-	Tags             []NoteListDocTagsTag                               `json:"tags"`
-	Attachment       *NoteListDocAttachmentMedia                        `json:"attachment"`
-	ExternalLinks    []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
-	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post            `json:"linkedMicroPosts"`
-	Meta             *NoteListDocMetaMicro_post_Meta                    `json:"meta"`
+	err = json.Unmarshal(
+		b, &v.NoteListDoc)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetId returns NoteListDoc.Id, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetId() string { return v.Id }
+type __premarshalNotesPublishedBetweenMicro_postsDocsMicro_post struct {
+	Id string `json:"id"`
 
-// GetSlug returns NoteListDoc.Slug, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetSlug() *string { return v.Slug }
+	Slug *string `json:"slug"`
 
-// GetTitle returns NoteListDoc.Title, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetTitle() *string { return v.Title }
+	Title *string `json:"title"`
 
-// GetContent returns NoteListDoc.Content, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetContent() *string { return v.Content }
+	Content *string `json:"content"`
 
-// GetPublishedAt returns NoteListDoc.PublishedAt, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetPublishedAt() *string { return v.PublishedAt }
+	PublishedAt *string `json:"publishedAt"`
 
-// GetAuthors returns NoteListDoc.Authors, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetAuthors() []NoteListDocAuthorsAuthor { return v.Authors }
+	Featured *bool `json:"featured"`
 
-// GetTags returns NoteListDoc.Tags, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetTags() []NoteListDocTagsTag { return v.Tags }
-
-// GetAttachment returns NoteListDoc.Attachment, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetAttachment() *NoteListDocAttachmentMedia { return v.Attachment }
-
-// GetExternalLinks returns NoteListDoc.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
-	return v.ExternalLinks
-}
-
-// GetLinkedMicroPosts returns NoteListDoc.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
-	return v.LinkedMicroPosts
-}
-
-// GetMeta returns NoteListDoc.Meta, and is useful for accessing the field via an interface.
-func (v *NoteListDoc) GetMeta() *NoteListDocMetaMicro_post_Meta { return v.Meta }
-
-// NoteListDocAttachmentMedia includes the requested fields of the GraphQL type Media.
-type NoteListDocAttachmentMedia struct {
-	Url      *string  `json:"url"`
-	Alt      *string  `json:"alt"`
-	Width    *float64 `json:"width"`
-	Height   *float64 `json:"height"`
-	Filename *string  `json:"filename"`
-	MimeType *string  `json:"mimeType"`
-}
-
-// GetUrl returns NoteListDocAttachmentMedia.Url, and is useful for accessing the field via an interface.
-func (v *NoteListDocAttachmentMedia) GetUrl() *string { return v.Url }
-
-// GetAlt returns NoteListDocAttachmentMedia.Alt, and is useful for accessing the field via an interface.
-func (v *NoteListDocAttachmentMedia) GetAlt() *string { return v.Alt }
-
-// GetWidth returns NoteListDocAttachmentMedia.Width, and is useful for accessing the field via an interface.
-func (v *NoteListDocAttachmentMedia) GetWidth() *float64 { return v.Width }
-
-// GetHeight returns NoteListDocAttachmentMedia.Height, and is useful for accessing the field via an interface.
-func (v *NoteListDocAttachmentMedia) GetHeight() *float64 { return v.Height }
-
-// GetFilename returns NoteListDocAttachmentMedia.Filename, and is useful for accessing the field via an interface.
-func (v *NoteListDocAttachmentMedia) GetFilename() *string { return v.Filename }
-
-// GetMimeType returns NoteListDocAttachmentMedia.MimeType, and is useful for accessing the field via an interface.
-func (v *NoteListDocAttachmentMedia) GetMimeType() *string { return v.MimeType }
-
-// NoteListDocAuthorsAuthor includes the requested fields of the GraphQL type Author.
-type NoteListDocAuthorsAuthor struct {
-	Name   *string                              `json:"name"`
-	Slug   string                               `json:"slug"`
-	Bio    *string                              `json:"bio"`
-	Avatar *NoteListDocAuthorsAuthorAvatarMedia `json:"avatar"`
-}
-
-// GetName returns NoteListDocAuthorsAuthor.Name, and is useful for accessing the field via an interface.
-func (v *NoteListDocAuthorsAuthor) GetName() *string { return v.Name }
-
-// GetSlug returns NoteListDocAuthorsAuthor.Slug, and is useful for accessing the field via an interface.
-func (v *NoteListDocAuthorsAuthor) GetSlug() string { return v.Slug }
-
-// GetBio returns NoteListDocAuthorsAuthor.Bio, and is useful for accessing the field via an interface.
-func (v *NoteListDocAuthorsAuthor) GetBio() *string { return v.Bio }
-
-// GetAvatar returns NoteListDocAuthorsAuthor.Avatar, and is useful for accessing the field via an interface.
-func (v *NoteListDocAuthorsAuthor) GetAvatar() *NoteListDocAuthorsAuthorAvatarMedia { return v.Avatar }
-
-// NoteListDocAuthorsAuthorAvatarMedia includes the requested fields of the GraphQL type Media.
-type NoteListDocAuthorsAuthorAvatarMedia struct {
-	Url    *string  `json:"url"`
-	Alt    *string  `json:"alt"`
-	Width  *float64 `json:"width"`
-	Height *float64 `json:"height"`
-}
-
-// GetUrl returns NoteListDocAuthorsAuthorAvatarMedia.Url, and is useful for accessing the field via an interface.
-func (v *NoteListDocAuthorsAuthorAvatarMedia) GetUrl() *string { return v.Url }
-
-// GetAlt returns NoteListDocAuthorsAuthorAvatarMedia.Alt, and is useful for accessing the field via an interface.
-func (v *NoteListDocAuthorsAuthorAvatarMedia) GetAlt() *string { return v.Alt }
-
-// GetWidth returns NoteListDocAuthorsAuthorAvatarMedia.Width, and is useful for accessing the field via an interface.
-func (v *NoteListDocAuthorsAuthorAvatarMedia) GetWidth() *float64 { return v.Width }
+	Authors []NoteListDocAuthorsAuthor `json:"authors"`
 
-// GetHeight returns NoteListDocAuthorsAuthorAvatarMedia.Height, and is useful for accessing the field via an interface.
-func (v *NoteListDocAuthorsAuthorAvatarMedia) GetHeight() *float64 { return v.Height }
+	Tags []NoteListDocTagsTag `json:"tags"`
 
-// NoteListDocExternalLinksMicro_post_external_link includes the requested fields of the GraphQL type Micro_post_external_link.
-type NoteListDocExternalLinksMicro_post_external_link struct {
-	Id         string `json:"id"`
-	Target_url string `json:"target_url"`
-}
+	Attachment *NoteListDocAttachmentMedia `json:"attachment"`
 
-// GetId returns NoteListDocExternalLinksMicro_post_external_link.Id, and is useful for accessing the field via an interface.
-func (v *NoteListDocExternalLinksMicro_post_external_link) GetId() string { return v.Id }
+	ExternalLinks []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
 
-// GetTarget_url returns NoteListDocExternalLinksMicro_post_external_link.Target_url, and is useful for accessing the field via an interface.
-func (v *NoteListDocExternalLinksMicro_post_external_link) GetTarget_url() string {
-	return v.Target_url
-}
+	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post `json:"linkedMicroPosts"`
 
-// NoteListDocLinkedMicroPostsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type NoteListDocLinkedMicroPostsMicro_post struct {
-	Id   string  `json:"id"`
-	Slug *string `json:"slug"`
+	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
 }
 
-// GetId returns NoteListDocLinkedMicroPostsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *NoteListDocLinkedMicroPostsMicro_post) GetId() string { return v.Id }
-
-// GetSlug returns NoteListDocLinkedMicroPostsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *NoteListDocLinkedMicroPostsMicro_post) GetSlug() *string { return v.Slug }
-
-// NoteListDocMetaMicro_post_Meta includes the requested fields of the GraphQL type Micro_post_Meta.
-type NoteListDocMetaMicro_post_Meta struct {
-	Title       *string                                   `json:"title"`
-	Description *string                                   `json:"description"`
-	Image       *NoteListDocMetaMicro_post_MetaImageMedia `json:"image"`
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetTitle returns NoteListDocMetaMicro_post_Meta.Title, and is useful for accessing the field via an interface.
-func (v *NoteListDocMetaMicro_post_Meta) GetTitle() *string { return v.Title }
-
-// GetDescription returns NoteListDocMetaMicro_post_Meta.Description, and is useful for accessing the field via an interface.
-func (v *NoteListDocMetaMicro_post_Meta) GetDescription() *string { return v.Description }
+func (v *NotesPublishedBetweenMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalNotesPublishedBetweenMicro_postsDocsMicro_post, error) {
+	var retval __premarshalNotesPublishedBetweenMicro_postsDocsMicro_post
 
-// GetImage returns NoteListDocMetaMicro_post_Meta.Image, and is useful for accessing the field via an interface.
-func (v *NoteListDocMetaMicro_post_Meta) GetImage() *NoteListDocMetaMicro_post_MetaImageMedia {
-	return v.Image
+	retval.Id = v.NoteListDoc.Id
+	retval.Slug = v.NoteListDoc.Slug
+	retval.Title = v.NoteListDoc.Title
+	retval.Content = v.NoteListDoc.Content
+	retval.PublishedAt = v.NoteListDoc.PublishedAt
+	retval.Featured = v.NoteListDoc.Featured
+	retval.Authors = v.NoteListDoc.Authors
+	retval.Tags = v.NoteListDoc.Tags
+	retval.Attachment = v.NoteListDoc.Attachment
+	retval.ExternalLinks = v.NoteListDoc.ExternalLinks
+	retval.LinkedMicroPosts = v.NoteListDoc.LinkedMicroPosts
+	retval.Meta = v.NoteListDoc.Meta
+	return &retval, nil
 }
 
-// NoteListDocMetaMicro_post_MetaImageMedia includes the requested fields of the GraphQL type Media.
-type NoteListDocMetaMicro_post_MetaImageMedia struct {
-	Url         *string  `json:"url"`
-	Description *string  `json:"description"`
-	Width       *float64 `json:"width"`
-	Height      *float64 `json:"height"`
+// NotesPublishedBetweenResponse is returned by NotesPublishedBetween on success.
+type NotesPublishedBetweenResponse struct {
+	Micro_posts *NotesPublishedBetweenMicro_posts `json:"Micro_posts"`
 }
 
-// GetUrl returns NoteListDocMetaMicro_post_MetaImageMedia.Url, and is useful for accessing the field via an interface.
-func (v *NoteListDocMetaMicro_post_MetaImageMedia) GetUrl() *string { return v.Url }
-
-// GetDescription returns NoteListDocMetaMicro_post_MetaImageMedia.Description, and is useful for accessing the field via an interface.
-func (v *NoteListDocMetaMicro_post_MetaImageMedia) GetDescription() *string { return v.Description }
-
-// GetWidth returns NoteListDocMetaMicro_post_MetaImageMedia.Width, and is useful for accessing the field via an interface.
-func (v *NoteListDocMetaMicro_post_MetaImageMedia) GetWidth() *float64 { return v.Width }
-
-// GetHeight returns NoteListDocMetaMicro_post_MetaImageMedia.Height, and is useful for accessing the field via an interface.
-func (v *NoteListDocMetaMicro_post_MetaImageMedia) GetHeight() *float64 { return v.Height }
-
-// NoteListDocTagsTag includes the requested fields of the GraphQL type Tag.
-type NoteListDocTagsTag struct {
-	Id    string  `json:"id"`
-	Name  string  `json:"name"`
-	Title *string `json:"title"`
+// GetMicro_posts returns NotesPublishedBetweenResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *NotesPublishedBetweenResponse) GetMicro_posts() *NotesPublishedBetweenMicro_posts {
+	return v.Micro_posts
 }
 
-// GetId returns NoteListDocTagsTag.Id, and is useful for accessing the field via an interface.
-func (v *NoteListDocTagsTag) GetId() string { return v.Id }
-
-// GetName returns NoteListDocTagsTag.Name, and is useful for accessing the field via an interface.
-func (v *NoteListDocTagsTag) GetName() string { return v.Name }
-
-// GetTitle returns NoteListDocTagsTag.Title, and is useful for accessing the field via an interface.
-func (v *NoteListDocTagsTag) GetTitle() *string { return v.Title }
-
-// NotesByAuthorSlugAndTypeMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type NotesByAuthorSlugAndTypeMicro_posts struct {
-	TotalPages int                                                 `json:"totalPages"`
-	Docs       []NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post `json:"docs"`
+// RelatedNoteCandidatesMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type RelatedNoteCandidatesMicro_posts struct {
+	Docs []RelatedNoteCandidatesMicro_postsDocsMicro_post `json:"docs"`
 }
 
-// GetTotalPages returns NotesByAuthorSlugAndTypeMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_posts) GetTotalPages() int { return v.TotalPages }
-
-// GetDocs returns NotesByAuthorSlugAndTypeMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_posts) GetDocs() []NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post {
+// GetDocs returns RelatedNoteCandidatesMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_posts) GetDocs() []RelatedNoteCandidatesMicro_postsDocsMicro_post {
 	return v.Docs
 }
 
-// NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post struct {
+// RelatedNoteCandidatesMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type RelatedNoteCandidatesMicro_postsDocsMicro_post struct {
 	NoteListDoc `json:"-"`
 }
 
-// GetId returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+// GetId returns RelatedNoteCandidatesMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
 
-// GetSlug returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetSlug() *string {
-	return v.NoteListDoc.Slug
-}
+// GetSlug returns RelatedNoteCandidatesMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
 
-// GetTitle returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetTitle() *string {
+// GetTitle returns RelatedNoteCandidatesMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetTitle() *string {
 	return v.NoteListDoc.Title
 }
 
-// GetContent returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetContent() *string {
+// GetContent returns RelatedNoteCandidatesMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetContent() *string {
 	return v.NoteListDoc.Content
 }
 
-// GetPublishedAt returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetPublishedAt() *string {
+// GetPublishedAt returns RelatedNoteCandidatesMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetPublishedAt() *string {
 	return v.NoteListDoc.PublishedAt
 }
 
-// GetAuthors returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
+// GetFeatured returns RelatedNoteCandidatesMicro_postsDocsMicro_post.Featured, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetFeatured() *bool {
+	return v.NoteListDoc.Featured
+}
+
+// GetAuthors returns RelatedNoteCandidatesMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
 	return v.NoteListDoc.Authors
 }
 
-// GetTags returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
+// GetTags returns RelatedNoteCandidatesMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
 	return v.NoteListDoc.Tags
 }
 
-// GetAttachment returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
+// GetAttachment returns RelatedNoteCandidatesMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
 	return v.NoteListDoc.Attachment
 }
 
-// GetExternalLinks returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
+// GetExternalLinks returns RelatedNoteCandidatesMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
 	return v.NoteListDoc.ExternalLinks
 }
 
-// GetLinkedMicroPosts returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
+// GetLinkedMicroPosts returns RelatedNoteCandidatesMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
 	return v.NoteListDoc.LinkedMicroPosts
 }
 
-// GetMeta returns NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
+// GetMeta returns RelatedNoteCandidatesMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
 	return v.NoteListDoc.Meta
 }
 
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post
+		*RelatedNoteCandidatesMicro_postsDocsMicro_post
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post = v
+	firstPass.RelatedNoteCandidatesMicro_postsDocsMicro_post = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1678,7 +1985,7 @@ func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []by
 	return nil
 }
 
-type __premarshalNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post struct {
+type __premarshalRelatedNoteCandidatesMicro_postsDocsMicro_post struct {
 	Id string `json:"id"`
 
 	Slug *string `json:"slug"`
@@ -1689,6 +1996,8 @@ type __premarshalNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post struct {
 
 	PublishedAt *string `json:"publishedAt"`
 
+	Featured *bool `json:"featured"`
+
 	Authors []NoteListDocAuthorsAuthor `json:"authors"`
 
 	Tags []NoteListDocTagsTag `json:"tags"`
@@ -1702,7 +2011,7 @@ type __premarshalNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post struct {
 	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
 }
 
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1710,14 +2019,15 @@ func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byt
 	return json.Marshal(premarshaled)
 }
 
-func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post, error) {
-	var retval __premarshalNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post
+func (v *RelatedNoteCandidatesMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalRelatedNoteCandidatesMicro_postsDocsMicro_post, error) {
+	var retval __premarshalRelatedNoteCandidatesMicro_postsDocsMicro_post
 
 	retval.Id = v.NoteListDoc.Id
 	retval.Slug = v.NoteListDoc.Slug
 	retval.Title = v.NoteListDoc.Title
 	retval.Content = v.NoteListDoc.Content
 	retval.PublishedAt = v.NoteListDoc.PublishedAt
+	retval.Featured = v.NoteListDoc.Featured
 	retval.Authors = v.NoteListDoc.Authors
 	retval.Tags = v.NoteListDoc.Tags
 	retval.Attachment = v.NoteListDoc.Attachment
@@ -1727,95 +2037,94 @@ func (v *NotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) __premarshalJSON() (
 	return &retval, nil
 }
 
-// NotesByAuthorSlugAndTypeResponse is returned by NotesByAuthorSlugAndType on success.
-type NotesByAuthorSlugAndTypeResponse struct {
-	Micro_posts *NotesByAuthorSlugAndTypeMicro_posts `json:"Micro_posts"`
+// RelatedNoteCandidatesResponse is returned by RelatedNoteCandidates on success.
+type RelatedNoteCandidatesResponse struct {
+	Micro_posts *RelatedNoteCandidatesMicro_posts `json:"Micro_posts"`
 }
 
-// GetMicro_posts returns NotesByAuthorSlugAndTypeResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugAndTypeResponse) GetMicro_posts() *NotesByAuthorSlugAndTypeMicro_posts {
+// GetMicro_posts returns RelatedNoteCandidatesResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *RelatedNoteCandidatesResponse) GetMicro_posts() *RelatedNoteCandidatesMicro_posts {
 	return v.Micro_posts
 }
 
-// NotesByAuthorSlugMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type NotesByAuthorSlugMicro_posts struct {
-	TotalPages int                                          `json:"totalPages"`
-	Docs       []NotesByAuthorSlugMicro_postsDocsMicro_post `json:"docs"`
+// SearchNotesMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type SearchNotesMicro_posts struct {
+	TotalPages int                                    `json:"totalPages"`
+	Docs       []SearchNotesMicro_postsDocsMicro_post `json:"docs"`
 }
 
-// GetTotalPages returns NotesByAuthorSlugMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_posts) GetTotalPages() int { return v.TotalPages }
+// GetTotalPages returns SearchNotesMicro_posts.TotalPages, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_posts) GetTotalPages() int { return v.TotalPages }
 
-// GetDocs returns NotesByAuthorSlugMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_posts) GetDocs() []NotesByAuthorSlugMicro_postsDocsMicro_post {
-	return v.Docs
-}
+// GetDocs returns SearchNotesMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_posts) GetDocs() []SearchNotesMicro_postsDocsMicro_post { return v.Docs }
 
-// NotesByAuthorSlugMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type NotesByAuthorSlugMicro_postsDocsMicro_post struct {
+// SearchNotesMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type SearchNotesMicro_postsDocsMicro_post struct {
 	NoteListDoc `json:"-"`
 }
 
-// GetId returns NotesByAuthorSlugMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+// GetId returns SearchNotesMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
 
-// GetSlug returns NotesByAuthorSlugMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
+// GetSlug returns SearchNotesMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
 
-// GetTitle returns NotesByAuthorSlugMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
+// GetTitle returns SearchNotesMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
 
-// GetContent returns NotesByAuthorSlugMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetContent() *string {
-	return v.NoteListDoc.Content
-}
+// GetContent returns SearchNotesMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetContent() *string { return v.NoteListDoc.Content }
 
-// GetPublishedAt returns NotesByAuthorSlugMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetPublishedAt() *string {
+// GetPublishedAt returns SearchNotesMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetPublishedAt() *string {
 	return v.NoteListDoc.PublishedAt
 }
 
-// GetAuthors returns NotesByAuthorSlugMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
+// GetFeatured returns SearchNotesMicro_postsDocsMicro_post.Featured, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetFeatured() *bool { return v.NoteListDoc.Featured }
+
+// GetAuthors returns SearchNotesMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
 	return v.NoteListDoc.Authors
 }
 
-// GetTags returns NotesByAuthorSlugMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
+// GetTags returns SearchNotesMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
 	return v.NoteListDoc.Tags
 }
 
-// GetAttachment returns NotesByAuthorSlugMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
+// GetAttachment returns SearchNotesMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
 	return v.NoteListDoc.Attachment
 }
 
-// GetExternalLinks returns NotesByAuthorSlugMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
+// GetExternalLinks returns SearchNotesMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
 	return v.NoteListDoc.ExternalLinks
 }
 
-// GetLinkedMicroPosts returns NotesByAuthorSlugMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
+// GetLinkedMicroPosts returns SearchNotesMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
 	return v.NoteListDoc.LinkedMicroPosts
 }
 
-// GetMeta returns NotesByAuthorSlugMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
+// GetMeta returns SearchNotesMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
+func (v *SearchNotesMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
 	return v.NoteListDoc.Meta
 }
 
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+func (v *SearchNotesMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*NotesByAuthorSlugMicro_postsDocsMicro_post
+		*SearchNotesMicro_postsDocsMicro_post
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.NotesByAuthorSlugMicro_postsDocsMicro_post = v
+	firstPass.SearchNotesMicro_postsDocsMicro_post = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1830,7 +2139,7 @@ func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) err
 	return nil
 }
 
-type __premarshalNotesByAuthorSlugMicro_postsDocsMicro_post struct {
+type __premarshalSearchNotesMicro_postsDocsMicro_post struct {
 	Id string `json:"id"`
 
 	Slug *string `json:"slug"`
@@ -1841,6 +2150,8 @@ type __premarshalNotesByAuthorSlugMicro_postsDocsMicro_post struct {
 
 	PublishedAt *string `json:"publishedAt"`
 
+	Featured *bool `json:"featured"`
+
 	Authors []NoteListDocAuthorsAuthor `json:"authors"`
 
 	Tags []NoteListDocTagsTag `json:"tags"`
@@ -1854,7 +2165,7 @@ type __premarshalNotesByAuthorSlugMicro_postsDocsMicro_post struct {
 	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
 }
 
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
+func (v *SearchNotesMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1862,14 +2173,15 @@ func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) MarshalJSON() ([]byte, erro
 	return json.Marshal(premarshaled)
 }
 
-func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalNotesByAuthorSlugMicro_postsDocsMicro_post, error) {
-	var retval __premarshalNotesByAuthorSlugMicro_postsDocsMicro_post
+func (v *SearchNotesMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalSearchNotesMicro_postsDocsMicro_post, error) {
+	var retval __premarshalSearchNotesMicro_postsDocsMicro_post
 
 	retval.Id = v.NoteListDoc.Id
 	retval.Slug = v.NoteListDoc.Slug
 	retval.Title = v.NoteListDoc.Title
 	retval.Content = v.NoteListDoc.Content
 	retval.PublishedAt = v.NoteListDoc.PublishedAt
+	retval.Featured = v.NoteListDoc.Featured
 	retval.Authors = v.NoteListDoc.Authors
 	retval.Tags = v.NoteListDoc.Tags
 	retval.Attachment = v.NoteListDoc.Attachment
@@ -1879,101 +2191,130 @@ func (v *NotesByAuthorSlugMicro_postsDocsMicro_post) __premarshalJSON() (*__prem
 	return &retval, nil
 }
 
-// NotesByAuthorSlugResponse is returned by NotesByAuthorSlug on success.
-type NotesByAuthorSlugResponse struct {
-	Micro_posts *NotesByAuthorSlugMicro_posts `json:"Micro_posts"`
+// SearchNotesResponse is returned by SearchNotes on success.
+type SearchNotesResponse struct {
+	Micro_posts *SearchNotesMicro_posts `json:"Micro_posts"`
 }
 
-// GetMicro_posts returns NotesByAuthorSlugResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *NotesByAuthorSlugResponse) GetMicro_posts() *NotesByAuthorSlugMicro_posts {
-	return v.Micro_posts
-}
+// GetMicro_posts returns SearchNotesResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *SearchNotesResponse) GetMicro_posts() *SearchNotesMicro_posts { return v.Micro_posts }
 
-// SearchNotesByAuthorAndTagIDsMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type SearchNotesByAuthorAndTagIDsMicro_posts struct {
-	TotalPages int                                                     `json:"totalPages"`
-	Docs       []SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post `json:"docs"`
+// SeriesBySlugResponse is returned by SeriesBySlug on success.
+type SeriesBySlugResponse struct {
+	Series *SeriesBySlugSeries `json:"Series"`
 }
 
-// GetTotalPages returns SearchNotesByAuthorAndTagIDsMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_posts) GetTotalPages() int { return v.TotalPages }
+// GetSeries returns SeriesBySlugResponse.Series, and is useful for accessing the field via an interface.
+func (v *SeriesBySlugResponse) GetSeries() *SeriesBySlugSeries { return v.Series }
 
-// GetDocs returns SearchNotesByAuthorAndTagIDsMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_posts) GetDocs() []SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post {
-	return v.Docs
+// SeriesBySlugSeries includes the requested fields of the GraphQL type Series.
+type SeriesBySlugSeries struct {
+	Docs []SeriesBySlugSeriesDocsSeries `json:"docs"`
 }
 
-// SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
+// GetDocs returns SeriesBySlugSeries.Docs, and is useful for accessing the field via an interface.
+func (v *SeriesBySlugSeries) GetDocs() []SeriesBySlugSeriesDocsSeries { return v.Docs }
+
+// SeriesBySlugSeriesDocsSeries includes the requested fields of the GraphQL type Series.
+type SeriesBySlugSeriesDocsSeries struct {
+	Id          string  `json:"id"`
+	Slug        string  `json:"slug"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
 }
 
-// GetId returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetId() string {
-	return v.NoteListDoc.Id
+// GetId returns SeriesBySlugSeriesDocsSeries.Id, and is useful for accessing the field via an interface.
+func (v *SeriesBySlugSeriesDocsSeries) GetId() string { return v.Id }
+
+// GetSlug returns SeriesBySlugSeriesDocsSeries.Slug, and is useful for accessing the field via an interface.
+func (v *SeriesBySlugSeriesDocsSeries) GetSlug() string { return v.Slug }
+
+// GetTitle returns SeriesBySlugSeriesDocsSeries.Title, and is useful for accessing the field via an interface.
+func (v *SeriesBySlugSeriesDocsSeries) GetTitle() *string { return v.Title }
+
+// GetDescription returns SeriesBySlugSeriesDocsSeries.Description, and is useful for accessing the field via an interface.
+func (v *SeriesBySlugSeriesDocsSeries) GetDescription() *string { return v.Description }
+
+// SeriesNotesMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type SeriesNotesMicro_posts struct {
+	Docs []SeriesNotesMicro_postsDocsMicro_post `json:"docs"`
 }
 
-// GetSlug returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetSlug() *string {
-	return v.NoteListDoc.Slug
+// GetDocs returns SeriesNotesMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_posts) GetDocs() []SeriesNotesMicro_postsDocsMicro_post {
+	return v.Docs
 }
 
-// GetTitle returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetTitle() *string {
-	return v.NoteListDoc.Title
+// SeriesNotesMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type SeriesNotesMicro_postsDocsMicro_post struct {
+	NoteListDoc `json:"-"`
 }
 
-// GetContent returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetContent() *string {
+// GetId returns SeriesNotesMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+
+// GetSlug returns SeriesNotesMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
+
+// GetTitle returns SeriesNotesMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
+
+// GetContent returns SeriesNotesMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetContent() *string {
 	return v.NoteListDoc.Content
 }
 
-// GetPublishedAt returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetPublishedAt() *string {
+// GetPublishedAt returns SeriesNotesMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetPublishedAt() *string {
 	return v.NoteListDoc.PublishedAt
 }
 
-// GetAuthors returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
+// GetFeatured returns SeriesNotesMicro_postsDocsMicro_post.Featured, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetFeatured() *bool {
+	return v.NoteListDoc.Featured
+}
+
+// GetAuthors returns SeriesNotesMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
 	return v.NoteListDoc.Authors
 }
 
-// GetTags returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
+// GetTags returns SeriesNotesMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
 	return v.NoteListDoc.Tags
 }
 
-// GetAttachment returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
+// GetAttachment returns SeriesNotesMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
 	return v.NoteListDoc.Attachment
 }
 
-// GetExternalLinks returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
+// GetExternalLinks returns SeriesNotesMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
 	return v.NoteListDoc.ExternalLinks
 }
 
-// GetLinkedMicroPosts returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
+// GetLinkedMicroPosts returns SeriesNotesMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
 	return v.NoteListDoc.LinkedMicroPosts
 }
 
-// GetMeta returns SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
+// GetMeta returns SeriesNotesMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
+func (v *SeriesNotesMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
 	return v.NoteListDoc.Meta
 }
 
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+func (v *SeriesNotesMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post
+		*SeriesNotesMicro_postsDocsMicro_post
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post = v
+	firstPass.SeriesNotesMicro_postsDocsMicro_post = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -1988,7 +2329,7 @@ func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) UnmarshalJSON(b
 	return nil
 }
 
-type __premarshalSearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post struct {
+type __premarshalSeriesNotesMicro_postsDocsMicro_post struct {
 	Id string `json:"id"`
 
 	Slug *string `json:"slug"`
@@ -1999,6 +2340,8 @@ type __premarshalSearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post struct {
 
 	PublishedAt *string `json:"publishedAt"`
 
+	Featured *bool `json:"featured"`
+
 	Authors []NoteListDocAuthorsAuthor `json:"authors"`
 
 	Tags []NoteListDocTagsTag `json:"tags"`
@@ -2012,7 +2355,7 @@ type __premarshalSearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post struct {
 	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
 }
 
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
+func (v *SeriesNotesMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -2020,14 +2363,15 @@ func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) MarshalJSON() ([
 	return json.Marshal(premarshaled)
 }
 
-func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalSearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post, error) {
-	var retval __premarshalSearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post
+func (v *SeriesNotesMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalSeriesNotesMicro_postsDocsMicro_post, error) {
+	var retval __premarshalSeriesNotesMicro_postsDocsMicro_post
 
 	retval.Id = v.NoteListDoc.Id
 	retval.Slug = v.NoteListDoc.Slug
 	retval.Title = v.NoteListDoc.Title
 	retval.Content = v.NoteListDoc.Content
 	retval.PublishedAt = v.NoteListDoc.PublishedAt
+	retval.Featured = v.NoteListDoc.Featured
 	retval.Authors = v.NoteListDoc.Authors
 	retval.Tags = v.NoteListDoc.Tags
 	retval.Attachment = v.NoteListDoc.Attachment
@@ -2037,2049 +2381,743 @@ func (v *SearchNotesByAuthorAndTagIDsMicro_postsDocsMicro_post) __premarshalJSON
 	return &retval, nil
 }
 
-// SearchNotesByAuthorAndTagIDsResponse is returned by SearchNotesByAuthorAndTagIDs on success.
-type SearchNotesByAuthorAndTagIDsResponse struct {
-	Micro_posts *SearchNotesByAuthorAndTagIDsMicro_posts `json:"Micro_posts"`
+// SeriesNotesResponse is returned by SeriesNotes on success.
+type SeriesNotesResponse struct {
+	Micro_posts *SeriesNotesMicro_posts `json:"Micro_posts"`
 }
 
-// GetMicro_posts returns SearchNotesByAuthorAndTagIDsResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorAndTagIDsResponse) GetMicro_posts() *SearchNotesByAuthorAndTagIDsMicro_posts {
+// GetMicro_posts returns SeriesNotesResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *SeriesNotesResponse) GetMicro_posts() *SeriesNotesMicro_posts {
 	return v.Micro_posts
 }
 
-// SearchNotesByAuthorSlugAndTypeMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type SearchNotesByAuthorSlugAndTypeMicro_posts struct {
-	TotalPages int                                                       `json:"totalPages"`
-	Docs       []SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post `json:"docs"`
+// TagByNameResponse is returned by TagByName on success.
+type TagByNameResponse struct {
+	Tags *TagByNameTags `json:"Tags"`
 }
 
-// GetTotalPages returns SearchNotesByAuthorSlugAndTypeMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_posts) GetTotalPages() int { return v.TotalPages }
-
-// GetDocs returns SearchNotesByAuthorSlugAndTypeMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_posts) GetDocs() []SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post {
-	return v.Docs
-}
+// GetTags returns TagByNameResponse.Tags, and is useful for accessing the field via an interface.
+func (v *TagByNameResponse) GetTags() *TagByNameTags { return v.Tags }
 
-// SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
+// TagByNameTags includes the requested fields of the GraphQL type Tags.
+type TagByNameTags struct {
+	Docs []TagByNameTagsDocsTag `json:"docs"`
 }
 
-// GetId returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetId() string {
-	return v.NoteListDoc.Id
-}
+// GetDocs returns TagByNameTags.Docs, and is useful for accessing the field via an interface.
+func (v *TagByNameTags) GetDocs() []TagByNameTagsDocsTag { return v.Docs }
 
-// GetSlug returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetSlug() *string {
-	return v.NoteListDoc.Slug
+// TagByNameTagsDocsTag includes the requested fields of the GraphQL type Tag.
+type TagByNameTagsDocsTag struct {
+	Id    string  `json:"id"`
+	Name  string  `json:"name"`
+	Title *string `json:"title"`
 }
 
-// GetTitle returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetTitle() *string {
-	return v.NoteListDoc.Title
-}
+// GetId returns TagByNameTagsDocsTag.Id, and is useful for accessing the field via an interface.
+func (v *TagByNameTagsDocsTag) GetId() string { return v.Id }
 
-// GetContent returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetContent() *string {
-	return v.NoteListDoc.Content
-}
+// GetName returns TagByNameTagsDocsTag.Name, and is useful for accessing the field via an interface.
+func (v *TagByNameTagsDocsTag) GetName() string { return v.Name }
 
-// GetPublishedAt returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetPublishedAt() *string {
-	return v.NoteListDoc.PublishedAt
-}
+// GetTitle returns TagByNameTagsDocsTag.Title, and is useful for accessing the field via an interface.
+func (v *TagByNameTagsDocsTag) GetTitle() *string { return v.Title }
 
-// GetAuthors returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
-	return v.NoteListDoc.Authors
+// TagCountsMicro_posts includes the requested fields of the GraphQL type Micro_posts.
+type TagCountsMicro_posts struct {
+	TotalPages int                                  `json:"totalPages"`
+	Docs       []TagCountsMicro_postsDocsMicro_post `json:"docs"`
 }
 
-// GetTags returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
-	return v.NoteListDoc.Tags
-}
+// GetTotalPages returns TagCountsMicro_posts.TotalPages, and is useful for accessing the field via an interface.
+func (v *TagCountsMicro_posts) GetTotalPages() int { return v.TotalPages }
 
-// GetAttachment returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
-	return v.NoteListDoc.Attachment
-}
+// GetDocs returns TagCountsMicro_posts.Docs, and is useful for accessing the field via an interface.
+func (v *TagCountsMicro_posts) GetDocs() []TagCountsMicro_postsDocsMicro_post { return v.Docs }
 
-// GetExternalLinks returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
-	return v.NoteListDoc.ExternalLinks
+// TagCountsMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
+type TagCountsMicro_postsDocsMicro_post struct {
+	Tags []TagCountsMicro_postsDocsMicro_postTagsTag `json:"tags"`
 }
 
-// GetLinkedMicroPosts returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
-	return v.NoteListDoc.LinkedMicroPosts
+// GetTags returns TagCountsMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
+func (v *TagCountsMicro_postsDocsMicro_post) GetTags() []TagCountsMicro_postsDocsMicro_postTagsTag {
+	return v.Tags
 }
 
-// GetMeta returns SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
-	return v.NoteListDoc.Meta
+// TagCountsMicro_postsDocsMicro_postTagsTag includes the requested fields of the GraphQL type Tag.
+type TagCountsMicro_postsDocsMicro_postTagsTag struct {
+	Id    string  `json:"id"`
+	Name  string  `json:"name"`
+	Title *string `json:"title"`
 }
 
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+// GetId returns TagCountsMicro_postsDocsMicro_postTagsTag.Id, and is useful for accessing the field via an interface.
+func (v *TagCountsMicro_postsDocsMicro_postTagsTag) GetId() string { return v.Id }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetName returns TagCountsMicro_postsDocsMicro_postTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *TagCountsMicro_postsDocsMicro_postTagsTag) GetName() string { return v.Name }
 
-	var firstPass struct {
-		*SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post = v
+// GetTitle returns TagCountsMicro_postsDocsMicro_postTagsTag.Title, and is useful for accessing the field via an interface.
+func (v *TagCountsMicro_postsDocsMicro_postTagsTag) GetTitle() *string { return v.Title }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// TagCountsResponse is returned by TagCounts on success.
+type TagCountsResponse struct {
+	Micro_posts *TagCountsMicro_posts `json:"Micro_posts"`
+}
 
-	err = json.Unmarshal(
-		b, &v.NoteListDoc)
-	if err != nil {
-		return err
-	}
-	return nil
+// GetMicro_posts returns TagCountsResponse.Micro_posts, and is useful for accessing the field via an interface.
+func (v *TagCountsResponse) GetMicro_posts() *TagCountsMicro_posts { return v.Micro_posts }
+
+// TagIDsByNamesResponse is returned by TagIDsByNames on success.
+type TagIDsByNamesResponse struct {
+	Tags *TagIDsByNamesTags `json:"Tags"`
 }
 
-type __premarshalSearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post struct {
-	Id string `json:"id"`
+// GetTags returns TagIDsByNamesResponse.Tags, and is useful for accessing the field via an interface.
+func (v *TagIDsByNamesResponse) GetTags() *TagIDsByNamesTags { return v.Tags }
 
-	Slug *string `json:"slug"`
+// TagIDsByNamesTags includes the requested fields of the GraphQL type Tags.
+type TagIDsByNamesTags struct {
+	Docs []TagIDsByNamesTagsDocsTag `json:"docs"`
+}
+
+// GetDocs returns TagIDsByNamesTags.Docs, and is useful for accessing the field via an interface.
+func (v *TagIDsByNamesTags) GetDocs() []TagIDsByNamesTagsDocsTag { return v.Docs }
 
+// TagIDsByNamesTagsDocsTag includes the requested fields of the GraphQL type Tag.
+type TagIDsByNamesTagsDocsTag struct {
+	Id    string  `json:"id"`
+	Name  string  `json:"name"`
 	Title *string `json:"title"`
+}
 
-	Content *string `json:"content"`
+// GetId returns TagIDsByNamesTagsDocsTag.Id, and is useful for accessing the field via an interface.
+func (v *TagIDsByNamesTagsDocsTag) GetId() string { return v.Id }
 
-	PublishedAt *string `json:"publishedAt"`
+// GetName returns TagIDsByNamesTagsDocsTag.Name, and is useful for accessing the field via an interface.
+func (v *TagIDsByNamesTagsDocsTag) GetName() string { return v.Name }
 
-	Authors []NoteListDocAuthorsAuthor `json:"authors"`
+// GetTitle returns TagIDsByNamesTagsDocsTag.Title, and is useful for accessing the field via an interface.
+func (v *TagIDsByNamesTagsDocsTag) GetTitle() *string { return v.Title }
 
-	Tags []NoteListDocTagsTag `json:"tags"`
+// __AdjacentNotesInput is used internally by genqlient
+type __AdjacentNotesInput struct {
+	Id             string                   `json:"id"`
+	PublishedAt    string                   `json:"publishedAt"`
+	Now            *string                  `json:"now,omitempty"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
+}
 
-	Attachment *NoteListDocAttachmentMedia `json:"attachment"`
+// GetId returns __AdjacentNotesInput.Id, and is useful for accessing the field via an interface.
+func (v *__AdjacentNotesInput) GetId() string { return v.Id }
 
-	ExternalLinks []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
+// GetPublishedAt returns __AdjacentNotesInput.PublishedAt, and is useful for accessing the field via an interface.
+func (v *__AdjacentNotesInput) GetPublishedAt() string { return v.PublishedAt }
 
-	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post `json:"linkedMicroPosts"`
+// GetNow returns __AdjacentNotesInput.Now, and is useful for accessing the field via an interface.
+func (v *__AdjacentNotesInput) GetNow() *string { return v.Now }
 
-	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
-}
+// GetLocale returns __AdjacentNotesInput.Locale, and is useful for accessing the field via an interface.
+func (v *__AdjacentNotesInput) GetLocale() *LocaleInputType { return v.Locale }
 
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetFallbackLocale returns __AdjacentNotesInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__AdjacentNotesInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
+
+// __ApprovedCommentsInput is used internally by genqlient
+type __ApprovedCommentsInput struct {
+	NoteID string `json:"noteID"`
+	Limit  *int   `json:"limit"`
 }
 
-func (v *SearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalSearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post, error) {
-	var retval __premarshalSearchNotesByAuthorSlugAndTypeMicro_postsDocsMicro_post
+// GetNoteID returns __ApprovedCommentsInput.NoteID, and is useful for accessing the field via an interface.
+func (v *__ApprovedCommentsInput) GetNoteID() string { return v.NoteID }
 
-	retval.Id = v.NoteListDoc.Id
-	retval.Slug = v.NoteListDoc.Slug
-	retval.Title = v.NoteListDoc.Title
-	retval.Content = v.NoteListDoc.Content
-	retval.PublishedAt = v.NoteListDoc.PublishedAt
-	retval.Authors = v.NoteListDoc.Authors
-	retval.Tags = v.NoteListDoc.Tags
-	retval.Attachment = v.NoteListDoc.Attachment
-	retval.ExternalLinks = v.NoteListDoc.ExternalLinks
-	retval.LinkedMicroPosts = v.NoteListDoc.LinkedMicroPosts
-	retval.Meta = v.NoteListDoc.Meta
-	return &retval, nil
-}
+// GetLimit returns __ApprovedCommentsInput.Limit, and is useful for accessing the field via an interface.
+func (v *__ApprovedCommentsInput) GetLimit() *int { return v.Limit }
 
-// SearchNotesByAuthorSlugAndTypeResponse is returned by SearchNotesByAuthorSlugAndType on success.
-type SearchNotesByAuthorSlugAndTypeResponse struct {
-	Micro_posts *SearchNotesByAuthorSlugAndTypeMicro_posts `json:"Micro_posts"`
+// __ArchiveDatesInput is used internally by genqlient
+type __ArchiveDatesInput struct {
+	Page  int     `json:"page"`
+	Limit int     `json:"limit"`
+	Now   *string `json:"now,omitempty"`
 }
 
-// GetMicro_posts returns SearchNotesByAuthorSlugAndTypeResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugAndTypeResponse) GetMicro_posts() *SearchNotesByAuthorSlugAndTypeMicro_posts {
-	return v.Micro_posts
-}
+// GetPage returns __ArchiveDatesInput.Page, and is useful for accessing the field via an interface.
+func (v *__ArchiveDatesInput) GetPage() int { return v.Page }
 
-// SearchNotesByAuthorSlugMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type SearchNotesByAuthorSlugMicro_posts struct {
-	TotalPages int                                                `json:"totalPages"`
-	Docs       []SearchNotesByAuthorSlugMicro_postsDocsMicro_post `json:"docs"`
-}
+// GetLimit returns __ArchiveDatesInput.Limit, and is useful for accessing the field via an interface.
+func (v *__ArchiveDatesInput) GetLimit() int { return v.Limit }
 
-// GetTotalPages returns SearchNotesByAuthorSlugMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_posts) GetTotalPages() int { return v.TotalPages }
+// GetNow returns __ArchiveDatesInput.Now, and is useful for accessing the field via an interface.
+func (v *__ArchiveDatesInput) GetNow() *string { return v.Now }
 
-// GetDocs returns SearchNotesByAuthorSlugMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_posts) GetDocs() []SearchNotesByAuthorSlugMicro_postsDocsMicro_post {
-	return v.Docs
+// __AuthorBySlugInput is used internally by genqlient
+type __AuthorBySlugInput struct {
+	Slug           string                   `json:"slug"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-// SearchNotesByAuthorSlugMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type SearchNotesByAuthorSlugMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
-}
+// GetSlug returns __AuthorBySlugInput.Slug, and is useful for accessing the field via an interface.
+func (v *__AuthorBySlugInput) GetSlug() string { return v.Slug }
 
-// GetId returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+// GetLocale returns __AuthorBySlugInput.Locale, and is useful for accessing the field via an interface.
+func (v *__AuthorBySlugInput) GetLocale() *LocaleInputType { return v.Locale }
 
-// GetSlug returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetSlug() *string {
-	return v.NoteListDoc.Slug
-}
+// GetFallbackLocale returns __AuthorBySlugInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__AuthorBySlugInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-// GetTitle returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetTitle() *string {
-	return v.NoteListDoc.Title
+// __AvailableAuthorsInput is used internally by genqlient
+type __AvailableAuthorsInput struct {
+	Limit          int                      `json:"limit"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-// GetContent returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetContent() *string {
-	return v.NoteListDoc.Content
-}
+// GetLimit returns __AvailableAuthorsInput.Limit, and is useful for accessing the field via an interface.
+func (v *__AvailableAuthorsInput) GetLimit() int { return v.Limit }
 
-// GetPublishedAt returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetPublishedAt() *string {
-	return v.NoteListDoc.PublishedAt
-}
+// GetLocale returns __AvailableAuthorsInput.Locale, and is useful for accessing the field via an interface.
+func (v *__AvailableAuthorsInput) GetLocale() *LocaleInputType { return v.Locale }
 
-// GetAuthors returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
-	return v.NoteListDoc.Authors
+// GetFallbackLocale returns __AvailableAuthorsInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__AvailableAuthorsInput) GetFallbackLocale() *FallbackLocaleInputType {
+	return v.FallbackLocale
 }
 
-// GetTags returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
-	return v.NoteListDoc.Tags
+// __AvailableTagsByPostTypeInput is used internally by genqlient
+type __AvailableTagsByPostTypeInput struct {
+	PostType *string          `json:"postType"`
+	Locale   *LocaleInputType `json:"locale"`
 }
 
-// GetAttachment returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
-	return v.NoteListDoc.Attachment
-}
+// GetPostType returns __AvailableTagsByPostTypeInput.PostType, and is useful for accessing the field via an interface.
+func (v *__AvailableTagsByPostTypeInput) GetPostType() *string { return v.PostType }
 
-// GetExternalLinks returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
-	return v.NoteListDoc.ExternalLinks
-}
+// GetLocale returns __AvailableTagsByPostTypeInput.Locale, and is useful for accessing the field via an interface.
+func (v *__AvailableTagsByPostTypeInput) GetLocale() *LocaleInputType { return v.Locale }
 
-// GetLinkedMicroPosts returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
-	return v.NoteListDoc.LinkedMicroPosts
+// __ChannelCountsInput is used internally by genqlient
+type __ChannelCountsInput struct {
+	Page           int                      `json:"page"`
+	Limit          int                      `json:"limit"`
+	Now            *string                  `json:"now,omitempty"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-// GetMeta returns SearchNotesByAuthorSlugMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
-	return v.NoteListDoc.Meta
-}
+// GetPage returns __ChannelCountsInput.Page, and is useful for accessing the field via an interface.
+func (v *__ChannelCountsInput) GetPage() int { return v.Page }
 
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+// GetLimit returns __ChannelCountsInput.Limit, and is useful for accessing the field via an interface.
+func (v *__ChannelCountsInput) GetLimit() int { return v.Limit }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetNow returns __ChannelCountsInput.Now, and is useful for accessing the field via an interface.
+func (v *__ChannelCountsInput) GetNow() *string { return v.Now }
 
-	var firstPass struct {
-		*SearchNotesByAuthorSlugMicro_postsDocsMicro_post
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.SearchNotesByAuthorSlugMicro_postsDocsMicro_post = v
+// GetLocale returns __ChannelCountsInput.Locale, and is useful for accessing the field via an interface.
+func (v *__ChannelCountsInput) GetLocale() *LocaleInputType { return v.Locale }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetFallbackLocale returns __ChannelCountsInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__ChannelCountsInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-	err = json.Unmarshal(
-		b, &v.NoteListDoc)
-	if err != nil {
-		return err
-	}
-	return nil
+// __CreateCommentInput is used internally by genqlient
+type __CreateCommentInput struct {
+	NoteID      string  `json:"noteID"`
+	AuthorName  string  `json:"authorName"`
+	AuthorEmail *string `json:"authorEmail"`
+	Body        string  `json:"body"`
 }
 
-type __premarshalSearchNotesByAuthorSlugMicro_postsDocsMicro_post struct {
-	Id string `json:"id"`
-
-	Slug *string `json:"slug"`
-
-	Title *string `json:"title"`
+// GetNoteID returns __CreateCommentInput.NoteID, and is useful for accessing the field via an interface.
+func (v *__CreateCommentInput) GetNoteID() string { return v.NoteID }
 
-	Content *string `json:"content"`
+// GetAuthorName returns __CreateCommentInput.AuthorName, and is useful for accessing the field via an interface.
+func (v *__CreateCommentInput) GetAuthorName() string { return v.AuthorName }
 
-	PublishedAt *string `json:"publishedAt"`
+// GetAuthorEmail returns __CreateCommentInput.AuthorEmail, and is useful for accessing the field via an interface.
+func (v *__CreateCommentInput) GetAuthorEmail() *string { return v.AuthorEmail }
 
-	Authors []NoteListDocAuthorsAuthor `json:"authors"`
+// GetBody returns __CreateCommentInput.Body, and is useful for accessing the field via an interface.
+func (v *__CreateCommentInput) GetBody() string { return v.Body }
 
-	Tags []NoteListDocTagsTag `json:"tags"`
+// __ListFeaturedNotesInput is used internally by genqlient
+type __ListFeaturedNotesInput struct {
+	Limit          int                      `json:"limit"`
+	Now            *string                  `json:"now,omitempty"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
+}
 
-	Attachment *NoteListDocAttachmentMedia `json:"attachment"`
+// GetLimit returns __ListFeaturedNotesInput.Limit, and is useful for accessing the field via an interface.
+func (v *__ListFeaturedNotesInput) GetLimit() int { return v.Limit }
 
-	ExternalLinks []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
+// GetNow returns __ListFeaturedNotesInput.Now, and is useful for accessing the field via an interface.
+func (v *__ListFeaturedNotesInput) GetNow() *string { return v.Now }
 
-	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post `json:"linkedMicroPosts"`
+// GetLocale returns __ListFeaturedNotesInput.Locale, and is useful for accessing the field via an interface.
+func (v *__ListFeaturedNotesInput) GetLocale() *LocaleInputType { return v.Locale }
 
-	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
+// GetFallbackLocale returns __ListFeaturedNotesInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__ListFeaturedNotesInput) GetFallbackLocale() *FallbackLocaleInputType {
+	return v.FallbackLocale
 }
 
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// __ListNotesInput is used internally by genqlient
+type __ListNotesInput struct {
+	Page           int                         `json:"page"`
+	Limit          int                         `json:"limit"`
+	Slug           *string                     `json:"slug,omitempty"`
+	AuthorSlugs    []string                    `json:"authorSlugs,omitempty"`
+	TagIDs         []string                    `json:"tagIDs,omitempty"`
+	AllTagIDs      []string                    `json:"allTagIDs,omitempty"`
+	PostType       *Micro_post_post_type_Input `json:"postType,omitempty"`
+	From           *string                     `json:"from,omitempty"`
+	To             *string                     `json:"to,omitempty"`
+	Now            *string                     `json:"now,omitempty"`
+	Locale         *LocaleInputType            `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType    `json:"fallbackLocale"`
 }
 
-func (v *SearchNotesByAuthorSlugMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalSearchNotesByAuthorSlugMicro_postsDocsMicro_post, error) {
-	var retval __premarshalSearchNotesByAuthorSlugMicro_postsDocsMicro_post
+// GetPage returns __ListNotesInput.Page, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetPage() int { return v.Page }
 
-	retval.Id = v.NoteListDoc.Id
-	retval.Slug = v.NoteListDoc.Slug
-	retval.Title = v.NoteListDoc.Title
-	retval.Content = v.NoteListDoc.Content
-	retval.PublishedAt = v.NoteListDoc.PublishedAt
-	retval.Authors = v.NoteListDoc.Authors
-	retval.Tags = v.NoteListDoc.Tags
-	retval.Attachment = v.NoteListDoc.Attachment
-	retval.ExternalLinks = v.NoteListDoc.ExternalLinks
-	retval.LinkedMicroPosts = v.NoteListDoc.LinkedMicroPosts
-	retval.Meta = v.NoteListDoc.Meta
-	return &retval, nil
-}
+// GetLimit returns __ListNotesInput.Limit, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetLimit() int { return v.Limit }
 
-// SearchNotesByAuthorSlugResponse is returned by SearchNotesByAuthorSlug on success.
-type SearchNotesByAuthorSlugResponse struct {
-	Micro_posts *SearchNotesByAuthorSlugMicro_posts `json:"Micro_posts"`
-}
+// GetSlug returns __ListNotesInput.Slug, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetSlug() *string { return v.Slug }
 
-// GetMicro_posts returns SearchNotesByAuthorSlugResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorSlugResponse) GetMicro_posts() *SearchNotesByAuthorSlugMicro_posts {
-	return v.Micro_posts
-}
+// GetAuthorSlugs returns __ListNotesInput.AuthorSlugs, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetAuthorSlugs() []string { return v.AuthorSlugs }
 
-// SearchNotesByAuthorTagIDsAndTypeMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type SearchNotesByAuthorTagIDsAndTypeMicro_posts struct {
-	TotalPages int                                                         `json:"totalPages"`
-	Docs       []SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post `json:"docs"`
-}
+// GetTagIDs returns __ListNotesInput.TagIDs, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetTagIDs() []string { return v.TagIDs }
 
-// GetTotalPages returns SearchNotesByAuthorTagIDsAndTypeMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_posts) GetTotalPages() int { return v.TotalPages }
+// GetAllTagIDs returns __ListNotesInput.AllTagIDs, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetAllTagIDs() []string { return v.AllTagIDs }
 
-// GetDocs returns SearchNotesByAuthorTagIDsAndTypeMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_posts) GetDocs() []SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post {
-	return v.Docs
-}
+// GetPostType returns __ListNotesInput.PostType, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetPostType() *Micro_post_post_type_Input { return v.PostType }
 
-// SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
-}
+// GetFrom returns __ListNotesInput.From, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetFrom() *string { return v.From }
 
-// GetId returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetId() string {
-	return v.NoteListDoc.Id
-}
+// GetTo returns __ListNotesInput.To, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetTo() *string { return v.To }
 
-// GetSlug returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetSlug() *string {
-	return v.NoteListDoc.Slug
-}
+// GetNow returns __ListNotesInput.Now, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetNow() *string { return v.Now }
 
-// GetTitle returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetTitle() *string {
-	return v.NoteListDoc.Title
-}
+// GetLocale returns __ListNotesInput.Locale, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetLocale() *LocaleInputType { return v.Locale }
 
-// GetContent returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetContent() *string {
-	return v.NoteListDoc.Content
-}
+// GetFallbackLocale returns __ListNotesInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__ListNotesInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-// GetPublishedAt returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetPublishedAt() *string {
-	return v.NoteListDoc.PublishedAt
+// __ListNotesPageInput is used internally by genqlient
+type __ListNotesPageInput struct {
+	Page           int                      `json:"page"`
+	Limit          int                      `json:"limit"`
+	AuthorsLimit   int                      `json:"authorsLimit"`
+	Now            *string                  `json:"now,omitempty"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-// GetAuthors returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
-	return v.NoteListDoc.Authors
-}
+// GetPage returns __ListNotesPageInput.Page, and is useful for accessing the field via an interface.
+func (v *__ListNotesPageInput) GetPage() int { return v.Page }
 
-// GetTags returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
-	return v.NoteListDoc.Tags
-}
+// GetLimit returns __ListNotesPageInput.Limit, and is useful for accessing the field via an interface.
+func (v *__ListNotesPageInput) GetLimit() int { return v.Limit }
 
-// GetAttachment returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
-	return v.NoteListDoc.Attachment
-}
+// GetAuthorsLimit returns __ListNotesPageInput.AuthorsLimit, and is useful for accessing the field via an interface.
+func (v *__ListNotesPageInput) GetAuthorsLimit() int { return v.AuthorsLimit }
 
-// GetExternalLinks returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
-	return v.NoteListDoc.ExternalLinks
-}
+// GetNow returns __ListNotesPageInput.Now, and is useful for accessing the field via an interface.
+func (v *__ListNotesPageInput) GetNow() *string { return v.Now }
 
-// GetLinkedMicroPosts returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
-	return v.NoteListDoc.LinkedMicroPosts
-}
+// GetLocale returns __ListNotesPageInput.Locale, and is useful for accessing the field via an interface.
+func (v *__ListNotesPageInput) GetLocale() *LocaleInputType { return v.Locale }
 
-// GetMeta returns SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
-	return v.NoteListDoc.Meta
+// GetFallbackLocale returns __ListNotesPageInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__ListNotesPageInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
+
+// __NoteBySlugInput is used internally by genqlient
+type __NoteBySlugInput struct {
+	Slug           string                   `json:"slug"`
+	Now            *string                  `json:"now,omitempty"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+// GetSlug returns __NoteBySlugInput.Slug, and is useful for accessing the field via an interface.
+func (v *__NoteBySlugInput) GetSlug() string { return v.Slug }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetNow returns __NoteBySlugInput.Now, and is useful for accessing the field via an interface.
+func (v *__NoteBySlugInput) GetNow() *string { return v.Now }
 
-	var firstPass struct {
-		*SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post = v
+// GetLocale returns __NoteBySlugInput.Locale, and is useful for accessing the field via an interface.
+func (v *__NoteBySlugInput) GetLocale() *LocaleInputType { return v.Locale }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetFallbackLocale returns __NoteBySlugInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__NoteBySlugInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-	err = json.Unmarshal(
-		b, &v.NoteListDoc)
-	if err != nil {
-		return err
-	}
-	return nil
+// __NoteDraftBySlugInput is used internally by genqlient
+type __NoteDraftBySlugInput struct {
+	Slug           string                   `json:"slug"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-type __premarshalSearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post struct {
-	Id string `json:"id"`
-
-	Slug *string `json:"slug"`
-
-	Title *string `json:"title"`
-
-	Content *string `json:"content"`
+// GetSlug returns __NoteDraftBySlugInput.Slug, and is useful for accessing the field via an interface.
+func (v *__NoteDraftBySlugInput) GetSlug() string { return v.Slug }
 
-	PublishedAt *string `json:"publishedAt"`
+// GetLocale returns __NoteDraftBySlugInput.Locale, and is useful for accessing the field via an interface.
+func (v *__NoteDraftBySlugInput) GetLocale() *LocaleInputType { return v.Locale }
 
-	Authors []NoteListDocAuthorsAuthor `json:"authors"`
+// GetFallbackLocale returns __NoteDraftBySlugInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__NoteDraftBySlugInput) GetFallbackLocale() *FallbackLocaleInputType {
+	return v.FallbackLocale
+}
 
-	Tags []NoteListDocTagsTag `json:"tags"`
+// __NoteRelationsInput is used internally by genqlient
+type __NoteRelationsInput struct {
+	Id             string                   `json:"id"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
+}
 
-	Attachment *NoteListDocAttachmentMedia `json:"attachment"`
+// GetId returns __NoteRelationsInput.Id, and is useful for accessing the field via an interface.
+func (v *__NoteRelationsInput) GetId() string { return v.Id }
 
-	ExternalLinks []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
+// GetLocale returns __NoteRelationsInput.Locale, and is useful for accessing the field via an interface.
+func (v *__NoteRelationsInput) GetLocale() *LocaleInputType { return v.Locale }
 
-	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post `json:"linkedMicroPosts"`
+// GetFallbackLocale returns __NoteRelationsInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__NoteRelationsInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
+// __NoteSeriesInput is used internally by genqlient
+type __NoteSeriesInput struct {
+	Id             string                   `json:"id"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetId returns __NoteSeriesInput.Id, and is useful for accessing the field via an interface.
+func (v *__NoteSeriesInput) GetId() string { return v.Id }
 
-func (v *SearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalSearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post, error) {
-	var retval __premarshalSearchNotesByAuthorTagIDsAndTypeMicro_postsDocsMicro_post
+// GetLocale returns __NoteSeriesInput.Locale, and is useful for accessing the field via an interface.
+func (v *__NoteSeriesInput) GetLocale() *LocaleInputType { return v.Locale }
 
-	retval.Id = v.NoteListDoc.Id
-	retval.Slug = v.NoteListDoc.Slug
-	retval.Title = v.NoteListDoc.Title
-	retval.Content = v.NoteListDoc.Content
-	retval.PublishedAt = v.NoteListDoc.PublishedAt
-	retval.Authors = v.NoteListDoc.Authors
-	retval.Tags = v.NoteListDoc.Tags
-	retval.Attachment = v.NoteListDoc.Attachment
-	retval.ExternalLinks = v.NoteListDoc.ExternalLinks
-	retval.LinkedMicroPosts = v.NoteListDoc.LinkedMicroPosts
-	retval.Meta = v.NoteListDoc.Meta
-	return &retval, nil
-}
+// GetFallbackLocale returns __NoteSeriesInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__NoteSeriesInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-// SearchNotesByAuthorTagIDsAndTypeResponse is returned by SearchNotesByAuthorTagIDsAndType on success.
-type SearchNotesByAuthorTagIDsAndTypeResponse struct {
-	Micro_posts *SearchNotesByAuthorTagIDsAndTypeMicro_posts `json:"Micro_posts"`
+// __NotesPublishedBetweenInput is used internally by genqlient
+type __NotesPublishedBetweenInput struct {
+	From           string                   `json:"from"`
+	To             string                   `json:"to"`
+	Page           int                      `json:"page"`
+	Limit          int                      `json:"limit"`
+	Now            *string                  `json:"now,omitempty"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-// GetMicro_posts returns SearchNotesByAuthorTagIDsAndTypeResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByAuthorTagIDsAndTypeResponse) GetMicro_posts() *SearchNotesByAuthorTagIDsAndTypeMicro_posts {
-	return v.Micro_posts
-}
+// GetFrom returns __NotesPublishedBetweenInput.From, and is useful for accessing the field via an interface.
+func (v *__NotesPublishedBetweenInput) GetFrom() string { return v.From }
 
-// SearchNotesByTagIDsAndTypeMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type SearchNotesByTagIDsAndTypeMicro_posts struct {
-	TotalPages int                                                   `json:"totalPages"`
-	Docs       []SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post `json:"docs"`
-}
+// GetTo returns __NotesPublishedBetweenInput.To, and is useful for accessing the field via an interface.
+func (v *__NotesPublishedBetweenInput) GetTo() string { return v.To }
 
-// GetTotalPages returns SearchNotesByTagIDsAndTypeMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_posts) GetTotalPages() int { return v.TotalPages }
+// GetPage returns __NotesPublishedBetweenInput.Page, and is useful for accessing the field via an interface.
+func (v *__NotesPublishedBetweenInput) GetPage() int { return v.Page }
 
-// GetDocs returns SearchNotesByTagIDsAndTypeMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_posts) GetDocs() []SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post {
-	return v.Docs
-}
+// GetLimit returns __NotesPublishedBetweenInput.Limit, and is useful for accessing the field via an interface.
+func (v *__NotesPublishedBetweenInput) GetLimit() int { return v.Limit }
 
-// SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
-}
+// GetNow returns __NotesPublishedBetweenInput.Now, and is useful for accessing the field via an interface.
+func (v *__NotesPublishedBetweenInput) GetNow() *string { return v.Now }
 
-// GetId returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+// GetLocale returns __NotesPublishedBetweenInput.Locale, and is useful for accessing the field via an interface.
+func (v *__NotesPublishedBetweenInput) GetLocale() *LocaleInputType { return v.Locale }
 
-// GetSlug returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetSlug() *string {
-	return v.NoteListDoc.Slug
+// GetFallbackLocale returns __NotesPublishedBetweenInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__NotesPublishedBetweenInput) GetFallbackLocale() *FallbackLocaleInputType {
+	return v.FallbackLocale
 }
 
-// GetTitle returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetTitle() *string {
-	return v.NoteListDoc.Title
+// __RelatedNoteCandidatesInput is used internally by genqlient
+type __RelatedNoteCandidatesInput struct {
+	Id             string                   `json:"id"`
+	TagIDs         []string                 `json:"tagIDs"`
+	AuthorSlugs    []string                 `json:"authorSlugs"`
+	Limit          int                      `json:"limit"`
+	Now            *string                  `json:"now,omitempty"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-// GetContent returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetContent() *string {
-	return v.NoteListDoc.Content
-}
+// GetId returns __RelatedNoteCandidatesInput.Id, and is useful for accessing the field via an interface.
+func (v *__RelatedNoteCandidatesInput) GetId() string { return v.Id }
 
-// GetPublishedAt returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetPublishedAt() *string {
-	return v.NoteListDoc.PublishedAt
-}
+// GetTagIDs returns __RelatedNoteCandidatesInput.TagIDs, and is useful for accessing the field via an interface.
+func (v *__RelatedNoteCandidatesInput) GetTagIDs() []string { return v.TagIDs }
 
-// GetAuthors returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
-	return v.NoteListDoc.Authors
-}
+// GetAuthorSlugs returns __RelatedNoteCandidatesInput.AuthorSlugs, and is useful for accessing the field via an interface.
+func (v *__RelatedNoteCandidatesInput) GetAuthorSlugs() []string { return v.AuthorSlugs }
 
-// GetTags returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
-	return v.NoteListDoc.Tags
-}
+// GetLimit returns __RelatedNoteCandidatesInput.Limit, and is useful for accessing the field via an interface.
+func (v *__RelatedNoteCandidatesInput) GetLimit() int { return v.Limit }
 
-// GetAttachment returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
-	return v.NoteListDoc.Attachment
-}
+// GetNow returns __RelatedNoteCandidatesInput.Now, and is useful for accessing the field via an interface.
+func (v *__RelatedNoteCandidatesInput) GetNow() *string { return v.Now }
 
-// GetExternalLinks returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
-	return v.NoteListDoc.ExternalLinks
-}
+// GetLocale returns __RelatedNoteCandidatesInput.Locale, and is useful for accessing the field via an interface.
+func (v *__RelatedNoteCandidatesInput) GetLocale() *LocaleInputType { return v.Locale }
 
-// GetLinkedMicroPosts returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
-	return v.NoteListDoc.LinkedMicroPosts
+// GetFallbackLocale returns __RelatedNoteCandidatesInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__RelatedNoteCandidatesInput) GetFallbackLocale() *FallbackLocaleInputType {
+	return v.FallbackLocale
 }
 
-// GetMeta returns SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
-	return v.NoteListDoc.Meta
+// __SearchNotesInput is used internally by genqlient
+type __SearchNotesInput struct {
+	Query          string                      `json:"query"`
+	Page           int                         `json:"page"`
+	Limit          int                         `json:"limit"`
+	Slug           *string                     `json:"slug,omitempty"`
+	AuthorSlugs    []string                    `json:"authorSlugs,omitempty"`
+	TagIDs         []string                    `json:"tagIDs,omitempty"`
+	AllTagIDs      []string                    `json:"allTagIDs,omitempty"`
+	PostType       *Micro_post_post_type_Input `json:"postType,omitempty"`
+	From           *string                     `json:"from,omitempty"`
+	To             *string                     `json:"to,omitempty"`
+	Now            *string                     `json:"now,omitempty"`
+	Locale         *LocaleInputType            `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType    `json:"fallbackLocale"`
 }
 
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post = v
+// GetQuery returns __SearchNotesInput.Query, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetQuery() string { return v.Query }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetPage returns __SearchNotesInput.Page, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetPage() int { return v.Page }
 
-	err = json.Unmarshal(
-		b, &v.NoteListDoc)
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// GetLimit returns __SearchNotesInput.Limit, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetLimit() int { return v.Limit }
 
-type __premarshalSearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post struct {
-	Id string `json:"id"`
+// GetSlug returns __SearchNotesInput.Slug, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetSlug() *string { return v.Slug }
 
-	Slug *string `json:"slug"`
+// GetAuthorSlugs returns __SearchNotesInput.AuthorSlugs, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetAuthorSlugs() []string { return v.AuthorSlugs }
 
-	Title *string `json:"title"`
+// GetTagIDs returns __SearchNotesInput.TagIDs, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetTagIDs() []string { return v.TagIDs }
 
-	Content *string `json:"content"`
+// GetAllTagIDs returns __SearchNotesInput.AllTagIDs, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetAllTagIDs() []string { return v.AllTagIDs }
 
-	PublishedAt *string `json:"publishedAt"`
+// GetPostType returns __SearchNotesInput.PostType, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetPostType() *Micro_post_post_type_Input { return v.PostType }
 
-	Authors []NoteListDocAuthorsAuthor `json:"authors"`
+// GetFrom returns __SearchNotesInput.From, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetFrom() *string { return v.From }
 
-	Tags []NoteListDocTagsTag `json:"tags"`
+// GetTo returns __SearchNotesInput.To, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetTo() *string { return v.To }
 
-	Attachment *NoteListDocAttachmentMedia `json:"attachment"`
+// GetNow returns __SearchNotesInput.Now, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetNow() *string { return v.Now }
 
-	ExternalLinks []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
+// GetLocale returns __SearchNotesInput.Locale, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetLocale() *LocaleInputType { return v.Locale }
 
-	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post `json:"linkedMicroPosts"`
+// GetFallbackLocale returns __SearchNotesInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__SearchNotesInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
+// __SeriesBySlugInput is used internally by genqlient
+type __SeriesBySlugInput struct {
+	Slug           string                   `json:"slug"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetSlug returns __SeriesBySlugInput.Slug, and is useful for accessing the field via an interface.
+func (v *__SeriesBySlugInput) GetSlug() string { return v.Slug }
 
-func (v *SearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalSearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post, error) {
-	var retval __premarshalSearchNotesByTagIDsAndTypeMicro_postsDocsMicro_post
+// GetLocale returns __SeriesBySlugInput.Locale, and is useful for accessing the field via an interface.
+func (v *__SeriesBySlugInput) GetLocale() *LocaleInputType { return v.Locale }
 
-	retval.Id = v.NoteListDoc.Id
-	retval.Slug = v.NoteListDoc.Slug
-	retval.Title = v.NoteListDoc.Title
-	retval.Content = v.NoteListDoc.Content
-	retval.PublishedAt = v.NoteListDoc.PublishedAt
-	retval.Authors = v.NoteListDoc.Authors
-	retval.Tags = v.NoteListDoc.Tags
-	retval.Attachment = v.NoteListDoc.Attachment
-	retval.ExternalLinks = v.NoteListDoc.ExternalLinks
-	retval.LinkedMicroPosts = v.NoteListDoc.LinkedMicroPosts
-	retval.Meta = v.NoteListDoc.Meta
-	return &retval, nil
-}
+// GetFallbackLocale returns __SeriesBySlugInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__SeriesBySlugInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-// SearchNotesByTagIDsAndTypeResponse is returned by SearchNotesByTagIDsAndType on success.
-type SearchNotesByTagIDsAndTypeResponse struct {
-	Micro_posts *SearchNotesByTagIDsAndTypeMicro_posts `json:"Micro_posts"`
+// __SeriesNotesInput is used internally by genqlient
+type __SeriesNotesInput struct {
+	SeriesID       string                   `json:"seriesID"`
+	Limit          int                      `json:"limit"`
+	Now            *string                  `json:"now,omitempty"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-// GetMicro_posts returns SearchNotesByTagIDsAndTypeResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsAndTypeResponse) GetMicro_posts() *SearchNotesByTagIDsAndTypeMicro_posts {
-	return v.Micro_posts
-}
+// GetSeriesID returns __SeriesNotesInput.SeriesID, and is useful for accessing the field via an interface.
+func (v *__SeriesNotesInput) GetSeriesID() string { return v.SeriesID }
 
-// SearchNotesByTagIDsMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type SearchNotesByTagIDsMicro_posts struct {
-	TotalPages int                                            `json:"totalPages"`
-	Docs       []SearchNotesByTagIDsMicro_postsDocsMicro_post `json:"docs"`
-}
+// GetLimit returns __SeriesNotesInput.Limit, and is useful for accessing the field via an interface.
+func (v *__SeriesNotesInput) GetLimit() int { return v.Limit }
 
-// GetTotalPages returns SearchNotesByTagIDsMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_posts) GetTotalPages() int { return v.TotalPages }
+// GetNow returns __SeriesNotesInput.Now, and is useful for accessing the field via an interface.
+func (v *__SeriesNotesInput) GetNow() *string { return v.Now }
 
-// GetDocs returns SearchNotesByTagIDsMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_posts) GetDocs() []SearchNotesByTagIDsMicro_postsDocsMicro_post {
-	return v.Docs
-}
+// GetLocale returns __SeriesNotesInput.Locale, and is useful for accessing the field via an interface.
+func (v *__SeriesNotesInput) GetLocale() *LocaleInputType { return v.Locale }
 
-// SearchNotesByTagIDsMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type SearchNotesByTagIDsMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
-}
+// GetFallbackLocale returns __SeriesNotesInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__SeriesNotesInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-// GetId returns SearchNotesByTagIDsMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
+// __TagByNameInput is used internally by genqlient
+type __TagByNameInput struct {
+	Name           string                   `json:"name"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
+}
 
-// GetSlug returns SearchNotesByTagIDsMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
+// GetName returns __TagByNameInput.Name, and is useful for accessing the field via an interface.
+func (v *__TagByNameInput) GetName() string { return v.Name }
 
-// GetTitle returns SearchNotesByTagIDsMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
+// GetLocale returns __TagByNameInput.Locale, and is useful for accessing the field via an interface.
+func (v *__TagByNameInput) GetLocale() *LocaleInputType { return v.Locale }
 
-// GetContent returns SearchNotesByTagIDsMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetContent() *string {
-	return v.NoteListDoc.Content
-}
+// GetFallbackLocale returns __TagByNameInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__TagByNameInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-// GetPublishedAt returns SearchNotesByTagIDsMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetPublishedAt() *string {
-	return v.NoteListDoc.PublishedAt
+// __TagCountsInput is used internally by genqlient
+type __TagCountsInput struct {
+	Page           int                      `json:"page"`
+	Limit          int                      `json:"limit"`
+	Now            *string                  `json:"now,omitempty"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-// GetAuthors returns SearchNotesByTagIDsMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
-	return v.NoteListDoc.Authors
-}
+// GetPage returns __TagCountsInput.Page, and is useful for accessing the field via an interface.
+func (v *__TagCountsInput) GetPage() int { return v.Page }
 
-// GetTags returns SearchNotesByTagIDsMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
-	return v.NoteListDoc.Tags
-}
+// GetLimit returns __TagCountsInput.Limit, and is useful for accessing the field via an interface.
+func (v *__TagCountsInput) GetLimit() int { return v.Limit }
 
-// GetAttachment returns SearchNotesByTagIDsMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
-	return v.NoteListDoc.Attachment
-}
+// GetNow returns __TagCountsInput.Now, and is useful for accessing the field via an interface.
+func (v *__TagCountsInput) GetNow() *string { return v.Now }
 
-// GetExternalLinks returns SearchNotesByTagIDsMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
-	return v.NoteListDoc.ExternalLinks
-}
+// GetLocale returns __TagCountsInput.Locale, and is useful for accessing the field via an interface.
+func (v *__TagCountsInput) GetLocale() *LocaleInputType { return v.Locale }
 
-// GetLinkedMicroPosts returns SearchNotesByTagIDsMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
-	return v.NoteListDoc.LinkedMicroPosts
-}
+// GetFallbackLocale returns __TagCountsInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__TagCountsInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-// GetMeta returns SearchNotesByTagIDsMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
-	return v.NoteListDoc.Meta
+// __TagIDsByNamesInput is used internally by genqlient
+type __TagIDsByNamesInput struct {
+	TagNames       []string                 `json:"tagNames"`
+	Locale         *LocaleInputType         `json:"locale"`
+	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
 }
 
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
+// GetTagNames returns __TagIDsByNamesInput.TagNames, and is useful for accessing the field via an interface.
+func (v *__TagIDsByNamesInput) GetTagNames() []string { return v.TagNames }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetLocale returns __TagIDsByNamesInput.Locale, and is useful for accessing the field via an interface.
+func (v *__TagIDsByNamesInput) GetLocale() *LocaleInputType { return v.Locale }
 
-	var firstPass struct {
-		*SearchNotesByTagIDsMicro_postsDocsMicro_post
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.SearchNotesByTagIDsMicro_postsDocsMicro_post = v
+// GetFallbackLocale returns __TagIDsByNamesInput.FallbackLocale, and is useful for accessing the field via an interface.
+func (v *__TagIDsByNamesInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
+// The query executed by AdjacentNotes.
+const AdjacentNotes_Operation = `
+query AdjacentNotes ($id: String!, $publishedAt: DateTime!, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	newer: Micro_posts(limit: 1, locale: $locale, fallbackLocale: $fallbackLocale, sort: "publishedAt", where: {_status:{equals:published},id:{not_equals:$id},publishedAt:{greater_than_equal:$publishedAt,less_than_equal:$now}}) {
+		docs {
+			id
+			slug
+			title
+		}
 	}
-
-	err = json.Unmarshal(
-		b, &v.NoteListDoc)
-	if err != nil {
-		return err
+	older: Micro_posts(limit: 1, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},id:{not_equals:$id},publishedAt:{less_than:$publishedAt,less_than_equal:$now}}) {
+		docs {
+			id
+			slug
+			title
+		}
 	}
-	return nil
 }
+`
 
-type __premarshalSearchNotesByTagIDsMicro_postsDocsMicro_post struct {
-	Id string `json:"id"`
-
-	Slug *string `json:"slug"`
-
-	Title *string `json:"title"`
-
-	Content *string `json:"content"`
-
-	PublishedAt *string `json:"publishedAt"`
-
-	Authors []NoteListDocAuthorsAuthor `json:"authors"`
-
-	Tags []NoteListDocTagsTag `json:"tags"`
-
-	Attachment *NoteListDocAttachmentMedia `json:"attachment"`
-
-	ExternalLinks []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
-
-	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post `json:"linkedMicroPosts"`
-
-	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
-}
-
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
-
-func (v *SearchNotesByTagIDsMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalSearchNotesByTagIDsMicro_postsDocsMicro_post, error) {
-	var retval __premarshalSearchNotesByTagIDsMicro_postsDocsMicro_post
-
-	retval.Id = v.NoteListDoc.Id
-	retval.Slug = v.NoteListDoc.Slug
-	retval.Title = v.NoteListDoc.Title
-	retval.Content = v.NoteListDoc.Content
-	retval.PublishedAt = v.NoteListDoc.PublishedAt
-	retval.Authors = v.NoteListDoc.Authors
-	retval.Tags = v.NoteListDoc.Tags
-	retval.Attachment = v.NoteListDoc.Attachment
-	retval.ExternalLinks = v.NoteListDoc.ExternalLinks
-	retval.LinkedMicroPosts = v.NoteListDoc.LinkedMicroPosts
-	retval.Meta = v.NoteListDoc.Meta
-	return &retval, nil
-}
-
-// SearchNotesByTagIDsResponse is returned by SearchNotesByTagIDs on success.
-type SearchNotesByTagIDsResponse struct {
-	Micro_posts *SearchNotesByTagIDsMicro_posts `json:"Micro_posts"`
-}
-
-// GetMicro_posts returns SearchNotesByTagIDsResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTagIDsResponse) GetMicro_posts() *SearchNotesByTagIDsMicro_posts {
-	return v.Micro_posts
-}
-
-// SearchNotesByTypeMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type SearchNotesByTypeMicro_posts struct {
-	TotalPages int                                          `json:"totalPages"`
-	Docs       []SearchNotesByTypeMicro_postsDocsMicro_post `json:"docs"`
-}
-
-// GetTotalPages returns SearchNotesByTypeMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_posts) GetTotalPages() int { return v.TotalPages }
-
-// GetDocs returns SearchNotesByTypeMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_posts) GetDocs() []SearchNotesByTypeMicro_postsDocsMicro_post {
-	return v.Docs
-}
-
-// SearchNotesByTypeMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type SearchNotesByTypeMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
-}
-
-// GetId returns SearchNotesByTypeMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
-
-// GetSlug returns SearchNotesByTypeMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
-
-// GetTitle returns SearchNotesByTypeMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
-
-// GetContent returns SearchNotesByTypeMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetContent() *string {
-	return v.NoteListDoc.Content
-}
-
-// GetPublishedAt returns SearchNotesByTypeMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetPublishedAt() *string {
-	return v.NoteListDoc.PublishedAt
-}
-
-// GetAuthors returns SearchNotesByTypeMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
-	return v.NoteListDoc.Authors
-}
-
-// GetTags returns SearchNotesByTypeMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
-	return v.NoteListDoc.Tags
-}
-
-// GetAttachment returns SearchNotesByTypeMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
-	return v.NoteListDoc.Attachment
-}
-
-// GetExternalLinks returns SearchNotesByTypeMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
-	return v.NoteListDoc.ExternalLinks
-}
-
-// GetLinkedMicroPosts returns SearchNotesByTypeMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
-	return v.NoteListDoc.LinkedMicroPosts
-}
-
-// GetMeta returns SearchNotesByTypeMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
-	return v.NoteListDoc.Meta
-}
-
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*SearchNotesByTypeMicro_postsDocsMicro_post
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.SearchNotesByTypeMicro_postsDocsMicro_post = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	err = json.Unmarshal(
-		b, &v.NoteListDoc)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-type __premarshalSearchNotesByTypeMicro_postsDocsMicro_post struct {
-	Id string `json:"id"`
-
-	Slug *string `json:"slug"`
-
-	Title *string `json:"title"`
-
-	Content *string `json:"content"`
-
-	PublishedAt *string `json:"publishedAt"`
-
-	Authors []NoteListDocAuthorsAuthor `json:"authors"`
-
-	Tags []NoteListDocTagsTag `json:"tags"`
-
-	Attachment *NoteListDocAttachmentMedia `json:"attachment"`
-
-	ExternalLinks []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
-
-	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post `json:"linkedMicroPosts"`
-
-	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
-}
-
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
-
-func (v *SearchNotesByTypeMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalSearchNotesByTypeMicro_postsDocsMicro_post, error) {
-	var retval __premarshalSearchNotesByTypeMicro_postsDocsMicro_post
-
-	retval.Id = v.NoteListDoc.Id
-	retval.Slug = v.NoteListDoc.Slug
-	retval.Title = v.NoteListDoc.Title
-	retval.Content = v.NoteListDoc.Content
-	retval.PublishedAt = v.NoteListDoc.PublishedAt
-	retval.Authors = v.NoteListDoc.Authors
-	retval.Tags = v.NoteListDoc.Tags
-	retval.Attachment = v.NoteListDoc.Attachment
-	retval.ExternalLinks = v.NoteListDoc.ExternalLinks
-	retval.LinkedMicroPosts = v.NoteListDoc.LinkedMicroPosts
-	retval.Meta = v.NoteListDoc.Meta
-	return &retval, nil
-}
-
-// SearchNotesByTypeResponse is returned by SearchNotesByType on success.
-type SearchNotesByTypeResponse struct {
-	Micro_posts *SearchNotesByTypeMicro_posts `json:"Micro_posts"`
-}
-
-// GetMicro_posts returns SearchNotesByTypeResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *SearchNotesByTypeResponse) GetMicro_posts() *SearchNotesByTypeMicro_posts {
-	return v.Micro_posts
-}
-
-// SearchNotesMicro_posts includes the requested fields of the GraphQL type Micro_posts.
-type SearchNotesMicro_posts struct {
-	TotalPages int                                    `json:"totalPages"`
-	Docs       []SearchNotesMicro_postsDocsMicro_post `json:"docs"`
-}
-
-// GetTotalPages returns SearchNotesMicro_posts.TotalPages, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_posts) GetTotalPages() int { return v.TotalPages }
-
-// GetDocs returns SearchNotesMicro_posts.Docs, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_posts) GetDocs() []SearchNotesMicro_postsDocsMicro_post { return v.Docs }
-
-// SearchNotesMicro_postsDocsMicro_post includes the requested fields of the GraphQL type Micro_post.
-type SearchNotesMicro_postsDocsMicro_post struct {
-	NoteListDoc `json:"-"`
-}
-
-// GetId returns SearchNotesMicro_postsDocsMicro_post.Id, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetId() string { return v.NoteListDoc.Id }
-
-// GetSlug returns SearchNotesMicro_postsDocsMicro_post.Slug, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetSlug() *string { return v.NoteListDoc.Slug }
-
-// GetTitle returns SearchNotesMicro_postsDocsMicro_post.Title, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetTitle() *string { return v.NoteListDoc.Title }
-
-// GetContent returns SearchNotesMicro_postsDocsMicro_post.Content, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetContent() *string { return v.NoteListDoc.Content }
-
-// GetPublishedAt returns SearchNotesMicro_postsDocsMicro_post.PublishedAt, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetPublishedAt() *string {
-	return v.NoteListDoc.PublishedAt
-}
-
-// GetAuthors returns SearchNotesMicro_postsDocsMicro_post.Authors, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetAuthors() []NoteListDocAuthorsAuthor {
-	return v.NoteListDoc.Authors
-}
-
-// GetTags returns SearchNotesMicro_postsDocsMicro_post.Tags, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetTags() []NoteListDocTagsTag {
-	return v.NoteListDoc.Tags
-}
-
-// GetAttachment returns SearchNotesMicro_postsDocsMicro_post.Attachment, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetAttachment() *NoteListDocAttachmentMedia {
-	return v.NoteListDoc.Attachment
-}
-
-// GetExternalLinks returns SearchNotesMicro_postsDocsMicro_post.ExternalLinks, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetExternalLinks() []NoteListDocExternalLinksMicro_post_external_link {
-	return v.NoteListDoc.ExternalLinks
-}
-
-// GetLinkedMicroPosts returns SearchNotesMicro_postsDocsMicro_post.LinkedMicroPosts, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetLinkedMicroPosts() []NoteListDocLinkedMicroPostsMicro_post {
-	return v.NoteListDoc.LinkedMicroPosts
-}
-
-// GetMeta returns SearchNotesMicro_postsDocsMicro_post.Meta, and is useful for accessing the field via an interface.
-func (v *SearchNotesMicro_postsDocsMicro_post) GetMeta() *NoteListDocMetaMicro_post_Meta {
-	return v.NoteListDoc.Meta
-}
-
-func (v *SearchNotesMicro_postsDocsMicro_post) UnmarshalJSON(b []byte) error {
-
-	if string(b) == "null" {
-		return nil
-	}
-
-	var firstPass struct {
-		*SearchNotesMicro_postsDocsMicro_post
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.SearchNotesMicro_postsDocsMicro_post = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
-
-	err = json.Unmarshal(
-		b, &v.NoteListDoc)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-type __premarshalSearchNotesMicro_postsDocsMicro_post struct {
-	Id string `json:"id"`
-
-	Slug *string `json:"slug"`
-
-	Title *string `json:"title"`
-
-	Content *string `json:"content"`
-
-	PublishedAt *string `json:"publishedAt"`
-
-	Authors []NoteListDocAuthorsAuthor `json:"authors"`
-
-	Tags []NoteListDocTagsTag `json:"tags"`
-
-	Attachment *NoteListDocAttachmentMedia `json:"attachment"`
-
-	ExternalLinks []NoteListDocExternalLinksMicro_post_external_link `json:"externalLinks"`
-
-	LinkedMicroPosts []NoteListDocLinkedMicroPostsMicro_post `json:"linkedMicroPosts"`
-
-	Meta *NoteListDocMetaMicro_post_Meta `json:"meta"`
-}
-
-func (v *SearchNotesMicro_postsDocsMicro_post) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
-
-func (v *SearchNotesMicro_postsDocsMicro_post) __premarshalJSON() (*__premarshalSearchNotesMicro_postsDocsMicro_post, error) {
-	var retval __premarshalSearchNotesMicro_postsDocsMicro_post
-
-	retval.Id = v.NoteListDoc.Id
-	retval.Slug = v.NoteListDoc.Slug
-	retval.Title = v.NoteListDoc.Title
-	retval.Content = v.NoteListDoc.Content
-	retval.PublishedAt = v.NoteListDoc.PublishedAt
-	retval.Authors = v.NoteListDoc.Authors
-	retval.Tags = v.NoteListDoc.Tags
-	retval.Attachment = v.NoteListDoc.Attachment
-	retval.ExternalLinks = v.NoteListDoc.ExternalLinks
-	retval.LinkedMicroPosts = v.NoteListDoc.LinkedMicroPosts
-	retval.Meta = v.NoteListDoc.Meta
-	return &retval, nil
-}
-
-// SearchNotesResponse is returned by SearchNotes on success.
-type SearchNotesResponse struct {
-	Micro_posts *SearchNotesMicro_posts `json:"Micro_posts"`
-}
-
-// GetMicro_posts returns SearchNotesResponse.Micro_posts, and is useful for accessing the field via an interface.
-func (v *SearchNotesResponse) GetMicro_posts() *SearchNotesMicro_posts { return v.Micro_posts }
-
-// TagByNameResponse is returned by TagByName on success.
-type TagByNameResponse struct {
-	Tags *TagByNameTags `json:"Tags"`
-}
-
-// GetTags returns TagByNameResponse.Tags, and is useful for accessing the field via an interface.
-func (v *TagByNameResponse) GetTags() *TagByNameTags { return v.Tags }
-
-// TagByNameTags includes the requested fields of the GraphQL type Tags.
-type TagByNameTags struct {
-	Docs []TagByNameTagsDocsTag `json:"docs"`
-}
-
-// GetDocs returns TagByNameTags.Docs, and is useful for accessing the field via an interface.
-func (v *TagByNameTags) GetDocs() []TagByNameTagsDocsTag { return v.Docs }
-
-// TagByNameTagsDocsTag includes the requested fields of the GraphQL type Tag.
-type TagByNameTagsDocsTag struct {
-	Id    string  `json:"id"`
-	Name  string  `json:"name"`
-	Title *string `json:"title"`
-}
-
-// GetId returns TagByNameTagsDocsTag.Id, and is useful for accessing the field via an interface.
-func (v *TagByNameTagsDocsTag) GetId() string { return v.Id }
-
-// GetName returns TagByNameTagsDocsTag.Name, and is useful for accessing the field via an interface.
-func (v *TagByNameTagsDocsTag) GetName() string { return v.Name }
-
-// GetTitle returns TagByNameTagsDocsTag.Title, and is useful for accessing the field via an interface.
-func (v *TagByNameTagsDocsTag) GetTitle() *string { return v.Title }
-
-// TagIDsByNamesResponse is returned by TagIDsByNames on success.
-type TagIDsByNamesResponse struct {
-	Tags *TagIDsByNamesTags `json:"Tags"`
-}
-
-// GetTags returns TagIDsByNamesResponse.Tags, and is useful for accessing the field via an interface.
-func (v *TagIDsByNamesResponse) GetTags() *TagIDsByNamesTags { return v.Tags }
-
-// TagIDsByNamesTags includes the requested fields of the GraphQL type Tags.
-type TagIDsByNamesTags struct {
-	Docs []TagIDsByNamesTagsDocsTag `json:"docs"`
-}
-
-// GetDocs returns TagIDsByNamesTags.Docs, and is useful for accessing the field via an interface.
-func (v *TagIDsByNamesTags) GetDocs() []TagIDsByNamesTagsDocsTag { return v.Docs }
-
-// TagIDsByNamesTagsDocsTag includes the requested fields of the GraphQL type Tag.
-type TagIDsByNamesTagsDocsTag struct {
-	Id    string  `json:"id"`
-	Name  string  `json:"name"`
-	Title *string `json:"title"`
-}
-
-// GetId returns TagIDsByNamesTagsDocsTag.Id, and is useful for accessing the field via an interface.
-func (v *TagIDsByNamesTagsDocsTag) GetId() string { return v.Id }
-
-// GetName returns TagIDsByNamesTagsDocsTag.Name, and is useful for accessing the field via an interface.
-func (v *TagIDsByNamesTagsDocsTag) GetName() string { return v.Name }
-
-// GetTitle returns TagIDsByNamesTagsDocsTag.Title, and is useful for accessing the field via an interface.
-func (v *TagIDsByNamesTagsDocsTag) GetTitle() *string { return v.Title }
-
-// __AuthorBySlugInput is used internally by genqlient
-type __AuthorBySlugInput struct {
-	Slug           string                   `json:"slug"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetSlug returns __AuthorBySlugInput.Slug, and is useful for accessing the field via an interface.
-func (v *__AuthorBySlugInput) GetSlug() string { return v.Slug }
-
-// GetLocale returns __AuthorBySlugInput.Locale, and is useful for accessing the field via an interface.
-func (v *__AuthorBySlugInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __AuthorBySlugInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__AuthorBySlugInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
-
-// __AvailableAuthorsInput is used internally by genqlient
-type __AvailableAuthorsInput struct {
-	Limit          int                      `json:"limit"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetLimit returns __AvailableAuthorsInput.Limit, and is useful for accessing the field via an interface.
-func (v *__AvailableAuthorsInput) GetLimit() int { return v.Limit }
-
-// GetLocale returns __AvailableAuthorsInput.Locale, and is useful for accessing the field via an interface.
-func (v *__AvailableAuthorsInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __AvailableAuthorsInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__AvailableAuthorsInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __AvailableTagsByPostTypeInput is used internally by genqlient
-type __AvailableTagsByPostTypeInput struct {
-	PostType *string          `json:"postType"`
-	Locale   *LocaleInputType `json:"locale"`
-}
-
-// GetPostType returns __AvailableTagsByPostTypeInput.PostType, and is useful for accessing the field via an interface.
-func (v *__AvailableTagsByPostTypeInput) GetPostType() *string { return v.PostType }
-
-// GetLocale returns __AvailableTagsByPostTypeInput.Locale, and is useful for accessing the field via an interface.
-func (v *__AvailableTagsByPostTypeInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// __ListNotesByAuthorAndTagIDsInput is used internally by genqlient
-type __ListNotesByAuthorAndTagIDsInput struct {
-	Slug           string                   `json:"slug"`
-	Page           int                      `json:"page"`
-	Limit          int                      `json:"limit"`
-	TagIDs         []string                 `json:"tagIDs"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetSlug returns __ListNotesByAuthorAndTagIDsInput.Slug, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorAndTagIDsInput) GetSlug() string { return v.Slug }
-
-// GetPage returns __ListNotesByAuthorAndTagIDsInput.Page, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorAndTagIDsInput) GetPage() int { return v.Page }
-
-// GetLimit returns __ListNotesByAuthorAndTagIDsInput.Limit, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorAndTagIDsInput) GetLimit() int { return v.Limit }
-
-// GetTagIDs returns __ListNotesByAuthorAndTagIDsInput.TagIDs, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorAndTagIDsInput) GetTagIDs() []string { return v.TagIDs }
-
-// GetLocale returns __ListNotesByAuthorAndTagIDsInput.Locale, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorAndTagIDsInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __ListNotesByAuthorAndTagIDsInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorAndTagIDsInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __ListNotesByAuthorTagIDsAndTypeInput is used internally by genqlient
-type __ListNotesByAuthorTagIDsAndTypeInput struct {
-	Slug           string                     `json:"slug"`
-	Page           int                        `json:"page"`
-	Limit          int                        `json:"limit"`
-	TagIDs         []string                   `json:"tagIDs"`
-	PostType       Micro_post_post_type_Input `json:"postType"`
-	Locale         *LocaleInputType           `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType   `json:"fallbackLocale"`
-}
-
-// GetSlug returns __ListNotesByAuthorTagIDsAndTypeInput.Slug, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorTagIDsAndTypeInput) GetSlug() string { return v.Slug }
-
-// GetPage returns __ListNotesByAuthorTagIDsAndTypeInput.Page, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorTagIDsAndTypeInput) GetPage() int { return v.Page }
-
-// GetLimit returns __ListNotesByAuthorTagIDsAndTypeInput.Limit, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorTagIDsAndTypeInput) GetLimit() int { return v.Limit }
-
-// GetTagIDs returns __ListNotesByAuthorTagIDsAndTypeInput.TagIDs, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorTagIDsAndTypeInput) GetTagIDs() []string { return v.TagIDs }
-
-// GetPostType returns __ListNotesByAuthorTagIDsAndTypeInput.PostType, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorTagIDsAndTypeInput) GetPostType() Micro_post_post_type_Input {
-	return v.PostType
-}
-
-// GetLocale returns __ListNotesByAuthorTagIDsAndTypeInput.Locale, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorTagIDsAndTypeInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __ListNotesByAuthorTagIDsAndTypeInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__ListNotesByAuthorTagIDsAndTypeInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __ListNotesByTagIDsAndTypeInput is used internally by genqlient
-type __ListNotesByTagIDsAndTypeInput struct {
-	Page           int                        `json:"page"`
-	Limit          int                        `json:"limit"`
-	TagIDs         []string                   `json:"tagIDs"`
-	PostType       Micro_post_post_type_Input `json:"postType"`
-	Locale         *LocaleInputType           `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType   `json:"fallbackLocale"`
-}
-
-// GetPage returns __ListNotesByTagIDsAndTypeInput.Page, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsAndTypeInput) GetPage() int { return v.Page }
-
-// GetLimit returns __ListNotesByTagIDsAndTypeInput.Limit, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsAndTypeInput) GetLimit() int { return v.Limit }
-
-// GetTagIDs returns __ListNotesByTagIDsAndTypeInput.TagIDs, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsAndTypeInput) GetTagIDs() []string { return v.TagIDs }
-
-// GetPostType returns __ListNotesByTagIDsAndTypeInput.PostType, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsAndTypeInput) GetPostType() Micro_post_post_type_Input { return v.PostType }
-
-// GetLocale returns __ListNotesByTagIDsAndTypeInput.Locale, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsAndTypeInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __ListNotesByTagIDsAndTypeInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsAndTypeInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __ListNotesByTagIDsInput is used internally by genqlient
-type __ListNotesByTagIDsInput struct {
-	Page           int                      `json:"page"`
-	Limit          int                      `json:"limit"`
-	TagIDs         []string                 `json:"tagIDs"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetPage returns __ListNotesByTagIDsInput.Page, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsInput) GetPage() int { return v.Page }
-
-// GetLimit returns __ListNotesByTagIDsInput.Limit, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsInput) GetLimit() int { return v.Limit }
-
-// GetTagIDs returns __ListNotesByTagIDsInput.TagIDs, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsInput) GetTagIDs() []string { return v.TagIDs }
-
-// GetLocale returns __ListNotesByTagIDsInput.Locale, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __ListNotesByTagIDsInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTagIDsInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __ListNotesByTypeInput is used internally by genqlient
-type __ListNotesByTypeInput struct {
-	Page           int                        `json:"page"`
-	Limit          int                        `json:"limit"`
-	PostType       Micro_post_post_type_Input `json:"postType"`
-	Locale         *LocaleInputType           `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType   `json:"fallbackLocale"`
-}
-
-// GetPage returns __ListNotesByTypeInput.Page, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTypeInput) GetPage() int { return v.Page }
-
-// GetLimit returns __ListNotesByTypeInput.Limit, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTypeInput) GetLimit() int { return v.Limit }
-
-// GetPostType returns __ListNotesByTypeInput.PostType, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTypeInput) GetPostType() Micro_post_post_type_Input { return v.PostType }
-
-// GetLocale returns __ListNotesByTypeInput.Locale, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTypeInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __ListNotesByTypeInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__ListNotesByTypeInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __ListNotesInput is used internally by genqlient
-type __ListNotesInput struct {
-	Page           int                      `json:"page"`
-	Limit          int                      `json:"limit"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetPage returns __ListNotesInput.Page, and is useful for accessing the field via an interface.
-func (v *__ListNotesInput) GetPage() int { return v.Page }
-
-// GetLimit returns __ListNotesInput.Limit, and is useful for accessing the field via an interface.
-func (v *__ListNotesInput) GetLimit() int { return v.Limit }
-
-// GetLocale returns __ListNotesInput.Locale, and is useful for accessing the field via an interface.
-func (v *__ListNotesInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __ListNotesInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__ListNotesInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
-
-// __NoteBySlugInput is used internally by genqlient
-type __NoteBySlugInput struct {
-	Slug           string                   `json:"slug"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetSlug returns __NoteBySlugInput.Slug, and is useful for accessing the field via an interface.
-func (v *__NoteBySlugInput) GetSlug() string { return v.Slug }
-
-// GetLocale returns __NoteBySlugInput.Locale, and is useful for accessing the field via an interface.
-func (v *__NoteBySlugInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __NoteBySlugInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__NoteBySlugInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
-
-// __NotesByAuthorSlugAndTypeInput is used internally by genqlient
-type __NotesByAuthorSlugAndTypeInput struct {
-	Slug           string                     `json:"slug"`
-	Page           int                        `json:"page"`
-	Limit          int                        `json:"limit"`
-	PostType       Micro_post_post_type_Input `json:"postType"`
-	Locale         *LocaleInputType           `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType   `json:"fallbackLocale"`
-}
-
-// GetSlug returns __NotesByAuthorSlugAndTypeInput.Slug, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugAndTypeInput) GetSlug() string { return v.Slug }
-
-// GetPage returns __NotesByAuthorSlugAndTypeInput.Page, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugAndTypeInput) GetPage() int { return v.Page }
-
-// GetLimit returns __NotesByAuthorSlugAndTypeInput.Limit, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugAndTypeInput) GetLimit() int { return v.Limit }
-
-// GetPostType returns __NotesByAuthorSlugAndTypeInput.PostType, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugAndTypeInput) GetPostType() Micro_post_post_type_Input { return v.PostType }
-
-// GetLocale returns __NotesByAuthorSlugAndTypeInput.Locale, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugAndTypeInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __NotesByAuthorSlugAndTypeInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugAndTypeInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __NotesByAuthorSlugInput is used internally by genqlient
-type __NotesByAuthorSlugInput struct {
-	Slug           string                   `json:"slug"`
-	Page           int                      `json:"page"`
-	Limit          int                      `json:"limit"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetSlug returns __NotesByAuthorSlugInput.Slug, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugInput) GetSlug() string { return v.Slug }
-
-// GetPage returns __NotesByAuthorSlugInput.Page, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugInput) GetPage() int { return v.Page }
-
-// GetLimit returns __NotesByAuthorSlugInput.Limit, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugInput) GetLimit() int { return v.Limit }
-
-// GetLocale returns __NotesByAuthorSlugInput.Locale, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __NotesByAuthorSlugInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__NotesByAuthorSlugInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __SearchNotesByAuthorAndTagIDsInput is used internally by genqlient
-type __SearchNotesByAuthorAndTagIDsInput struct {
-	Query          string                   `json:"query"`
-	Slug           string                   `json:"slug"`
-	Page           int                      `json:"page"`
-	Limit          int                      `json:"limit"`
-	TagIDs         []string                 `json:"tagIDs"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetQuery returns __SearchNotesByAuthorAndTagIDsInput.Query, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorAndTagIDsInput) GetQuery() string { return v.Query }
-
-// GetSlug returns __SearchNotesByAuthorAndTagIDsInput.Slug, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorAndTagIDsInput) GetSlug() string { return v.Slug }
-
-// GetPage returns __SearchNotesByAuthorAndTagIDsInput.Page, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorAndTagIDsInput) GetPage() int { return v.Page }
-
-// GetLimit returns __SearchNotesByAuthorAndTagIDsInput.Limit, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorAndTagIDsInput) GetLimit() int { return v.Limit }
-
-// GetTagIDs returns __SearchNotesByAuthorAndTagIDsInput.TagIDs, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorAndTagIDsInput) GetTagIDs() []string { return v.TagIDs }
-
-// GetLocale returns __SearchNotesByAuthorAndTagIDsInput.Locale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorAndTagIDsInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __SearchNotesByAuthorAndTagIDsInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorAndTagIDsInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __SearchNotesByAuthorSlugAndTypeInput is used internally by genqlient
-type __SearchNotesByAuthorSlugAndTypeInput struct {
-	Query          string                     `json:"query"`
-	Slug           string                     `json:"slug"`
-	Page           int                        `json:"page"`
-	Limit          int                        `json:"limit"`
-	PostType       Micro_post_post_type_Input `json:"postType"`
-	Locale         *LocaleInputType           `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType   `json:"fallbackLocale"`
-}
-
-// GetQuery returns __SearchNotesByAuthorSlugAndTypeInput.Query, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugAndTypeInput) GetQuery() string { return v.Query }
-
-// GetSlug returns __SearchNotesByAuthorSlugAndTypeInput.Slug, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugAndTypeInput) GetSlug() string { return v.Slug }
-
-// GetPage returns __SearchNotesByAuthorSlugAndTypeInput.Page, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugAndTypeInput) GetPage() int { return v.Page }
-
-// GetLimit returns __SearchNotesByAuthorSlugAndTypeInput.Limit, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugAndTypeInput) GetLimit() int { return v.Limit }
-
-// GetPostType returns __SearchNotesByAuthorSlugAndTypeInput.PostType, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugAndTypeInput) GetPostType() Micro_post_post_type_Input {
-	return v.PostType
-}
-
-// GetLocale returns __SearchNotesByAuthorSlugAndTypeInput.Locale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugAndTypeInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __SearchNotesByAuthorSlugAndTypeInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugAndTypeInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __SearchNotesByAuthorSlugInput is used internally by genqlient
-type __SearchNotesByAuthorSlugInput struct {
-	Query          string                   `json:"query"`
-	Slug           string                   `json:"slug"`
-	Page           int                      `json:"page"`
-	Limit          int                      `json:"limit"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetQuery returns __SearchNotesByAuthorSlugInput.Query, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugInput) GetQuery() string { return v.Query }
-
-// GetSlug returns __SearchNotesByAuthorSlugInput.Slug, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugInput) GetSlug() string { return v.Slug }
-
-// GetPage returns __SearchNotesByAuthorSlugInput.Page, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugInput) GetPage() int { return v.Page }
-
-// GetLimit returns __SearchNotesByAuthorSlugInput.Limit, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugInput) GetLimit() int { return v.Limit }
-
-// GetLocale returns __SearchNotesByAuthorSlugInput.Locale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __SearchNotesByAuthorSlugInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorSlugInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __SearchNotesByAuthorTagIDsAndTypeInput is used internally by genqlient
-type __SearchNotesByAuthorTagIDsAndTypeInput struct {
-	Query          string                     `json:"query"`
-	Slug           string                     `json:"slug"`
-	Page           int                        `json:"page"`
-	Limit          int                        `json:"limit"`
-	TagIDs         []string                   `json:"tagIDs"`
-	PostType       Micro_post_post_type_Input `json:"postType"`
-	Locale         *LocaleInputType           `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType   `json:"fallbackLocale"`
-}
-
-// GetQuery returns __SearchNotesByAuthorTagIDsAndTypeInput.Query, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorTagIDsAndTypeInput) GetQuery() string { return v.Query }
-
-// GetSlug returns __SearchNotesByAuthorTagIDsAndTypeInput.Slug, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorTagIDsAndTypeInput) GetSlug() string { return v.Slug }
-
-// GetPage returns __SearchNotesByAuthorTagIDsAndTypeInput.Page, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorTagIDsAndTypeInput) GetPage() int { return v.Page }
-
-// GetLimit returns __SearchNotesByAuthorTagIDsAndTypeInput.Limit, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorTagIDsAndTypeInput) GetLimit() int { return v.Limit }
-
-// GetTagIDs returns __SearchNotesByAuthorTagIDsAndTypeInput.TagIDs, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorTagIDsAndTypeInput) GetTagIDs() []string { return v.TagIDs }
-
-// GetPostType returns __SearchNotesByAuthorTagIDsAndTypeInput.PostType, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorTagIDsAndTypeInput) GetPostType() Micro_post_post_type_Input {
-	return v.PostType
-}
-
-// GetLocale returns __SearchNotesByAuthorTagIDsAndTypeInput.Locale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorTagIDsAndTypeInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __SearchNotesByAuthorTagIDsAndTypeInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByAuthorTagIDsAndTypeInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __SearchNotesByTagIDsAndTypeInput is used internally by genqlient
-type __SearchNotesByTagIDsAndTypeInput struct {
-	Query          string                     `json:"query"`
-	Page           int                        `json:"page"`
-	Limit          int                        `json:"limit"`
-	TagIDs         []string                   `json:"tagIDs"`
-	PostType       Micro_post_post_type_Input `json:"postType"`
-	Locale         *LocaleInputType           `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType   `json:"fallbackLocale"`
-}
-
-// GetQuery returns __SearchNotesByTagIDsAndTypeInput.Query, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsAndTypeInput) GetQuery() string { return v.Query }
-
-// GetPage returns __SearchNotesByTagIDsAndTypeInput.Page, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsAndTypeInput) GetPage() int { return v.Page }
-
-// GetLimit returns __SearchNotesByTagIDsAndTypeInput.Limit, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsAndTypeInput) GetLimit() int { return v.Limit }
-
-// GetTagIDs returns __SearchNotesByTagIDsAndTypeInput.TagIDs, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsAndTypeInput) GetTagIDs() []string { return v.TagIDs }
-
-// GetPostType returns __SearchNotesByTagIDsAndTypeInput.PostType, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsAndTypeInput) GetPostType() Micro_post_post_type_Input {
-	return v.PostType
-}
-
-// GetLocale returns __SearchNotesByTagIDsAndTypeInput.Locale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsAndTypeInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __SearchNotesByTagIDsAndTypeInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsAndTypeInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __SearchNotesByTagIDsInput is used internally by genqlient
-type __SearchNotesByTagIDsInput struct {
-	Query          string                   `json:"query"`
-	Page           int                      `json:"page"`
-	Limit          int                      `json:"limit"`
-	TagIDs         []string                 `json:"tagIDs"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetQuery returns __SearchNotesByTagIDsInput.Query, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsInput) GetQuery() string { return v.Query }
-
-// GetPage returns __SearchNotesByTagIDsInput.Page, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsInput) GetPage() int { return v.Page }
-
-// GetLimit returns __SearchNotesByTagIDsInput.Limit, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsInput) GetLimit() int { return v.Limit }
-
-// GetTagIDs returns __SearchNotesByTagIDsInput.TagIDs, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsInput) GetTagIDs() []string { return v.TagIDs }
-
-// GetLocale returns __SearchNotesByTagIDsInput.Locale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __SearchNotesByTagIDsInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTagIDsInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __SearchNotesByTypeInput is used internally by genqlient
-type __SearchNotesByTypeInput struct {
-	Query          string                     `json:"query"`
-	Page           int                        `json:"page"`
-	Limit          int                        `json:"limit"`
-	PostType       Micro_post_post_type_Input `json:"postType"`
-	Locale         *LocaleInputType           `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType   `json:"fallbackLocale"`
-}
-
-// GetQuery returns __SearchNotesByTypeInput.Query, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTypeInput) GetQuery() string { return v.Query }
-
-// GetPage returns __SearchNotesByTypeInput.Page, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTypeInput) GetPage() int { return v.Page }
-
-// GetLimit returns __SearchNotesByTypeInput.Limit, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTypeInput) GetLimit() int { return v.Limit }
-
-// GetPostType returns __SearchNotesByTypeInput.PostType, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTypeInput) GetPostType() Micro_post_post_type_Input { return v.PostType }
-
-// GetLocale returns __SearchNotesByTypeInput.Locale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTypeInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __SearchNotesByTypeInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesByTypeInput) GetFallbackLocale() *FallbackLocaleInputType {
-	return v.FallbackLocale
-}
-
-// __SearchNotesInput is used internally by genqlient
-type __SearchNotesInput struct {
-	Query          string                   `json:"query"`
-	Page           int                      `json:"page"`
-	Limit          int                      `json:"limit"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetQuery returns __SearchNotesInput.Query, and is useful for accessing the field via an interface.
-func (v *__SearchNotesInput) GetQuery() string { return v.Query }
-
-// GetPage returns __SearchNotesInput.Page, and is useful for accessing the field via an interface.
-func (v *__SearchNotesInput) GetPage() int { return v.Page }
-
-// GetLimit returns __SearchNotesInput.Limit, and is useful for accessing the field via an interface.
-func (v *__SearchNotesInput) GetLimit() int { return v.Limit }
-
-// GetLocale returns __SearchNotesInput.Locale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __SearchNotesInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__SearchNotesInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
-
-// __TagByNameInput is used internally by genqlient
-type __TagByNameInput struct {
-	Name           string                   `json:"name"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetName returns __TagByNameInput.Name, and is useful for accessing the field via an interface.
-func (v *__TagByNameInput) GetName() string { return v.Name }
-
-// GetLocale returns __TagByNameInput.Locale, and is useful for accessing the field via an interface.
-func (v *__TagByNameInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __TagByNameInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__TagByNameInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
-
-// __TagIDsByNamesInput is used internally by genqlient
-type __TagIDsByNamesInput struct {
-	TagNames       []string                 `json:"tagNames"`
-	Locale         *LocaleInputType         `json:"locale"`
-	FallbackLocale *FallbackLocaleInputType `json:"fallbackLocale"`
-}
-
-// GetTagNames returns __TagIDsByNamesInput.TagNames, and is useful for accessing the field via an interface.
-func (v *__TagIDsByNamesInput) GetTagNames() []string { return v.TagNames }
-
-// GetLocale returns __TagIDsByNamesInput.Locale, and is useful for accessing the field via an interface.
-func (v *__TagIDsByNamesInput) GetLocale() *LocaleInputType { return v.Locale }
-
-// GetFallbackLocale returns __TagIDsByNamesInput.FallbackLocale, and is useful for accessing the field via an interface.
-func (v *__TagIDsByNamesInput) GetFallbackLocale() *FallbackLocaleInputType { return v.FallbackLocale }
-
-// The query executed by AuthorBySlug.
-const AuthorBySlug_Operation = `
-query AuthorBySlug ($slug: String!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Authors(where: {slug:{equals:$slug}}, limit: 1, locale: $locale, fallbackLocale: $fallbackLocale) {
-		docs {
-			id
-			name
-			slug
-			bio
-			avatar {
-				url
-				alt
-				width
-				height
-			}
-		}
-	}
-}
-`
-
-func AuthorBySlug(
-	ctx_ context.Context,
-	client_ graphql.Client,
-	slug string,
-	locale *LocaleInputType,
-	fallbackLocale *FallbackLocaleInputType,
-) (data_ *AuthorBySlugResponse, err_ error) {
-	req_ := &graphql.Request{
-		OpName: "AuthorBySlug",
-		Query:  AuthorBySlug_Operation,
-		Variables: &__AuthorBySlugInput{
-			Slug:           slug,
-			Locale:         locale,
-			FallbackLocale: fallbackLocale,
-		},
-	}
-
-	data_ = &AuthorBySlugResponse{}
-	resp_ := &graphql.Response{Data: data_}
-
-	err_ = client_.MakeRequest(
-		ctx_,
-		req_,
-		resp_,
-	)
-
-	return data_, err_
-}
-
-// The query executed by AvailableAuthors.
-const AvailableAuthors_Operation = `
-query AvailableAuthors ($limit: Int!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Authors(limit: $limit, sort: "name", locale: $locale, fallbackLocale: $fallbackLocale) {
-		docs {
-			id
-			name
-			slug
-			bio
-			avatar {
-				url
-				alt
-				width
-				height
-			}
-		}
-	}
-}
-`
-
-func AvailableAuthors(
-	ctx_ context.Context,
-	client_ graphql.Client,
-	limit int,
-	locale *LocaleInputType,
-	fallbackLocale *FallbackLocaleInputType,
-) (data_ *AvailableAuthorsResponse, err_ error) {
-	req_ := &graphql.Request{
-		OpName: "AvailableAuthors",
-		Query:  AvailableAuthors_Operation,
-		Variables: &__AvailableAuthorsInput{
-			Limit:          limit,
-			Locale:         locale,
-			FallbackLocale: fallbackLocale,
-		},
-	}
-
-	data_ = &AvailableAuthorsResponse{}
-	resp_ := &graphql.Response{Data: data_}
-
-	err_ = client_.MakeRequest(
-		ctx_,
-		req_,
-		resp_,
-	)
-
-	return data_, err_
-}
-
-// The query executed by AvailableTagsByPostType.
-const AvailableTagsByPostType_Operation = `
-query AvailableTagsByPostType ($postType: String, $locale: LocaleInputType) {
-	availableTagsByMicroPostType(postType: $postType, locale: $locale) {
-		id
-		name
-		title
-	}
-}
-`
-
-func AvailableTagsByPostType(
-	ctx_ context.Context,
-	client_ graphql.Client,
-	postType *string,
-	locale *LocaleInputType,
-) (data_ *AvailableTagsByPostTypeResponse, err_ error) {
-	req_ := &graphql.Request{
-		OpName: "AvailableTagsByPostType",
-		Query:  AvailableTagsByPostType_Operation,
-		Variables: &__AvailableTagsByPostTypeInput{
-			PostType: postType,
-			Locale:   locale,
-		},
-	}
-
-	data_ = &AvailableTagsByPostTypeResponse{}
-	resp_ := &graphql.Response{Data: data_}
-
-	err_ = client_.MakeRequest(
-		ctx_,
-		req_,
-		resp_,
-	)
-
-	return data_, err_
-}
-
-// The query executed by ListNotes.
-const ListNotes_Operation = `
-query ListNotes ($page: Int!, $limit: Int!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published}}) {
-		totalPages
-		docs {
-			... NoteListDoc
-		}
-	}
-}
-fragment NoteListDoc on Micro_post {
-	id
-	slug
-	title
-	content
-	publishedAt
-	authors {
-		name
-		slug
-		bio
-		avatar {
-			url
-			alt
-			width
-			height
-		}
-	}
-	tags {
-		id
-		name
-		title
-	}
-	attachment {
-		url
-		alt
-		width
-		height
-		filename
-		mimeType
-	}
-	externalLinks {
-		id
-		target_url
-	}
-	linkedMicroPosts {
-		id
-		slug
-	}
-	meta {
-		title
-		description
-		image {
-			url
-			description
-			width
-			height
-		}
-	}
-}
-`
-
-func ListNotes(
+func AdjacentNotes(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	page int,
-	limit int,
+	id string,
+	publishedAt string,
+	now *string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *ListNotesResponse, err_ error) {
+) (data_ *AdjacentNotesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListNotes",
-		Query:  ListNotes_Operation,
-		Variables: &__ListNotesInput{
-			Page:           page,
-			Limit:          limit,
+		OpName: "AdjacentNotes",
+		Query:  AdjacentNotes_Operation,
+		Variables: &__AdjacentNotesInput{
+			Id:             id,
+			PublishedAt:    publishedAt,
+			Now:            now,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
-	}
-
-	data_ = &ListNotesResponse{}
-	resp_ := &graphql.Response{Data: data_}
-
-	err_ = client_.MakeRequest(
-		ctx_,
-		req_,
-		resp_,
-	)
-
-	return data_, err_
-}
-
-// The query executed by ListNotesByAuthorAndTagIDs.
-const ListNotesByAuthorAndTagIDs_Operation = `
-query ListNotesByAuthorAndTagIDs ($slug: String!, $page: Int!, $limit: Int!, $tagIDs: [JSON!]!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},authorSlug:{equals:$slug},tags:{in:$tagIDs}}) {
-		totalPages
-		docs {
-			... NoteListDoc
-		}
-	}
-}
-fragment NoteListDoc on Micro_post {
-	id
-	slug
-	title
-	content
-	publishedAt
-	authors {
-		name
-		slug
-		bio
-		avatar {
-			url
-			alt
-			width
-			height
-		}
-	}
-	tags {
-		id
-		name
-		title
-	}
-	attachment {
-		url
-		alt
-		width
-		height
-		filename
-		mimeType
-	}
-	externalLinks {
-		id
-		target_url
-	}
-	linkedMicroPosts {
-		id
-		slug
-	}
-	meta {
-		title
-		description
-		image {
-			url
-			description
-			width
-			height
+	}
+
+	data_ = &AdjacentNotesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ApprovedComments.
+const ApprovedComments_Operation = `
+query ApprovedComments ($noteID: JSON!, $limit: Int) {
+	Comments(limit: $limit, sort: "createdAt", where: {note:{equals:$noteID},status:{equals:approved}}) {
+		docs {
+			id
+			authorName
+			body
+			createdAt
 		}
 	}
 }
 `
 
-func ListNotesByAuthorAndTagIDs(
+func ApprovedComments(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	slug string,
-	page int,
-	limit int,
-	tagIDs []string,
-	locale *LocaleInputType,
-	fallbackLocale *FallbackLocaleInputType,
-) (data_ *ListNotesByAuthorAndTagIDsResponse, err_ error) {
+	noteID string,
+	limit *int,
+) (data_ *ApprovedCommentsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListNotesByAuthorAndTagIDs",
-		Query:  ListNotesByAuthorAndTagIDs_Operation,
-		Variables: &__ListNotesByAuthorAndTagIDsInput{
-			Slug:           slug,
-			Page:           page,
-			Limit:          limit,
-			TagIDs:         tagIDs,
-			Locale:         locale,
-			FallbackLocale: fallbackLocale,
+		OpName: "ApprovedComments",
+		Query:  ApprovedComments_Operation,
+		Variables: &__ApprovedCommentsInput{
+			NoteID: noteID,
+			Limit:  limit,
 		},
 	}
 
-	data_ = &ListNotesByAuthorAndTagIDsResponse{}
+	data_ = &ApprovedCommentsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -4091,93 +3129,93 @@ func ListNotesByAuthorAndTagIDs(
 	return data_, err_
 }
 
-// The query executed by ListNotesByAuthorTagIDsAndType.
-const ListNotesByAuthorTagIDsAndType_Operation = `
-query ListNotesByAuthorTagIDsAndType ($slug: String!, $page: Int!, $limit: Int!, $tagIDs: [JSON!]!, $postType: Micro_post_post_type_Input!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},authorSlug:{equals:$slug},tags:{in:$tagIDs},post_type:{equals:$postType}}) {
+// The query executed by ArchiveDates.
+const ArchiveDates_Operation = `
+query ArchiveDates ($page: Int!, $limit: Int!, $now: DateTime) {
+	Micro_posts(page: $page, limit: $limit, sort: "-publishedAt", where: {_status:{equals:published},publishedAt:{less_than_equal:$now}}) {
 		totalPages
 		docs {
-			... NoteListDoc
+			publishedAt
 		}
 	}
 }
-fragment NoteListDoc on Micro_post {
-	id
-	slug
-	title
-	content
-	publishedAt
-	authors {
-		name
-		slug
-		bio
-		avatar {
-			url
-			alt
-			width
-			height
-		}
-	}
-	tags {
-		id
-		name
-		title
-	}
-	attachment {
-		url
-		alt
-		width
-		height
-		filename
-		mimeType
-	}
-	externalLinks {
-		id
-		target_url
-	}
-	linkedMicroPosts {
-		id
-		slug
+`
+
+func ArchiveDates(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	page int,
+	limit int,
+	now *string,
+) (data_ *ArchiveDatesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ArchiveDates",
+		Query:  ArchiveDates_Operation,
+		Variables: &__ArchiveDatesInput{
+			Page:  page,
+			Limit: limit,
+			Now:   now,
+		},
 	}
-	meta {
-		title
-		description
-		image {
-			url
-			description
-			width
-			height
+
+	data_ = &ArchiveDatesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by AuthorBySlug.
+const AuthorBySlug_Operation = `
+query AuthorBySlug ($slug: String!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Authors(where: {slug:{equals:$slug}}, limit: 1, locale: $locale, fallbackLocale: $fallbackLocale) {
+		docs {
+			id
+			name
+			slug
+			bio
+			avatar {
+				url
+				alt
+				width
+				height
+			}
+			website
+			location
+			socials {
+				id
+				platform
+				handle
+				url
+			}
 		}
 	}
 }
 `
 
-func ListNotesByAuthorTagIDsAndType(
+func AuthorBySlug(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	slug string,
-	page int,
-	limit int,
-	tagIDs []string,
-	postType Micro_post_post_type_Input,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *ListNotesByAuthorTagIDsAndTypeResponse, err_ error) {
+) (data_ *AuthorBySlugResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListNotesByAuthorTagIDsAndType",
-		Query:  ListNotesByAuthorTagIDsAndType_Operation,
-		Variables: &__ListNotesByAuthorTagIDsAndTypeInput{
+		OpName: "AuthorBySlug",
+		Query:  AuthorBySlug_Operation,
+		Variables: &__AuthorBySlugInput{
 			Slug:           slug,
-			Page:           page,
-			Limit:          limit,
-			TagIDs:         tagIDs,
-			PostType:       postType,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &ListNotesByAuthorTagIDsAndTypeResponse{}
+	data_ = &AuthorBySlugResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -4189,89 +3227,44 @@ func ListNotesByAuthorTagIDsAndType(
 	return data_, err_
 }
 
-// The query executed by ListNotesByTagIDs.
-const ListNotesByTagIDs_Operation = `
-query ListNotesByTagIDs ($page: Int!, $limit: Int!, $tagIDs: [JSON!]!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},tags:{in:$tagIDs}}) {
-		totalPages
+// The query executed by AvailableAuthors.
+const AvailableAuthors_Operation = `
+query AvailableAuthors ($limit: Int!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Authors(limit: $limit, sort: "name", locale: $locale, fallbackLocale: $fallbackLocale) {
 		docs {
-			... NoteListDoc
-		}
-	}
-}
-fragment NoteListDoc on Micro_post {
-	id
-	slug
-	title
-	content
-	publishedAt
-	authors {
-		name
-		slug
-		bio
-		avatar {
-			url
-			alt
-			width
-			height
-		}
-	}
-	tags {
-		id
-		name
-		title
-	}
-	attachment {
-		url
-		alt
-		width
-		height
-		filename
-		mimeType
-	}
-	externalLinks {
-		id
-		target_url
-	}
-	linkedMicroPosts {
-		id
-		slug
-	}
-	meta {
-		title
-		description
-		image {
-			url
-			description
-			width
-			height
+			id
+			name
+			slug
+			bio
+			avatar {
+				url
+				alt
+				width
+				height
+			}
 		}
 	}
 }
 `
 
-func ListNotesByTagIDs(
+func AvailableAuthors(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	page int,
 	limit int,
-	tagIDs []string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *ListNotesByTagIDsResponse, err_ error) {
+) (data_ *AvailableAuthorsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListNotesByTagIDs",
-		Query:  ListNotesByTagIDs_Operation,
-		Variables: &__ListNotesByTagIDsInput{
-			Page:           page,
+		OpName: "AvailableAuthors",
+		Query:  AvailableAuthors_Operation,
+		Variables: &__AvailableAuthorsInput{
 			Limit:          limit,
-			TagIDs:         tagIDs,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &ListNotesByTagIDsResponse{}
+	data_ = &AvailableAuthorsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -4283,91 +3276,33 @@ func ListNotesByTagIDs(
 	return data_, err_
 }
 
-// The query executed by ListNotesByTagIDsAndType.
-const ListNotesByTagIDsAndType_Operation = `
-query ListNotesByTagIDsAndType ($page: Int!, $limit: Int!, $tagIDs: [JSON!]!, $postType: Micro_post_post_type_Input!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},tags:{in:$tagIDs},post_type:{equals:$postType}}) {
-		totalPages
-		docs {
-			... NoteListDoc
-		}
-	}
-}
-fragment NoteListDoc on Micro_post {
-	id
-	slug
-	title
-	content
-	publishedAt
-	authors {
-		name
-		slug
-		bio
-		avatar {
-			url
-			alt
-			width
-			height
-		}
-	}
-	tags {
-		id
-		name
-		title
-	}
-	attachment {
-		url
-		alt
-		width
-		height
-		filename
-		mimeType
-	}
-	externalLinks {
-		id
-		target_url
-	}
-	linkedMicroPosts {
+// The query executed by AvailableTagsByPostType.
+const AvailableTagsByPostType_Operation = `
+query AvailableTagsByPostType ($postType: String, $locale: LocaleInputType) {
+	availableTagsByMicroPostType(postType: $postType, locale: $locale) {
 		id
-		slug
-	}
-	meta {
-		title
-		description
-		image {
-			url
-			description
-			width
-			height
-		}
+		name
+		title
 	}
 }
 `
 
-func ListNotesByTagIDsAndType(
+func AvailableTagsByPostType(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	page int,
-	limit int,
-	tagIDs []string,
-	postType Micro_post_post_type_Input,
+	postType *string,
 	locale *LocaleInputType,
-	fallbackLocale *FallbackLocaleInputType,
-) (data_ *ListNotesByTagIDsAndTypeResponse, err_ error) {
+) (data_ *AvailableTagsByPostTypeResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListNotesByTagIDsAndType",
-		Query:  ListNotesByTagIDsAndType_Operation,
-		Variables: &__ListNotesByTagIDsAndTypeInput{
-			Page:           page,
-			Limit:          limit,
-			TagIDs:         tagIDs,
-			PostType:       postType,
-			Locale:         locale,
-			FallbackLocale: fallbackLocale,
+		OpName: "AvailableTagsByPostType",
+		Query:  AvailableTagsByPostType_Operation,
+		Variables: &__AvailableTagsByPostTypeInput{
+			PostType: postType,
+			Locale:   locale,
 		},
 	}
 
-	data_ = &ListNotesByTagIDsAndTypeResponse{}
+	data_ = &AvailableTagsByPostTypeResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -4379,89 +3314,46 @@ func ListNotesByTagIDsAndType(
 	return data_, err_
 }
 
-// The query executed by ListNotesByType.
-const ListNotesByType_Operation = `
-query ListNotesByType ($page: Int!, $limit: Int!, $postType: Micro_post_post_type_Input!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},post_type:{equals:$postType}}) {
+// The query executed by ChannelCounts.
+const ChannelCounts_Operation = `
+query ChannelCounts ($page: Int!, $limit: Int!, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, where: {_status:{equals:published},publishedAt:{less_than_equal:$now}}) {
 		totalPages
 		docs {
-			... NoteListDoc
-		}
-	}
-}
-fragment NoteListDoc on Micro_post {
-	id
-	slug
-	title
-	content
-	publishedAt
-	authors {
-		name
-		slug
-		bio
-		avatar {
-			url
-			alt
-			width
-			height
-		}
-	}
-	tags {
-		id
-		name
-		title
-	}
-	attachment {
-		url
-		alt
-		width
-		height
-		filename
-		mimeType
-	}
-	externalLinks {
-		id
-		target_url
-	}
-	linkedMicroPosts {
-		id
-		slug
-	}
-	meta {
-		title
-		description
-		image {
-			url
-			description
-			width
-			height
+			post_type
+			authors {
+				slug
+			}
+			tags {
+				name
+			}
 		}
 	}
 }
 `
 
-func ListNotesByType(
+func ChannelCounts(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	page int,
 	limit int,
-	postType Micro_post_post_type_Input,
+	now *string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *ListNotesByTypeResponse, err_ error) {
+) (data_ *ChannelCountsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ListNotesByType",
-		Query:  ListNotesByType_Operation,
-		Variables: &__ListNotesByTypeInput{
+		OpName: "ChannelCounts",
+		Query:  ChannelCounts_Operation,
+		Variables: &__ChannelCountsInput{
 			Page:           page,
 			Limit:          limit,
-			PostType:       postType,
+			Now:            now,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &ListNotesByTypeResponse{}
+	data_ = &ChannelCountsResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -4473,81 +3365,36 @@ func ListNotesByType(
 	return data_, err_
 }
 
-// The query executed by NoteBySlug.
-const NoteBySlug_Operation = `
-query NoteBySlug ($slug: String!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(limit: 1, locale: $locale, fallbackLocale: $fallbackLocale, where: {_status:{equals:published},slug:{equals:$slug}}) {
-		docs {
-			id
-			slug
-			title
-			content
-			publishedAt
-			authors {
-				name
-				slug
-				bio
-				avatar {
-					url
-					alt
-					width
-					height
-				}
-			}
-			tags {
-				id
-				name
-				title
-			}
-			attachment {
-				url
-				alt
-				width
-				height
-				filename
-				mimeType
-			}
-			externalLinks {
-				id
-				target_url
-			}
-			linkedMicroPosts {
-				id
-				slug
-			}
-			meta {
-				title
-				description
-				image {
-					url
-					description
-					width
-					height
-				}
-			}
-		}
+// The mutation executed by CreateComment.
+const CreateComment_Operation = `
+mutation CreateComment ($noteID: String!, $authorName: String!, $authorEmail: String, $body: String!) {
+	createComment(data: {note:$noteID,authorName:$authorName,authorEmail:$authorEmail,body:$body}) {
+		id
+		status
 	}
 }
 `
 
-func NoteBySlug(
+func CreateComment(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	slug string,
-	locale *LocaleInputType,
-	fallbackLocale *FallbackLocaleInputType,
-) (data_ *NoteBySlugResponse, err_ error) {
+	noteID string,
+	authorName string,
+	authorEmail *string,
+	body string,
+) (data_ *CreateCommentResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "NoteBySlug",
-		Query:  NoteBySlug_Operation,
-		Variables: &__NoteBySlugInput{
-			Slug:           slug,
-			Locale:         locale,
-			FallbackLocale: fallbackLocale,
+		OpName: "CreateComment",
+		Query:  CreateComment_Operation,
+		Variables: &__CreateCommentInput{
+			NoteID:      noteID,
+			AuthorName:  authorName,
+			AuthorEmail: authorEmail,
+			Body:        body,
 		},
 	}
 
-	data_ = &NoteBySlugResponse{}
+	data_ = &CreateCommentResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -4559,11 +3406,10 @@ func NoteBySlug(
 	return data_, err_
 }
 
-// The query executed by NotesByAuthorSlug.
-const NotesByAuthorSlug_Operation = `
-query NotesByAuthorSlug ($slug: String!, $page: Int!, $limit: Int!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},authorSlug:{equals:$slug}}) {
-		totalPages
+// The query executed by ListFeaturedNotes.
+const ListFeaturedNotes_Operation = `
+query ListFeaturedNotes ($limit: Int!, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},publishedAt:{less_than_equal:$now},featured:{equals:true}}) {
 		docs {
 			... NoteListDoc
 		}
@@ -4575,6 +3421,7 @@ fragment NoteListDoc on Micro_post {
 	title
 	content
 	publishedAt
+	featured
 	authors {
 		name
 		slug
@@ -4620,28 +3467,26 @@ fragment NoteListDoc on Micro_post {
 }
 `
 
-func NotesByAuthorSlug(
+func ListFeaturedNotes(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	slug string,
-	page int,
 	limit int,
+	now *string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *NotesByAuthorSlugResponse, err_ error) {
+) (data_ *ListFeaturedNotesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "NotesByAuthorSlug",
-		Query:  NotesByAuthorSlug_Operation,
-		Variables: &__NotesByAuthorSlugInput{
-			Slug:           slug,
-			Page:           page,
+		OpName: "ListFeaturedNotes",
+		Query:  ListFeaturedNotes_Operation,
+		Variables: &__ListFeaturedNotesInput{
 			Limit:          limit,
+			Now:            now,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &NotesByAuthorSlugResponse{}
+	data_ = &ListFeaturedNotesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -4653,10 +3498,10 @@ func NotesByAuthorSlug(
 	return data_, err_
 }
 
-// The query executed by NotesByAuthorSlugAndType.
-const NotesByAuthorSlugAndType_Operation = `
-query NotesByAuthorSlugAndType ($slug: String!, $page: Int!, $limit: Int!, $postType: Micro_post_post_type_Input!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},authorSlug:{equals:$slug},post_type:{equals:$postType}}) {
+// The query executed by ListNotes.
+const ListNotes_Operation = `
+query ListNotes ($page: Int!, $limit: Int!, $slug: String, $authorSlugs: [String!], $tagIDs: [JSON!], $allTagIDs: [JSON!], $postType: Micro_post_post_type_Input, $from: DateTime, $to: DateTime, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},authorSlug:{equals:$slug,in:$authorSlugs},tags:{in:$tagIDs,all:$allTagIDs},post_type:{equals:$postType},publishedAt:{greater_than_equal:$from,less_than:$to,less_than_equal:$now}}) {
 		totalPages
 		docs {
 			... NoteListDoc
@@ -4669,6 +3514,7 @@ fragment NoteListDoc on Micro_post {
 	title
 	content
 	publishedAt
+	featured
 	authors {
 		name
 		slug
@@ -4714,30 +3560,42 @@ fragment NoteListDoc on Micro_post {
 }
 `
 
-func NotesByAuthorSlugAndType(
+func ListNotes(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	slug string,
 	page int,
 	limit int,
-	postType Micro_post_post_type_Input,
+	slug *string,
+	authorSlugs []string,
+	tagIDs []string,
+	allTagIDs []string,
+	postType *Micro_post_post_type_Input,
+	from *string,
+	to *string,
+	now *string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *NotesByAuthorSlugAndTypeResponse, err_ error) {
+) (data_ *ListNotesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "NotesByAuthorSlugAndType",
-		Query:  NotesByAuthorSlugAndType_Operation,
-		Variables: &__NotesByAuthorSlugAndTypeInput{
-			Slug:           slug,
+		OpName: "ListNotes",
+		Query:  ListNotes_Operation,
+		Variables: &__ListNotesInput{
 			Page:           page,
 			Limit:          limit,
+			Slug:           slug,
+			AuthorSlugs:    authorSlugs,
+			TagIDs:         tagIDs,
+			AllTagIDs:      allTagIDs,
 			PostType:       postType,
+			From:           from,
+			To:             to,
+			Now:            now,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &NotesByAuthorSlugAndTypeResponse{}
+	data_ = &ListNotesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -4749,15 +3607,34 @@ func NotesByAuthorSlugAndType(
 	return data_, err_
 }
 
-// The query executed by SearchNotes.
-const SearchNotes_Operation = `
-query SearchNotes ($query: String!, $page: Int!, $limit: Int!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},OR:[{title:{like:$query}},{title:{contains:$query}},{content:{like:$query}},{content:{contains:$query}},{meta__description:{like:$query}},{meta__description:{contains:$query}}]}) {
+// The query executed by ListNotesPage.
+const ListNotesPage_Operation = `
+query ListNotesPage ($page: Int!, $limit: Int!, $authorsLimit: Int!, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},publishedAt:{less_than_equal:$now}}) {
 		totalPages
 		docs {
 			... NoteListDoc
 		}
 	}
+	Authors(limit: $authorsLimit, sort: "name", locale: $locale, fallbackLocale: $fallbackLocale) {
+		docs {
+			id
+			name
+			slug
+			bio
+			avatar {
+				url
+				alt
+				width
+				height
+			}
+		}
+	}
+	availableTagsByMicroPostType(locale: $locale) {
+		id
+		name
+		title
+	}
 }
 fragment NoteListDoc on Micro_post {
 	id
@@ -4765,6 +3642,7 @@ fragment NoteListDoc on Micro_post {
 	title
 	content
 	publishedAt
+	featured
 	authors {
 		name
 		slug
@@ -4810,28 +3688,118 @@ fragment NoteListDoc on Micro_post {
 }
 `
 
-func SearchNotes(
+func ListNotesPage(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	page int,
+	limit int,
+	authorsLimit int,
+	now *string,
+	locale *LocaleInputType,
+	fallbackLocale *FallbackLocaleInputType,
+) (data_ *ListNotesPageResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListNotesPage",
+		Query:  ListNotesPage_Operation,
+		Variables: &__ListNotesPageInput{
+			Page:           page,
+			Limit:          limit,
+			AuthorsLimit:   authorsLimit,
+			Now:            now,
+			Locale:         locale,
+			FallbackLocale: fallbackLocale,
+		},
+	}
+
+	data_ = &ListNotesPageResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by NoteBySlug.
+const NoteBySlug_Operation = `
+query NoteBySlug ($slug: String!, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(limit: 1, locale: $locale, fallbackLocale: $fallbackLocale, where: {_status:{equals:published},publishedAt:{less_than_equal:$now},slug:{equals:$slug}}) {
+		docs {
+			id
+			slug
+			title
+			content
+			publishedAt
+			authors {
+				name
+				slug
+				bio
+				avatar {
+					url
+					alt
+					width
+					height
+				}
+			}
+			tags {
+				id
+				name
+				title
+			}
+			attachment {
+				url
+				alt
+				width
+				height
+				filename
+				mimeType
+			}
+			externalLinks {
+				id
+				target_url
+			}
+			linkedMicroPosts {
+				id
+				slug
+			}
+			meta {
+				title
+				description
+				image {
+					url
+					description
+					width
+					height
+				}
+			}
+		}
+	}
+}
+`
+
+func NoteBySlug(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	query string,
-	page int,
-	limit int,
+	slug string,
+	now *string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *SearchNotesResponse, err_ error) {
+) (data_ *NoteBySlugResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "SearchNotes",
-		Query:  SearchNotes_Operation,
-		Variables: &__SearchNotesInput{
-			Query:          query,
-			Page:           page,
-			Limit:          limit,
+		OpName: "NoteBySlug",
+		Query:  NoteBySlug_Operation,
+		Variables: &__NoteBySlugInput{
+			Slug:           slug,
+			Now:            now,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &SearchNotesResponse{}
+	data_ = &NoteBySlugResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -4843,11 +3811,10 @@ func SearchNotes(
 	return data_, err_
 }
 
-// The query executed by SearchNotesByAuthorAndTagIDs.
-const SearchNotesByAuthorAndTagIDs_Operation = `
-query SearchNotesByAuthorAndTagIDs ($query: String!, $slug: String!, $page: Int!, $limit: Int!, $tagIDs: [JSON!]!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},authorSlug:{equals:$slug},tags:{in:$tagIDs},OR:[{title:{like:$query}},{title:{contains:$query}},{content:{like:$query}},{content:{contains:$query}},{meta__description:{like:$query}},{meta__description:{contains:$query}}]}) {
-		totalPages
+// The query executed by NoteDraftBySlug.
+const NoteDraftBySlug_Operation = `
+query NoteDraftBySlug ($slug: String!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(draft: true, limit: 1, locale: $locale, fallbackLocale: $fallbackLocale, where: {slug:{equals:$slug}}) {
 		docs {
 			... NoteListDoc
 		}
@@ -4859,6 +3826,7 @@ fragment NoteListDoc on Micro_post {
 	title
 	content
 	publishedAt
+	featured
 	authors {
 		name
 		slug
@@ -4904,32 +3872,24 @@ fragment NoteListDoc on Micro_post {
 }
 `
 
-func SearchNotesByAuthorAndTagIDs(
+func NoteDraftBySlug(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	query string,
 	slug string,
-	page int,
-	limit int,
-	tagIDs []string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *SearchNotesByAuthorAndTagIDsResponse, err_ error) {
+) (data_ *NoteDraftBySlugResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "SearchNotesByAuthorAndTagIDs",
-		Query:  SearchNotesByAuthorAndTagIDs_Operation,
-		Variables: &__SearchNotesByAuthorAndTagIDsInput{
-			Query:          query,
+		OpName: "NoteDraftBySlug",
+		Query:  NoteDraftBySlug_Operation,
+		Variables: &__NoteDraftBySlugInput{
 			Slug:           slug,
-			Page:           page,
-			Limit:          limit,
-			TagIDs:         tagIDs,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &SearchNotesByAuthorAndTagIDsResponse{}
+	data_ = &NoteDraftBySlugResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -4941,91 +3901,86 @@ func SearchNotesByAuthorAndTagIDs(
 	return data_, err_
 }
 
-// The query executed by SearchNotesByAuthorSlug.
-const SearchNotesByAuthorSlug_Operation = `
-query SearchNotesByAuthorSlug ($query: String!, $slug: String!, $page: Int!, $limit: Int!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},authorSlug:{equals:$slug},OR:[{title:{like:$query}},{title:{contains:$query}},{content:{like:$query}},{content:{contains:$query}},{meta__description:{like:$query}},{meta__description:{contains:$query}}]}) {
-		totalPages
+// The query executed by NoteRelations.
+const NoteRelations_Operation = `
+query NoteRelations ($id: String!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(limit: 1, locale: $locale, fallbackLocale: $fallbackLocale, where: {_status:{equals:published},id:{equals:$id}}) {
 		docs {
-			... NoteListDoc
+			id
+			authors {
+				slug
+			}
+			tags {
+				id
+			}
 		}
 	}
 }
-fragment NoteListDoc on Micro_post {
-	id
-	slug
-	title
-	content
-	publishedAt
-	authors {
-		name
-		slug
-		bio
-		avatar {
-			url
-			alt
-			width
-			height
-		}
-	}
-	tags {
-		id
-		name
-		title
-	}
-	attachment {
-		url
-		alt
-		width
-		height
-		filename
-		mimeType
-	}
-	externalLinks {
-		id
-		target_url
-	}
-	linkedMicroPosts {
-		id
-		slug
+`
+
+func NoteRelations(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	locale *LocaleInputType,
+	fallbackLocale *FallbackLocaleInputType,
+) (data_ *NoteRelationsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "NoteRelations",
+		Query:  NoteRelations_Operation,
+		Variables: &__NoteRelationsInput{
+			Id:             id,
+			Locale:         locale,
+			FallbackLocale: fallbackLocale,
+		},
 	}
-	meta {
-		title
-		description
-		image {
-			url
-			description
-			width
-			height
+
+	data_ = &NoteRelationsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by NoteSeries.
+const NoteSeries_Operation = `
+query NoteSeries ($id: String!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(limit: 1, locale: $locale, fallbackLocale: $fallbackLocale, where: {_status:{equals:published},id:{equals:$id}}) {
+		docs {
+			id
+			series {
+				id
+				slug
+				title
+			}
 		}
 	}
 }
 `
 
-func SearchNotesByAuthorSlug(
+func NoteSeries(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	query string,
-	slug string,
-	page int,
-	limit int,
+	id string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *SearchNotesByAuthorSlugResponse, err_ error) {
+) (data_ *NoteSeriesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "SearchNotesByAuthorSlug",
-		Query:  SearchNotesByAuthorSlug_Operation,
-		Variables: &__SearchNotesByAuthorSlugInput{
-			Query:          query,
-			Slug:           slug,
-			Page:           page,
-			Limit:          limit,
+		OpName: "NoteSeries",
+		Query:  NoteSeries_Operation,
+		Variables: &__NoteSeriesInput{
+			Id:             id,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &SearchNotesByAuthorSlugResponse{}
+	data_ = &NoteSeriesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -5037,10 +3992,10 @@ func SearchNotesByAuthorSlug(
 	return data_, err_
 }
 
-// The query executed by SearchNotesByAuthorSlugAndType.
-const SearchNotesByAuthorSlugAndType_Operation = `
-query SearchNotesByAuthorSlugAndType ($query: String!, $slug: String!, $page: Int!, $limit: Int!, $postType: Micro_post_post_type_Input!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},authorSlug:{equals:$slug},post_type:{equals:$postType},OR:[{title:{like:$query}},{title:{contains:$query}},{content:{like:$query}},{content:{contains:$query}},{meta__description:{like:$query}},{meta__description:{contains:$query}}]}) {
+// The query executed by NotesPublishedBetween.
+const NotesPublishedBetween_Operation = `
+query NotesPublishedBetween ($from: DateTime!, $to: DateTime!, $page: Int!, $limit: Int!, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},publishedAt:{greater_than_equal:$from,less_than:$to,less_than_equal:$now}}) {
 		totalPages
 		docs {
 			... NoteListDoc
@@ -5053,6 +4008,7 @@ fragment NoteListDoc on Micro_post {
 	title
 	content
 	publishedAt
+	featured
 	authors {
 		name
 		slug
@@ -5098,32 +4054,32 @@ fragment NoteListDoc on Micro_post {
 }
 `
 
-func SearchNotesByAuthorSlugAndType(
+func NotesPublishedBetween(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	query string,
-	slug string,
+	from string,
+	to string,
 	page int,
 	limit int,
-	postType Micro_post_post_type_Input,
+	now *string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *SearchNotesByAuthorSlugAndTypeResponse, err_ error) {
+) (data_ *NotesPublishedBetweenResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "SearchNotesByAuthorSlugAndType",
-		Query:  SearchNotesByAuthorSlugAndType_Operation,
-		Variables: &__SearchNotesByAuthorSlugAndTypeInput{
-			Query:          query,
-			Slug:           slug,
+		OpName: "NotesPublishedBetween",
+		Query:  NotesPublishedBetween_Operation,
+		Variables: &__NotesPublishedBetweenInput{
+			From:           from,
+			To:             to,
 			Page:           page,
 			Limit:          limit,
-			PostType:       postType,
+			Now:            now,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &SearchNotesByAuthorSlugAndTypeResponse{}
+	data_ = &NotesPublishedBetweenResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -5135,11 +4091,10 @@ func SearchNotesByAuthorSlugAndType(
 	return data_, err_
 }
 
-// The query executed by SearchNotesByAuthorTagIDsAndType.
-const SearchNotesByAuthorTagIDsAndType_Operation = `
-query SearchNotesByAuthorTagIDsAndType ($query: String!, $slug: String!, $page: Int!, $limit: Int!, $tagIDs: [JSON!]!, $postType: Micro_post_post_type_Input!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},authorSlug:{equals:$slug},tags:{in:$tagIDs},post_type:{equals:$postType},OR:[{title:{like:$query}},{title:{contains:$query}},{content:{like:$query}},{content:{contains:$query}},{meta__description:{like:$query}},{meta__description:{contains:$query}}]}) {
-		totalPages
+// The query executed by RelatedNoteCandidates.
+const RelatedNoteCandidates_Operation = `
+query RelatedNoteCandidates ($id: String!, $tagIDs: [JSON!]!, $authorSlugs: [String!]!, $limit: Int!, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},publishedAt:{less_than_equal:$now},id:{not_equals:$id},OR:[{tags:{in:$tagIDs}},{authorSlug:{in:$authorSlugs}}]}) {
 		docs {
 			... NoteListDoc
 		}
@@ -5151,6 +4106,7 @@ fragment NoteListDoc on Micro_post {
 	title
 	content
 	publishedAt
+	featured
 	authors {
 		name
 		slug
@@ -5196,34 +4152,32 @@ fragment NoteListDoc on Micro_post {
 }
 `
 
-func SearchNotesByAuthorTagIDsAndType(
+func RelatedNoteCandidates(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	query string,
-	slug string,
-	page int,
-	limit int,
+	id string,
 	tagIDs []string,
-	postType Micro_post_post_type_Input,
+	authorSlugs []string,
+	limit int,
+	now *string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *SearchNotesByAuthorTagIDsAndTypeResponse, err_ error) {
+) (data_ *RelatedNoteCandidatesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "SearchNotesByAuthorTagIDsAndType",
-		Query:  SearchNotesByAuthorTagIDsAndType_Operation,
-		Variables: &__SearchNotesByAuthorTagIDsAndTypeInput{
-			Query:          query,
-			Slug:           slug,
-			Page:           page,
-			Limit:          limit,
+		OpName: "RelatedNoteCandidates",
+		Query:  RelatedNoteCandidates_Operation,
+		Variables: &__RelatedNoteCandidatesInput{
+			Id:             id,
 			TagIDs:         tagIDs,
-			PostType:       postType,
+			AuthorSlugs:    authorSlugs,
+			Limit:          limit,
+			Now:            now,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &SearchNotesByAuthorTagIDsAndTypeResponse{}
+	data_ = &RelatedNoteCandidatesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -5235,10 +4189,10 @@ func SearchNotesByAuthorTagIDsAndType(
 	return data_, err_
 }
 
-// The query executed by SearchNotesByTagIDs.
-const SearchNotesByTagIDs_Operation = `
-query SearchNotesByTagIDs ($query: String!, $page: Int!, $limit: Int!, $tagIDs: [JSON!]!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},tags:{in:$tagIDs},OR:[{title:{like:$query}},{title:{contains:$query}},{content:{like:$query}},{content:{contains:$query}},{meta__description:{like:$query}},{meta__description:{contains:$query}}]}) {
+// The query executed by SearchNotes.
+const SearchNotes_Operation = `
+query SearchNotes ($query: String!, $page: Int!, $limit: Int!, $slug: String, $authorSlugs: [String!], $tagIDs: [JSON!], $allTagIDs: [JSON!], $postType: Micro_post_post_type_Input, $from: DateTime, $to: DateTime, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},authorSlug:{equals:$slug,in:$authorSlugs},tags:{in:$tagIDs,all:$allTagIDs},post_type:{equals:$postType},publishedAt:{greater_than_equal:$from,less_than:$to,less_than_equal:$now},OR:[{title:{like:$query}},{title:{contains:$query}},{content:{like:$query}},{content:{contains:$query}},{meta__description:{like:$query}},{meta__description:{contains:$query}}]}) {
 		totalPages
 		docs {
 			... NoteListDoc
@@ -5251,6 +4205,7 @@ fragment NoteListDoc on Micro_post {
 	title
 	content
 	publishedAt
+	featured
 	authors {
 		name
 		slug
@@ -5296,30 +4251,44 @@ fragment NoteListDoc on Micro_post {
 }
 `
 
-func SearchNotesByTagIDs(
+func SearchNotes(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	query string,
 	page int,
 	limit int,
+	slug *string,
+	authorSlugs []string,
 	tagIDs []string,
+	allTagIDs []string,
+	postType *Micro_post_post_type_Input,
+	from *string,
+	to *string,
+	now *string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *SearchNotesByTagIDsResponse, err_ error) {
+) (data_ *SearchNotesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "SearchNotesByTagIDs",
-		Query:  SearchNotesByTagIDs_Operation,
-		Variables: &__SearchNotesByTagIDsInput{
+		OpName: "SearchNotes",
+		Query:  SearchNotes_Operation,
+		Variables: &__SearchNotesInput{
 			Query:          query,
 			Page:           page,
 			Limit:          limit,
+			Slug:           slug,
+			AuthorSlugs:    authorSlugs,
 			TagIDs:         tagIDs,
+			AllTagIDs:      allTagIDs,
+			PostType:       postType,
+			From:           from,
+			To:             to,
+			Now:            now,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &SearchNotesByTagIDsResponse{}
+	data_ = &SearchNotesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -5331,93 +4300,38 @@ func SearchNotesByTagIDs(
 	return data_, err_
 }
 
-// The query executed by SearchNotesByTagIDsAndType.
-const SearchNotesByTagIDsAndType_Operation = `
-query SearchNotesByTagIDsAndType ($query: String!, $page: Int!, $limit: Int!, $tagIDs: [JSON!]!, $postType: Micro_post_post_type_Input!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},tags:{in:$tagIDs},post_type:{equals:$postType},OR:[{title:{like:$query}},{title:{contains:$query}},{content:{like:$query}},{content:{contains:$query}},{meta__description:{like:$query}},{meta__description:{contains:$query}}]}) {
-		totalPages
+// The query executed by SeriesBySlug.
+const SeriesBySlug_Operation = `
+query SeriesBySlug ($slug: String!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Series(limit: 1, locale: $locale, fallbackLocale: $fallbackLocale, where: {slug:{equals:$slug}}) {
 		docs {
-			... NoteListDoc
-		}
-	}
-}
-fragment NoteListDoc on Micro_post {
-	id
-	slug
-	title
-	content
-	publishedAt
-	authors {
-		name
-		slug
-		bio
-		avatar {
-			url
-			alt
-			width
-			height
-		}
-	}
-	tags {
-		id
-		name
-		title
-	}
-	attachment {
-		url
-		alt
-		width
-		height
-		filename
-		mimeType
-	}
-	externalLinks {
-		id
-		target_url
-	}
-	linkedMicroPosts {
-		id
-		slug
-	}
-	meta {
-		title
-		description
-		image {
-			url
+			id
+			slug
+			title
 			description
-			width
-			height
 		}
 	}
 }
 `
 
-func SearchNotesByTagIDsAndType(
+func SeriesBySlug(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	query string,
-	page int,
-	limit int,
-	tagIDs []string,
-	postType Micro_post_post_type_Input,
+	slug string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *SearchNotesByTagIDsAndTypeResponse, err_ error) {
+) (data_ *SeriesBySlugResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "SearchNotesByTagIDsAndType",
-		Query:  SearchNotesByTagIDsAndType_Operation,
-		Variables: &__SearchNotesByTagIDsAndTypeInput{
-			Query:          query,
-			Page:           page,
-			Limit:          limit,
-			TagIDs:         tagIDs,
-			PostType:       postType,
+		OpName: "SeriesBySlug",
+		Query:  SeriesBySlug_Operation,
+		Variables: &__SeriesBySlugInput{
+			Slug:           slug,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &SearchNotesByTagIDsAndTypeResponse{}
+	data_ = &SeriesBySlugResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -5429,11 +4343,10 @@ func SearchNotesByTagIDsAndType(
 	return data_, err_
 }
 
-// The query executed by SearchNotesByType.
-const SearchNotesByType_Operation = `
-query SearchNotesByType ($query: String!, $page: Int!, $limit: Int!, $postType: Micro_post_post_type_Input!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
-	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "-publishedAt", where: {_status:{equals:published},post_type:{equals:$postType},OR:[{title:{like:$query}},{title:{contains:$query}},{content:{like:$query}},{content:{contains:$query}},{meta__description:{like:$query}},{meta__description:{contains:$query}}]}) {
-		totalPages
+// The query executed by SeriesNotes.
+const SeriesNotes_Operation = `
+query SeriesNotes ($seriesID: JSON!, $limit: Int!, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, sort: "seriesPosition", where: {_status:{equals:published},publishedAt:{less_than_equal:$now},series:{equals:$seriesID}}) {
 		docs {
 			... NoteListDoc
 		}
@@ -5445,6 +4358,7 @@ fragment NoteListDoc on Micro_post {
 	title
 	content
 	publishedAt
+	featured
 	authors {
 		name
 		slug
@@ -5490,30 +4404,28 @@ fragment NoteListDoc on Micro_post {
 }
 `
 
-func SearchNotesByType(
+func SeriesNotes(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	query string,
-	page int,
+	seriesID string,
 	limit int,
-	postType Micro_post_post_type_Input,
+	now *string,
 	locale *LocaleInputType,
 	fallbackLocale *FallbackLocaleInputType,
-) (data_ *SearchNotesByTypeResponse, err_ error) {
+) (data_ *SeriesNotesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "SearchNotesByType",
-		Query:  SearchNotesByType_Operation,
-		Variables: &__SearchNotesByTypeInput{
-			Query:          query,
-			Page:           page,
+		OpName: "SeriesNotes",
+		Query:  SeriesNotes_Operation,
+		Variables: &__SeriesNotesInput{
+			SeriesID:       seriesID,
 			Limit:          limit,
-			PostType:       postType,
+			Now:            now,
 			Locale:         locale,
 			FallbackLocale: fallbackLocale,
 		},
 	}
 
-	data_ = &SearchNotesByTypeResponse{}
+	data_ = &SeriesNotesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -5567,6 +4479,55 @@ func TagByName(
 	return data_, err_
 }
 
+// The query executed by TagCounts.
+const TagCounts_Operation = `
+query TagCounts ($page: Int!, $limit: Int!, $now: DateTime, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
+	Micro_posts(page: $page, limit: $limit, locale: $locale, fallbackLocale: $fallbackLocale, where: {_status:{equals:published},publishedAt:{less_than_equal:$now}}) {
+		totalPages
+		docs {
+			tags {
+				id
+				name
+				title
+			}
+		}
+	}
+}
+`
+
+func TagCounts(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	page int,
+	limit int,
+	now *string,
+	locale *LocaleInputType,
+	fallbackLocale *FallbackLocaleInputType,
+) (data_ *TagCountsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "TagCounts",
+		Query:  TagCounts_Operation,
+		Variables: &__TagCountsInput{
+			Page:           page,
+			Limit:          limit,
+			Now:            now,
+			Locale:         locale,
+			FallbackLocale: fallbackLocale,
+		},
+	}
+
+	data_ = &TagCountsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by TagIDsByNames.
 const TagIDsByNames_Operation = `
 query TagIDsByNames ($tagNames: [String!]!, $locale: LocaleInputType, $fallbackLocale: FallbackLocaleInputType) {
@@ -0,0 +1,27 @@
+package gql
+
+import (
+	"context"
+	"errors"
+
+	genqlientgraphql "github.com/Khan/genqlient/graphql"
+)
+
+const pingOperation = `query Ping { __typename }`
+
+// Ping sends the cheapest query the CMS can answer, for readiness probes. It
+// lives outside the generated operations because it needs no schema types.
+func Ping(ctx context.Context, client genqlientgraphql.Client) error {
+	if client == nil {
+		return errors.New("graphql client is required")
+	}
+
+	var data struct {
+		Typename string `json:"__typename"`
+	}
+	return client.MakeRequest(
+		ctx,
+		&genqlientgraphql.Request{OpName: "Ping", Query: pingOperation},
+		&genqlientgraphql.Response{Data: &data},
+	)
+}
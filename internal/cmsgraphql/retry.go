@@ -0,0 +1,170 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	genqlientgraphql "github.com/Khan/genqlient/graphql"
+)
+
+const defaultMaxAttempts = 3
+const defaultRetryBaseDelay = 100 * time.Millisecond
+const defaultRetryMaxDelay = 2 * time.Second
+const defaultBreakerThreshold = 5
+const defaultBreakerCooldown = 30 * time.Second
+
+// ErrCircuitOpen is returned without contacting the CMS while it is considered
+// down after repeated failures.
+var ErrCircuitOpen = errors.New("cms circuit open")
+
+type RetryConfig struct {
+	// MaxAttempts counts the first try. Mutations are never retried.
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// BreakerThreshold consecutive failed requests open the circuit for
+	// BreakerCooldown; the first request after that decides whether it closes.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+type retryClient struct {
+	base  genqlientgraphql.Client
+	cfg   RetryConfig
+	sleep func(ctx context.Context, delay time.Duration) error
+	now   func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+// WithRetry retries queries that failed with a 5xx or a timeout, backing off
+// exponentially with full jitter, and stops calling a CMS that keeps failing.
+func WithRetry(base genqlientgraphql.Client, cfg RetryConfig) genqlientgraphql.Client {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = defaultRetryBaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = defaultRetryMaxDelay
+	}
+	if cfg.BreakerThreshold <= 0 {
+		cfg.BreakerThreshold = defaultBreakerThreshold
+	}
+	if cfg.BreakerCooldown <= 0 {
+		cfg.BreakerCooldown = defaultBreakerCooldown
+	}
+
+	return &retryClient{base: base, cfg: cfg, sleep: sleepContext, now: time.Now}
+}
+
+func (c *retryClient) MakeRequest(
+	ctx context.Context,
+	req *genqlientgraphql.Request,
+	resp *genqlientgraphql.Response,
+) error {
+	if !c.allow() {
+		return ErrCircuitOpen
+	}
+
+	attempts := c.cfg.MaxAttempts
+	if isMutation(req) {
+		attempts = 1
+	}
+
+	var err error
+	for attempt := range attempts {
+		if attempt > 0 {
+			if sleepErr := c.sleep(ctx, c.backoff(attempt)); sleepErr != nil {
+				break
+			}
+			resp.Errors = nil
+		}
+
+		err = c.base.MakeRequest(ctx, req, resp)
+		if !retryable(ctx, err) {
+			break
+		}
+	}
+
+	c.record(ctx, err)
+	return err
+}
+
+func (c *retryClient) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return !c.now().Before(c.openUntil)
+}
+
+// record opens the circuit once BreakerThreshold requests in a row have failed
+// the way a down CMS fails. Answers the CMS gave on purpose, such as GraphQL
+// errors, and requests the caller abandoned leave it alone.
+func (c *retryClient) record(ctx context.Context, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !retryable(ctx, err) {
+		if ctx.Err() == nil {
+			c.failures = 0
+		}
+		return
+	}
+
+	c.failures++
+	if c.failures >= c.cfg.BreakerThreshold {
+		c.openUntil = c.now().Add(c.cfg.BreakerCooldown)
+	}
+}
+
+func (c *retryClient) backoff(attempt int) time.Duration {
+	delay := c.cfg.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > c.cfg.MaxDelay {
+		delay = c.cfg.MaxDelay
+	}
+
+	return rand.N(delay) + 1
+}
+
+func retryable(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	var httpErr *genqlientgraphql.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+func isMutation(req *genqlientgraphql.Request) bool {
+	return req != nil && strings.HasPrefix(strings.TrimSpace(req.Query), "mutation")
+}
+
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,106 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	genqlientgraphql "github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+type scriptedClient struct {
+	errs  []error
+	calls int
+}
+
+func (c *scriptedClient) MakeRequest(context.Context, *genqlientgraphql.Request, *genqlientgraphql.Response) error {
+	c.calls++
+	if len(c.errs) == 0 {
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func newTestRetryClient(base genqlientgraphql.Client, cfg RetryConfig) *retryClient {
+	client := WithRetry(base, cfg).(*retryClient)
+	client.sleep = func(context.Context, time.Duration) error { return nil }
+	return client
+}
+
+func TestRetryClientRetriesServerErrorsUntilSuccess(t *testing.T) {
+	t.Parallel()
+
+	base := &scriptedClient{errs: []error{
+		&genqlientgraphql.HTTPError{StatusCode: http.StatusBadGateway},
+		context.DeadlineExceeded,
+	}}
+	client := newTestRetryClient(base, RetryConfig{MaxAttempts: 3})
+
+	err := client.MakeRequest(context.Background(), &genqlientgraphql.Request{Query: "query Notes { id }"},
+		&genqlientgraphql.Response{})
+
+	require.NoError(t, err)
+	require.Equal(t, 3, base.calls)
+}
+
+func TestRetryClientDoesNotRetryClientErrorsOrMutations(t *testing.T) {
+	t.Parallel()
+
+	base := &scriptedClient{errs: []error{&genqlientgraphql.HTTPError{StatusCode: http.StatusBadRequest}}}
+	client := newTestRetryClient(base, RetryConfig{MaxAttempts: 3})
+	err := client.MakeRequest(context.Background(), &genqlientgraphql.Request{Query: "query Notes { id }"},
+		&genqlientgraphql.Response{})
+	require.Error(t, err)
+	require.Equal(t, 1, base.calls)
+
+	base = &scriptedClient{errs: []error{&genqlientgraphql.HTTPError{StatusCode: http.StatusServiceUnavailable}}}
+	client = newTestRetryClient(base, RetryConfig{MaxAttempts: 3})
+	err = client.MakeRequest(context.Background(), &genqlientgraphql.Request{Query: "\n mutation CreateComment { id }"},
+		&genqlientgraphql.Response{})
+	require.Error(t, err)
+	require.Equal(t, 1, base.calls)
+}
+
+func TestRetryClientOpensCircuitAfterConsecutiveFailures(t *testing.T) {
+	t.Parallel()
+
+	unavailable := &genqlientgraphql.HTTPError{StatusCode: http.StatusServiceUnavailable}
+	base := &scriptedClient{errs: []error{unavailable, unavailable}}
+	client := newTestRetryClient(base, RetryConfig{MaxAttempts: 1, BreakerThreshold: 2, BreakerCooldown: time.Minute})
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	client.now = func() time.Time { return now }
+	req := &genqlientgraphql.Request{Query: "query Notes { id }"}
+
+	for range 2 {
+		require.ErrorAs(t, client.MakeRequest(context.Background(), req, &genqlientgraphql.Response{}),
+			new(*genqlientgraphql.HTTPError))
+	}
+	err := client.MakeRequest(context.Background(), req, &genqlientgraphql.Response{})
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, 2, base.calls)
+
+	now = now.Add(time.Minute)
+	require.NoError(t, client.MakeRequest(context.Background(), req, &genqlientgraphql.Response{}))
+	require.Equal(t, 3, base.calls)
+}
+
+func TestRetryClientStopsWhenCallerGivesUp(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	base := &scriptedClient{errs: []error{errors.Join(context.Canceled, errors.New("connection reset"))}}
+	client := newTestRetryClient(base, RetryConfig{MaxAttempts: 3, BreakerThreshold: 1})
+	cancel()
+
+	err := client.MakeRequest(ctx, &genqlientgraphql.Request{Query: "query Notes { id }"}, &genqlientgraphql.Response{})
+
+	require.Error(t, err)
+	require.Equal(t, 1, base.calls)
+	require.NotErrorIs(t, client.MakeRequest(context.Background(), &genqlientgraphql.Request{},
+		&genqlientgraphql.Response{}), ErrCircuitOpen)
+}
@@ -0,0 +1,76 @@
+package cmshooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"blog/internal/notes"
+	"blog/internal/surrogate"
+)
+
+const purgeTimeout = 10 * time.Second
+
+// NotesCacheInvalidator drops the notes service aggregates a change shows up in.
+func NotesCacheInvalidator(service *notes.Service) Invalidator {
+	return func(_ context.Context, keys []string) error {
+		if service == nil {
+			return nil
+		}
+		if slices.Contains(keys, surrogate.ListArchive) {
+			service.InvalidateArchiveIndex()
+		}
+		if slices.Contains(keys, surrogate.ListTags) {
+			service.InvalidateTagCounts()
+		}
+		if slices.Contains(keys, surrogate.ListNotes) {
+			service.InvalidateChannelCounts()
+		}
+		return nil
+	}
+}
+
+// NewPurgeInvalidator posts the keys as {"tags": [...]} to endpoint, the body a
+// Cloudflare purge_cache call expects for the Cache-Tag header. A non-empty
+// token is sent as a bearer token.
+func NewPurgeInvalidator(endpoint string, token string, client *http.Client) (Invalidator, error) {
+	parsed, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("cdn purge URL must be absolute: %q", endpoint)
+	}
+	if client == nil {
+		client = &http.Client{Timeout: purgeTimeout}
+	}
+	token = strings.TrimSpace(token)
+
+	return func(ctx context.Context, keys []string) error {
+		body, err := json.Marshal(map[string][]string{"tags": keys})
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, parsed.String(), bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("purge cdn: %w", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("purge cdn: unexpected status %d", resp.StatusCode)
+		}
+		return nil
+	}, nil
+}
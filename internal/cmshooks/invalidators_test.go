@@ -0,0 +1,43 @@
+package cmshooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeInvalidatorPostsCacheTags(t *testing.T) {
+	t.Parallel()
+
+	var received struct {
+		Tags []string `json:"tags"`
+	}
+	var authorization string
+	cdn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(cdn.Close)
+
+	purge, err := NewPurgeInvalidator(cdn.URL+"/purge_cache", "cdn-token", nil)
+	require.NoError(t, err)
+	require.NoError(t, purge(context.Background(), []string{"note:hello-world", "list:notes"}))
+	require.Equal(t, []string{"note:hello-world", "list:notes"}, received.Tags)
+	require.Equal(t, "Bearer cdn-token", authorization)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(failing.Close)
+	purge, err = NewPurgeInvalidator(failing.URL, "", nil)
+	require.NoError(t, err)
+	require.Error(t, purge(context.Background(), []string{"list:notes"}))
+
+	_, err = NewPurgeInvalidator("/purge", "", nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,215 @@
+// Package cmshooks receives Payload CMS afterChange webhooks and turns them into
+// the surrogate keys of the pages a change affects.
+package cmshooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog/internal/surrogate"
+)
+
+const PayloadPath = "/.hooks/payload"
+const SignatureHeader = "X-Payload-Signature"
+const TimestampHeader = "X-Payload-Timestamp"
+const signaturePrefix = "sha256="
+const maxPayloadBytes = 1 << 20
+
+// maxSignatureSkew is how far the signed timestamp may drift from the server
+// clock; older deliveries are rejected so a captured request cannot be replayed.
+const maxSignatureSkew = 5 * time.Minute
+
+const (
+	CollectionNotes   = "micro-posts"
+	CollectionAuthors = "authors"
+	CollectionTags    = "tags"
+	CollectionSeries  = "series"
+)
+
+// Invalidator drops whatever is cached under the given surrogate keys.
+type Invalidator func(ctx context.Context, keys []string) error
+
+type Handler struct {
+	secret       []byte
+	invalidators []Invalidator
+}
+
+type changeEvent struct {
+	Collection  string          `json:"collection"`
+	Operation   string          `json:"operation"`
+	Doc         json.RawMessage `json:"doc"`
+	PreviousDoc json.RawMessage `json:"previousDoc"`
+}
+
+// changedDoc keeps relationships raw: Payload sends populated documents or bare
+// IDs depending on the hook's depth, and one shape must not spoil the other.
+type changedDoc struct {
+	Slug    string            `json:"slug"`
+	Name    string            `json:"name"`
+	Authors []json.RawMessage `json:"authors"`
+	Tags    []json.RawMessage `json:"tags"`
+}
+
+type relatedDoc struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+func NewHandler(secret string, invalidators ...Invalidator) (*Handler, error) {
+	secret = strings.TrimSpace(secret)
+	if secret == "" {
+		return nil, errors.New("payload webhook secret is required")
+	}
+
+	return &Handler{secret: []byte(secret), invalidators: invalidators}, nil
+}
+
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.Handle(PayloadPath, h)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadBytes))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if !h.validSignature(r.Header.Get(SignatureHeader), r.Header.Get(TimestampHeader), body, time.Now()) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var event changeEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	keys := AffectedKeys(event.Collection, event.Doc, event.PreviousDoc)
+	if len(keys) > 0 {
+		for _, invalidate := range h.invalidators {
+			if invalidate == nil {
+				continue
+			}
+			if err := invalidate(r.Context(), keys); err != nil {
+				http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+				return
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(map[string][]string{"keys": keys})
+}
+
+// Sign returns the signature header value the CMS must send for body along
+// with timestamp, the Unix seconds it sends in TimestampHeader.
+func Sign(secret string, timestamp string, body []byte) string {
+	return signaturePrefix + hex.EncodeToString(signature([]byte(strings.TrimSpace(secret)), timestamp, body))
+}
+
+func signature(secret []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+func (h *Handler) validSignature(header string, timestamp string, body []byte, now time.Time) bool {
+	provided, ok := strings.CutPrefix(strings.TrimSpace(header), signaturePrefix)
+	if !ok {
+		return false
+	}
+	decoded, err := hex.DecodeString(provided)
+	if err != nil {
+		return false
+	}
+
+	timestamp = strings.TrimSpace(timestamp)
+	sentAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if skew := now.Sub(time.Unix(sentAt, 0)); skew > maxSignatureSkew || skew < -maxSignatureSkew {
+		return false
+	}
+
+	return hmac.Equal(decoded, signature(h.secret, timestamp, body))
+}
+
+// AffectedKeys lists the surrogate keys of every page that renders the changed
+// document before or after the change. Unknown collections affect nothing.
+func AffectedKeys(collection string, docs ...json.RawMessage) []string {
+	keys := make([]string, 0)
+	seen := make(map[string]struct{})
+	add := func(values ...string) {
+		for _, value := range values {
+			if value == "" {
+				continue
+			}
+			if _, ok := seen[value]; ok {
+				continue
+			}
+			seen[value] = struct{}{}
+			keys = append(keys, value)
+		}
+	}
+
+	for _, raw := range docs {
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+		var doc changedDoc
+		if err := json.Unmarshal(raw, &doc); err != nil {
+			continue
+		}
+
+		switch strings.TrimSpace(collection) {
+		case CollectionNotes:
+			add(surrogate.Note(doc.Slug))
+			for _, author := range doc.Authors {
+				add(surrogate.Author(related(author).Slug))
+			}
+			for _, tag := range doc.Tags {
+				add(surrogate.Tag(related(tag).Name))
+			}
+			add(surrogate.Listings()...)
+		case CollectionAuthors:
+			add(surrogate.Author(doc.Slug))
+		case CollectionTags:
+			add(surrogate.Tag(doc.Name), surrogate.ListTags)
+		case CollectionSeries:
+			add(surrogate.ListSeries)
+		}
+	}
+
+	return keys
+}
+
+// related decodes a populated relationship. A bare ID names no page on its own,
+// so it yields an empty document and only the listings are purged for it.
+func related(raw json.RawMessage) relatedDoc {
+	var doc relatedDoc
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return relatedDoc{}
+	}
+
+	return doc
+}
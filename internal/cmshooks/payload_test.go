@@ -0,0 +1,129 @@
+package cmshooks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "hook-secret"
+
+func postPayload(handler http.Handler, body string, timestamp string, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, PayloadPath, strings.NewReader(body))
+	req.Header.Set(TimestampHeader, timestamp)
+	if signature != "" {
+		req.Header.Set(SignatureHeader, signature)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func postSigned(handler http.Handler, body string) *httptest.ResponseRecorder {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	return postPayload(handler, body, timestamp, Sign(testSecret, timestamp, []byte(body)))
+}
+
+func TestHandlerInvalidatesKeysForChangedNote(t *testing.T) {
+	t.Parallel()
+
+	var invalidated []string
+	handler, err := NewHandler(testSecret, func(_ context.Context, keys []string) error {
+		invalidated = keys
+		return nil
+	})
+	require.NoError(t, err)
+
+	body := `{
+		"collection":"micro-posts",
+		"operation":"update",
+		"doc":{"slug":"hello-world","authors":[{"slug":"l-you"}],"tags":[{"name":"go"}]},
+		"previousDoc":{"slug":"hello","authors":[{"slug":"l-you"}],"tags":[{"name":"intro"}]}
+	}`
+	rec := postSigned(handler, body)
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	require.Equal(t, []string{
+		"note:hello-world",
+		"author:l-you",
+		"tag:go",
+		"list:notes",
+		"list:archive",
+		"list:tags",
+		"list:series",
+		"list:feed",
+		"note:hello",
+		"tag:intro",
+	}, invalidated)
+	require.Contains(t, rec.Body.String(), `"note:hello-world"`)
+}
+
+func TestHandlerRejectsBadSignaturesAndMethods(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	handler, err := NewHandler(testSecret, func(context.Context, []string) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, err)
+
+	body := `{"collection":"authors","doc":{"slug":"l-you"}}`
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-maxSignatureSkew-time.Minute).Unix(), 10)
+	require.Equal(t, http.StatusUnauthorized, postPayload(handler, body, now, "").Code)
+	require.Equal(t, http.StatusUnauthorized, postPayload(handler, body, now, Sign("other", now, []byte(body))).Code)
+	require.Equal(t, http.StatusUnauthorized, postPayload(handler, body, now, "sha256=zz").Code)
+	staleSignature := Sign(testSecret, stale, []byte(body))
+	require.Equal(t, http.StatusUnauthorized, postPayload(handler, body, stale, staleSignature).Code)
+	require.Equal(t, http.StatusUnauthorized, postPayload(handler, body, "", Sign(testSecret, "", []byte(body))).Code)
+	require.Equal(t, http.StatusUnauthorized, postPayload(handler, body, stale, Sign(testSecret, now, []byte(body))).Code)
+	require.False(t, called)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, PayloadPath, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	_, err = NewHandler(" ")
+	require.Error(t, err)
+}
+
+func TestHandlerReportsInvalidatorFailure(t *testing.T) {
+	t.Parallel()
+
+	handler, err := NewHandler(testSecret, func(context.Context, []string) error {
+		return errors.New("purge failed")
+	})
+	require.NoError(t, err)
+
+	body := `{"collection":"tags","doc":{"name":"go"}}`
+	require.Equal(t, http.StatusBadGateway, postSigned(handler, body).Code)
+}
+
+func TestAffectedKeysIgnoresUnknownCollections(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, AffectedKeys("media", []byte(`{"slug":"cover"}`)))
+	require.Equal(t, []string{"author:l-you"}, AffectedKeys("authors", []byte(`{"slug":"L-You"}`), []byte("null")))
+}
+
+func TestAffectedKeysAcceptsRelationshipIDs(t *testing.T) {
+	t.Parallel()
+
+	keys := AffectedKeys("micro-posts", []byte(`{"slug":"hello-world","authors":["a1",{"slug":"l-you"}],"tags":[7]}`))
+	require.Equal(t, []string{
+		"note:hello-world",
+		"author:l-you",
+		"list:notes",
+		"list:archive",
+		"list:tags",
+		"list:series",
+		"list:feed",
+	}, keys)
+}
@@ -0,0 +1,234 @@
+package comments
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	gql "blog/internal/cmsgraphql"
+	genqlientgraphql "github.com/Khan/genqlient/graphql"
+)
+
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+	StatusSpam     Status = "spam"
+)
+
+const FieldAuthorName = "name"
+const FieldAuthorEmail = "email"
+const FieldBody = "body"
+
+const ProblemRequired = "required"
+const ProblemTooLong = "too_long"
+const ProblemInvalid = "invalid"
+
+const MaxAuthorNameLength = 80
+const MaxAuthorEmailLength = 254
+const MaxBodyLength = 4000
+
+const defaultListLimit = 100
+
+type Comment struct {
+	ID           string
+	AuthorName   string
+	Body         string
+	CreatedAt    string
+	CreatedAtISO string
+}
+
+type Submission struct {
+	NoteID      string
+	AuthorName  string
+	AuthorEmail string
+	Body        string
+}
+
+type Service struct {
+	client genqlientgraphql.Client
+	limit  int
+}
+
+func NewService(client genqlientgraphql.Client, limit int) *Service {
+	if limit < 1 {
+		limit = defaultListLimit
+	}
+
+	return &Service{
+		client: client,
+		limit:  limit,
+	}
+}
+
+func ParseStatus(raw string) Status {
+	switch Status(strings.ToLower(strings.TrimSpace(raw))) {
+	case StatusPending:
+		return StatusPending
+	case StatusApproved:
+		return StatusApproved
+	case StatusRejected:
+		return StatusRejected
+	case StatusSpam:
+		return StatusSpam
+	default:
+		return ""
+	}
+}
+
+func (s *Service) ListApproved(ctx context.Context, noteID string) ([]Comment, error) {
+	noteID = strings.TrimSpace(noteID)
+	if noteID == "" {
+		return []Comment{}, nil
+	}
+
+	limit := s.limit
+	response, err := gql.ApprovedComments(ctx, s.client, noteID, &limit)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil || response.Comments == nil {
+		return []Comment{}, nil
+	}
+
+	out := make([]Comment, 0, len(response.Comments.Docs))
+	for _, doc := range response.Comments.Docs {
+		body := strings.TrimSpace(stringValue(doc.Body))
+		if body == "" {
+			continue
+		}
+		out = append(out, Comment{
+			ID:           strings.TrimSpace(doc.Id),
+			AuthorName:   strings.TrimSpace(stringValue(doc.AuthorName)),
+			Body:         body,
+			CreatedAt:    formatDate(doc.CreatedAt),
+			CreatedAtISO: formatDateISO(doc.CreatedAt),
+		})
+	}
+
+	return out, nil
+}
+
+func (s *Service) Submit(ctx context.Context, submission Submission) (Status, error) {
+	submission = submission.Normalize()
+	if strings.TrimSpace(submission.NoteID) == "" {
+		return "", fmt.Errorf("comment note id is required")
+	}
+	if problems := submission.Validate(); len(problems) > 0 {
+		return "", fmt.Errorf("invalid comment submission: %v", problems)
+	}
+
+	var email *string
+	if submission.AuthorEmail != "" {
+		email = &submission.AuthorEmail
+	}
+
+	response, err := gql.CreateComment(
+		ctx,
+		s.client,
+		submission.NoteID,
+		submission.AuthorName,
+		email,
+		submission.Body,
+	)
+	if err != nil {
+		return "", err
+	}
+	if response == nil || response.CreateComment == nil || response.CreateComment.Status == nil {
+		return StatusPending, nil
+	}
+
+	status := ParseStatus(string(*response.CreateComment.Status))
+	if status == "" {
+		return StatusPending, nil
+	}
+
+	return status, nil
+}
+
+func (submission Submission) Normalize() Submission {
+	return Submission{
+		NoteID:      strings.TrimSpace(submission.NoteID),
+		AuthorName:  strings.Join(strings.Fields(submission.AuthorName), " "),
+		AuthorEmail: strings.TrimSpace(submission.AuthorEmail),
+		Body:        strings.TrimSpace(strings.ReplaceAll(submission.Body, "\r\n", "\n")),
+	}
+}
+
+func (submission Submission) Validate() map[string]string {
+	submission = submission.Normalize()
+	problems := map[string]string{}
+
+	switch {
+	case submission.AuthorName == "":
+		problems[FieldAuthorName] = ProblemRequired
+	case utf8.RuneCountInString(submission.AuthorName) > MaxAuthorNameLength:
+		problems[FieldAuthorName] = ProblemTooLong
+	}
+
+	if submission.AuthorEmail != "" {
+		address, err := mail.ParseAddress(submission.AuthorEmail)
+		switch {
+		case len(submission.AuthorEmail) > MaxAuthorEmailLength:
+			problems[FieldAuthorEmail] = ProblemTooLong
+		case err != nil || address.Address != submission.AuthorEmail:
+			problems[FieldAuthorEmail] = ProblemInvalid
+		}
+	}
+
+	switch {
+	case submission.Body == "":
+		problems[FieldBody] = ProblemRequired
+	case utf8.RuneCountInString(submission.Body) > MaxBodyLength:
+		problems[FieldBody] = ProblemTooLong
+	}
+
+	return problems
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func formatDate(raw *string) string {
+	parsed, ok := parseTimestamp(raw)
+	if !ok {
+		return strings.TrimSpace(stringValue(raw))
+	}
+
+	return parsed.Format("2006-01-02")
+}
+
+func formatDateISO(raw *string) string {
+	parsed, ok := parseTimestamp(raw)
+	if !ok {
+		return strings.TrimSpace(stringValue(raw))
+	}
+
+	return parsed.UTC().Format(time.RFC3339)
+}
+
+func parseTimestamp(raw *string) (time.Time, bool) {
+	value := strings.TrimSpace(stringValue(raw))
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		parsed, err = time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return time.Time{}, false
+		}
+	}
+
+	return parsed, true
+}
@@ -0,0 +1,92 @@
+package comments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+type stubClient struct {
+	payload string
+	request *graphql.Request
+}
+
+func (c *stubClient) MakeRequest(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+	c.request = req
+	if c.payload == "" {
+		return fmt.Errorf("unexpected request %s", req.OpName)
+	}
+	return json.Unmarshal([]byte(c.payload), resp.Data)
+}
+
+func TestSubmissionValidate(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, Submission{AuthorName: " Reader ", Body: "Hi"}.Validate())
+	require.Equal(t, map[string]string{
+		FieldAuthorName: ProblemRequired,
+		FieldBody:       ProblemRequired,
+	}, Submission{AuthorName: "  ", Body: "\n"}.Validate())
+	require.Equal(t, map[string]string{
+		FieldAuthorName:  ProblemTooLong,
+		FieldAuthorEmail: ProblemInvalid,
+		FieldBody:        ProblemTooLong,
+	}, Submission{
+		AuthorName:  strings.Repeat("n", MaxAuthorNameLength+1),
+		AuthorEmail: "Reader <reader@example.com>",
+		Body:        strings.Repeat("я", MaxBodyLength+1),
+	}.Validate())
+}
+
+func TestListApprovedSkipsEmptyBodies(t *testing.T) {
+	t.Parallel()
+
+	client := &stubClient{payload: `{"Comments":{"docs":[
+		{"id":"c1","authorName":" Reader ","body":" Hi ","createdAt":"2024-01-03T10:00:00.000Z"},
+		{"id":"c2","authorName":"Ghost","body":"  "}
+	]}}`}
+	items, err := NewService(client, 0).ListApproved(context.Background(), "note-1")
+	require.NoError(t, err)
+	require.Equal(t, []Comment{{
+		ID:           "c1",
+		AuthorName:   "Reader",
+		Body:         "Hi",
+		CreatedAt:    "2024-01-03",
+		CreatedAtISO: "2024-01-03T10:00:00Z",
+	}}, items)
+
+	empty, err := NewService(&stubClient{}, 0).ListApproved(context.Background(), " ")
+	require.NoError(t, err)
+	require.Empty(t, empty)
+}
+
+func TestSubmitReturnsModerationStatus(t *testing.T) {
+	t.Parallel()
+
+	client := &stubClient{payload: `{"createComment":{"id":"c3","status":"approved"}}`}
+	status, err := NewService(client, 0).Submit(context.Background(), Submission{
+		NoteID:     "note-1",
+		AuthorName: "Reader",
+		Body:       "Hi\r\nthere",
+	})
+	require.NoError(t, err)
+	require.Equal(t, StatusApproved, status)
+	require.Equal(t, "CreateComment", client.request.OpName)
+
+	pending := &stubClient{payload: `{"createComment":{"id":"c4","status":null}}`}
+	status, err = NewService(pending, 0).Submit(context.Background(), Submission{
+		NoteID:     "note-1",
+		AuthorName: "Reader",
+		Body:       "Hi",
+	})
+	require.NoError(t, err)
+	require.Equal(t, StatusPending, status)
+
+	_, err = NewService(&stubClient{}, 0).Submit(context.Background(), Submission{NoteID: "note-1"})
+	require.Error(t, err)
+}
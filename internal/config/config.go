@@ -2,8 +2,10 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
@@ -13,16 +15,76 @@ type Config struct {
 
 	LovelyEyeScriptURL string
 	LovelyEyeSiteID    string
+	MermaidScriptURL   string
+	CodeLightStyle     string
+	CodeDarkStyle      string
+	CodeLineNumbers    bool
+	SanitizeRawHTML    bool
+	SmartTypography    bool
+
+	AnalyticsEventsURL string
+
+	EnableViewCounts        bool
+	ViewCountsFlushInterval time.Duration
+
+	AdminToken string
+
+	PayloadWebhookSecret string
+	CDNPurgeURL          string
+	CDNPurgeToken        string
+
+	PreviewSecret    string
+	PreviewTTL       time.Duration
+	PreviewScheduled bool
+
+	NavigationFile string
 
 	EnableImageLoader   bool
 	EnableResolverDebug bool
+	EmbedStatic         bool
+	EnableComments      bool
+
+	ContentSource string
+	ContentDir    string
 
 	GraphQLEndpoint  string
 	GraphQLAuthToken string
 
+	GraphQLMaxAttempts      int
+	GraphQLBreakerThreshold int
+	GraphQLBreakerCooldown  time.Duration
+
 	PageSize int
+
+	LiveMaxConnections int
+	LiveIdleTimeout    time.Duration
+
+	ReadinessTimeout time.Duration
+	RequestTimeout   time.Duration
+
+	Maintenance           bool
+	MaintenanceFile       string
+	MaintenanceRetryAfter time.Duration
+
+	NoIndex        bool
+	SitePassword   string
+	RobotsAllow    []string
+	RobotsDisallow []string
+
+	EnableImageProxy      bool
+	ImageProxySourceURL   string
+	ImageProxyCacheDir    string
+	ImageProxyCacheMaxMB  int
+	ImageProxyQueryParams []string
+	ImageProxyMaxRenders  int
 }
 
+const (
+	ContentSourceGraphQL  = "graphql"
+	ContentSourceFiles    = "files"
+	ContentSourceFixtures = "fixtures"
+)
+
 func Load() Config {
 	return Config{
 		ListenAddr: getEnv("BLOG_LISTEN_ADDR", ":8080"),
@@ -30,12 +92,62 @@ func Load() Config {
 
 		LovelyEyeScriptURL: strings.TrimSpace(os.Getenv("LOVELY_EYE_SCRIPT_URL")),
 		LovelyEyeSiteID:    strings.TrimSpace(os.Getenv("LOVELY_EYE_SITE_ID")),
+		MermaidScriptURL:   strings.TrimSpace(os.Getenv("BLOG_MERMAID_SCRIPT_URL")),
+		CodeLightStyle:     getEnv("BLOG_CODE_LIGHT_STYLE", "github"),
+		CodeDarkStyle:      getEnv("BLOG_CODE_DARK_STYLE", "monokai"),
+		CodeLineNumbers:    getEnvBool("BLOG_CODE_LINE_NUMBERS", false),
+		SanitizeRawHTML:    getEnvBool("BLOG_SANITIZE_RAW_HTML", false),
+		SmartTypography:    getEnvBool("BLOG_SMART_TYPOGRAPHY", true),
+		AnalyticsEventsURL: strings.TrimSpace(os.Getenv("BLOG_ANALYTICS_EVENTS_URL")),
+		AdminToken:         strings.TrimSpace(os.Getenv("BLOG_ADMIN_TOKEN")),
+		NavigationFile:     strings.TrimSpace(os.Getenv("BLOG_NAVIGATION_FILE")),
+
+		EnableViewCounts:        getEnvBool("BLOG_ENABLE_VIEW_COUNTS", false),
+		ViewCountsFlushInterval: getEnvDuration("BLOG_VIEW_COUNTS_FLUSH_INTERVAL", 30*time.Second),
+
+		PayloadWebhookSecret: strings.TrimSpace(os.Getenv("BLOG_PAYLOAD_WEBHOOK_SECRET")),
+		CDNPurgeURL:          strings.TrimSpace(os.Getenv("BLOG_CDN_PURGE_URL")),
+		CDNPurgeToken:        strings.TrimSpace(os.Getenv("BLOG_CDN_PURGE_TOKEN")),
+
+		PreviewSecret:    strings.TrimSpace(os.Getenv("BLOG_PREVIEW_SECRET")),
+		PreviewTTL:       getEnvDuration("BLOG_PREVIEW_TTL", 24*time.Hour),
+		PreviewScheduled: getEnvBool("BLOG_PREVIEW_SCHEDULED", false),
 
 		EnableImageLoader:   getEnvBool("BLOG_ENABLE_IMAGE_LOADER", false),
 		EnableResolverDebug: getEnvBool("BLOG_ENABLE_RESOLVER_DEBUG", false),
+		EmbedStatic:         getEnvBool("BLOG_EMBED_STATIC", false),
+		EnableComments:      getEnvBool("BLOG_ENABLE_COMMENTS", false),
+		ContentSource:       strings.ToLower(getEnv("BLOG_CONTENT_SOURCE", ContentSourceGraphQL)),
+		ContentDir:          strings.TrimSpace(os.Getenv("BLOG_CONTENT_DIR")),
 		GraphQLEndpoint:     getEnv("BLOG_GRAPHQL_ENDPOINT", "http://localhost:3000/api/graphql"),
 		GraphQLAuthToken:    os.Getenv("BLOG_GRAPHQL_AUTH_TOKEN"),
 		PageSize:            getEnvInt("BLOG_NOTES_PAGE_SIZE", 12),
+
+		GraphQLMaxAttempts:      getEnvInt("BLOG_GRAPHQL_MAX_ATTEMPTS", 3),
+		GraphQLBreakerThreshold: getEnvInt("BLOG_GRAPHQL_BREAKER_THRESHOLD", 5),
+		GraphQLBreakerCooldown:  getEnvDuration("BLOG_GRAPHQL_BREAKER_COOLDOWN", 30*time.Second),
+
+		LiveMaxConnections: getEnvInt("BLOG_LIVE_MAX_CONNECTIONS", 256),
+		LiveIdleTimeout:    getEnvDuration("BLOG_LIVE_IDLE_TIMEOUT", 30*time.Second),
+
+		ReadinessTimeout: getEnvDuration("BLOG_READINESS_TIMEOUT", 3*time.Second),
+		RequestTimeout:   getEnvDuration("BLOG_REQUEST_TIMEOUT", 10*time.Second),
+
+		Maintenance:           getEnvBool("BLOG_MAINTENANCE", false),
+		MaintenanceFile:       strings.TrimSpace(os.Getenv("BLOG_MAINTENANCE_FILE")),
+		MaintenanceRetryAfter: getEnvDuration("BLOG_MAINTENANCE_RETRY_AFTER", 5*time.Minute),
+
+		NoIndex:        getEnvBool("BLOG_NOINDEX", false),
+		SitePassword:   strings.TrimSpace(os.Getenv("BLOG_SITE_PASSWORD")),
+		RobotsAllow:    getEnvList("BLOG_ROBOTS_ALLOW", []string{"/"}),
+		RobotsDisallow: getEnvList("BLOG_ROBOTS_DISALLOW", nil),
+
+		EnableImageProxy:      getEnvBool("BLOG_ENABLE_IMAGE_PROXY", false),
+		ImageProxySourceURL:   strings.TrimSpace(os.Getenv("BLOG_IMAGE_PROXY_SOURCE_URL")),
+		ImageProxyCacheDir:    getEnv("BLOG_IMAGE_PROXY_CACHE_DIR", filepath.Join(os.TempDir(), "blog-images")),
+		ImageProxyCacheMaxMB:  getEnvInt("BLOG_IMAGE_PROXY_CACHE_MAX_MB", 512),
+		ImageProxyQueryParams: getEnvList("BLOG_IMAGE_PROXY_SOURCE_QUERY_PARAMS", nil),
+		ImageProxyMaxRenders:  getEnvInt("BLOG_IMAGE_PROXY_MAX_RENDERS", 0),
 	}
 }
 
@@ -62,6 +174,36 @@ func getEnvInt(key string, fallback int) int {
 	return parsed
 }
 
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return fallback
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed <= 0 {
+		return fallback
+	}
+
+	return parsed
+}
+
+func getEnvList(key string, fallback []string) []string {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return fallback
+	}
+
+	out := make([]string, 0, strings.Count(value, ",")+1)
+	for item := range strings.SplitSeq(value, ",") {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			out = append(out, trimmed)
+		}
+	}
+
+	return out
+}
+
 func getEnvBool(key string, fallback bool) bool {
 	value := strings.TrimSpace(os.Getenv(key))
 	if value == "" {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -13,14 +14,34 @@ import (
 	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
 )
 
-func BuildRobots(rootURL string) frameworkdiscovery.Robots {
-	document := frameworkdiscovery.Robots{
-		Rules: []frameworkdiscovery.RobotsRule{
-			{
-				UserAgent: "*",
-				Allow:     []string{"/"},
+type RobotsConfig struct {
+	Allow    []string
+	Disallow []string
+	NoIndex  bool
+}
+
+func BuildRobots(rootURL string, cfg RobotsConfig) frameworkdiscovery.Robots {
+	if cfg.NoIndex {
+		return frameworkdiscovery.Robots{
+			Rules: []frameworkdiscovery.RobotsRule{
+				{
+					UserAgent: "*",
+					Disallow:  []string{"/"},
+				},
 			},
-		},
+		}
+	}
+
+	rule := frameworkdiscovery.RobotsRule{
+		UserAgent: "*",
+		Allow:     robotsPaths(cfg.Allow),
+		Disallow:  robotsPaths(cfg.Disallow),
+	}
+	if len(rule.Allow) == 0 && len(rule.Disallow) == 0 {
+		rule.Allow = []string{"/"}
+	}
+	document := frameworkdiscovery.Robots{
+		Rules: []frameworkdiscovery.RobotsRule{rule},
 	}
 
 	trimmedRoot := strings.TrimSpace(rootURL)
@@ -31,6 +52,25 @@ func BuildRobots(rootURL string) frameworkdiscovery.Robots {
 	return document
 }
 
+func robotsPaths(paths []string) []string {
+	out := make([]string, 0, len(paths))
+	for _, pathValue := range paths {
+		pathValue = strings.TrimSpace(pathValue)
+		if pathValue == "" {
+			continue
+		}
+		if !strings.HasPrefix(pathValue, "/") && !strings.HasPrefix(pathValue, "*") {
+			pathValue = "/" + pathValue
+		}
+		out = append(out, pathValue)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+
+	return out
+}
+
 func BuildFeedDocument(
 	rootURL string,
 	i18nConfig frameworki18n.Config,
@@ -38,7 +78,7 @@ func BuildFeedDocument(
 	noteItems []notes.NoteSummary,
 ) frameworkdiscovery.FeedDocument {
 	homeURL := joinRootAndPath(rootURL, frameworki18n.LocalizePath(i18nConfig, locale, routePathRoot))
-	feedURL := joinRootAndPath(rootURL, rssEndpointPath) + "?" + queryParamLocale + "=" + url.QueryEscape(locale)
+	feedURL := feedSelfURL(rootURL, rssEndpointPath, locale)
 
 	items := make([]frameworkdiscovery.FeedItem, 0, len(noteItems))
 	for _, note := range noteItems {
@@ -107,6 +147,66 @@ func BuildFeedDocument(
 	}
 }
 
+// BuildAuthorFeedDocument is BuildFeedDocument for the notes of one author,
+// served from the author's page path.
+func BuildAuthorFeedDocument(
+	rootURL string,
+	i18nConfig frameworki18n.Config,
+	locale string,
+	author notes.Author,
+	noteItems []notes.NoteSummary,
+) frameworkdiscovery.FeedDocument {
+	name := firstNonEmpty(author.Name, author.Slug)
+	pagePath := routePathAuthor + url.PathEscape(notes.NormalizeSlug(author.Slug))
+
+	document := BuildFeedDocument(rootURL, i18nConfig, locale, noteItems)
+	document.Title = "RevoTale Notes by " + name
+	document.Description = "Latest notes and micro posts by " + name + " on RevoTale"
+	document.Link = joinRootAndPath(rootURL, frameworki18n.LocalizePath(i18nConfig, locale, pagePath))
+	document.SelfURL = feedSelfURL(rootURL, AuthorFeedPath(author.Slug), locale)
+	return document
+}
+
+// BuildTagFeedDocument is BuildFeedDocument for the notes of one tag, served
+// from the tag's page path.
+func BuildTagFeedDocument(
+	rootURL string,
+	i18nConfig frameworki18n.Config,
+	locale string,
+	tag notes.Tag,
+	noteItems []notes.NoteSummary,
+) frameworkdiscovery.FeedDocument {
+	title := "#" + firstNonEmpty(tag.Title, tag.Name)
+	pagePath := routePathTag + url.PathEscape(notes.NormalizeSlug(tag.Name))
+
+	document := BuildFeedDocument(rootURL, i18nConfig, locale, noteItems)
+	document.Title = "RevoTale Notes in " + title
+	document.Description = "Latest notes and micro posts tagged " + title + " on RevoTale"
+	document.Link = joinRootAndPath(rootURL, frameworki18n.LocalizePath(i18nConfig, locale, pagePath))
+	document.SelfURL = feedSelfURL(rootURL, TagFeedPath(tag.Name), locale)
+	return document
+}
+
+// AuthorFeedPath is the feed of one author, next to the author page.
+func AuthorFeedPath(slug string) string {
+	return routePathAuthor + url.PathEscape(notes.NormalizeSlug(slug)) + rssEndpointPath
+}
+
+// TagFeedPath is the feed of one tag, next to the tag page.
+func TagFeedPath(name string) string {
+	return routePathTag + url.PathEscape(notes.NormalizeSlug(name)) + rssEndpointPath
+}
+
+// FeedPathSlug returns the author slug or tag name of a per-channel feed path
+// such as /author/<slug>/feed.xml.
+func FeedPathSlug(requestPath string) string {
+	return notes.NormalizeSlug(path.Base(path.Dir(path.Clean("/" + requestPath))))
+}
+
+func feedSelfURL(rootURL string, feedPath string, locale string) string {
+	return joinRootAndPath(rootURL, feedPath) + "?" + queryParamLocale + "=" + url.QueryEscape(locale)
+}
+
 func FeedListFilterFromQuery(query url.Values) notes.ListFilter {
 	return rssListFilterFromQuery(query)
 }
@@ -116,7 +216,14 @@ func BuildRootSitemapEntries(
 	i18nConfig frameworki18n.Config,
 ) ([]frameworkdiscovery.SitemapEntry, error) {
 	now := time.Now().UTC()
-	paths := []string{routePathRoot, routePathChannels, routePathTales, routePathMicroTales}
+	paths := []string{
+		routePathRoot,
+		routePathChannels,
+		routePathTales,
+		routePathMicroTales,
+		routePathArchive,
+		routePathTags,
+	}
 	entries := make([]frameworkdiscovery.SitemapEntry, 0, len(paths))
 	for _, pathValue := range paths {
 		entry, err := sitemapEntryForPath(rootURL, i18nConfig, pathValue)
@@ -277,23 +384,33 @@ func buildAuthorSitemapEntries(
 	authors := sliceAuthorsPage(baseResult.Authors, chunkID, pageSize)
 	now := time.Now().UTC()
 	priority := 1.0
-	entries := make([]frameworkdiscovery.SitemapEntry, 0, len(authors))
+	typePriority := 0.8
+	entries := make([]frameworkdiscovery.SitemapEntry, 0, len(authors)*3)
 	for _, author := range authors {
-		authorSlug := strings.TrimSpace(author.Slug)
+		authorSlug := notes.NormalizeSlug(author.Slug)
 		if authorSlug == "" {
 			continue
 		}
 
-		pathValue := routePathAuthor + url.PathEscape(authorSlug)
-		entry, buildErr := sitemapEntryForPath(rootURL, i18nConfig, pathValue)
-		if buildErr != nil {
-			continue
+		authorPath := routePathAuthor + url.PathEscape(authorSlug)
+		for _, candidate := range []struct {
+			path     string
+			priority *float64
+		}{
+			{path: authorPath, priority: &priority},
+			{path: authorPath + routePathTales, priority: &typePriority},
+			{path: authorPath + routePathMicroTales, priority: &typePriority},
+		} {
+			entry, buildErr := sitemapEntryForPath(rootURL, i18nConfig, candidate.path)
+			if buildErr != nil {
+				continue
+			}
+			out := toDiscoverySitemapEntry(entry)
+			out.ChangeFrequency = "weekly"
+			out.Priority = candidate.priority
+			out.LastModified = &now
+			entries = append(entries, out)
 		}
-		out := toDiscoverySitemapEntry(entry)
-		out.ChangeFrequency = "weekly"
-		out.Priority = &priority
-		out.LastModified = &now
-		entries = append(entries, out)
 	}
 
 	return entries, nil
@@ -328,7 +445,7 @@ func buildTagSitemapEntries(
 	now := time.Now().UTC()
 	entries := make([]frameworkdiscovery.SitemapEntry, 0, len(tags))
 	for _, tag := range tags {
-		tagName := strings.TrimSpace(tag.Name)
+		tagName := notes.NormalizeSlug(tag.Name)
 		if tagName == "" {
 			continue
 		}
@@ -13,27 +13,53 @@ import (
 func TestBuildRobotsIncludesSitemap(t *testing.T) {
 	t.Parallel()
 
-	document := BuildRobots("https://revotale.com/blog/notes")
+	document := BuildRobots("https://revotale.com/blog/notes", RobotsConfig{})
 	require.Len(t, document.Rules, 1)
 	require.Equal(t, "*", document.Rules[0].UserAgent)
 	require.Equal(t, []string{"/"}, document.Rules[0].Allow)
 	require.Equal(t, []string{"https://revotale.com/blog/notes/sitemap-index.xml"}, document.Sitemaps)
 }
 
+func TestBuildRobotsUsesConfiguredRules(t *testing.T) {
+	t.Parallel()
+
+	document := BuildRobots("https://revotale.com/blog/notes", RobotsConfig{
+		Allow:    []string{"/", " "},
+		Disallow: []string{".admin/", "/*?q="},
+	})
+	require.Len(t, document.Rules, 1)
+	require.Equal(t, []string{"/"}, document.Rules[0].Allow)
+	require.Equal(t, []string{"/.admin/", "/*?q="}, document.Rules[0].Disallow)
+	require.Len(t, document.Sitemaps, 1)
+}
+
+func TestBuildRobotsNoIndexDisallowsEverything(t *testing.T) {
+	t.Parallel()
+
+	document := BuildRobots("https://revotale.com/blog/notes", RobotsConfig{
+		Allow:   []string{"/"},
+		NoIndex: true,
+	})
+	require.Len(t, document.Rules, 1)
+	require.Empty(t, document.Rules[0].Allow)
+	require.Equal(t, []string{"/"}, document.Rules[0].Disallow)
+	require.Empty(t, document.Sitemaps)
+}
+
 func TestFeedListFilterFromQuery(t *testing.T) {
 	t.Parallel()
 
 	filter := FeedListFilterFromQuery(url.Values{
 		"page":   []string{"2"},
-		"author": []string{"l-you"},
+		"author": []string{"l-you,zed"},
 		"tag":    []string{"go"},
 		"type":   []string{"short"},
 		"q":      []string{"build"},
 	})
 
 	require.Equal(t, 2, filter.Page)
-	require.Equal(t, "l-you", filter.AuthorSlug)
-	require.Equal(t, "go", filter.TagName)
+	require.Equal(t, []string{"l-you", "zed"}, filter.AuthorSlugs)
+	require.Equal(t, []string{"go"}, filter.TagNames)
 	require.Equal(t, notes.NoteTypeShort, filter.Type)
 	require.Equal(t, "build", filter.Query)
 }
@@ -151,10 +177,14 @@ func TestBuildSitemapIDsAndEntriesByID(t *testing.T) {
 		1,
 	)
 	require.NoError(t, err)
-	require.Len(t, authorEntries, 1)
+	require.Len(t, authorEntries, 3)
 	require.Equal(t, "https://revotale.com/blog/notes/author/l-you", authorEntries[0].URL)
 	require.NotNil(t, authorEntries[0].Priority)
 	require.Equal(t, 1.0, *authorEntries[0].Priority)
+	require.Equal(t, "https://revotale.com/blog/notes/author/l-you/tales", authorEntries[1].URL)
+	require.Equal(t, "https://revotale.com/blog/notes/author/l-you/micro-tales", authorEntries[2].URL)
+	require.NotNil(t, authorEntries[1].Priority)
+	require.Equal(t, 0.8, *authorEntries[1].Priority)
 
 }
 
@@ -0,0 +1,165 @@
+package discovery
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"blog/internal/notes"
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
+	frameworksite "github.com/RevoTale/no-js/framework/site"
+)
+
+// ChannelsOPMLPath serves every author and tag feed as one OPML outline that
+// RSS readers can import in a single step.
+const ChannelsOPMLPath = "/channels.opml"
+
+const opmlContentType = "text/x-opml; charset=utf-8"
+const opmlCacheControl = "public, max-age=3600, s-maxage=3600"
+
+type OPMLDocument struct {
+	XMLName xml.Name      `xml:"opml"`
+	Version string        `xml:"version,attr"`
+	Title   string        `xml:"head>title"`
+	Body    []OPMLOutline `xml:"body>outline"`
+}
+
+type OPMLOutline struct {
+	Text     string        `xml:"text,attr"`
+	Title    string        `xml:"title,attr,omitempty"`
+	Type     string        `xml:"type,attr,omitempty"`
+	XMLURL   string        `xml:"xmlUrl,attr,omitempty"`
+	HTMLURL  string        `xml:"htmlUrl,attr,omitempty"`
+	Outlines []OPMLOutline `xml:"outline"`
+}
+
+// BuildChannelsOPML groups the feed of every author and tag under an
+// "Authors" and a "Tags" folder.
+func BuildChannelsOPML(
+	rootURL string,
+	i18nConfig frameworki18n.Config,
+	locale string,
+	authors []notes.Author,
+	tags []notes.Tag,
+) OPMLDocument {
+	authorOutlines := make([]OPMLOutline, 0, len(authors))
+	for _, author := range authors {
+		authorSlug := notes.NormalizeSlug(author.Slug)
+		if authorSlug == "" {
+			continue
+		}
+		pagePath := frameworki18n.LocalizePath(i18nConfig, locale, routePathAuthor+url.PathEscape(authorSlug))
+		authorOutlines = append(authorOutlines, feedOutline(
+			firstNonEmpty(author.Name, authorSlug),
+			feedSelfURL(rootURL, AuthorFeedPath(authorSlug), locale),
+			joinRootAndPath(rootURL, pagePath),
+		))
+	}
+
+	tagOutlines := make([]OPMLOutline, 0, len(tags))
+	for _, tag := range tags {
+		tagName := notes.NormalizeSlug(tag.Name)
+		if tagName == "" {
+			continue
+		}
+		pagePath := frameworki18n.LocalizePath(i18nConfig, locale, routePathTag+url.PathEscape(tagName))
+		tagOutlines = append(tagOutlines, feedOutline(
+			"#"+firstNonEmpty(tag.Title, tag.Name),
+			feedSelfURL(rootURL, TagFeedPath(tagName), locale),
+			joinRootAndPath(rootURL, pagePath),
+		))
+	}
+
+	return OPMLDocument{
+		Version: "2.0",
+		Title:   "RevoTale Channels",
+		Body: []OPMLOutline{
+			{Text: "Authors", Outlines: authorOutlines},
+			{Text: "Tags", Outlines: tagOutlines},
+		},
+	}
+}
+
+func feedOutline(title string, feedURL string, pageURL string) OPMLOutline {
+	return OPMLOutline{Text: title, Title: title, Type: "rss", XMLURL: feedURL, HTMLURL: pageURL}
+}
+
+// OPMLHandler serves BuildChannelsOPML for the channels listed on the first
+// notes page, the same set the sidebar and sitemaps use.
+type OPMLHandler struct {
+	service    notesLister
+	site       frameworksite.Resolver
+	i18nConfig frameworki18n.Config
+	logError   func(error)
+}
+
+func NewOPMLHandler(
+	service notesLister,
+	site frameworksite.Resolver,
+	i18nConfig frameworki18n.Config,
+) *OPMLHandler {
+	return &OPMLHandler{service: service, site: site, i18nConfig: i18nConfig}
+}
+
+// WithErrorLog reports why listing the channels failed.
+func (h *OPMLHandler) WithErrorLog(logError func(error)) *OPMLHandler {
+	h.logError = logError
+	return h
+}
+
+func (h *OPMLHandler) Register(mux *http.ServeMux) {
+	mux.Handle(ChannelsOPMLPath, h)
+}
+
+func (h *OPMLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	locale := h.locale(r.URL.Query().Get(queryParamLocale))
+	result, err := h.service.ListNotes(r.Context(), locale, notes.ListFilter{Page: 1}, notes.ListOptions{})
+	if err != nil {
+		if h.logError != nil {
+			h.logError(fmt.Errorf("list opml channels: %w", err))
+		}
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	rootURL := ""
+	if h.site != nil {
+		rootURL = h.site.Resolve(r)
+	}
+	body, err := xml.MarshalIndent(
+		BuildChannelsOPML(rootURL, h.i18nConfig, locale, result.Authors, result.Tags),
+		"",
+		"  ",
+	)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", opmlContentType)
+	w.Header().Set("Cache-Control", opmlCacheControl)
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(body)
+}
+
+func (h *OPMLHandler) locale(requested string) string {
+	requested = strings.ToLower(strings.TrimSpace(requested))
+	if slices.Contains(h.i18nConfig.Locales, requested) {
+		return requested
+	}
+
+	return h.i18nConfig.DefaultLocale
+}
@@ -0,0 +1,96 @@
+package discovery
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"blog/internal/notes"
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSiteResolver struct{}
+
+func (stubSiteResolver) CanonicalURL() string {
+	return "https://revotale.com/blog/notes"
+}
+
+func (stubSiteResolver) Resolve(*http.Request) string {
+	return "https://revotale.com/blog/notes"
+}
+
+func TestOPMLHandlerListsAuthorAndTagFeeds(t *testing.T) {
+	t.Parallel()
+
+	var requestedLocale string
+	service := stubNotesLister{
+		listFn: func(
+			_ context.Context,
+			locale string,
+			_ notes.ListFilter,
+			_ notes.ListOptions,
+		) (notes.NotesListResult, error) {
+			requestedLocale = locale
+			return notes.NotesListResult{
+				Authors: []notes.Author{{Name: "L You", Slug: "l-you"}, {Name: "Nameless"}},
+				Tags:    []notes.Tag{{Name: "machine-learning", Title: "Machine learning"}},
+			}, nil
+		},
+	}
+	handler := NewOPMLHandler(service, stubSiteResolver{}, frameworki18n.Config{
+		Locales:       []string{"en", "uk"},
+		DefaultLocale: "en",
+		PrefixMode:    frameworki18n.PrefixAsNeeded,
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ChannelsOPMLPath+"?locale=uk", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "uk", requestedLocale)
+	require.Equal(t, "text/x-opml; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.True(t, strings.HasPrefix(rec.Body.String(), xml.Header))
+
+	var document OPMLDocument
+	require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &document))
+	require.Len(t, document.Body, 2)
+	require.Equal(t, []OPMLOutline{{
+		Text:    "L You",
+		Title:   "L You",
+		Type:    "rss",
+		XMLURL:  "https://revotale.com/blog/notes/author/l-you/feed.xml?locale=uk",
+		HTMLURL: "https://revotale.com/blog/notes/uk/author/l-you",
+	}}, document.Body[0].Outlines)
+	require.Equal(t, "#Machine learning", document.Body[1].Outlines[0].Text)
+	require.Equal(t, "https://revotale.com/blog/notes/tag/machine-learning/feed.xml?locale=uk",
+		document.Body[1].Outlines[0].XMLURL)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, ChannelsOPMLPath+"?locale=xx", nil))
+	require.Equal(t, "en", requestedLocale)
+}
+
+func TestOPMLHandlerReportsListingFailures(t *testing.T) {
+	t.Parallel()
+
+	var logged error
+	handler := NewOPMLHandler(stubNotesLister{
+		listFn: func(context.Context, string, notes.ListFilter, notes.ListOptions) (notes.NotesListResult, error) {
+			return notes.NotesListResult{}, errors.New("cms down")
+		},
+	}, stubSiteResolver{}, frameworki18n.Config{DefaultLocale: "en"}).WithErrorLog(func(err error) {
+		logged = err
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ChannelsOPMLPath, nil))
+	require.Equal(t, http.StatusBadGateway, rec.Code)
+	require.ErrorContains(t, logged, "cms down")
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, ChannelsOPMLPath, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
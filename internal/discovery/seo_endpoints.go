@@ -20,6 +20,8 @@ const routePathRoot = "/"
 const routePathChannels = "/channels"
 const routePathTales = "/tales"
 const routePathMicroTales = "/micro-tales"
+const routePathArchive = "/archive"
+const routePathTags = "/tags"
 
 const routePathNote = "/note/"
 const routePathAuthor = "/author/"
@@ -45,12 +47,14 @@ type notesLister interface {
 }
 
 func rssListFilterFromQuery(query url.Values) notes.ListFilter {
+	tagNames, tagMode := notes.ParseTagFilter(query.Get(queryParamTag))
 	return notes.ListFilter{
-		Page:       parsePositiveInt(query.Get(queryParamPage), 1),
-		AuthorSlug: strings.TrimSpace(query.Get(queryParamAuthor)),
-		TagName:    strings.TrimSpace(query.Get(queryParamTag)),
-		Type:       notes.ParseNoteType(query.Get(queryParamType)),
-		Query:      strings.TrimSpace(query.Get(queryParamSearch)),
+		Page:        parsePositiveInt(query.Get(queryParamPage), 1),
+		AuthorSlugs: notes.ParseAuthorFilter(query.Get(queryParamAuthor)),
+		TagNames:    tagNames,
+		TagMode:     tagMode,
+		Type:        notes.ParseNoteType(query.Get(queryParamType)),
+		Query:       strings.TrimSpace(query.Get(queryParamSearch)),
 	}
 }
 
@@ -0,0 +1,197 @@
+package formaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"blog/internal/middleware"
+	"github.com/RevoTale/no-js/framework"
+)
+
+const defaultMaxFormBytes = 64 << 10
+
+type FieldErrors map[string]string
+
+func (errs FieldErrors) Error() string {
+	fields := make([]string, 0, len(errs))
+	for field := range errs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return "invalid form fields: " + strings.Join(fields, ", ")
+}
+
+type Submission[C interface{}, P interface{}, F interface{}] struct {
+	Runtime framework.RuntimeContext[C]
+	Request *http.Request
+	Params  P
+	Form    F
+	Errors  FieldErrors
+}
+
+type ActionModule[C interface{}, P interface{}, F interface{}] struct {
+	MaxFormBytes int64
+	Validate     func(ctx context.Context, appCtx C, form F) FieldErrors
+	Run          func(ctx context.Context, appCtx C, r *http.Request, params P, form F) (string, error)
+	Render       func(w http.ResponseWriter, submission Submission[C, P, F]) error
+}
+
+func (module ActionModule[C, P, F]) Handle(
+	runtime framework.RuntimeContext[C],
+	w http.ResponseWriter,
+	r *http.Request,
+	params P,
+) error {
+	if module.Run == nil {
+		return fmt.Errorf("form action run function is required")
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return nil
+	}
+	if err := middleware.CheckSameOrigin(r); err != nil {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return nil
+	}
+
+	maxBytes := module.MaxFormBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxFormBytes
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+	if err := r.ParseForm(); err != nil {
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, http.StatusText(status), status)
+		return nil
+	}
+
+	var form F
+	if err := Decode(r.PostForm, &form); err != nil {
+		var errs FieldErrors
+		if errors.As(err, &errs) {
+			return module.rerender(runtime, w, r, params, form, errs)
+		}
+		return err
+	}
+
+	appCtx := runtime.AppContext()
+	if module.Validate != nil {
+		if errs := module.Validate(r.Context(), appCtx, form); len(errs) > 0 {
+			return module.rerender(runtime, w, r, params, form, errs)
+		}
+	}
+
+	redirectTo, err := module.Run(r.Context(), appCtx, r, params, form)
+	if err != nil {
+		var errs FieldErrors
+		if errors.As(err, &errs) && len(errs) > 0 {
+			return module.rerender(runtime, w, r, params, form, errs)
+		}
+		return err
+	}
+
+	redirectTo = strings.TrimSpace(redirectTo)
+	if redirectTo == "" {
+		redirectTo = r.URL.Path
+	}
+	http.Redirect(w, r, redirectTo, http.StatusSeeOther)
+	return nil
+}
+
+func (module ActionModule[C, P, F]) rerender(
+	runtime framework.RuntimeContext[C],
+	w http.ResponseWriter,
+	r *http.Request,
+	params P,
+	form F,
+	errs FieldErrors,
+) error {
+	if module.Render == nil {
+		http.Error(w, errs.Error(), http.StatusUnprocessableEntity)
+		return nil
+	}
+
+	writer := &statusResponseWriter{ResponseWriter: w, status: http.StatusUnprocessableEntity}
+	return module.Render(writer, Submission[C, P, F]{
+		Runtime: runtime,
+		Request: r,
+		Params:  params,
+		Form:    form,
+		Errors:  errs,
+	})
+}
+
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if statusCode == http.StatusOK {
+		statusCode = w.status
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *statusResponseWriter) Write(content []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(content)
+}
+
+func Decode(values url.Values, target interface{}) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("form target must be a pointer to a struct, got %T", target)
+	}
+
+	structValue := value.Elem()
+	structType := structValue.Type()
+	for idx := range structType.NumField() {
+		field := structType.Field(idx)
+		name := strings.TrimSpace(field.Tag.Get("form"))
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+
+		raw := strings.TrimSpace(values.Get(name))
+		fieldValue := structValue.Field(idx)
+		switch fieldValue.Kind() {
+		case reflect.String:
+			fieldValue.SetString(raw)
+		case reflect.Bool:
+			fieldValue.SetBool(raw == "on" || raw == "true" || raw == "1")
+		case reflect.Int, reflect.Int64:
+			if raw == "" {
+				continue
+			}
+			parsed, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil {
+				return FieldErrors{name: "must be a number"}
+			}
+			fieldValue.SetInt(parsed)
+		default:
+			return fmt.Errorf("form field %s has unsupported type %s", field.Name, field.Type)
+		}
+	}
+
+	return nil
+}
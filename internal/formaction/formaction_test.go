@@ -0,0 +1,141 @@
+package formaction
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/RevoTale/no-js/framework"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRuntime struct {
+	framework.RuntimeContext[string]
+}
+
+func (stubRuntime) AppContext() string {
+	return "app"
+}
+
+type commentForm struct {
+	Name    string `form:"name"`
+	Body    string `form:"body"`
+	Notify  bool   `form:"notify"`
+	Rating  int    `form:"rating"`
+	Ignored string
+}
+
+func newCommentAction(ran *commentForm) ActionModule[string, framework.SlugParams, commentForm] {
+	return ActionModule[string, framework.SlugParams, commentForm]{
+		Validate: func(_ context.Context, _ string, form commentForm) FieldErrors {
+			if form.Body == "" {
+				return FieldErrors{"body": "required"}
+			}
+			return nil
+		},
+		Run: func(
+			_ context.Context,
+			appCtx string,
+			_ *http.Request,
+			params framework.SlugParams,
+			form commentForm,
+		) (string, error) {
+			*ran = form
+			return "/note/" + params.Slug + "?from=" + appCtx, nil
+		},
+		Render: func(w http.ResponseWriter, submission Submission[string, framework.SlugParams, commentForm]) error {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, err := w.Write([]byte("error:" + submission.Errors["body"] + ":" + submission.Form.Name))
+			return err
+		},
+	}
+}
+
+func postForm(values url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/note/hello/comments", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func TestActionModuleRedirectsAfterSuccessfulRun(t *testing.T) {
+	t.Parallel()
+
+	var ran commentForm
+	action := newCommentAction(&ran)
+	rec := httptest.NewRecorder()
+	req := postForm(url.Values{"name": {" Ann "}, "body": {"hi"}, "notify": {"on"}, "rating": {"4"}})
+
+	err := action.Handle(stubRuntime{}, rec, req, framework.SlugParams{Slug: "hello"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusSeeOther, rec.Code)
+	require.Equal(t, "/note/hello?from=app", rec.Header().Get("Location"))
+	require.Equal(t, commentForm{Name: "Ann", Body: "hi", Notify: true, Rating: 4}, ran)
+}
+
+func TestActionModuleRerendersValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	var ran commentForm
+	action := newCommentAction(&ran)
+	rec := httptest.NewRecorder()
+
+	err := action.Handle(stubRuntime{}, rec, postForm(url.Values{"name": {"Ann"}}), framework.SlugParams{Slug: "hello"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.Equal(t, "error:required:Ann", rec.Body.String())
+	require.Empty(t, ran.Name)
+
+	rec = httptest.NewRecorder()
+	err = action.Handle(
+		stubRuntime{},
+		rec,
+		postForm(url.Values{"body": {"hi"}, "rating": {"many"}}),
+		framework.SlugParams{Slug: "hello"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestActionModuleRejectsOversizedBodies(t *testing.T) {
+	t.Parallel()
+
+	var ran commentForm
+	action := newCommentAction(&ran)
+	action.MaxFormBytes = 16
+	rec := httptest.NewRecorder()
+
+	err := action.Handle(
+		stubRuntime{},
+		rec,
+		postForm(url.Values{"body": {strings.Repeat("x", 64)}}),
+		framework.SlugParams{Slug: "hello"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestActionModuleRejectsCrossSitePosts(t *testing.T) {
+	t.Parallel()
+
+	var ran commentForm
+	req := postForm(url.Values{"body": {"hi"}})
+	req.Header.Set("Sec-Fetch-Site", "cross-site")
+	rec := httptest.NewRecorder()
+
+	err := newCommentAction(&ran).Handle(stubRuntime{}, rec, req, framework.SlugParams{Slug: "hello"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Empty(t, ran.Body)
+}
+
+func TestDecodeRequiresStructPointer(t *testing.T) {
+	t.Parallel()
+
+	var form commentForm
+	require.Error(t, Decode(url.Values{}, form))
+	require.NoError(t, Decode(url.Values{"name": {"x"}}, &form))
+	require.Equal(t, "x", form.Name)
+}
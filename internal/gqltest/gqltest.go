@@ -0,0 +1,304 @@
+// Package gqltest fakes the CMS GraphQL API for tests. Responses come from
+// declarative fixtures, one per operation, usually loaded from a directory of
+// JSON files named after the operation:
+//
+//	{
+//	  "data": {"Authors": {"docs": [{"id": "author-1", "slug": "l-you"}]}},
+//	  "cases": [
+//	    {"when": {"slug": "missing"}, "data": {"Authors": {"docs": []}}},
+//	    {"when": {"slug": "removed"}, "errors": [{"message": "Not Found"}]},
+//	    {"when": {"slug": "cms-down"}, "transportError": "connection refused"}
+//	  ]
+//	}
+//
+// The first case whose variables all match wins; otherwise the top-level
+// response is used. Handlers cover the few operations that need code, and the
+// client records every call so tests can assert on the variables sent.
+package gqltest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Response is one canned answer. Errors are returned as GraphQL errors, the
+// way the CMS reports them; TransportError fails the request before any
+// GraphQL response exists.
+type Response struct {
+	Data           json.RawMessage `json:"data,omitempty"`
+	Errors         gqlerror.List   `json:"errors,omitempty"`
+	TransportError string          `json:"transportError,omitempty"`
+}
+
+// Case overrides the default response for requests whose variables match
+// every entry of When.
+type Case struct {
+	When map[string]json.RawMessage `json:"when"`
+	Response
+}
+
+// Fixture holds the responses for one operation.
+type Fixture struct {
+	Response
+	Cases []Case `json:"cases,omitempty"`
+}
+
+// Data returns a fixture that always answers with payload.
+func Data(payload string) Fixture {
+	return Fixture{Response: Response{Data: json.RawMessage(payload)}}
+}
+
+// HandlerFunc answers an operation in code. It takes precedence over the
+// fixture of the same operation and can fall back to it with Client.Respond.
+type HandlerFunc func(ctx context.Context, req *graphql.Request, resp *graphql.Response) error
+
+// Call is one recorded request.
+type Call struct {
+	OpName    string
+	Variables map[string]json.RawMessage
+}
+
+// Client implements graphql.Client from fixtures and handlers. It is safe for
+// concurrent use.
+type Client struct {
+	mu       sync.Mutex
+	fixtures map[string]Fixture
+	handlers map[string]HandlerFunc
+	failures map[string]error
+	checks   []func(*graphql.Request) error
+	calls    []Call
+}
+
+// New returns a client without fixtures.
+func New() *Client {
+	return &Client{
+		fixtures: map[string]Fixture{},
+		handlers: map[string]HandlerFunc{},
+		failures: map[string]error{},
+	}
+}
+
+// Load reads every *.json file at the root of fsys as the fixture of the
+// operation named by the file.
+func Load(fsys fs.FS) (*Client, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read fixtures: %w", err)
+	}
+
+	client := New()
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		raw, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read fixture %s: %w", entry.Name(), err)
+		}
+		var fixture Fixture
+		if err := json.Unmarshal(raw, &fixture); err != nil {
+			return nil, fmt.Errorf("decode fixture %s: %w", entry.Name(), err)
+		}
+		client.SetFixture(strings.TrimSuffix(entry.Name(), ".json"), fixture)
+	}
+
+	return client, nil
+}
+
+// SetFixture replaces the fixture of opName.
+func (c *Client) SetFixture(opName string, fixture Fixture) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fixtures[opName] = fixture
+	return c
+}
+
+// Handle answers opName with handler instead of its fixture.
+func (c *Client) Handle(opName string, handler HandlerFunc) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.handlers[opName] = handler
+	return c
+}
+
+// Fail makes every call of opName return err until Fail is called again with
+// a nil error.
+func (c *Client) Fail(opName string, err error) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err == nil {
+		delete(c.failures, opName)
+	} else {
+		c.failures[opName] = err
+	}
+	return c
+}
+
+// Check runs check before every request; a non-nil error fails the request.
+// Use it for invariants such as required variables.
+func (c *Client) Check(check func(*graphql.Request) error) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checks = append(c.checks, check)
+	return c
+}
+
+// Calls returns the recorded requests in order.
+func (c *Client) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Call(nil), c.calls...)
+}
+
+// CallsTo returns the recorded requests of opName in order.
+func (c *Client) CallsTo(opName string) []Call {
+	var calls []Call
+	for _, call := range c.Calls() {
+		if call.OpName == opName {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (c *Client) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if req == nil {
+		return errors.New("gqltest: nil request")
+	}
+
+	c.mu.Lock()
+	c.calls = append(c.calls, Call{OpName: req.OpName, Variables: Variables(req)})
+	checks := c.checks
+	failure := c.failures[req.OpName]
+	handler := c.handlers[req.OpName]
+	c.mu.Unlock()
+
+	for _, check := range checks {
+		if err := check(req); err != nil {
+			return err
+		}
+	}
+	if failure != nil {
+		return failure
+	}
+	if handler != nil {
+		return handler(ctx, req, resp)
+	}
+
+	return c.Respond(req.OpName, req, resp)
+}
+
+// Respond answers req from the fixture registered under opName, which lets a
+// handler special-case a few requests, or reuse another operation's fixture.
+func (c *Client) Respond(opName string, req *graphql.Request, resp *graphql.Response) error {
+	c.mu.Lock()
+	fixture, ok := c.fixtures[opName]
+	c.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("gqltest: no fixture for operation %q", opName)
+	}
+
+	response := fixture.Response
+	variables := Variables(req)
+	for _, candidate := range fixture.Cases {
+		if matches(candidate.When, variables) {
+			response = candidate.Response
+			break
+		}
+	}
+
+	return response.apply(resp)
+}
+
+func (response Response) apply(resp *graphql.Response) error {
+	if response.TransportError != "" {
+		return errors.New(response.TransportError)
+	}
+	if len(response.Data) > 0 && resp != nil && resp.Data != nil {
+		if err := json.Unmarshal(response.Data, resp.Data); err != nil {
+			return fmt.Errorf("gqltest: decode fixture data: %w", err)
+		}
+	}
+	if len(response.Errors) > 0 {
+		if resp != nil {
+			resp.Errors = response.Errors
+		}
+		return response.Errors
+	}
+
+	return nil
+}
+
+func matches(when map[string]json.RawMessage, variables map[string]json.RawMessage) bool {
+	for key, want := range when {
+		got, ok := variables[key]
+		if !ok {
+			return false
+		}
+		var wantValue, gotValue any
+		if json.Unmarshal(want, &wantValue) != nil || json.Unmarshal(got, &gotValue) != nil {
+			return false
+		}
+		if !reflect.DeepEqual(wantValue, gotValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// Decode unmarshals a JSON payload into the response data, for handlers.
+func Decode(resp *graphql.Response, payload string) error {
+	return json.Unmarshal([]byte(payload), resp.Data)
+}
+
+// Variables returns the request variables as they would be sent on the wire.
+// Variables left out by omitempty are absent from the map.
+func Variables(req *graphql.Request) map[string]json.RawMessage {
+	values := map[string]json.RawMessage{}
+	if req == nil || req.Variables == nil {
+		return values
+	}
+
+	raw, err := json.Marshal(req.Variables)
+	if err != nil {
+		return values
+	}
+	_ = json.Unmarshal(raw, &values)
+	return values
+}
+
+// HasVar reports whether req sends the variable key.
+func HasVar(req *graphql.Request, key string) bool {
+	_, ok := Variables(req)[key]
+	return ok
+}
+
+// VarString returns the trimmed string variable key, or "" when it is absent
+// or not a string.
+func VarString(req *graphql.Request, key string) string {
+	entry, ok := Variables(req)[key]
+	if !ok {
+		return ""
+	}
+
+	var value string
+	if err := json.Unmarshal(entry, &value); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(value)
+}
@@ -0,0 +1,122 @@
+package gqltest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type authorData struct {
+	Authors struct {
+		Docs []struct {
+			Slug string `json:"slug"`
+		} `json:"docs"`
+	} `json:"Authors"`
+}
+
+func authorRequest(slug string) *graphql.Request {
+	return &graphql.Request{OpName: "AuthorBySlug", Variables: map[string]any{"slug": slug, "limit": 1}}
+}
+
+func requestAuthor(t *testing.T, client *Client, slug string) (authorData, error) {
+	t.Helper()
+
+	var data authorData
+	err := client.MakeRequest(context.Background(), authorRequest(slug), &graphql.Response{Data: &data})
+	return data, err
+}
+
+func TestLoadAnswersFromCasesAndDefaults(t *testing.T) {
+	t.Parallel()
+
+	client, err := Load(fstest.MapFS{
+		"AuthorBySlug.json": {Data: []byte(`{
+			"data": {"Authors": {"docs": [{"slug": "l-you"}]}},
+			"cases": [
+				{"when": {"slug": "missing"}, "data": {"Authors": {"docs": []}}},
+				{"when": {"slug": "restricted"}, "errors": [{"message": "Forbidden", "extensions": {"code": "FORBIDDEN"}}]},
+				{"when": {"slug": "cms-down", "limit": 1}, "transportError": "connection refused"}
+			]
+		}`)},
+		"README.md": {Data: []byte("not a fixture")},
+	})
+	require.NoError(t, err)
+
+	data, err := requestAuthor(t, client, "zed")
+	require.NoError(t, err)
+	require.Equal(t, "l-you", data.Authors.Docs[0].Slug)
+
+	data, err = requestAuthor(t, client, "missing")
+	require.NoError(t, err)
+	require.Empty(t, data.Authors.Docs)
+
+	_, err = requestAuthor(t, client, "restricted")
+	var graphQLErrors gqlerror.List
+	require.ErrorAs(t, err, &graphQLErrors)
+	require.Equal(t, "FORBIDDEN", graphQLErrors[0].Extensions["code"])
+
+	_, err = requestAuthor(t, client, "cms-down")
+	require.EqualError(t, err, "connection refused")
+
+	err = client.MakeRequest(context.Background(), &graphql.Request{OpName: "Unknown"}, &graphql.Response{})
+	require.ErrorContains(t, err, `no fixture for operation "Unknown"`)
+}
+
+func TestClientRecordsCallsAndInjectsFailures(t *testing.T) {
+	t.Parallel()
+
+	client := New().SetFixture("AuthorBySlug", Data(`{"Authors": {"docs": [{"slug": "l-you"}]}}`))
+	_, err := requestAuthor(t, client, "l-you")
+	require.NoError(t, err)
+
+	outage := errors.New("cms down")
+	client.Fail("AuthorBySlug", outage)
+	_, err = requestAuthor(t, client, "zed")
+	require.ErrorIs(t, err, outage)
+
+	client.Fail("AuthorBySlug", nil)
+	_, err = requestAuthor(t, client, "zed")
+	require.NoError(t, err)
+
+	calls := client.CallsTo("AuthorBySlug")
+	require.Len(t, calls, 3)
+	require.JSONEq(t, `"zed"`, string(calls[1].Variables["slug"]))
+	require.Empty(t, client.CallsTo("NoteBySlug"))
+}
+
+func TestHandlersAndChecksRunBeforeFixtures(t *testing.T) {
+	t.Parallel()
+
+	client := New().SetFixture("AuthorBySlug", Data(`{"Authors": {"docs": [{"slug": "l-you"}]}}`))
+	client.Handle("AuthorBySlug", func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		if VarString(req, "slug") == "guest" {
+			return Decode(resp, `{"Authors": {"docs": [{"slug": "guest"}]}}`)
+		}
+		return client.Respond("AuthorBySlug", req, resp)
+	})
+	client.Check(func(req *graphql.Request) error {
+		if !HasVar(req, "limit") {
+			return errors.New("limit is required")
+		}
+		return nil
+	})
+
+	data, err := requestAuthor(t, client, "guest")
+	require.NoError(t, err)
+	require.Equal(t, "guest", data.Authors.Docs[0].Slug)
+
+	data, err = requestAuthor(t, client, "zed")
+	require.NoError(t, err)
+	require.Equal(t, "l-you", data.Authors.Docs[0].Slug)
+
+	err = client.MakeRequest(context.Background(), &graphql.Request{
+		OpName:    "AuthorBySlug",
+		Variables: map[string]any{"slug": "zed"},
+	}, &graphql.Response{Data: &authorData{}})
+	require.EqualError(t, err, "limit is required")
+}
@@ -17,8 +17,14 @@ var cdnS3PathPattern = regexp.MustCompile(`((?:^|/)cdn/image/s3/)(\d+)(/)`)
 // These widths must stay aligned with the imgproxy routes in compose.base.yml.
 var deviceSizes = []int{32, 64, 128, 256, 450, 530, 640, 828, 1080, 1200, 1920}
 
+// DimensionsFunc reports the intrinsic size of a source image when it is
+// already known, without fetching it.
+type DimensionsFunc func(src string) (width int, height int, ok bool)
+
 type Loader struct {
-	enabled bool
+	enabled    bool
+	pathPrefix string
+	dimensions DimensionsFunc
 }
 
 func New(enabled bool) Loader {
@@ -27,6 +33,35 @@ func New(enabled bool) Loader {
 	}
 }
 
+// WithPathPrefix points generated URLs at another resizing endpoint, such as the
+// in-process image proxy, instead of the imgproxy deployment.
+func (l Loader) WithPathPrefix(prefix string) Loader {
+	trimmed := strings.Trim(strings.TrimSpace(prefix), "/")
+	if trimmed == "" {
+		return l
+	}
+	l.pathPrefix = "/" + trimmed
+	return l
+}
+
+func (l Loader) WithDimensions(fn DimensionsFunc) Loader {
+	l.dimensions = fn
+	return l
+}
+
+func (l Loader) Dimensions(src string) (int, int, bool) {
+	trimmed := strings.TrimSpace(src)
+	if l.dimensions == nil || trimmed == "" {
+		return 0, 0, false
+	}
+
+	width, height, ok := l.dimensions(trimmed)
+	if !ok || width <= 0 || height <= 0 {
+		return 0, 0, false
+	}
+	return width, height, true
+}
+
 func (l Loader) Enabled() bool {
 	return l.enabled
 }
@@ -43,37 +78,60 @@ func (l Loader) URL(src string, width int) string {
 	encodedSrc := strings.ReplaceAll(trimmed, " ", "%20")
 	targetWidth := normalizeWidth(width)
 
-	if cdnS3PathPattern.MatchString(encodedSrc) {
+	if l.pathPrefix == "" && cdnS3PathPattern.MatchString(encodedSrc) {
 		replacement := fmt.Sprintf("${1}%d${3}", targetWidth)
 		return cdnS3PathPattern.ReplaceAllString(encodedSrc, replacement)
 	}
 
 	relativePath := strings.TrimLeft(encodedSrc, "/")
-	return fmt.Sprintf("%s/%d/%s", blogPathPrefix, targetWidth, relativePath)
+	return fmt.Sprintf("%s/%d/%s", l.prefix(), targetWidth, relativePath)
 }
 
-func (l Loader) ResponsiveSrcSet(src string, maxWidth int) (string, error) {
+type Variant struct {
+	URL   string
+	Width int
+}
+
+func (l Loader) Variants(src string, maxWidth int) ([]Variant, error) {
 	if !l.enabled {
-		return "", errors.New("loader not enabled")
+		return nil, errors.New("loader not enabled")
 	}
 
 	widths, err := responsiveWidths(maxWidth)
 	if err != nil {
-		return "", err
-	}
-	if len(widths) == 0 {
-		return src, nil
+		return nil, err
 	}
-	parts := make([]string, 0, len(widths))
+	out := make([]Variant, 0, len(widths))
 	for _, width := range widths {
 		url := l.URL(src, width)
 		if url == "" {
 			continue
 		}
-		parts = append(parts, fmt.Sprintf("%s %dw", url, width))
+		out = append(out, Variant{URL: url, Width: width})
 	}
 
-	return strings.Join(parts, ", "), nil
+	return out, nil
+}
+
+func (l Loader) ResponsiveSrcSet(src string, maxWidth int) (string, error) {
+	variants, err := l.Variants(src, maxWidth)
+	if err != nil {
+		return "", err
+	}
+	if len(variants) == 0 {
+		return src, nil
+	}
+
+	return SrcSet(variants), nil
+}
+
+func SrcSet(variants []Variant) string {
+	parts := make([]string, 0, len(variants))
+	for _, variant := range variants {
+		parts = append(parts, fmt.Sprintf("%s %dw", variant.URL, variant.Width))
+	}
+
+	return strings.Join(parts, ", ")
 }
 
 func (l Loader) Thumb(src string, originalWidth int, originalHeight int) (string, int, int) {
@@ -98,10 +156,21 @@ func (l Loader) Thumb(src string, originalWidth int, originalHeight int) (string
 	return outURL, thumbWidth, outHeight
 }
 
+func (l Loader) prefix() string {
+	if l.pathPrefix == "" {
+		return blogPathPrefix
+	}
+	return l.pathPrefix
+}
+
 func MarkdownSizes() string {
 	return markdownSizesValue
 }
 
+func Widths() []int {
+	return append([]int(nil), deviceSizes...)
+}
+
 func normalizeWidth(width int) int {
 	target := width
 	if target <= 0 {
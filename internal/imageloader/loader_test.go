@@ -95,3 +95,55 @@ func TestLoaderThumb_Uses1080AndScalesHeight(t *testing.T) {
 	assert.Equal(t, 1080, width)
 	assert.Equal(t, 567, height)
 }
+
+func TestLoaderURL_WithPathPrefixTargetsProxy(t *testing.T) {
+	t.Parallel()
+
+	loader := New(true).WithPathPrefix("/.img/")
+	assert.Equal(t, "/.img/640/api/media/file/pic.png", loader.URL("/api/media/file/pic.png", 600))
+	assert.Equal(t, "/.img/1080/cdn/image/s3/828/files/pic.webp", loader.URL("/cdn/image/s3/828/files/pic.webp", 1080))
+	assert.Equal(t, New(true), New(true).WithPathPrefix(" "))
+}
+
+func TestLoaderVariants_MatchSrcSet(t *testing.T) {
+	t.Parallel()
+
+	loader := New(true)
+	variants, err := loader.Variants("/images/pic.webp", 64)
+	require.NoError(t, err)
+	assert.Equal(t, []Variant{
+		{URL: blogImageURL(32, "images/pic.webp"), Width: 32},
+		{URL: blogImageURL(64, "images/pic.webp"), Width: 64},
+	}, variants)
+	assert.Equal(t, blogSrcSet("images/pic.webp", 32, 64), SrcSet(variants))
+
+	_, err = New(false).Variants("/images/pic.webp", 64)
+	require.Error(t, err)
+}
+
+func TestLoaderDimensions_UsesHookWhenKnown(t *testing.T) {
+	t.Parallel()
+
+	_, _, ok := New(true).Dimensions("/images/pic.webp")
+	assert.False(t, ok)
+
+	loader := New(true).WithDimensions(func(src string) (int, int, bool) {
+		switch src {
+		case "/images/pic.webp":
+			return 1600, 900, true
+		case "/images/broken.webp":
+			return 0, 900, true
+		}
+		return 0, 0, false
+	})
+
+	width, height, ok := loader.Dimensions(" /images/pic.webp ")
+	require.True(t, ok)
+	assert.Equal(t, 1600, width)
+	assert.Equal(t, 900, height)
+
+	_, _, ok = loader.Dimensions("/images/broken.webp")
+	assert.False(t, ok)
+	_, _, ok = loader.Dimensions("/images/unknown.webp")
+	assert.False(t, ok)
+}
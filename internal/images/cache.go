@@ -0,0 +1,181 @@
+package images
+
+import (
+	"container/list"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+const cacheFileExt = ".img"
+
+// diskCache keeps encoded variants on disk and evicts the least recently
+// served files once the directory grows past maxBytes.
+type diskCache struct {
+	dir      string
+	maxBytes int64
+
+	mu      sync.Mutex
+	size    int64
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key  string
+	size int64
+}
+
+func newDiskCache(dir string, maxBytes int64) (*diskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	cache := &diskCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+	if err := cache.load(); err != nil {
+		return nil, err
+	}
+
+	return cache, nil
+}
+
+func (c *diskCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	element, ok := c.entries[key]
+	if ok {
+		c.order.MoveToFront(element)
+	}
+	c.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	content, err := os.ReadFile(c.path(key))
+	if err != nil {
+		c.remove(key)
+		return nil, false
+	}
+
+	return content, true
+}
+
+func (c *diskCache) Put(key string, content []byte) error {
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*cacheEntry)
+		c.size -= entry.size
+		entry.size = int64(len(content))
+		c.size += entry.size
+		c.order.MoveToFront(element)
+	} else {
+		c.entries[key] = c.order.PushFront(&cacheEntry{key: key, size: int64(len(content))})
+		c.size += int64(len(content))
+	}
+	c.evictLocked()
+
+	return nil
+}
+
+func (c *diskCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+func (c *diskCache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.removeLocked(key)
+}
+
+func (c *diskCache) removeLocked(key string) {
+	element, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	c.order.Remove(element)
+	delete(c.entries, key)
+	c.size -= element.Value.(*cacheEntry).size
+	_ = os.Remove(c.path(key))
+}
+
+func (c *diskCache) evictLocked() {
+	for c.maxBytes > 0 && c.size > c.maxBytes && c.order.Len() > 1 {
+		oldest := c.order.Back()
+		c.removeLocked(oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *diskCache) load() error {
+	dirEntries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	type existing struct {
+		key   string
+		size  int64
+		mtime int64
+	}
+	found := make([]existing, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || filepath.Ext(name) != cacheFileExt {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		found = append(found, existing{
+			key:   name[:len(name)-len(cacheFileExt)],
+			size:  info.Size(),
+			mtime: info.ModTime().UnixNano(),
+		})
+	}
+
+	sort.Slice(found, func(i int, j int) bool {
+		return found[i].mtime > found[j].mtime
+	})
+	for _, item := range found {
+		c.entries[item.key] = c.order.PushBack(&cacheEntry{key: item.key, size: item.size})
+		c.size += item.size
+	}
+	c.evictLocked()
+
+	return nil
+}
+
+func (c *diskCache) path(key string) string {
+	return filepath.Join(c.dir, key+cacheFileExt)
+}
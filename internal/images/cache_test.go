@@ -0,0 +1,35 @@
+package images
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	cache, err := newDiskCache(dir, 10)
+	require.NoError(t, err)
+
+	require.NoError(t, cache.Put("a", []byte("aaaa")))
+	require.NoError(t, cache.Put("b", []byte("bbbb")))
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+	require.NoError(t, cache.Put("c", []byte("cccc")))
+
+	_, ok = cache.Get("b")
+	require.False(t, ok)
+	content, ok := cache.Get("a")
+	require.True(t, ok)
+	require.Equal(t, "aaaa", string(content))
+	require.Equal(t, int64(8), cache.Size())
+
+	reopened, err := newDiskCache(dir, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), reopened.Size())
+	content, ok = reopened.Get("c")
+	require.True(t, ok)
+	require.Equal(t, "cccc", string(content))
+}
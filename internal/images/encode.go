@@ -0,0 +1,80 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"strings"
+
+	"github.com/gen2brain/avif"
+	"github.com/gen2brain/webp"
+	"golang.org/x/image/draw"
+)
+
+const formatAVIF = "avif"
+const formatWebP = "webp"
+const formatJPEG = "jpeg"
+const formatPNG = "png"
+
+const jpegQuality = 82
+const webpQuality = 78
+const avifQuality = 55
+const avifSpeed = 8
+
+var formatContentTypes = map[string]string{
+	formatAVIF: "image/avif",
+	formatWebP: "image/webp",
+	formatJPEG: "image/jpeg",
+	formatPNG:  "image/png",
+}
+
+// negotiateFormat picks the smallest format the client advertises, falling
+// back to a format every browser decodes for the source kind.
+func negotiateFormat(accept string, sourceFormat string, allowAVIF bool) string {
+	accept = strings.ToLower(accept)
+	if allowAVIF && strings.Contains(accept, "image/avif") {
+		return formatAVIF
+	}
+	if strings.Contains(accept, "image/webp") {
+		return formatWebP
+	}
+	if sourceFormat == formatJPEG {
+		return formatJPEG
+	}
+
+	return formatPNG
+}
+
+func resize(src image.Image, width int) image.Image {
+	bounds := src.Bounds()
+	if width <= 0 || bounds.Dx() <= width {
+		return src
+	}
+
+	height := max(1, bounds.Dy()*width/bounds.Dx())
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Src, nil)
+	return dst
+}
+
+func encode(img image.Image, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case formatAVIF:
+		err = avif.Encode(&buf, img, avif.Options{Quality: avifQuality, QualityAlpha: avifQuality, Speed: avifSpeed})
+	case formatWebP:
+		err = webp.Encode(&buf, img, webp.Options{Quality: webpQuality, Method: webp.DefaultMethod})
+	case formatJPEG:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	default:
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,381 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"blog/internal/imageloader"
+)
+
+const PathPrefix = "/.img/"
+
+const defaultCacheMaxBytes = 512 << 20
+const defaultMaxSourceBytes = 25 << 20
+const defaultMaxSourcePixels = 50_000_000
+const defaultFetchTimeout = 15 * time.Second
+const proxyCacheControl = "public, max-age=604800"
+const maxKnownDimensions = 10_000
+
+var errSourceNotAllowed = errors.New("image source not allowed")
+var errSourceTooLarge = errors.New("image source too large")
+
+type Config struct {
+	// SourceURL is the CMS origin attachments are fetched from; absolute source
+	// URLs on any other host are rejected so the proxy cannot be used as an
+	// open relay.
+	SourceURL string
+	// SourceQueryParams lists the query parameters forwarded to the source and
+	// kept in the cache key; any other parameter is dropped so clients cannot
+	// mint unbounded cache entries for the same image.
+	SourceQueryParams []string
+	CacheDir          string
+	CacheMaxBytes     int64
+	// MaxRenders bounds how many variants are decoded and encoded at once;
+	// zero means one per CPU.
+	MaxRenders  int
+	Widths      []int
+	DisableAVIF bool
+	Client      *http.Client
+}
+
+type Proxy struct {
+	source      *url.URL
+	queryParams []string
+	cache       *diskCache
+	widths      []int
+	allowAVIF   bool
+	client      *http.Client
+	renders     chan struct{}
+
+	mu       sync.Mutex
+	inflight map[string]*call
+
+	dimensionsMu sync.RWMutex
+	dimensions   map[string]image.Point
+}
+
+type call struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+	content []byte
+	err     error
+}
+
+func New(cfg Config) (*Proxy, error) {
+	source, err := url.Parse(strings.TrimSpace(cfg.SourceURL))
+	if err != nil || source.Scheme == "" || source.Host == "" {
+		return nil, fmt.Errorf("image proxy source URL must be absolute: %q", cfg.SourceURL)
+	}
+	if strings.TrimSpace(cfg.CacheDir) == "" {
+		return nil, fmt.Errorf("image proxy cache dir is required")
+	}
+
+	maxBytes := cfg.CacheMaxBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultCacheMaxBytes
+	}
+	cache, err := newDiskCache(cfg.CacheDir, maxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("open image cache: %w", err)
+	}
+
+	widths := cfg.Widths
+	if len(widths) == 0 {
+		widths = imageloader.Widths()
+	}
+	client := cfg.Client
+	if client == nil {
+		client = &http.Client{Timeout: defaultFetchTimeout}
+	}
+	maxRenders := cfg.MaxRenders
+	if maxRenders <= 0 {
+		maxRenders = runtime.GOMAXPROCS(0)
+	}
+
+	return &Proxy{
+		source:      source,
+		queryParams: slices.Clone(cfg.SourceQueryParams),
+		cache:       cache,
+		widths:      widths,
+		allowAVIF:   !cfg.DisableAVIF,
+		client:      client,
+		renders:     make(chan struct{}, maxRenders),
+		inflight:    make(map[string]*call),
+		dimensions:  make(map[string]image.Point),
+	}, nil
+}
+
+// Register mounts the proxy on mux under PathPrefix. Requests look like
+// /.img/<width>/<source path>, which matches imageloader URLs built with
+// PathPrefix as their path prefix.
+func (p *Proxy) Register(mux *http.ServeMux) {
+	mux.Handle(PathPrefix, p)
+}
+
+// Dimensions reports the intrinsic size of a source image the proxy has already
+// decoded. It never fetches, so markup rendered before the first request for an
+// image simply goes without width and height.
+func (p *Proxy) Dimensions(src string) (int, int, bool) {
+	rawSource, rawQuery, _ := strings.Cut(strings.TrimSpace(src), "?")
+	sourceURL, err := p.resolveSource(rawSource, rawQuery)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	p.dimensionsMu.RLock()
+	size, ok := p.dimensions[sourceURL.String()]
+	p.dimensionsMu.RUnlock()
+	return size.X, size.Y, ok
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	width, sourceURL, err := p.parseRequest(r)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	format := negotiateFormat(r.Header.Get("Accept"), sourceFormatFromPath(sourceURL.Path), p.allowAVIF)
+	content, err := p.variant(r.Context(), sourceURL, width, format)
+	switch {
+	case errors.Is(err, errSourceNotAllowed):
+		http.NotFound(w, r)
+		return
+	case err != nil:
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", formatContentTypes[format])
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.Header().Set("Cache-Control", proxyCacheControl)
+	w.Header().Add("Vary", "Accept")
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(content)
+}
+
+func (p *Proxy) parseRequest(r *http.Request) (int, *url.URL, error) {
+	rest, ok := strings.CutPrefix(r.URL.Path, PathPrefix)
+	if !ok {
+		return 0, nil, errSourceNotAllowed
+	}
+	rawWidth, rawSource, ok := strings.Cut(rest, "/")
+	if !ok || rawSource == "" {
+		return 0, nil, errSourceNotAllowed
+	}
+
+	width, err := strconv.Atoi(rawWidth)
+	if err != nil || !slices.Contains(p.widths, width) {
+		return 0, nil, errSourceNotAllowed
+	}
+
+	sourceURL, err := p.resolveSource(rawSource, r.URL.RawQuery)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return width, sourceURL, nil
+}
+
+func (p *Proxy) resolveSource(rawSource string, rawQuery string) (*url.URL, error) {
+	// Path cleaning collapses "https://" to "https:/" before it reaches us.
+	for _, scheme := range []string{"https:/", "http:/"} {
+		if rest, ok := strings.CutPrefix(rawSource, scheme); ok {
+			rawSource = scheme + "/" + strings.TrimPrefix(rest, "/")
+			break
+		}
+	}
+
+	target, err := url.Parse(rawSource)
+	if err != nil {
+		return nil, errSourceNotAllowed
+	}
+	if target.IsAbs() {
+		if !strings.EqualFold(target.Host, p.source.Host) {
+			return nil, errSourceNotAllowed
+		}
+		target.Scheme = p.source.Scheme
+	} else {
+		target = p.source.ResolveReference(&url.URL{Path: "/" + strings.TrimLeft(target.Path, "/")})
+	}
+	target.RawQuery = p.sourceQuery(rawQuery)
+	target.Fragment = ""
+
+	return target, nil
+}
+
+// sourceQuery keeps only the configured query parameters, in a stable order.
+func (p *Proxy) sourceQuery(rawQuery string) string {
+	if rawQuery == "" || len(p.queryParams) == 0 {
+		return ""
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return ""
+	}
+
+	kept := make(url.Values, len(p.queryParams))
+	for _, name := range p.queryParams {
+		if values, ok := query[name]; ok {
+			kept[name] = values
+		}
+	}
+	return kept.Encode()
+}
+
+// variant renders each key once for all concurrent requests. The render is
+// cancelled once every request waiting for it has gone away.
+func (p *Proxy) variant(ctx context.Context, sourceURL *url.URL, width int, format string) ([]byte, error) {
+	key := cacheKey(sourceURL.String(), width, format)
+	if content, ok := p.cache.Get(key); ok {
+		return content, nil
+	}
+
+	p.mu.Lock()
+	pending, ok := p.inflight[key]
+	if !ok {
+		renderCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		pending = &call{done: make(chan struct{}), cancel: cancel}
+		p.inflight[key] = pending
+		go p.renderVariant(renderCtx, key, pending, sourceURL, width, format)
+	}
+	pending.waiters++
+	p.mu.Unlock()
+
+	select {
+	case <-pending.done:
+		return pending.content, pending.err
+	case <-ctx.Done():
+		p.mu.Lock()
+		pending.waiters--
+		if pending.waiters == 0 {
+			pending.cancel()
+		}
+		p.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+func (p *Proxy) renderVariant(
+	ctx context.Context,
+	key string,
+	pending *call,
+	sourceURL *url.URL,
+	width int,
+	format string,
+) {
+	defer pending.cancel()
+
+	select {
+	case p.renders <- struct{}{}:
+		pending.content, pending.err = p.render(ctx, sourceURL, width, format)
+		<-p.renders
+	case <-ctx.Done():
+		pending.err = ctx.Err()
+	}
+	if pending.err == nil {
+		_ = p.cache.Put(key, pending.content)
+	}
+
+	p.mu.Lock()
+	delete(p.inflight, key)
+	p.mu.Unlock()
+	close(pending.done)
+}
+
+func (p *Proxy) render(ctx context.Context, sourceURL *url.URL, width int, format string) ([]byte, error) {
+	raw, err := p.fetch(ctx, sourceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("decode image config: %w", err)
+	}
+	if cfg.Width*cfg.Height > defaultMaxSourcePixels {
+		return nil, errSourceTooLarge
+	}
+	p.rememberDimensions(sourceURL, cfg.Width, cfg.Height)
+
+	img, _, err := image.Decode(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+
+	return encode(resize(img, width), format)
+}
+
+func (p *Proxy) fetch(ctx context.Context, sourceURL *url.URL) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch image: unexpected status %d", resp.StatusCode)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, defaultMaxSourceBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) > defaultMaxSourceBytes {
+		return nil, errSourceTooLarge
+	}
+
+	return raw, nil
+}
+
+func (p *Proxy) rememberDimensions(sourceURL *url.URL, width int, height int) {
+	p.dimensionsMu.Lock()
+	defer p.dimensionsMu.Unlock()
+	if _, ok := p.dimensions[sourceURL.String()]; !ok && len(p.dimensions) >= maxKnownDimensions {
+		return
+	}
+	p.dimensions[sourceURL.String()] = image.Pt(width, height)
+}
+
+func cacheKey(source string, width int, format string) string {
+	sum := sha256.Sum256([]byte(source + "|" + strconv.Itoa(width) + "|" + format))
+	return hex.EncodeToString(sum[:])
+}
+
+func sourceFormatFromPath(pathValue string) string {
+	lower := strings.ToLower(pathValue)
+	if strings.HasSuffix(lower, ".jpg") || strings.HasSuffix(lower, ".jpeg") {
+		return formatJPEG
+	}
+
+	return formatPNG
+}
@@ -0,0 +1,229 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newSourceServer(t *testing.T, width int, height int) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := range width {
+		for y := range height {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	var hits atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/media/file/pic.png" {
+			http.NotFound(w, r)
+			return
+		}
+		hits.Add(1)
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(buf.Bytes())
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &hits
+}
+
+func newTestProxy(t *testing.T, sourceURL string) *Proxy {
+	t.Helper()
+
+	proxy, err := New(Config{
+		SourceURL:   sourceURL,
+		CacheDir:    t.TempDir(),
+		Widths:      []int{32, 64},
+		DisableAVIF: true,
+	})
+	require.NoError(t, err)
+	return proxy
+}
+
+func serveProxy(proxy *Proxy, path string, accept string) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	proxy.Register(mux)
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestProxyResizesAndCachesVariants(t *testing.T) {
+	t.Parallel()
+
+	source, hits := newSourceServer(t, 120, 60)
+	proxy := newTestProxy(t, source.URL)
+
+	rec := serveProxy(proxy, "/.img/32/api/media/file/pic.png", "image/png,*/*")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	require.Equal(t, "Accept", rec.Header().Get("Vary"))
+	decoded, err := png.Decode(rec.Body)
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 32, 16), decoded.Bounds())
+
+	rec = serveProxy(proxy, "/.img/32/api/media/file/pic.png", "image/png,*/*")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, int64(1), hits.Load())
+	require.Positive(t, proxy.cache.Size())
+
+	cleanedSource := strings.Replace(source.URL, "://", ":/", 1)
+	rec = serveProxy(proxy, "/.img/64/"+cleanedSource+"/api/media/file/pic.png", "image/webp,*/*")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "image/webp", rec.Header().Get("Content-Type"))
+	require.Equal(t, int64(2), hits.Load())
+}
+
+func TestProxyRejectsUnknownWidthsAndForeignHosts(t *testing.T) {
+	t.Parallel()
+
+	source, hits := newSourceServer(t, 16, 16)
+	proxy := newTestProxy(t, source.URL)
+
+	for _, path := range []string{
+		"/.img/33/api/media/file/pic.png",
+		"/.img/abc/api/media/file/pic.png",
+		"/.img/32",
+		"/.img/32/https:/evil.example/pic.png",
+	} {
+		rec := serveProxy(proxy, path, "")
+		require.Equal(t, http.StatusNotFound, rec.Code, path)
+	}
+	require.Zero(t, hits.Load())
+
+	rec := serveProxy(proxy, "/.img/32/api/media/file/missing.png", "")
+	require.Equal(t, http.StatusBadGateway, rec.Code)
+}
+
+func TestProxyDropsQueryParamsOutsideTheAllowList(t *testing.T) {
+	t.Parallel()
+
+	var queries []string
+	var mu sync.Mutex
+	source, _ := newSourceServer(t, 16, 16)
+	recorder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.RawQuery)
+		mu.Unlock()
+		r.URL.RawQuery = ""
+		resp, err := http.Get(source.URL + r.URL.Path)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		_, _ = io.Copy(w, resp.Body)
+	}))
+	t.Cleanup(recorder.Close)
+
+	proxy, err := New(Config{
+		SourceURL:         recorder.URL,
+		SourceQueryParams: []string{"v"},
+		CacheDir:          t.TempDir(),
+		Widths:            []int{32},
+		DisableAVIF:       true,
+	})
+	require.NoError(t, err)
+
+	for _, path := range []string{
+		"/.img/32/api/media/file/pic.png?v=1&bust=1",
+		"/.img/32/api/media/file/pic.png?bust=2&v=1",
+		"/.img/32/api/media/file/pic.png?v=1",
+	} {
+		rec := serveProxy(proxy, path, "")
+		require.Equal(t, http.StatusOK, rec.Code, path)
+	}
+	rec := serveProxy(proxy, "/.img/32/api/media/file/pic.png?bust=3", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{"v=1", ""}, queries)
+}
+
+func TestProxyCancelsRenderWhenEveryRequestLeaves(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	source := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+		close(cancelled)
+	}))
+	t.Cleanup(source.Close)
+	proxy := newTestProxy(t, source.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan *httptest.ResponseRecorder)
+	go func() {
+		req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/.img/32/api/media/file/slow.png", nil)
+		rec := httptest.NewRecorder()
+		proxy.ServeHTTP(rec, req)
+		done <- rec
+	}()
+
+	<-started
+	cancel()
+	require.Equal(t, http.StatusBadGateway, (<-done).Code)
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("source fetch was not cancelled after the only request left")
+	}
+}
+
+func TestProxyRemembersSourceDimensions(t *testing.T) {
+	t.Parallel()
+
+	source, _ := newSourceServer(t, 120, 60)
+	proxy := newTestProxy(t, source.URL)
+
+	_, _, ok := proxy.Dimensions("/api/media/file/pic.png")
+	require.False(t, ok)
+
+	rec := serveProxy(proxy, "/.img/32/api/media/file/pic.png", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	for _, src := range []string{"/api/media/file/pic.png", source.URL + "/api/media/file/pic.png"} {
+		width, height, ok := proxy.Dimensions(src)
+		require.True(t, ok, src)
+		require.Equal(t, 120, width)
+		require.Equal(t, 60, height)
+	}
+	_, _, ok = proxy.Dimensions("https://evil.example/api/media/file/pic.png")
+	require.False(t, ok)
+}
+
+func TestNegotiateFormat(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, formatAVIF, negotiateFormat("image/avif,image/webp,*/*", formatPNG, true))
+	require.Equal(t, formatWebP, negotiateFormat("image/avif,image/webp,*/*", formatPNG, false))
+	require.Equal(t, formatJPEG, negotiateFormat("*/*", formatJPEG, true))
+	require.Equal(t, formatPNG, negotiateFormat("", formatPNG, true))
+}
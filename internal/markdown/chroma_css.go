@@ -15,22 +15,24 @@ const (
 	chromaDarkStyle  = "monokai"
 )
 
-var (
-	chromaCSSOnce sync.Once
-	chromaCSS     template.CSS
-)
+var chromaCSSCache sync.Map
 
-func ChromaCSS() template.CSS {
-	chromaCSSOnce.Do(func() {
-		chromaCSS = template.CSS(buildChromaCSS())
-	})
+// ChromaCSS returns the stylesheet for the highlight styles, switching between
+// the light and dark style with prefers-color-scheme.
+func ChromaCSS(highlight CodeHighlight) template.CSS {
+	key := highlight.lightStyle() + "|" + highlight.darkStyle()
+	if cached, ok := chromaCSSCache.Load(key); ok {
+		return cached.(template.CSS)
+	}
 
-	return chromaCSS
+	css := template.CSS(buildChromaCSS(highlight.lightStyle(), highlight.darkStyle()))
+	chromaCSSCache.Store(key, css)
+	return css
 }
 
-func buildChromaCSS() string {
-	lightCSS := buildSingleStyleCSS(chromaLightStyle)
-	darkCSS := buildSingleStyleCSS(chromaDarkStyle)
+func buildChromaCSS(lightStyle string, darkStyle string) string {
+	lightCSS := buildSingleStyleCSS(lightStyle)
+	darkCSS := buildSingleStyleCSS(darkStyle)
 
 	var out strings.Builder
 	if lightCSS != "" {
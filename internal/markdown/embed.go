@@ -0,0 +1,201 @@
+package markdown
+
+import (
+	stdhtml "html"
+	"io"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gomarkdown/markdown/ast"
+)
+
+const (
+	EmbedYouTube = "youtube"
+	EmbedVimeo   = "vimeo"
+	EmbedGist    = "gist"
+)
+
+var (
+	youTubeIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+	vimeoIDPattern   = regexp.MustCompile(`^[0-9]+$`)
+	gistIDPattern    = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+	gistUserPattern  = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+)
+
+type embed struct {
+	provider string
+	src      string
+	srcDoc   string
+	title    string
+	href     string
+}
+
+// DefaultEmbedProviders lists every provider the renderer knows how to embed.
+func DefaultEmbedProviders() []string {
+	return []string{EmbedYouTube, EmbedVimeo, EmbedGist}
+}
+
+// standaloneEmbed matches a paragraph holding nothing but a bare link to an
+// allowed provider. Links with custom text stay links.
+func standaloneEmbed(paragraph *ast.Paragraph, opts Options) (embed, bool) {
+	if len(opts.EmbedProviders) == 0 {
+		return embed{}, false
+	}
+
+	var link *ast.Link
+	for _, child := range paragraph.Children {
+		switch typed := child.(type) {
+		case *ast.Link:
+			if link != nil {
+				return embed{}, false
+			}
+			link = typed
+		case *ast.Text:
+			if strings.TrimSpace(string(typed.Literal)) != "" {
+				return embed{}, false
+			}
+		default:
+			return embed{}, false
+		}
+	}
+	if link == nil || link.NoteID != 0 {
+		return embed{}, false
+	}
+
+	href := strings.TrimSpace(string(link.Destination))
+	text := strings.TrimSpace(collectLinkText(link))
+	if text != "" && text != href {
+		return embed{}, false
+	}
+
+	found, ok := embedFromURL(href)
+	if !ok || !slices.Contains(opts.EmbedProviders, found.provider) {
+		return embed{}, false
+	}
+	if title := strings.TrimSpace(embedTitle(found.provider, opts)); title != "" {
+		found.title = title
+	}
+	return found, true
+}
+
+func embedTitle(provider string, opts Options) string {
+	switch provider {
+	case EmbedYouTube:
+		return opts.EmbedYouTubeTitle
+	case EmbedVimeo:
+		return opts.EmbedVimeoTitle
+	case EmbedGist:
+		return opts.EmbedGistTitle
+	default:
+		return ""
+	}
+}
+
+func embedFromURL(href string) (embed, bool) {
+	parsed, err := url.Parse(href)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return embed{}, false
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+
+	switch host {
+	case "youtube.com", "m.youtube.com", "youtu.be":
+		return youTubeEmbed(host, parsed, segments, href)
+	case "vimeo.com", "player.vimeo.com":
+		id := segments[len(segments)-1]
+		if !vimeoIDPattern.MatchString(id) {
+			return embed{}, false
+		}
+		return embed{
+			provider: EmbedVimeo,
+			src:      "https://player.vimeo.com/video/" + id + "?dnt=1",
+			title:    "Vimeo video",
+			href:     href,
+		}, true
+	case "gist.github.com":
+		if len(segments) != 2 || !gistUserPattern.MatchString(segments[0]) || !gistIDPattern.MatchString(segments[1]) {
+			return embed{}, false
+		}
+		script := `<script src="https://gist.github.com/` + segments[0] + "/" + segments[1] + `.js"></script>`
+		return embed{
+			provider: EmbedGist,
+			srcDoc:   `<base target="_blank">` + script,
+			title:    "GitHub gist",
+			href:     href,
+		}, true
+	default:
+		return embed{}, false
+	}
+}
+
+func youTubeEmbed(host string, parsed *url.URL, segments []string, href string) (embed, bool) {
+	id := ""
+	switch {
+	case host == "youtu.be" && len(segments) == 1:
+		id = segments[0]
+	case len(segments) == 1 && segments[0] == "watch":
+		id = parsed.Query().Get("v")
+	case len(segments) == 2 && (segments[0] == "shorts" || segments[0] == "embed" || segments[0] == "live"):
+		id = segments[1]
+	}
+	if !youTubeIDPattern.MatchString(id) {
+		return embed{}, false
+	}
+
+	src := "https://www.youtube-nocookie.com/embed/" + id
+	if start := youTubeStart(parsed.Query().Get("t")); start > 0 {
+		src += "?start=" + strconv.Itoa(start)
+	}
+	return embed{
+		provider: EmbedYouTube,
+		src:      src,
+		title:    "YouTube video",
+		href:     href,
+	}, true
+}
+
+func youTubeStart(value string) int {
+	seconds, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(value), "s"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return seconds
+}
+
+func renderEmbed(writer io.Writer, item embed) {
+	_, _ = io.WriteString(writer, `<figure class="markdown-embed markdown-embed-`)
+	_, _ = io.WriteString(writer, item.provider)
+	_, _ = io.WriteString(writer, `"><div class="markdown-embed-frame"><iframe title="`)
+	_, _ = io.WriteString(writer, stdhtml.EscapeString(item.title))
+	if item.srcDoc != "" {
+		_, _ = io.WriteString(writer, `" srcdoc="`)
+		_, _ = io.WriteString(writer, stdhtml.EscapeString(item.srcDoc))
+		_, _ = io.WriteString(writer, `" sandbox="allow-scripts allow-popups allow-popups-to-escape-sandbox"`)
+	} else {
+		_, _ = io.WriteString(writer, `" src="`)
+		_, _ = io.WriteString(writer, stdhtml.EscapeString(item.src))
+		_, _ = io.WriteString(writer, `" allow="encrypted-media; picture-in-picture; fullscreen" allowfullscreen`)
+	}
+	_, _ = io.WriteString(writer, ` loading="lazy" referrerpolicy="strict-origin-when-cross-origin"></iframe></div>`)
+	_, _ = io.WriteString(writer, `<figcaption class="markdown-embed-caption"><a href="`)
+	_, _ = io.WriteString(writer, stdhtml.EscapeString(item.href))
+	_, _ = io.WriteString(writer, `" target="_blank" rel="noopener noreferrer">`)
+	_, _ = io.WriteString(writer, stdhtml.EscapeString(item.href))
+	_, _ = io.WriteString(writer, "</a></figcaption></figure>\n")
+}
+
+func collectLinkText(link *ast.Link) string {
+	var builder strings.Builder
+	ast.WalkFunc(link, func(node ast.Node, entering bool) ast.WalkStatus {
+		if text, ok := node.(*ast.Text); ok && entering {
+			builder.Write(text.Literal)
+		}
+		return ast.GoToNext
+	})
+	return builder.String()
+}
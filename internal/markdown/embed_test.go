@@ -0,0 +1,71 @@
+package markdown
+
+import (
+	stdhtml "html"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToHTML_EmbedsBareProviderLinks(t *testing.T) {
+	t.Parallel()
+
+	opts := Options{EmbedProviders: DefaultEmbedProviders()}
+	cases := map[string]string{
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=42s": `src="https://www.youtube-nocookie.com/embed/` +
+			`dQw4w9WgXcQ?start=42"`,
+		"https://youtu.be/dQw4w9WgXcQ": `src="https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ"`,
+		"https://vimeo.com/76979871":   `src="https://player.vimeo.com/video/76979871?dnt=1"`,
+		"https://gist.github.com/octocat/6cad326836d38bd3a7ae": `srcdoc="&lt;base target=&#34;_blank&#34;&gt;` +
+			`&lt;script src=&#34;https://gist.github.com/octocat/6cad326836d38bd3a7ae.js&#34;&gt;&lt;/script&gt;"`,
+	}
+	for input, want := range cases {
+		html := string(ToHTML("Intro\n\n"+input+"\n\nOutro", opts))
+		require.Contains(t, html, `<figure class="markdown-embed markdown-embed-`, input)
+		require.Contains(t, html, want, input)
+		require.Contains(t, html, `<figcaption class="markdown-embed-caption"><a href="`+stdhtml.EscapeString(input), input)
+		require.Contains(t, html, "<p>Outro</p>", input)
+	}
+}
+
+func TestToHTML_EmbedsTranslatedLinkTokens(t *testing.T) {
+	t.Parallel()
+
+	html := string(ToHTML("[https://vimeo.com/76979871](external_link://v1)", Options{
+		TranslateLinks: map[string]string{"v1": "https://vimeo.com/76979871"},
+		EmbedProviders: []string{EmbedVimeo},
+	}))
+
+	require.Contains(t, html, `class="markdown-embed markdown-embed-vimeo"`)
+}
+
+func TestToHTML_LabelsEmbedsWithLocalizedTitles(t *testing.T) {
+	t.Parallel()
+
+	opts := Options{EmbedProviders: DefaultEmbedProviders(), EmbedVimeoTitle: "Vimeo-Video"}
+	require.Contains(t, string(ToHTML("https://vimeo.com/76979871", opts)), `<iframe title="Vimeo-Video"`)
+	require.Contains(t, string(ToHTML("https://youtu.be/dQw4w9WgXcQ", opts)), `<iframe title="YouTube video"`)
+}
+
+func TestToHTML_KeepsLinksWhenEmbedNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{
+		"https://youtu.be/dQw4w9WgXcQ",
+		"Watch https://youtu.be/dQw4w9WgXcQ today",
+		"[my talk](https://youtu.be/dQw4w9WgXcQ)",
+		"https://example.com/watch?v=dQw4w9WgXcQ",
+	} {
+		opts := Options{EmbedProviders: []string{EmbedVimeo, EmbedYouTube}}
+		if input == "https://youtu.be/dQw4w9WgXcQ" {
+			opts.EmbedProviders = []string{EmbedVimeo}
+		}
+
+		html := string(ToHTML(input, opts))
+		require.NotContains(t, html, "<iframe", input)
+		require.Contains(t, html, `href="https://`, input)
+	}
+
+	html := string(ToHTML("https://youtu.be/dQw4w9WgXcQ", Options{}))
+	require.NotContains(t, html, "<iframe")
+}
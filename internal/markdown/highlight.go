@@ -0,0 +1,72 @@
+package markdown
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+)
+
+var fenceHighlightPattern = regexp.MustCompile(`\{([0-9,\s-]+)\}`)
+
+// CodeHighlight configures syntax highlighting of fenced code blocks. Empty
+// style names fall back to github (light) and monokai (dark).
+type CodeHighlight struct {
+	LightStyle  string
+	DarkStyle   string
+	LineNumbers bool
+}
+
+func (h CodeHighlight) lightStyle() string {
+	return nonEmpty(strings.TrimSpace(h.LightStyle), chromaLightStyle)
+}
+
+func (h CodeHighlight) darkStyle() string {
+	return nonEmpty(strings.TrimSpace(h.DarkStyle), chromaDarkStyle)
+}
+
+func (h CodeHighlight) formatter(info []byte) *chromahtml.Formatter {
+	options := []chromahtml.Option{chromahtml.WithClasses(true)}
+	if h.LineNumbers {
+		options = append(options, chromahtml.WithLineNumbers(true))
+	}
+	if ranges := highlightRanges(string(info)); len(ranges) > 0 {
+		options = append(options, chromahtml.HighlightLines(ranges))
+	}
+
+	return chromahtml.New(options...)
+}
+
+// highlightRanges reads line ranges such as {3-5} or {1,4-6} from a fence
+// info string.
+func highlightRanges(info string) [][2]int {
+	match := fenceHighlightPattern.FindStringSubmatch(info)
+	if match == nil {
+		return nil
+	}
+
+	ranges := make([][2]int, 0, 2)
+	for part := range strings.SplitSeq(match[1], ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		rawStart, rawEnd, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(strings.TrimSpace(rawStart))
+		if err != nil || start < 1 {
+			continue
+		}
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(strings.TrimSpace(rawEnd))
+			if err != nil || end < start {
+				continue
+			}
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+
+	return ranges
+}
@@ -0,0 +1,42 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighlightRanges(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, [][2]int{{3, 5}}, highlightRanges("go {3-5}"))
+	require.Equal(t, [][2]int{{1, 1}, {4, 6}}, highlightRanges("{1, 4-6}"))
+	require.Equal(t, [][2]int{{2, 2}}, highlightRanges("go {0,2,5-3}"))
+	require.Nil(t, highlightRanges("go"))
+}
+
+func TestToHTML_HighlightsFenceLineRangesAndLineNumbers(t *testing.T) {
+	t.Parallel()
+
+	input := "```go {2}\na := 1\nb := 2\n```"
+
+	html := string(ToHTML(input, Options{}))
+	require.Contains(t, html, `<p class="code-block-language">go</p>`)
+	require.Contains(t, html, `<span class="line hl">`)
+	require.NotContains(t, html, `<span class="ln">`)
+
+	html = string(ToHTML(input, Options{CodeHighlight: CodeHighlight{LineNumbers: true}}))
+	require.Contains(t, html, `<span class="ln">1</span>`)
+	require.Contains(t, html, `<span class="line hl"><span class="ln">2</span>`)
+}
+
+func TestChromaCSS_UsesConfiguredStyles(t *testing.T) {
+	t.Parallel()
+
+	defaults := string(ChromaCSS(CodeHighlight{}))
+	require.Equal(t, defaults, string(ChromaCSS(CodeHighlight{LightStyle: "github", DarkStyle: "monokai"})))
+
+	custom := string(ChromaCSS(CodeHighlight{LightStyle: "solarized-light", DarkStyle: "dracula"}))
+	require.NotEqual(t, defaults, custom)
+	require.Contains(t, custom, "@media (prefers-color-scheme: dark)")
+}
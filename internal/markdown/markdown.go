@@ -13,7 +13,6 @@ import (
 
 	"blog/internal/imageloader"
 	"github.com/alecthomas/chroma/v2"
-	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
 	"github.com/alecthomas/chroma/v2/lexers"
 	"github.com/alecthomas/chroma/v2/styles"
 	md "github.com/gomarkdown/markdown"
@@ -33,7 +32,9 @@ const (
 	imageLabel           = "[image]"
 	codeCopyLabel        = "copy"
 	codeCopiedLabel      = "copied"
+	footnoteBackLabel    = "back to reference"
 	plainTextLabel       = "plain text"
+	mermaidLanguage      = "mermaid"
 )
 
 type Options struct {
@@ -44,6 +45,22 @@ type Options struct {
 	CodeCopyLabel   string
 	CodeCopiedLabel string
 	PlainTextLabel  string
+	CodeHighlight   CodeHighlight
+	RawHTML         RawHTMLMode
+	// Typographer curls straight quotes and turns --, --- and ... into en
+	// dashes, em dashes and ellipses in prose. Code is left untouched.
+	Typographer bool
+
+	FootnoteBackLabel string
+
+	// EmbedProviders allowlists the providers whose bare links are turned into
+	// embeds; see DefaultEmbedProviders. Empty keeps every link a link.
+	EmbedProviders []string
+	// Embed titles label each provider's iframe for assistive technology;
+	// empty titles fall back to English.
+	EmbedYouTubeTitle string
+	EmbedVimeoTitle   string
+	EmbedGistTitle    string
 
 	ExcerptCodeBlockLabel string
 	ExcerptTableLabel     string
@@ -56,29 +73,8 @@ type Options struct {
 const lastGoodBreakRatio = 0.8
 
 var (
-	markdownCodeBlockPattern          = regexp.MustCompile("(?s)```.*?```")
-	markdownTablePattern              = regexp.MustCompile(`(?m)^\|.*\|.*$`)
-	markdownImagePattern              = regexp.MustCompile(`!\[.*?\]\(.*?\)`)
-	markdownHorizontalRulePattern     = regexp.MustCompile(`(?m)^---+$`)
-	markdownFootnoteDefinitionPattern = regexp.MustCompile(`(?m)^\[\^[^\]]+\]: .*$`)
-	markdownFootnoteReferencePattern  = regexp.MustCompile(`\[\^[^\]]+\]`)
-	markdownBoldItalicPattern         = regexp.MustCompile(`\*\*\*(.*?)\*\*\*`)
-	markdownBoldPattern               = regexp.MustCompile(`\*\*(.*?)\*\*`)
-	markdownItalicAsteriskPattern     = regexp.MustCompile(`\*(.*?)\*`)
-	markdownItalicUnderscorePattern   = regexp.MustCompile(`_(.*?)_`)
-	markdownHeadingPattern            = regexp.MustCompile(`(?m)^#{1,6}\s+(.*?)$`)
-	markdownStrikethroughPattern      = regexp.MustCompile(`~~(.*?)~~`)
-	markdownInlineCodePattern         = regexp.MustCompile("`(.*?)`")
-	markdownLinkPattern               = regexp.MustCompile(`\[(.*?)\]\(.*?\)`)
-	markdownBlockquotePattern         = regexp.MustCompile(`(?m)^\s*>\s*(.*?)$`)
-	markdownTaskListPattern           = regexp.MustCompile(`(?m)^\s*-\s\[[ x]\]\s+`)
-	markdownOrderedListPattern        = regexp.MustCompile(`(?m)^\s*\d+\.\s+`)
-	htmlTagPattern                    = regexp.MustCompile(`<[^>]*>`)
-	markdownSpaceTabPattern           = regexp.MustCompile(`[ \t]{2,}`)
-	markdownTripleNewLinePattern      = regexp.MustCompile(`\n{3,}`)
-	markdownLeadingNewLinePattern     = regexp.MustCompile(`^\n+`)
-	markdownTrailingNewLinePattern    = regexp.MustCompile(`\n+$`)
-	excerptPlaceholders               = []string{codeBlockPlaceholder, tablePlaceholder, imagePlaceholder}
+	markdownMermaidFencePattern = regexp.MustCompile("(?mi)^[ \t]*(?:```+|~~~+)[ \t]*mermaid(?:[ \t].*)?$")
+	excerptPlaceholders         = []string{codeBlockPlaceholder, tablePlaceholder, imagePlaceholder}
 )
 
 func ToHTML(input string, opts Options) template.HTML {
@@ -86,12 +82,12 @@ func ToHTML(input string, opts Options) template.HTML {
 		return template.HTML("")
 	}
 
-	p := parser.NewWithExtensions(parser.CommonExtensions | parser.AutoHeadingIDs)
+	p := parser.NewWithExtensions(parser.CommonExtensions | parser.AutoHeadingIDs | parser.Footnotes)
 	doc := p.Parse([]byte(input))
 	normalizeLinks(doc, opts)
 
 	renderer := mdhtml.NewRenderer(mdhtml.RendererOptions{
-		Flags: mdhtml.CommonFlags | mdhtml.SkipHTML,
+		Flags: rendererFlags(opts),
 		RenderNodeHook: func(writer io.Writer, node ast.Node, entering bool) (ast.WalkStatus, bool) {
 			return renderNodeHook(writer, node, entering, opts)
 		},
@@ -100,6 +96,22 @@ func ToHTML(input string, opts Options) template.HTML {
 	return template.HTML(md.Render(doc, renderer))
 }
 
+// HasMermaid reports whether the note contains a mermaid diagram block, so
+// pages only load the diagram renderer when they need it.
+func HasMermaid(input string) bool {
+	return markdownMermaidFencePattern.MatchString(input)
+}
+
+func rendererFlags(opts Options) mdhtml.Flags {
+	flags := mdhtml.CommonFlags | mdhtml.SkipHTML | mdhtml.FootnoteReturnLinks
+	flags &^= mdhtml.Smartypants | mdhtml.SmartypantsFractions | mdhtml.SmartypantsDashes | mdhtml.SmartypantsLatexDashes
+	if opts.Typographer {
+		flags |= mdhtml.Smartypants | mdhtml.SmartypantsDashes | mdhtml.SmartypantsLatexDashes
+	}
+
+	return flags
+}
+
 func Excerpt(input string, maxChars int) string {
 	return ExcerptWithOptions(input, maxChars, Options{})
 }
@@ -121,36 +133,6 @@ func ExcerptWithOptions(input string, maxChars int, opts Options) string {
 	return replaceExcerptPlaceholders(safeTruncate(clean, maxChars), opts)
 }
 
-func markdownToPlainText(markdown string) string {
-	text := markdown
-	text = markdownCodeBlockPattern.ReplaceAllString(text, codeBlockPlaceholder)
-	text = markdownTablePattern.ReplaceAllString(text, tablePlaceholder)
-	text = markdownImagePattern.ReplaceAllString(text, imagePlaceholder)
-	text = markdownHorizontalRulePattern.ReplaceAllString(text, "")
-	text = markdownFootnoteDefinitionPattern.ReplaceAllString(text, "")
-	text = markdownFootnoteReferencePattern.ReplaceAllString(text, "")
-
-	text = markdownBoldItalicPattern.ReplaceAllString(text, "$1")
-	text = markdownBoldPattern.ReplaceAllString(text, "$1")
-	text = markdownItalicAsteriskPattern.ReplaceAllString(text, "$1")
-	text = markdownItalicUnderscorePattern.ReplaceAllString(text, "$1")
-	text = markdownHeadingPattern.ReplaceAllString(text, "\n$1\n")
-	text = markdownStrikethroughPattern.ReplaceAllString(text, "$1")
-	text = markdownInlineCodePattern.ReplaceAllString(text, "`$1`")
-	text = markdownLinkPattern.ReplaceAllString(text, "$1")
-	text = markdownBlockquotePattern.ReplaceAllString(text, "$1")
-	text = markdownTaskListPattern.ReplaceAllString(text, "- ")
-	text = markdownOrderedListPattern.ReplaceAllString(text, "- ")
-	text = htmlTagPattern.ReplaceAllString(text, "")
-	text = markdownSpaceTabPattern.ReplaceAllString(text, " ")
-	text = markdownTripleNewLinePattern.ReplaceAllString(text, "\n\n")
-	text = markdownLeadingNewLinePattern.ReplaceAllString(text, "")
-	text = markdownTrailingNewLinePattern.ReplaceAllString(text, "")
-	text = strings.TrimSpace(text)
-
-	return text
-}
-
 func safeTruncate(text string, maxChars int) string {
 	runes := []rune(text)
 	if len(runes) <= maxChars {
@@ -278,10 +260,45 @@ func currentWebsiteRoots(opts Options) []string {
 }
 
 func renderNodeHook(writer io.Writer, node ast.Node, entering bool, opts Options) (ast.WalkStatus, bool) {
+	if literal, ok := rawHTMLLiteral(node); ok {
+		if entering {
+			renderRawHTML(writer, literal, opts)
+		}
+		return ast.GoToNext, true
+	}
+
 	switch typedNode := node.(type) {
 	case *ast.Heading:
 		renderHeading(writer, typedNode, entering)
 		return ast.GoToNext, true
+	case *ast.List:
+		if typedNode.IsFootnotesList {
+			renderFootnotesList(writer, entering)
+			return ast.GoToNext, true
+		}
+	case *ast.ListItem:
+		if typedNode.RefLink != nil {
+			renderFootnoteItem(writer, typedNode, entering, opts)
+			return ast.GoToNext, true
+		}
+	case *ast.Paragraph:
+		if item, ok := standaloneEmbed(typedNode, opts); ok {
+			if entering {
+				renderEmbed(writer, item)
+			}
+			return ast.SkipChildren, true
+		}
+		if image := standaloneImage(typedNode); image != nil {
+			if entering {
+				renderImageFigure(writer, image, opts)
+			}
+			return ast.SkipChildren, true
+		}
+	case *ast.Image:
+		if entering {
+			renderImage(writer, typedNode, opts)
+		}
+		return ast.SkipChildren, true
 	}
 
 	if !entering {
@@ -295,9 +312,6 @@ func renderNodeHook(writer io.Writer, node ast.Node, entering bool, opts Options
 	case *ast.Code:
 		renderInlineCode(writer, typedNode)
 		return ast.SkipChildren, true
-	case *ast.Image:
-		renderImage(writer, typedNode, opts)
-		return ast.SkipChildren, true
 	default:
 		return ast.GoToNext, false
 	}
@@ -341,6 +355,10 @@ func effectiveHeadingLevel(level int) int {
 func renderCodeBlock(writer io.Writer, block *ast.CodeBlock, opts Options) {
 	code := string(block.Literal)
 	language := codeLanguage(block.Info)
+	if language == mermaidLanguage {
+		renderMermaidBlock(writer, code)
+		return
+	}
 	languageLabel := language
 	if languageLabel == "" {
 		languageLabel = opts.plainTextLabel()
@@ -361,7 +379,7 @@ func renderCodeBlock(writer io.Writer, block *ast.CodeBlock, opts Options) {
 	_, _ = io.WriteString(writer, `</span></button>`)
 	_, _ = io.WriteString(writer, `</figcaption>`)
 
-	renderHighlightedCodeBlock(writer, language, code)
+	renderHighlightedCodeBlock(writer, language, code, block.Info, opts.CodeHighlight)
 
 	_, _ = io.WriteString(writer, `<textarea class="code-copy-source" aria-hidden="true" tabindex="-1" readonly>`)
 	_, _ = io.WriteString(writer, stdhtml.EscapeString(code))
@@ -369,6 +387,41 @@ func renderCodeBlock(writer io.Writer, block *ast.CodeBlock, opts Options) {
 	_, _ = io.WriteString(writer, `</figure>`)
 }
 
+func renderFootnotesList(writer io.Writer, entering bool) {
+	if entering {
+		_, _ = io.WriteString(writer, `<section class="footnotes" role="doc-endnotes"><ol class="footnotes-list">`)
+		return
+	}
+
+	_, _ = io.WriteString(writer, "</ol></section>\n")
+}
+
+// renderFootnoteItem keeps the fn:/fnref: anchors the default renderer uses for
+// references so both ends of a footnote link up.
+func renderFootnoteItem(writer io.Writer, item *ast.ListItem, entering bool, opts Options) {
+	slug := string(mdhtml.Slugify(item.RefLink))
+	if entering {
+		_, _ = io.WriteString(writer, `<li class="footnote-item" id="fn:`)
+		_, _ = io.WriteString(writer, stdhtml.EscapeString(slug))
+		_, _ = io.WriteString(writer, `">`)
+		return
+	}
+
+	_, _ = io.WriteString(writer, ` <a class="footnote-backref" href="#fnref:`)
+	_, _ = io.WriteString(writer, stdhtml.EscapeString(slug))
+	_, _ = io.WriteString(writer, `" role="doc-backlink" aria-label="`)
+	_, _ = io.WriteString(writer, stdhtml.EscapeString(opts.footnoteBackLabel()))
+	_, _ = io.WriteString(writer, `">↩</a></li>`)
+}
+
+// renderMermaidBlock leaves the diagram source readable until mermaid.js, when
+// the page loads it, replaces the block with the rendered SVG.
+func renderMermaidBlock(writer io.Writer, code string) {
+	_, _ = io.WriteString(writer, `<pre class="mermaid">`)
+	_, _ = io.WriteString(writer, stdhtml.EscapeString(code))
+	_, _ = io.WriteString(writer, "</pre>\n")
+}
+
 func (opts Options) codeCopyLabel() string {
 	return nonEmpty(opts.CodeCopyLabel, codeCopyLabel)
 }
@@ -381,6 +434,10 @@ func (opts Options) plainTextLabel() string {
 	return nonEmpty(opts.PlainTextLabel, plainTextLabel)
 }
 
+func (opts Options) footnoteBackLabel() string {
+	return nonEmpty(opts.FootnoteBackLabel, footnoteBackLabel)
+}
+
 func (opts Options) excerptCodeBlockLabel() string {
 	return nonEmpty(opts.ExcerptCodeBlockLabel, codeBlockLabel)
 }
@@ -405,7 +462,7 @@ func nonEmpty(value string, fallback string) string {
 	return trimmed
 }
 
-func renderHighlightedCodeBlock(writer io.Writer, language string, code string) {
+func renderHighlightedCodeBlock(writer io.Writer, language string, code string, info []byte, highlight CodeHighlight) {
 	lexer := pickLexer(language, code)
 	iterator, err := lexer.Tokenise(nil, code)
 	if err != nil {
@@ -413,12 +470,51 @@ func renderHighlightedCodeBlock(writer io.Writer, language string, code string)
 		return
 	}
 
-	formatter := chromahtml.New(chromahtml.WithClasses(true))
+	formatter := highlight.formatter(info)
 	if err := formatter.Format(writer, styles.Fallback, iterator); err != nil {
 		renderPlainCodeBlock(writer, code)
 	}
 }
 
+// standaloneImage returns the image when it is the only content of a
+// paragraph, so it can be rendered as a figure instead of inline.
+func standaloneImage(paragraph *ast.Paragraph) *ast.Image {
+	var image *ast.Image
+	for _, child := range paragraph.Children {
+		switch typed := child.(type) {
+		case *ast.Image:
+			if image != nil {
+				return nil
+			}
+			image = typed
+		case *ast.Text:
+			if strings.TrimSpace(string(typed.Literal)) != "" {
+				return nil
+			}
+		default:
+			return nil
+		}
+	}
+
+	return image
+}
+
+func renderImageFigure(writer io.Writer, image *ast.Image, opts Options) {
+	caption := strings.TrimSpace(collectImageText(image))
+	if caption == "" {
+		_, _ = io.WriteString(writer, `<p>`)
+		renderImage(writer, image, opts)
+		_, _ = io.WriteString(writer, "</p>\n")
+		return
+	}
+
+	_, _ = io.WriteString(writer, `<figure class="markdown-figure">`)
+	renderImage(writer, image, opts)
+	_, _ = io.WriteString(writer, `<figcaption class="markdown-figure-caption">`)
+	_, _ = io.WriteString(writer, stdhtml.EscapeString(caption))
+	_, _ = io.WriteString(writer, "</figcaption></figure>\n")
+}
+
 func renderImage(writer io.Writer, image *ast.Image, opts Options) {
 	if image == nil {
 		return
@@ -436,11 +532,18 @@ func renderImage(writer io.Writer, image *ast.Image, opts Options) {
 	titleText := stdhtml.EscapeString(strings.TrimSpace(string(image.Title)))
 	srcSet, err := opts.ImageLoader.ResponsiveSrcSet(rawSrc, 0)
 
-	_, _ = io.WriteString(writer, `<img src="`)
+	_, _ = io.WriteString(writer, `<img class="markdown-image" src="`)
 	_, _ = io.WriteString(writer, stdhtml.EscapeString(src))
 	_, _ = io.WriteString(writer, `" alt="`)
 	_, _ = io.WriteString(writer, altText)
-	_, _ = io.WriteString(writer, `" loading="lazy"`)
+	_, _ = io.WriteString(writer, `" loading="lazy" decoding="async"`)
+	if width, height, ok := opts.ImageLoader.Dimensions(rawSrc); ok {
+		_, _ = io.WriteString(writer, ` width="`)
+		_, _ = io.WriteString(writer, strconv.Itoa(width))
+		_, _ = io.WriteString(writer, `" height="`)
+		_, _ = io.WriteString(writer, strconv.Itoa(height))
+		_, _ = io.WriteString(writer, `"`)
+	}
 	if titleText != "" {
 		_, _ = io.WriteString(writer, ` title="`)
 		_, _ = io.WriteString(writer, titleText)
@@ -519,7 +622,8 @@ func codeLanguage(info []byte) string {
 		return ""
 	}
 
-	fields := strings.Fields(trimmed)
+	language, _, _ := strings.Cut(trimmed, "{")
+	fields := strings.Fields(language)
 	if len(fields) == 0 {
 		return ""
 	}
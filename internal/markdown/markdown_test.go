@@ -1,6 +1,7 @@
 package markdown
 
 import (
+	"strings"
 	"testing"
 
 	"blog/internal/imageloader"
@@ -125,6 +126,31 @@ func TestToHTML_TransformsImageSourcesWithLoader(t *testing.T) {
 	require.Contains(t, html, `sizes="(max-width: 660px) 100vw, 672px"`)
 }
 
+func TestToHTML_WrapsStandaloneImagesWithAltInFigure(t *testing.T) {
+	t.Parallel()
+
+	html := string(ToHTML(
+		"![A cat on a mat](/images/cat.png)\n\n![](/images/plain.png)\n\nText ![inline](/images/inline.png) here.",
+		Options{
+			ImageLoader: imageloader.New(true).WithDimensions(func(src string) (int, int, bool) {
+				return 1200, 800, src == "/images/cat.png"
+			}),
+		},
+	))
+
+	require.Contains(t, html, `<figure class="markdown-figure"><img class="markdown-image" `+
+		`src="/cdn/image/blog/1080/images/cat.png" alt="A cat on a mat" loading="lazy" decoding="async" `+
+		`width="1200" height="800"`)
+	require.Contains(t, html, `<figcaption class="markdown-figure-caption">A cat on a mat</figcaption></figure>`)
+	require.Contains(t, html, `<p><img class="markdown-image" src="/cdn/image/blog/1080/images/plain.png" alt="" `+
+		`loading="lazy" decoding="async" srcset=`)
+	require.Contains(t, html,
+		`<p>Text <img class="markdown-image" src="/cdn/image/blog/1080/images/inline.png" alt="inline"`)
+	require.Contains(t, html, `/> here.</p>`)
+	require.Equal(t, 1, strings.Count(html, "<figure"))
+	require.NotContains(t, html, `"" />`)
+}
+
 func TestToHTML_DemotesHeadingsToAvoidH1(t *testing.T) {
 	t.Parallel()
 
@@ -135,3 +161,93 @@ func TestToHTML_DemotesHeadingsToAvoidH1(t *testing.T) {
 	require.Contains(t, html, `<h3 id="section-title">Section title</h3>`)
 	require.Contains(t, html, `<h6 id="small-title">Small title</h6>`)
 }
+
+func TestToHTML_RendersFootnotesWithBacklinks(t *testing.T) {
+	html := string(ToHTML("Claim[^1] and more[^src].\n\n[^1]: First source.\n[^src]: Second *source*.\n", Options{
+		FootnoteBackLabel: "zurueck zum verweis",
+	}))
+
+	require.Contains(t, html, `<sup class="footnote-ref" id="fnref:1"><a href="#fn:1">1</a></sup>`)
+	require.Contains(t, html, `<section class="footnotes" role="doc-endnotes">`)
+	require.Contains(t, html, `<li class="footnote-item" id="fn:src">Second <em>source</em>.`)
+	require.Contains(t, html,
+		`<a class="footnote-backref" href="#fnref:1" role="doc-backlink" aria-label="zurueck zum verweis">`)
+	require.NotContains(t, html, "[^1]")
+}
+
+func TestExcerpt_StripsFootnotes(t *testing.T) {
+	got := Excerpt("Claim[^1] stands.\n\n[^1]: First source.", 100)
+	require.Equal(t, "Claim stands.", got)
+}
+
+func TestToHTML_RendersMermaidBlocksAsDiagramSource(t *testing.T) {
+	t.Parallel()
+
+	input := "Flow:\n\n```mermaid\ngraph TD\n  A-->B & C\n```\n\n```go\nfmt.Println(1)\n```"
+	html := string(ToHTML(input, Options{}))
+
+	require.Contains(t, html, "<pre class=\"mermaid\">graph TD\n  A--&gt;B &amp; C\n</pre>")
+	require.Equal(t, 1, strings.Count(html, `<figure class="code-block">`))
+	require.True(t, HasMermaid(input))
+	require.True(t, HasMermaid("~~~ Mermaid\nsequenceDiagram\n~~~"))
+	require.False(t, HasMermaid("```go\n// mermaid\n```"))
+}
+
+func TestToHTML_TypographerIsOptIn(t *testing.T) {
+	t.Parallel()
+
+	input := "\"Quoted\" it's -- a --- pause... `a -- \"b\"...`"
+
+	plain := string(ToHTML(input, Options{}))
+	require.Contains(t, plain, "&quot;Quoted&quot; it's -- a --- pause...")
+
+	smart := string(ToHTML(input, Options{Typographer: true}))
+	require.Contains(t, smart, "&ldquo;Quoted&rdquo; it&rsquo;s &ndash; a &mdash; pause&hellip;")
+	require.Contains(t, smart, `<code class="inline-code">a -- &#34;b&#34;...</code>`)
+}
+
+func TestExcerpt_FlattensNestedMarkdown(t *testing.T) {
+	t.Parallel()
+
+	input := "## A [**bold _link_**](https://example.com) title\n\n" +
+		"> quoted *line*\n>\n> - [x] done `x*y`\n>   - nested <b>item</b>\n\n" +
+		"| a | b |\n| - | - |\n| 1 | 2 |\n\n" +
+		"snake_case_name and 2*3*4 stay literal\n\n---\n\nend"
+
+	got := Excerpt(input, 500)
+
+	require.Equal(t, "A bold link title\n\nquoted line\n\n- done `x*y`\n- nested item\n\n[table]\n\n"+
+		"snake_case_name and 2*3*4 stay literal\n\nend", got)
+}
+
+const benchmarkNote = "# Rewriting the blog\n\n" +
+	"I'm tired of **heavy** NextJs runtime for a _simple_ blog. Rewriting it with [Go](external_link://a1) " +
+	"and `templ`, see the [framework](micro_post://n1) note.\n\n" +
+	"## Why\n\n" +
+	"- fewer moving parts\n- 1. faster builds\n- [x] no client bundle\n\n" +
+	"> Quotes with ***emphasis*** and ~~strike~~ text.\n\n" +
+	"```go\nfunc main() {\n\tfmt.Println(\"hello\")\n}\n```\n\n" +
+	"| a | b |\n| - | - |\n| 1 | 2 |\n\n" +
+	"![diagram](/images/diagram.png)\n\n" +
+	"Closing thoughts[^1] with <b>inline html</b> and a final paragraph that goes on for a while so " +
+	"the excerpt has to be truncated somewhere in the middle of this sentence.\n\n" +
+	"[^1]: A footnote.\n"
+
+func BenchmarkExcerpt(b *testing.B) {
+	for b.Loop() {
+		_ = Excerpt(benchmarkNote, 260)
+	}
+}
+
+func BenchmarkToHTML(b *testing.B) {
+	opts := Options{
+		TranslateLinks: map[string]string{"n1": "/note/framework"},
+		RootURL:        "https://revotale.com",
+		Typographer:    true,
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = ToHTML(benchmarkNote, opts)
+	}
+}
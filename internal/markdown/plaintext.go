@@ -0,0 +1,139 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gomarkdown/markdown/ast"
+	"github.com/gomarkdown/markdown/parser"
+)
+
+const plainTextParserExtensions = parser.CommonExtensions | parser.Footnotes
+
+var (
+	plainTextTaskPrefixPattern = regexp.MustCompile(`^\[[ xX]\]\s+`)
+	plainTextSpacePattern      = regexp.MustCompile(`[ \t]{2,}`)
+)
+
+// markdownToPlainText flattens the parsed document into the text shown in
+// excerpts: one paragraph per block, "- " per list item, and placeholders for
+// code blocks, tables and images. Footnotes and raw HTML are dropped.
+func markdownToPlainText(markdown string) string {
+	if strings.TrimSpace(markdown) == "" {
+		return ""
+	}
+
+	doc := parser.NewWithExtensions(plainTextParserExtensions).Parse([]byte(markdown))
+	var writer plainTextWriter
+	writer.blocks(doc.GetChildren())
+
+	return strings.TrimSpace(writer.out.String())
+}
+
+type plainTextWriter struct {
+	out strings.Builder
+}
+
+func (w *plainTextWriter) blocks(nodes []ast.Node) {
+	for _, node := range nodes {
+		w.block(node)
+	}
+}
+
+func (w *plainTextWriter) block(node ast.Node) {
+	switch typed := node.(type) {
+	case *ast.Footnotes, *ast.HorizontalRule, *ast.HTMLBlock:
+		return
+	case *ast.List:
+		if typed.IsFootnotesList {
+			return
+		}
+		w.paragraph(strings.Join(plainTextListLines(typed, nil), "\n"))
+	case *ast.BlockQuote, *ast.Aside:
+		w.blocks(typed.GetChildren())
+	case *ast.CodeBlock:
+		w.paragraph(codeBlockPlaceholder)
+	case *ast.Table:
+		w.paragraph(tablePlaceholder)
+	default:
+		w.paragraph(plainTextInline(node))
+	}
+}
+
+func (w *plainTextWriter) paragraph(text string) {
+	text = strings.TrimSpace(plainTextSpacePattern.ReplaceAllString(text, " "))
+	if text == "" {
+		return
+	}
+	if w.out.Len() > 0 {
+		w.out.WriteString("\n\n")
+	}
+	w.out.WriteString(text)
+}
+
+func plainTextListLines(list *ast.List, lines []string) []string {
+	for _, child := range list.Children {
+		item, ok := child.(*ast.ListItem)
+		if !ok {
+			continue
+		}
+
+		parts := make([]string, 0, len(item.Children))
+		var nested []*ast.List
+		for _, block := range item.Children {
+			if sublist, ok := block.(*ast.List); ok {
+				nested = append(nested, sublist)
+				continue
+			}
+			if text := strings.TrimSpace(plainTextInline(block)); text != "" {
+				parts = append(parts, text)
+			}
+		}
+		if len(parts) > 0 {
+			lines = append(lines, "- "+plainTextTaskPrefixPattern.ReplaceAllString(strings.Join(parts, " "), ""))
+		}
+		for _, sublist := range nested {
+			lines = plainTextListLines(sublist, lines)
+		}
+	}
+
+	return lines
+}
+
+func plainTextInline(node ast.Node) string {
+	var builder strings.Builder
+	ast.WalkFunc(node, func(current ast.Node, entering bool) ast.WalkStatus {
+		if !entering {
+			return ast.GoToNext
+		}
+
+		switch typed := current.(type) {
+		case *ast.Text:
+			builder.Write(typed.Literal)
+		case *ast.Code:
+			builder.WriteByte('`')
+			builder.Write(typed.Literal)
+			builder.WriteByte('`')
+		case *ast.Math:
+			builder.Write(typed.Literal)
+		case *ast.Hardbreak:
+			builder.WriteByte('\n')
+		case *ast.Image:
+			builder.WriteString(imagePlaceholder)
+			return ast.SkipChildren
+		case *ast.CodeBlock:
+			builder.WriteString(codeBlockPlaceholder)
+		case *ast.Table:
+			builder.WriteString(tablePlaceholder)
+			return ast.SkipChildren
+		case *ast.Link:
+			if typed.NoteID != 0 {
+				return ast.SkipChildren
+			}
+		}
+
+		return ast.GoToNext
+	})
+
+	return builder.String()
+}
@@ -0,0 +1,76 @@
+package markdown
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+const wordsPerMinute = 220
+
+// CJK scripts are read per character rather than per whitespace-separated word.
+const cjkCharsPerMinute = 500
+
+type ReadingStats struct {
+	Words   int
+	Minutes int
+}
+
+// Reading estimates the word count and reading time of a markdown note from
+// the same plain text used for excerpts. Code blocks, tables and images are
+// not counted.
+func Reading(input string) ReadingStats {
+	clean := markdownToPlainText(input)
+	if clean == "" {
+		return ReadingStats{}
+	}
+
+	words := 0
+	cjkChars := 0
+	for _, field := range strings.Fields(clean) {
+		if isExcerptPlaceholder(field) {
+			continue
+		}
+
+		cjkInField := 0
+		hasOther := false
+		for _, r := range field {
+			switch {
+			case isCJK(r):
+				cjkInField++
+			case unicode.IsLetter(r) || unicode.IsDigit(r):
+				hasOther = true
+			}
+		}
+		cjkChars += cjkInField
+		if hasOther {
+			words++
+		}
+	}
+
+	total := words + cjkChars
+	if total == 0 {
+		return ReadingStats{}
+	}
+
+	minutes := math.Ceil(float64(words)/wordsPerMinute + float64(cjkChars)/cjkCharsPerMinute)
+
+	return ReadingStats{
+		Words:   total,
+		Minutes: max(1, int(minutes)),
+	}
+}
+
+func isExcerptPlaceholder(field string) bool {
+	for _, placeholder := range excerptPlaceholders {
+		if strings.Contains(field, placeholder) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
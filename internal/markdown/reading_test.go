@@ -0,0 +1,39 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReading_EmptyInput(t *testing.T) {
+	require.Equal(t, ReadingStats{}, Reading("  \n"))
+}
+
+func TestReading_ShortNoteRoundsUpToOneMinute(t *testing.T) {
+	got := Reading("# Title\n\nSome **bold** text with a [link](external_link://a1).")
+	require.Equal(t, ReadingStats{Words: 7, Minutes: 1}, got)
+}
+
+func TestReading_SkipsCodeBlocksTablesAndImages(t *testing.T) {
+	input := "" +
+		"before\n" +
+		"```go\nfmt.Println(\"x\")\n```\n" +
+		"![img](https://example.com/p.png)\n" +
+		"| a | b |\n" +
+		"| - | - |\n" +
+		"after"
+
+	require.Equal(t, ReadingStats{Words: 2, Minutes: 1}, Reading(input))
+}
+
+func TestReading_EstimatesMinutesFromWordCount(t *testing.T) {
+	input := strings.Repeat("word ", 1000)
+	require.Equal(t, ReadingStats{Words: 1000, Minutes: 5}, Reading(input))
+}
+
+func TestReading_CountsCJKCharacters(t *testing.T) {
+	input := strings.Repeat("日本語", 400)
+	require.Equal(t, ReadingStats{Words: 1200, Minutes: 3}, Reading(input))
+}
@@ -0,0 +1,73 @@
+package markdown
+
+import (
+	"io"
+	"regexp"
+	"sync"
+
+	"github.com/gomarkdown/markdown/ast"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+type RawHTMLMode int
+
+const (
+	// RawHTMLSkip drops raw HTML from the markdown source.
+	RawHTMLSkip RawHTMLMode = iota
+	// RawHTMLSanitize keeps raw HTML that passes the inline allowlist, such as
+	// <kbd> and <details>, and strips everything else including scripts.
+	RawHTMLSanitize
+)
+
+var (
+	rawHTMLPolicyOnce sync.Once
+	rawHTMLPolicy     *bluemonday.Policy
+)
+
+func sanitizeRawHTML(raw []byte) []byte {
+	rawHTMLPolicyOnce.Do(func() {
+		rawHTMLPolicy = newRawHTMLPolicy()
+	})
+	return rawHTMLPolicy.SanitizeBytes(raw)
+}
+
+func newRawHTMLPolicy() *bluemonday.Policy {
+	policy := bluemonday.NewPolicy()
+	policy.AllowElements(
+		"abbr", "b", "br", "cite", "code", "dd", "del", "details", "dfn", "div", "dl", "dt", "em",
+		"figcaption", "figure", "hr", "i", "ins", "kbd", "mark", "p", "pre", "q", "s", "samp", "small",
+		"span", "strong", "sub", "summary", "sup", "time", "u", "var",
+	)
+	policy.AllowLists()
+	policy.AllowTables()
+	policy.AllowAttrs("title").Globally()
+	policy.AllowAttrs("lang").Matching(regexp.MustCompile(`^[A-Za-z-]{2,16}$`)).Globally()
+	policy.AllowAttrs("open").Matching(regexp.MustCompile(`^(?:|open)$`)).OnElements("details")
+	policy.AllowAttrs("datetime").OnElements("time", "ins", "del")
+	policy.AllowAttrs("cite").OnElements("q", "ins", "del")
+
+	policy.AllowStandardURLs()
+	policy.AllowAttrs("href").OnElements("a")
+	policy.RequireNoReferrerOnLinks(true)
+	policy.AddTargetBlankToFullyQualifiedLinks(true)
+
+	return policy
+}
+
+func renderRawHTML(writer io.Writer, literal []byte, opts Options) {
+	if opts.RawHTML != RawHTMLSanitize {
+		return
+	}
+	_, _ = writer.Write(sanitizeRawHTML(literal))
+}
+
+func rawHTMLLiteral(node ast.Node) ([]byte, bool) {
+	switch typed := node.(type) {
+	case *ast.HTMLBlock:
+		return typed.Literal, true
+	case *ast.HTMLSpan:
+		return typed.Literal, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,38 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const rawHTMLInput = "Press <kbd>Ctrl</kbd>+<kbd>C</kbd> <span onclick=\"x()\">now</span>.\n\n" +
+	"<details open>\n<summary>More</summary>\n\nHidden <mark>text</mark>.\n\n</details>\n\n" +
+	"<script>alert(1)</script>\n\n" +
+	"<a href=\"javascript:alert(1)\">bad</a> <iframe src=\"https://evil.example\"></iframe>"
+
+func TestToHTML_SkipsRawHTMLByDefault(t *testing.T) {
+	t.Parallel()
+
+	html := string(ToHTML(rawHTMLInput, Options{}))
+
+	require.NotContains(t, html, "<kbd>")
+	require.NotContains(t, html, "<details")
+	require.NotContains(t, html, "<script")
+}
+
+func TestToHTML_SanitizesRawHTML(t *testing.T) {
+	t.Parallel()
+
+	html := string(ToHTML(rawHTMLInput, Options{RawHTML: RawHTMLSanitize}))
+
+	require.Contains(t, html, "Press <kbd>Ctrl</kbd>+<kbd>C</kbd> <span>now</span>.")
+	require.Contains(t, html, "<details open")
+	require.Contains(t, html, "<summary>More</summary>")
+	require.Contains(t, html, "<mark>text</mark>")
+	require.Contains(t, html, "</details>")
+	require.NotContains(t, html, "<script")
+	require.NotContains(t, html, "onclick")
+	require.NotContains(t, html, "javascript:")
+	require.NotContains(t, html, "<iframe")
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+const AdminPathPrefix = "/.admin/"
+const adminCacheControl = "private, no-store"
+const adminRealm = `Basic realm="blog admin", charset="UTF-8"`
+
+func WithAdminAuth(token string) func(http.Handler) http.Handler {
+	token = strings.TrimSpace(token)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if next == nil {
+				return
+			}
+			if r == nil || r.URL == nil || !strings.HasPrefix(r.URL.Path, AdminPathPrefix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if token == "" {
+				http.NotFound(w, r)
+				return
+			}
+
+			w.Header().Set("X-Robots-Tag", noIndexRobotsTag)
+			if !adminAuthorized(r, token) {
+				w.Header().Set("Cache-Control", adminCacheControl)
+				w.Header().Set("WWW-Authenticate", adminRealm)
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(&privateResponseWriter{ResponseWriter: w}, r)
+		})
+	}
+}
+
+func adminAuthorized(r *http.Request, token string) bool {
+	if _, password, ok := r.BasicAuth(); ok {
+		return secretsEqual(password, token)
+	}
+
+	authorization := strings.TrimSpace(r.Header.Get("Authorization"))
+	scheme, credentials, found := strings.Cut(authorization, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return false
+	}
+	return secretsEqual(strings.TrimSpace(credentials), token)
+}
+
+func secretsEqual(provided string, expected string) bool {
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(expected)) == 1
+}
+
+// PrivateResponseWriter keeps the response written through it out of shared
+// caches, for pages rendered for one visitor.
+func PrivateResponseWriter(w http.ResponseWriter) http.ResponseWriter {
+	return &privateResponseWriter{ResponseWriter: w}
+}
+
+type privateResponseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *privateResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.Header().Set("Cache-Control", adminCacheControl)
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *privateResponseWriter) Write(content []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(content)
+}
+
+func (w *privateResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
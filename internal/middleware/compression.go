@@ -0,0 +1,410 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+const encodingBrotli = "br"
+const encodingGzip = "gzip"
+const defaultCompressionMinSize = 1024
+
+var defaultCompressibleContentTypes = []string{
+	"application/atom+xml",
+	"application/javascript",
+	"application/json",
+	"application/manifest+json",
+	"application/rss+xml",
+	"application/xml",
+	"image/svg+xml",
+	"text/css",
+	"text/html",
+	"text/javascript",
+	"text/plain",
+	"text/xml",
+}
+
+type CompressionConfig struct {
+	MinSize      int
+	ContentTypes []string
+
+	StaticFiles      fs.FS
+	StaticPathPrefix func() string
+}
+
+func WithCompression(cfg CompressionConfig) func(http.Handler) http.Handler {
+	minSize := cfg.MinSize
+	if minSize <= 0 {
+		minSize = defaultCompressionMinSize
+	}
+	contentTypes := normalizedContentTypes(cfg.ContentTypes)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if next == nil {
+				return
+			}
+			addVary(w.Header(), "Accept-Encoding")
+			if r == nil || r.URL == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			inner := withoutAcceptEncoding(r)
+			if encoding == "" || r.Method == http.MethodHead {
+				next.ServeHTTP(w, inner)
+				return
+			}
+
+			if cfg.StaticFiles != nil && cfg.StaticPathPrefix != nil {
+				sidecarPath, ok := staticSidecarPath(cfg.StaticFiles, cfg.StaticPathPrefix(), r.URL.Path, encoding)
+				if ok {
+					serveSidecar(next, w, inner, sidecarPath, encoding)
+					return
+				}
+			}
+
+			writer := &compressResponseWriter{
+				ResponseWriter: w,
+				encoding:       encoding,
+				minSize:        minSize,
+				contentTypes:   contentTypes,
+			}
+			defer func() {
+				_ = writer.Close()
+			}()
+
+			next.ServeHTTP(writer, inner)
+		})
+	}
+}
+
+func withoutAcceptEncoding(r *http.Request) *http.Request {
+	if strings.TrimSpace(r.Header.Get("Accept-Encoding")) == "" {
+		return r
+	}
+
+	clone := r.Clone(r.Context())
+	clone.Header.Del("Accept-Encoding")
+	return clone
+}
+
+func staticSidecarPath(staticFiles fs.FS, prefix string, requestPath string, encoding string) (string, bool) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" || !strings.HasPrefix(requestPath, prefix) {
+		return "", false
+	}
+
+	relative := strings.TrimPrefix(path.Clean("/"+strings.TrimPrefix(requestPath, prefix)), "/")
+	if relative == "" || relative == "." {
+		return "", false
+	}
+
+	sidecar := relative + sidecarExtension(encoding)
+	info, err := fs.Stat(staticFiles, sidecar)
+	if err != nil || info.IsDir() {
+		return "", false
+	}
+
+	return requestPath + sidecarExtension(encoding), true
+}
+
+func sidecarExtension(encoding string) string {
+	if encoding == encodingBrotli {
+		return ".br"
+	}
+	return ".gz"
+}
+
+func serveSidecar(next http.Handler, w http.ResponseWriter, r *http.Request, sidecarPath string, encoding string) {
+	originalPath := r.URL.Path
+	sidecarRequest := r.Clone(r.Context())
+	sidecarRequest.URL.Path = sidecarPath
+	sidecarRequest.URL.RawPath = ""
+
+	header := w.Header()
+	if contentType := mime.TypeByExtension(path.Ext(originalPath)); contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+
+	next.ServeHTTP(&sidecarResponseWriter{ResponseWriter: w, encoding: encoding}, sidecarRequest)
+}
+
+type sidecarResponseWriter struct {
+	http.ResponseWriter
+	encoding    string
+	wroteHeader bool
+}
+
+func (w *sidecarResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if statusCode == http.StatusOK || statusCode == http.StatusPartialContent {
+		w.Header().Set("Content-Encoding", w.encoding)
+	} else {
+		w.Header().Del("Content-Encoding")
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *sidecarResponseWriter) Write(content []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(content)
+}
+
+type compressResponseWriter struct {
+	http.ResponseWriter
+	encoding     string
+	minSize      int
+	contentTypes []string
+
+	statusCode  int
+	wroteHeader bool
+	decided     bool
+	compress    bool
+	buffer      bytes.Buffer
+	writer      io.WriteCloser
+}
+
+func (w *compressResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.statusCode = statusCode
+
+	if !w.eligible() {
+		w.decide(false)
+		return
+	}
+
+	if contentLength := w.Header().Get("Content-Length"); contentLength != "" {
+		if size, err := strconv.Atoi(contentLength); err == nil && size < w.minSize {
+			w.decide(false)
+		}
+	}
+}
+
+func (w *compressResponseWriter) Write(content []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.decided {
+		if w.compress {
+			return w.writer.Write(content)
+		}
+		return w.ResponseWriter.Write(content)
+	}
+
+	written, _ := w.buffer.Write(content)
+	if w.buffer.Len() >= w.minSize {
+		if err := w.decide(true); err != nil {
+			return 0, err
+		}
+	}
+
+	return written, nil
+}
+
+func (w *compressResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.decided {
+		_ = w.decide(true)
+	}
+	if flusher, ok := w.writer.(interface{ Flush() error }); ok && w.compress {
+		_ = flusher.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *compressResponseWriter) Close() error {
+	if !w.wroteHeader {
+		return nil
+	}
+	if !w.decided {
+		if err := w.decide(false); err != nil {
+			return err
+		}
+	}
+	if w.compress && w.writer != nil {
+		return w.writer.Close()
+	}
+	return nil
+}
+
+func (w *compressResponseWriter) eligible() bool {
+	if !bodyAllowedForStatus(w.statusCode) {
+		return false
+	}
+
+	header := w.Header()
+	if strings.TrimSpace(header.Get("Content-Encoding")) != "" {
+		return false
+	}
+
+	return contentTypeAllowed(header.Get("Content-Type"), w.contentTypes)
+}
+
+func (w *compressResponseWriter) decide(compress bool) error {
+	w.decided = true
+	w.compress = compress
+
+	header := w.Header()
+	if compress {
+		header.Del("Content-Length")
+		header.Set("Content-Encoding", w.encoding)
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set("ETag", "W/"+etag)
+		}
+		w.ResponseWriter.WriteHeader(w.statusCode)
+		w.writer = newEncoder(w.encoding, w.ResponseWriter)
+		if w.buffer.Len() == 0 {
+			return nil
+		}
+		_, err := w.writer.Write(w.buffer.Bytes())
+		w.buffer.Reset()
+		return err
+	}
+
+	w.ResponseWriter.WriteHeader(w.statusCode)
+	if w.buffer.Len() == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(w.buffer.Bytes())
+	w.buffer.Reset()
+	return err
+}
+
+func newEncoder(encoding string, w io.Writer) io.WriteCloser {
+	if encoding == encodingBrotli {
+		return brotli.NewWriterLevel(w, brotli.DefaultCompression)
+	}
+	return gzip.NewWriter(w)
+}
+
+func negotiateEncoding(headerValue string) string {
+	brQuality := -1.0
+	gzipQuality := -1.0
+	wildcardQuality := -1.0
+
+	for _, part := range strings.Split(headerValue, ",") {
+		token, quality := parseEncodingToken(part)
+		switch token {
+		case encodingBrotli:
+			brQuality = quality
+		case encodingGzip:
+			gzipQuality = quality
+		case "*":
+			wildcardQuality = quality
+		}
+	}
+
+	if brQuality < 0 {
+		brQuality = wildcardQuality
+	}
+	if gzipQuality < 0 {
+		gzipQuality = wildcardQuality
+	}
+
+	switch {
+	case brQuality > 0 && brQuality >= gzipQuality:
+		return encodingBrotli
+	case gzipQuality > 0:
+		return encodingGzip
+	default:
+		return ""
+	}
+}
+
+func parseEncodingToken(part string) (string, float64) {
+	token := strings.TrimSpace(part)
+	if token == "" {
+		return "", 0
+	}
+
+	quality := 1.0
+	if semicolon := strings.Index(token, ";"); semicolon >= 0 {
+		for _, param := range strings.Split(token[semicolon+1:], ";") {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(strings.ToLower(param), "q=") {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(param[2:]), 64)
+			if err != nil {
+				quality = 0
+				continue
+			}
+			quality = parsed
+		}
+		token = strings.TrimSpace(token[:semicolon])
+	}
+
+	return strings.ToLower(token), quality
+}
+
+func contentTypeAllowed(contentType string, allowlist []string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return slices.Contains(allowlist, strings.ToLower(mediaType))
+}
+
+func normalizedContentTypes(values []string) []string {
+	if len(values) == 0 {
+		return defaultCompressibleContentTypes
+	}
+
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" {
+			continue
+		}
+		normalized = append(normalized, value)
+	}
+
+	return normalized
+}
+
+func bodyAllowedForStatus(statusCode int) bool {
+	if statusCode >= 100 && statusCode < 200 {
+		return false
+	}
+	return statusCode != http.StatusNoContent && statusCode != http.StatusNotModified
+}
+
+func addVary(header http.Header, value string) {
+	for _, existing := range strings.Split(header.Get("Vary"), ",") {
+		if strings.EqualFold(strings.TrimSpace(existing), value) {
+			return
+		}
+	}
+
+	current := strings.TrimSpace(header.Get("Vary"))
+	if current == "" {
+		header.Set("Vary", value)
+		return
+	}
+	header.Set("Vary", current+", "+value)
+}
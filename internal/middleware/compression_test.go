@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/require"
+)
+
+func serveCompressed(
+	t *testing.T,
+	handler http.Handler,
+	target string,
+	acceptEncoding string,
+) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestWithCompressionPrefersBrotli(t *testing.T) {
+	t.Parallel()
+
+	body := strings.Repeat("<p>hello</p>", 200)
+	handler := WithCompression(CompressionConfig{})(htmlHandler(body))
+	rec := serveCompressed(t, handler, "/", "gzip, deflate, br")
+
+	require.Equal(t, "br", rec.Header().Get("Content-Encoding"))
+	require.Contains(t, rec.Header().Get("Vary"), "Accept-Encoding")
+	decoded, err := io.ReadAll(brotli.NewReader(rec.Body))
+	require.NoError(t, err)
+	require.Equal(t, body, string(decoded))
+}
+
+func TestWithCompressionFallsBackToGzip(t *testing.T) {
+	t.Parallel()
+
+	body := strings.Repeat("<p>hello</p>", 200)
+	handler := WithCompression(CompressionConfig{})(htmlHandler(body))
+	rec := serveCompressed(t, handler, "/", "gzip, br;q=0")
+
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	reader, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, body, string(decoded))
+}
+
+func TestWithCompressionSkipsSmallAndDisallowedResponses(t *testing.T) {
+	t.Parallel()
+
+	small := serveCompressed(t, WithCompression(CompressionConfig{})(htmlHandler("<p>tiny</p>")), "/", "br")
+	require.Empty(t, small.Header().Get("Content-Encoding"))
+	require.Equal(t, "<p>tiny</p>", small.Body.String())
+
+	image := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write([]byte(strings.Repeat("x", 4096)))
+	})
+	rec := serveCompressed(t, WithCompression(CompressionConfig{})(image), "/", "br")
+	require.Empty(t, rec.Header().Get("Content-Encoding"))
+	require.Len(t, rec.Body.String(), 4096)
+}
+
+func TestWithCompressionWeakensStrongETag(t *testing.T) {
+	t.Parallel()
+
+	body := strings.Repeat("<p>hello</p>", 200)
+	handler := WithCompression(CompressionConfig{})(WithETag(htmlHandler(body)))
+	rec := serveCompressed(t, handler, "/", "gzip")
+
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	require.True(t, strings.HasPrefix(rec.Header().Get("ETag"), `W/"`))
+}
+
+func TestWithCompressionServesPrecompressedStaticSidecar(t *testing.T) {
+	t.Parallel()
+
+	staticDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(staticDir, "app.js"), []byte("console.log(1)"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(staticDir, "app.js.br"), []byte("brotli-bytes"), 0o600))
+
+	files := http.StripPrefix("/_assets/", http.FileServer(http.Dir(staticDir)))
+	handler := WithCompression(CompressionConfig{
+		StaticFiles: os.DirFS(staticDir),
+		StaticPathPrefix: func() string {
+			return "/_assets/"
+		},
+	})(files)
+
+	rec := serveCompressed(t, handler, "/_assets/app.js", "br, gzip")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "br", rec.Header().Get("Content-Encoding"))
+	require.Contains(t, rec.Header().Get("Content-Type"), "javascript")
+	require.Equal(t, "brotli-bytes", rec.Body.String())
+
+	gzipOnly := serveCompressed(t, handler, "/_assets/app.js", "gzip")
+	require.Empty(t, gzipOnly.Header().Get("Content-Encoding"))
+	require.Equal(t, "console.log(1)", gzipOnly.Body.String())
+}
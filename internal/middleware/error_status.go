@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+)
+
+type errorStatusContextKey struct{}
+
+// WithErrorStatus lets loaders pick the status of a failed response. The
+// framework answers every error that is not a not-found with 500; when a
+// loader called SetErrorStatus, that 500 is sent with the chosen status
+// instead, so a rejected or invalid CMS query does not read as a server fault.
+func WithErrorStatus(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if next == nil {
+			return
+		}
+		if r == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		status := new(atomic.Int32)
+		writer := &errorStatusResponseWriter{ResponseWriter: w, status: status}
+		next.ServeHTTP(writer, r.WithContext(context.WithValue(r.Context(), errorStatusContextKey{}, status)))
+	})
+}
+
+// SetErrorStatus records the status to send if the request ends in a server
+// error. Only 4xx statuses are accepted; it is a no-op outside WithErrorStatus.
+func SetErrorStatus(ctx context.Context, statusCode int) {
+	if ctx == nil || statusCode < http.StatusBadRequest || statusCode >= http.StatusInternalServerError {
+		return
+	}
+	status, ok := ctx.Value(errorStatusContextKey{}).(*atomic.Int32)
+	if !ok || status == nil {
+		return
+	}
+
+	status.CompareAndSwap(0, int32(statusCode))
+}
+
+type errorStatusResponseWriter struct {
+	http.ResponseWriter
+	status *atomic.Int32
+}
+
+func (w *errorStatusResponseWriter) WriteHeader(statusCode int) {
+	if statusCode == http.StatusInternalServerError {
+		if override := int(w.status.Load()); override != 0 {
+			statusCode = override
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *errorStatusResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *errorStatusResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
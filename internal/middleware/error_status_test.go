@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithErrorStatusReplacesServerErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	handler := WithErrorStatus(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		SetErrorStatus(r.Context(), http.StatusForbidden)
+		SetErrorStatus(r.Context(), http.StatusBadRequest)
+		http.Error(w, "failed", http.StatusInternalServerError)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/note/restricted", nil))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestWithErrorStatusKeepsOtherResponses(t *testing.T) {
+	t.Parallel()
+
+	notFound := WithErrorStatus(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		SetErrorStatus(r.Context(), http.StatusForbidden)
+		http.NotFound(w, r)
+	}))
+	rec := httptest.NewRecorder()
+	notFound.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/note/missing", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	unset := WithErrorStatus(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		SetErrorStatus(r.Context(), http.StatusBadGateway)
+		http.Error(w, "failed", http.StatusInternalServerError)
+	}))
+	rec = httptest.NewRecorder()
+	unset.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+}
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// WithETag gives successful HTML responses to GET and HEAD a strong ETag
+// hashed from the body, and answers a matching If-None-Match with 304. HTML
+// responses are buffered to hash them; everything else, such as images and
+// static assets, streams through untouched.
+func WithETag(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if next == nil {
+			return
+		}
+		if r == nil || !isReadMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		writer := &etagResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(writer, r)
+		writer.finish(r)
+	})
+}
+
+type etagResponseWriter struct {
+	http.ResponseWriter
+	body        bytes.Buffer
+	statusCode  int
+	wroteHeader bool
+	passthrough bool
+}
+
+func (w *etagResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.statusCode = statusCode
+
+	if statusCode != http.StatusOK || strings.TrimSpace(w.Header().Get("ETag")) != "" ||
+		!isHTMLContentType(w.Header().Get("Content-Type")) {
+		w.passthrough = true
+		w.ResponseWriter.WriteHeader(statusCode)
+	}
+}
+
+func (w *etagResponseWriter) Write(content []byte) (int, error) {
+	if !w.wroteHeader {
+		// Sniff the type as net/http would, so the body can be told apart
+		// before deciding whether to buffer it.
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", http.DetectContentType(content))
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.passthrough {
+		return w.ResponseWriter.Write(content)
+	}
+	return w.body.Write(content)
+}
+
+func (w *etagResponseWriter) Flush() {
+	if !w.passthrough {
+		// Streaming responses can't be hashed up front, so send what's buffered untagged.
+		w.passthrough = true
+		w.wroteHeader = true
+		w.ResponseWriter.WriteHeader(w.statusCode)
+		if w.body.Len() > 0 {
+			_, _ = w.ResponseWriter.Write(w.body.Bytes())
+			w.body.Reset()
+		}
+	}
+
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *etagResponseWriter) finish(r *http.Request) {
+	if w.passthrough {
+		return
+	}
+
+	etag := strongETag(w.body.Bytes())
+	w.Header().Set("ETag", etag)
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		header := w.Header()
+		header.Del("Content-Type")
+		header.Del("Content-Length")
+		w.ResponseWriter.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(w.body.Len()))
+	w.ResponseWriter.WriteHeader(w.statusCode)
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.ResponseWriter.Write(w.body.Bytes())
+}
+
+func strongETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+func etagMatches(headerValue string, etag string) bool {
+	headerValue = strings.TrimSpace(headerValue)
+	if headerValue == "" {
+		return false
+	}
+	if headerValue == "*" {
+		return true
+	}
+
+	for _, candidate := range strings.Split(headerValue, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isHTMLContentType(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	return strings.EqualFold(strings.TrimSpace(mediaType), "text/html")
+}
+
+func isReadMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
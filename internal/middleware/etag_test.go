@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func htmlHandler(body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+func TestWithETagSetsStrongETag(t *testing.T) {
+	t.Parallel()
+
+	handler := WithETag(htmlHandler("<p>hello</p>"))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "<p>hello</p>", rec.Body.String())
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	require.NotContains(t, etag, "W/")
+	require.Equal(t, "12", rec.Header().Get("Content-Length"))
+
+	second := httptest.NewRecorder()
+	handler.ServeHTTP(second, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, etag, second.Header().Get("ETag"))
+}
+
+func TestWithETagAnswersIfNoneMatchWithNotModified(t *testing.T) {
+	t.Parallel()
+
+	handler := WithETag(htmlHandler("<p>hello</p>"))
+	first := httptest.NewRecorder()
+	handler.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/", nil))
+	etag := first.Header().Get("ETag")
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-None-Match", `"other", `+etag)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotModified, rec.Code)
+	require.Empty(t, rec.Body.String())
+	require.Equal(t, etag, rec.Header().Get("ETag"))
+}
+
+func TestWithETagReturnsFullBodyWhenContentChanges(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-None-Match", `"stale"`)
+	rec := httptest.NewRecorder()
+	WithETag(htmlHandler("<p>fresh</p>")).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "<p>fresh</p>", rec.Body.String())
+}
+
+func TestWithETagSkipsErrorsAndUnsafeMethods(t *testing.T) {
+	t.Parallel()
+
+	failing := WithETag(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	rec := httptest.NewRecorder()
+	failing.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Empty(t, rec.Header().Get("ETag"))
+
+	post := httptest.NewRecorder()
+	WithETag(htmlHandler("ok")).ServeHTTP(post, httptest.NewRequest(http.MethodPost, "/", nil))
+	require.Equal(t, http.StatusOK, post.Code)
+	require.Empty(t, post.Header().Get("ETag"))
+}
+
+func TestWithETagOnlyBuffersHTML(t *testing.T) {
+	t.Parallel()
+
+	image := WithETag(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "image/webp")
+		_, _ = w.Write([]byte("RIFF....WEBP"))
+	}))
+	rec := httptest.NewRecorder()
+	image.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/_img/a.webp", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "RIFF....WEBP", rec.Body.String())
+	require.Empty(t, rec.Header().Get("ETag"))
+	require.Empty(t, rec.Header().Get("Content-Length"), "non-HTML bodies are not buffered")
+
+	sniffed := WithETag(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("<!doctype html><p>hi</p>"))
+	}))
+	rec = httptest.NewRecorder()
+	sniffed.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.NotEmpty(t, rec.Header().Get("ETag"))
+	require.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const linkHeader = "Link"
+
+type linksContextKey struct{}
+
+type links struct {
+	mu     sync.Mutex
+	values []string
+}
+
+// WithLinkHeader collects the links handlers declare through AddLink and sends
+// them as a Link header, so crawlers can follow rel="prev"/"next" pagination
+// without parsing the page.
+func WithLinkHeader(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if next == nil {
+			return
+		}
+		if r == nil || !isReadMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		collected := &links{}
+		writer := &linkHeaderResponseWriter{ResponseWriter: w, links: collected}
+		next.ServeHTTP(writer, r.WithContext(context.WithValue(r.Context(), linksContextKey{}, collected)))
+	})
+}
+
+// AddLink declares a link to targetURL with the given relation for the
+// response being rendered. It is a no-op outside WithLinkHeader.
+func AddLink(ctx context.Context, targetURL string, rel string) {
+	targetURL = strings.TrimSpace(targetURL)
+	rel = strings.TrimSpace(rel)
+	if ctx == nil || targetURL == "" || rel == "" || strings.ContainsAny(targetURL, "<>\r\n") {
+		return
+	}
+	collected, ok := ctx.Value(linksContextKey{}).(*links)
+	if !ok || collected == nil {
+		return
+	}
+
+	value := "<" + targetURL + `>; rel="` + rel + `"`
+	collected.mu.Lock()
+	defer collected.mu.Unlock()
+	for _, existing := range collected.values {
+		if existing == value {
+			return
+		}
+	}
+	collected.values = append(collected.values, value)
+}
+
+func (l *links) snapshot() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.values...)
+}
+
+type linkHeaderResponseWriter struct {
+	http.ResponseWriter
+	links       *links
+	wroteHeader bool
+}
+
+func (w *linkHeaderResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if values := w.links.snapshot(); len(values) > 0 && statusCode < http.StatusBadRequest {
+			w.Header().Set(linkHeader, strings.Join(values, ", "))
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *linkHeaderResponseWriter) Write(content []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(content)
+}
+
+func (w *linkHeaderResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *linkHeaderResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLinkHeaderEmitsDeclaredLinks(t *testing.T) {
+	t.Parallel()
+
+	handler := WithLinkHeader(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AddLink(r.Context(), "https://example.com/?page=1", "prev")
+		AddLink(r.Context(), "https://example.com/?page=3", "next")
+		AddLink(r.Context(), "https://example.com/?page=3", "next")
+		AddLink(r.Context(), "https://example.com/>; rel=\"evil", "next")
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?page=2", nil))
+	require.Equal(t,
+		`<https://example.com/?page=1>; rel="prev", <https://example.com/?page=3>; rel="next"`,
+		rec.Header().Get("Link"),
+	)
+	require.Equal(t, "ok", rec.Body.String())
+
+	notFound := WithLinkHeader(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AddLink(r.Context(), "https://example.com/?page=3", "next")
+		http.NotFound(w, r)
+	}))
+	rec = httptest.NewRecorder()
+	notFound.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?page=2", nil))
+	require.Empty(t, rec.Header().Get("Link"))
+
+	require.NotPanics(t, func() { AddLink(context.Background(), "https://example.com/", "next") })
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const liveQueryKey = "__live"
+const eventStreamContentType = "text/event-stream"
+const defaultLiveMaxConnections = 256
+const defaultLiveIdleTimeout = 30 * time.Second
+const liveRetryAfterSeconds = 2
+
+type LiveConnectionConfig struct {
+	MaxConnections int
+	IdleTimeout    time.Duration
+}
+
+type LiveConnections struct {
+	slots       chan struct{}
+	idleTimeout time.Duration
+	open        atomic.Int64
+	shed        atomic.Int64
+}
+
+func NewLiveConnections(cfg LiveConnectionConfig) *LiveConnections {
+	maxConnections := cfg.MaxConnections
+	if maxConnections <= 0 {
+		maxConnections = defaultLiveMaxConnections
+	}
+	idleTimeout := cfg.IdleTimeout
+	if idleTimeout <= 0 {
+		idleTimeout = defaultLiveIdleTimeout
+	}
+
+	return &LiveConnections{
+		slots:       make(chan struct{}, maxConnections),
+		idleTimeout: idleTimeout,
+	}
+}
+
+func (c *LiveConnections) Open() int {
+	return int(c.open.Load())
+}
+
+func (c *LiveConnections) Shed() int {
+	return int(c.shed.Load())
+}
+
+func (c *LiveConnections) acquire() bool {
+	select {
+	case c.slots <- struct{}{}:
+		c.open.Add(1)
+		return true
+	default:
+		c.shed.Add(1)
+		return false
+	}
+}
+
+func (c *LiveConnections) release() {
+	c.open.Add(-1)
+	<-c.slots
+}
+
+// WithLiveConnectionLimit sheds live requests over the cap and cancels those
+// whose handler has not written for the idle timeout. It has to wrap the
+// handler inside any middleware that buffers the body, such as WithETag;
+// above a buffer it would see no writes until the response ends and the idle
+// timeout would turn into a total request timeout.
+func WithLiveConnectionLimit(connections *LiveConnections) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if next == nil {
+				return
+			}
+			if connections == nil || !isLiveRequest(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if !connections.acquire() {
+				w.Header().Set("Retry-After", strconv.Itoa(liveRetryAfterSeconds))
+				w.Header().Set("Cache-Control", "no-store")
+				http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+				return
+			}
+			defer connections.release()
+
+			ctx, cancel := context.WithCancel(r.Context())
+			defer cancel()
+			idle := time.AfterFunc(connections.idleTimeout, cancel)
+			defer idle.Stop()
+
+			writer := &idleResponseWriter{
+				ResponseWriter: w,
+				idle:           idle,
+				timeout:        connections.idleTimeout,
+			}
+			next.ServeHTTP(writer, r.WithContext(ctx))
+		})
+	}
+}
+
+func isLiveRequest(r *http.Request) bool {
+	if r == nil || r.URL == nil {
+		return false
+	}
+	if r.URL.Query().Has(liveQueryKey) {
+		return true
+	}
+
+	return strings.Contains(strings.ToLower(r.Header.Get("Accept")), eventStreamContentType)
+}
+
+type idleResponseWriter struct {
+	http.ResponseWriter
+	idle    *time.Timer
+	timeout time.Duration
+}
+
+func (w *idleResponseWriter) Write(content []byte) (int, error) {
+	w.idle.Reset(w.timeout)
+	return w.ResponseWriter.Write(content)
+}
+
+func (w *idleResponseWriter) Flush() {
+	w.idle.Reset(w.timeout)
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *idleResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLiveConnectionLimitShedsExcessLiveRequests(t *testing.T) {
+	t.Parallel()
+
+	connections := NewLiveConnections(LiveConnectionConfig{MaxConnections: 1, IdleTimeout: time.Minute})
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	handler := WithLiveConnectionLimit(connections)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get(liveQueryKey) == "navigation" {
+			close(entered)
+			<-release
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/?__live=navigation", nil))
+	}()
+	<-entered
+	require.Equal(t, 1, connections.Open())
+
+	shed := httptest.NewRecorder()
+	handler.ServeHTTP(shed, httptest.NewRequest(http.MethodGet, "/tag/go?__live=filter", nil))
+	require.Equal(t, http.StatusServiceUnavailable, shed.Code)
+	require.Equal(t, "2", shed.Header().Get("Retry-After"))
+	require.Equal(t, 1, connections.Shed())
+
+	stream := httptest.NewRequest(http.MethodGet, "/events", nil)
+	stream.Header.Set("Accept", "text/event-stream")
+	streamRec := httptest.NewRecorder()
+	handler.ServeHTTP(streamRec, stream)
+	require.Equal(t, http.StatusServiceUnavailable, streamRec.Code)
+
+	page := httptest.NewRecorder()
+	handler.ServeHTTP(page, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusOK, page.Code)
+
+	close(release)
+	<-done
+	require.Equal(t, 0, connections.Open())
+
+	again := httptest.NewRecorder()
+	handler.ServeHTTP(again, httptest.NewRequest(http.MethodGet, "/tag/go?__live=filter", nil))
+	require.Equal(t, http.StatusOK, again.Code)
+}
+
+func TestWithLiveConnectionLimitCancelsIdleConnections(t *testing.T) {
+	t.Parallel()
+
+	connections := NewLiveConnections(LiveConnectionConfig{MaxConnections: 1, IdleTimeout: 50 * time.Millisecond})
+	handler := WithLiveConnectionLimit(connections)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for range 3 {
+			time.Sleep(10 * time.Millisecond)
+			_, _ = w.Write([]byte("data: tick\n\n"))
+			w.(http.Flusher).Flush()
+		}
+		require.NoError(t, r.Context().Err())
+
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+			t.Error("idle live connection was not cancelled")
+		}
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/events", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, "data: tick\n\ndata: tick\n\ndata: tick\n\n", rec.Body.String())
+	require.Equal(t, 0, connections.Open())
+}
+
+func TestWithLiveConnectionLimitSeesWritesBelowETagBuffer(t *testing.T) {
+	t.Parallel()
+
+	connections := NewLiveConnections(LiveConnectionConfig{MaxConnections: 1, IdleTimeout: 50 * time.Millisecond})
+	streaming := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		for range 5 {
+			time.Sleep(25 * time.Millisecond)
+			_, _ = w.Write([]byte("<li>note</li>"))
+		}
+		require.NoError(t, r.Context().Err(), "a live request that keeps writing must not count as idle")
+	})
+	handler := WithETag(WithLiveConnectionLimit(connections)(streaming))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?__live=navigation", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotEmpty(t, rec.Header().Get("ETag"))
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+const maintenanceCacheControl = "no-store"
+const defaultMaintenanceRetryAfter = 5 * time.Minute
+
+// MaintenanceConfig turns every page into a 503 while a deploy or CMS
+// migration is in progress.
+type MaintenanceConfig struct {
+	// Enabled switches maintenance on for the life of the process.
+	Enabled bool
+	// SentinelFile, when set, switches maintenance on while the file exists,
+	// so it can be toggled without a restart.
+	SentinelFile string
+	// RetryAfter is sent to clients and crawlers; it defaults to five minutes.
+	RetryAfter time.Duration
+	// OpenPrefixes keep probes and static assets reachable.
+	OpenPrefixes []string
+	// RenderPage writes the 503 page body. Without it, or when it fails, a
+	// plain-text body is sent.
+	RenderPage func(w io.Writer, r *http.Request) error
+}
+
+func (cfg MaintenanceConfig) active() bool {
+	if cfg.Enabled {
+		return true
+	}
+	if cfg.SentinelFile == "" {
+		return false
+	}
+	_, err := os.Stat(cfg.SentinelFile)
+	return err == nil
+}
+
+// WithMaintenance answers every request outside the open prefixes with 503
+// and Retry-After while maintenance is on.
+func WithMaintenance(cfg MaintenanceConfig) func(http.Handler) http.Handler {
+	if cfg.RetryAfter <= 0 {
+		cfg.RetryAfter = defaultMaintenanceRetryAfter
+	}
+
+	return func(next http.Handler) http.Handler {
+		if !cfg.Enabled && cfg.SentinelFile == "" {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if next == nil {
+				return
+			}
+			if r == nil || r.URL == nil || hasAnyPrefix(r.URL.Path, cfg.OpenPrefixes) || !cfg.active() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("Retry-After", strconv.Itoa(int(cfg.RetryAfter/time.Second)))
+			w.Header().Set("Cache-Control", maintenanceCacheControl)
+			var body bytes.Buffer
+			if cfg.RenderPage != nil && cfg.RenderPage(&body, r) == nil {
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				if r.Method != http.MethodHead {
+					_, _ = body.WriteTo(w)
+				}
+				return
+			}
+			http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithMaintenanceServesPageExceptOpenPrefixes(t *testing.T) {
+	t.Parallel()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	handler := WithMaintenance(MaintenanceConfig{
+		Enabled:      true,
+		RetryAfter:   2 * time.Minute,
+		OpenPrefixes: []string{"/healthz"},
+		RenderPage: func(w io.Writer, _ *http.Request) error {
+			_, err := w.Write([]byte("<h1>Back shortly</h1>"))
+			return err
+		},
+	})(next)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/note/hello", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Equal(t, "120", rec.Header().Get("Retry-After"))
+	require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	require.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, "<h1>Back shortly</h1>", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, "ok", rec.Body.String())
+}
+
+func TestWithMaintenanceFollowsSentinelFile(t *testing.T) {
+	t.Parallel()
+
+	sentinel := filepath.Join(t.TempDir(), "maintenance")
+	handler := WithMaintenance(MaintenanceConfig{
+		SentinelFile: sentinel,
+		RenderPage: func(io.Writer, *http.Request) error {
+			return errors.New("render failed")
+		},
+	})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, "ok", rec.Body.String())
+
+	require.NoError(t, os.WriteFile(sentinel, nil, 0o600))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Equal(t, "300", rec.Header().Get("Retry-After"))
+	require.Contains(t, rec.Body.String(), "Service Unavailable")
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import "net/http"
+
+const noIndexRobotsTag = "noindex, nofollow"
+
+// WithNoIndex marks every response as non-indexable, for staging deployments
+// that must stay out of search results even when robots.txt is ignored.
+func WithNoIndex(enabled bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !enabled {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if next == nil {
+				return
+			}
+			w.Header().Set("X-Robots-Tag", noIndexRobotsTag)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
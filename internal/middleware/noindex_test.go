@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithNoIndex(t *testing.T) {
+	t.Parallel()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	rec := httptest.NewRecorder()
+	WithNoIndex(true)(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/robots.txt", nil))
+	require.Equal(t, "noindex, nofollow", rec.Header().Get("X-Robots-Tag"))
+	require.Equal(t, "ok", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	WithNoIndex(false)(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Empty(t, rec.Header().Get("X-Robots-Tag"))
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// WithPrivateQuery keeps responses to requests that carry queryKey out of shared
+// caches and search indexes, for signed links such as draft previews.
+func WithPrivateQuery(queryKey string) func(http.Handler) http.Handler {
+	queryKey = strings.TrimSpace(queryKey)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if next == nil {
+				return
+			}
+			if queryKey == "" || r == nil || r.URL == nil || !r.URL.Query().Has(queryKey) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("X-Robots-Tag", noIndexRobotsTag)
+			next.ServeHTTP(&privateResponseWriter{ResponseWriter: w}, r)
+		})
+	}
+}
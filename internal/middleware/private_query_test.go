@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPrivateQuery(t *testing.T) {
+	t.Parallel()
+
+	handler := WithPrivateQuery("preview")(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=60")
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/note/hello-world?preview=token", nil))
+	require.Equal(t, "private, no-store", rec.Header().Get("Cache-Control"))
+	require.Equal(t, "noindex, nofollow", rec.Header().Get("X-Robots-Tag"))
+	require.Equal(t, "ok", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/note/hello-world", nil))
+	require.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+	require.Empty(t, rec.Header().Get("X-Robots-Tag"))
+}
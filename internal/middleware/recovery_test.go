@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRecoveryTurnsPanicsIntoServerErrors(t *testing.T) {
+	t.Parallel()
+
+	var logged error
+	handler := WithRecovery(func(err error) { logged = err })(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("loader exploded")
+	}))
+
+	rec := httptest.NewRecorder()
+	require.NotPanics(t, func() {
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/note/hello-world", nil))
+	})
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	require.ErrorContains(t, logged, "panic serving GET /note/hello-world: loader exploded")
+	require.ErrorContains(t, logged, "recovery_test.go")
+}
+
+func TestWithRecoveryKeepsStartedResponses(t *testing.T) {
+	t.Parallel()
+
+	handler := WithRecovery(nil)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("<html>"))
+		panic("late failure")
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "<html>", rec.Body.String())
+}
+
+func TestWithRecoveryRethrowsAbortHandler(t *testing.T) {
+	t.Parallel()
+
+	handler := WithRecovery(nil)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	require.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const defaultRequestTimeout = 10 * time.Second
+
+type RequestTimeoutConfig struct {
+	Default time.Duration
+	// Routes overrides Default for paths under a prefix, matched against the
+	// path with its locale already stripped; the longest prefix wins.
+	Routes map[string]time.Duration
+}
+
+// WithRequestTimeout bounds how long loaders may wait on upstreams. When the
+// deadline fires, the server error the framework answers with is sent as 504
+// Gateway Timeout and kept out of shared caches, so a slow CMS does not read
+// as a broken page for the error cache lifetime.
+func WithRequestTimeout(cfg RequestTimeoutConfig) func(http.Handler) http.Handler {
+	fallback := cfg.Default
+	if fallback <= 0 {
+		fallback = defaultRequestTimeout
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if next == nil {
+				return
+			}
+			if r == nil || r.URL == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), routeTimeout(cfg.Routes, r.URL.Path, fallback))
+			defer cancel()
+
+			writer := &timeoutResponseWriter{ResponseWriter: w, ctx: ctx}
+			next.ServeHTTP(writer, r.WithContext(ctx))
+		})
+	}
+}
+
+func routeTimeout(routes map[string]time.Duration, pathValue string, fallback time.Duration) time.Duration {
+	timeout := fallback
+	longest := -1
+	for prefix, candidate := range routes {
+		if candidate <= 0 || len(prefix) <= longest || !strings.HasPrefix(pathValue, prefix) {
+			continue
+		}
+		timeout = candidate
+		longest = len(prefix)
+	}
+
+	return timeout
+}
+
+type timeoutResponseWriter struct {
+	http.ResponseWriter
+	ctx      context.Context
+	timedOut bool
+}
+
+func (w *timeoutResponseWriter) WriteHeader(statusCode int) {
+	if statusCode != http.StatusInternalServerError || !errors.Is(w.ctx.Err(), context.DeadlineExceeded) {
+		w.ResponseWriter.WriteHeader(statusCode)
+		return
+	}
+
+	w.timedOut = true
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(http.StatusGatewayTimeout)
+	_, _ = w.ResponseWriter.Write([]byte(http.StatusText(http.StatusGatewayTimeout) + "\n"))
+}
+
+// Write drops the server error body once it has been replaced.
+func (w *timeoutResponseWriter) Write(p []byte) (int, error) {
+	if w.timedOut {
+		return len(p), nil
+	}
+
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *timeoutResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *timeoutResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
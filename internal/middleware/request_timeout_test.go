@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRequestTimeoutAnswersGatewayTimeoutWhenDeadlineFires(t *testing.T) {
+	t.Parallel()
+
+	handler := WithRequestTimeout(RequestTimeoutConfig{Default: 10 * time.Millisecond})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+			w.Header().Set("Cache-Control", "public, max-age=3600")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}),
+	)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	require.Equal(t, "Gateway Timeout\n", rec.Body.String())
+}
+
+func TestWithRequestTimeoutKeepsOtherErrors(t *testing.T) {
+	t.Parallel()
+
+	handler := WithRequestTimeout(RequestTimeoutConfig{Default: time.Second})(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "failed", http.StatusInternalServerError)
+		}),
+	)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Equal(t, "failed\n", rec.Body.String())
+}
+
+func TestWithRequestTimeoutPicksTheLongestRoutePrefix(t *testing.T) {
+	t.Parallel()
+
+	routes := map[string]time.Duration{
+		"/archive":      time.Minute,
+		"/archive/2024": time.Hour,
+		"/tags":         0,
+	}
+	require.Equal(t, time.Hour, routeTimeout(routes, "/archive/2024/01", time.Second))
+	require.Equal(t, time.Minute, routeTimeout(routes, "/archive", time.Second))
+	require.Equal(t, time.Second, routeTimeout(routes, "/tags", time.Second))
+	require.Equal(t, time.Second, routeTimeout(routes, "/note/hello-world", time.Second))
+
+	var remaining time.Duration
+	handler := WithRequestTimeout(RequestTimeoutConfig{Routes: routes})(
+		http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			deadline, ok := r.Context().Deadline()
+			require.True(t, ok)
+			remaining = time.Until(deadline)
+		}),
+	)
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/archive", nil))
+	require.Greater(t, remaining, defaultRequestTimeout)
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+const siteRealm = `Basic realm="blog", charset="UTF-8"`
+
+// WithSiteAuth puts the whole site behind HTTP Basic auth (any username,
+// password as password) or a Bearer token, so staging deployments are not
+// publicly readable. Requests whose path starts with one of the public
+// prefixes, such as probes, static assets or signed webhooks, pass through.
+// An empty password disables the gate.
+func WithSiteAuth(password string, publicPrefixes ...string) func(http.Handler) http.Handler {
+	password = strings.TrimSpace(password)
+
+	return func(next http.Handler) http.Handler {
+		if password == "" {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if next == nil {
+				return
+			}
+			if r == nil || r.URL == nil || hasAnyPrefix(r.URL.Path, publicPrefixes) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !adminAuthorized(r, password) {
+				w.Header().Set("Cache-Control", adminCacheControl)
+				w.Header().Set("WWW-Authenticate", siteRealm)
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(&privateResponseWriter{ResponseWriter: w}, r)
+		})
+	}
+}
+
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if prefix != "" && strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSiteAuth(t *testing.T) {
+	t.Parallel()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=60")
+		_, _ = w.Write([]byte("ok"))
+	})
+	handler := WithSiteAuth("secret", "/healthz", "/static/")(next)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Contains(t, rec.Header().Get("WWW-Authenticate"), "Basic")
+	require.Equal(t, "private, no-store", rec.Header().Get("Cache-Control"))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.SetBasicAuth("anyone", "secret")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, "ok", rec.Body.String())
+	require.Equal(t, "private, no-store", rec.Header().Get("Cache-Control"))
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/static/app.css", nil))
+	require.Equal(t, "ok", rec.Body.String())
+	require.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+
+	rec = httptest.NewRecorder()
+	WithSiteAuth("")(next).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, "ok", rec.Body.String())
+}
+
+func TestWithSiteAuthLeavesAdminPagesToTheAdminToken(t *testing.T) {
+	t.Parallel()
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	handler := WithSiteAuth("site-secret", AdminPathPrefix)(WithAdminAuth("admin-secret")(next))
+
+	req := httptest.NewRequest(http.MethodGet, AdminPathPrefix+"preview-diff/hello", nil)
+	req.SetBasicAuth("anyone", "admin-secret")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, "ok", rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodGet, AdminPathPrefix+"preview-diff/hello", nil)
+	req.SetBasicAuth("anyone", "site-secret")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code, "the site password does not open admin pages")
+}
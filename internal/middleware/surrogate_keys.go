@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const surrogateKeyHeader = "Surrogate-Key"
+const cacheTagHeader = "Cache-Tag"
+
+type surrogateKeysContextKey struct{}
+
+type surrogateKeys struct {
+	mu   sync.Mutex
+	keys []string
+	seen map[string]struct{}
+}
+
+// WithSurrogateKeys collects the cache keys loaders declare through
+// AddSurrogateKeys and sends them as Surrogate-Key (Fastly, space separated)
+// and Cache-Tag (Cloudflare, comma separated) so a CDN can purge exactly the
+// pages a CMS change touches.
+func WithSurrogateKeys(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if next == nil {
+			return
+		}
+		if r == nil || !isReadMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		keys := &surrogateKeys{seen: make(map[string]struct{})}
+		writer := &surrogateKeysResponseWriter{ResponseWriter: w, keys: keys}
+		next.ServeHTTP(writer, r.WithContext(context.WithValue(r.Context(), surrogateKeysContextKey{}, keys)))
+	})
+}
+
+// AddSurrogateKeys declares cache keys for the response being rendered. It is a
+// no-op outside WithSurrogateKeys.
+func AddSurrogateKeys(ctx context.Context, keys ...string) {
+	if ctx == nil {
+		return
+	}
+	collected, ok := ctx.Value(surrogateKeysContextKey{}).(*surrogateKeys)
+	if !ok || collected == nil {
+		return
+	}
+
+	collected.mu.Lock()
+	defer collected.mu.Unlock()
+	for _, key := range keys {
+		key = strings.Join(strings.Fields(key), "-")
+		if key == "" {
+			continue
+		}
+		if _, ok := collected.seen[key]; ok {
+			continue
+		}
+		collected.seen[key] = struct{}{}
+		collected.keys = append(collected.keys, key)
+	}
+}
+
+func (k *surrogateKeys) snapshot() []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return append([]string(nil), k.keys...)
+}
+
+type surrogateKeysResponseWriter struct {
+	http.ResponseWriter
+	keys        *surrogateKeys
+	wroteHeader bool
+}
+
+func (w *surrogateKeysResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if keys := w.keys.snapshot(); len(keys) > 0 && statusCode < http.StatusBadRequest {
+			w.Header().Set(surrogateKeyHeader, strings.Join(keys, " "))
+			w.Header().Set(cacheTagHeader, strings.Join(keys, ","))
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *surrogateKeysResponseWriter) Write(content []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(content)
+}
+
+func (w *surrogateKeysResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *surrogateKeysResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
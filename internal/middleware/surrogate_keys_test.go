@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSurrogateKeysEmitsDeclaredKeys(t *testing.T) {
+	t.Parallel()
+
+	handler := WithSurrogateKeys(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AddSurrogateKeys(r.Context(), "note:hello-world", "author:l-you")
+		AddSurrogateKeys(r.Context(), "note:hello-world", "", "tag:go lang")
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/note/hello-world", nil))
+	require.Equal(t, "note:hello-world author:l-you tag:go-lang", rec.Header().Get("Surrogate-Key"))
+	require.Equal(t, "note:hello-world,author:l-you,tag:go-lang", rec.Header().Get("Cache-Tag"))
+	require.Equal(t, "ok", rec.Body.String())
+}
+
+func TestWithSurrogateKeysSkipsErrorsAndWrites(t *testing.T) {
+	t.Parallel()
+
+	notFound := WithSurrogateKeys(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AddSurrogateKeys(r.Context(), "note:missing")
+		http.NotFound(w, r)
+	}))
+	rec := httptest.NewRecorder()
+	notFound.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/note/missing", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Empty(t, rec.Header().Get("Surrogate-Key"))
+
+	post := WithSurrogateKeys(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AddSurrogateKeys(r.Context(), "note:hello-world")
+		w.WriteHeader(http.StatusSeeOther)
+	}))
+	rec = httptest.NewRecorder()
+	post.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/note/hello-world/comments", nil))
+	require.Empty(t, rec.Header().Get("Cache-Tag"))
+}
+
+func TestAddSurrogateKeysWithoutMiddlewareIsNoop(t *testing.T) {
+	t.Parallel()
+
+	require.NotPanics(t, func() {
+		AddSurrogateKeys(httptest.NewRequest(http.MethodGet, "/", nil).Context(), "list:notes")
+	})
+}
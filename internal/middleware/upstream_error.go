@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+type upstreamFailureContextKey struct{}
+
+// WithUpstreamErrors sends the server error of a request whose loaders called
+// MarkUpstreamFailure as 502 Bad Gateway with the page render writes, so a
+// CMS outage reads as such instead of as a broken blog. Other server errors
+// stay 500, and deadline errors are left to WithRequestTimeout.
+func WithUpstreamErrors(render func(w io.Writer, r *http.Request) error) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if next == nil {
+				return
+			}
+			if r == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			failed := new(atomic.Bool)
+			r = r.WithContext(context.WithValue(r.Context(), upstreamFailureContextKey{}, failed))
+			writer := &upstreamErrorResponseWriter{ResponseWriter: w, request: r, failed: failed, render: render}
+			next.ServeHTTP(writer, r)
+		})
+	}
+}
+
+// MarkUpstreamFailure records that the request failed because an upstream
+// did. It is a no-op outside WithUpstreamErrors.
+func MarkUpstreamFailure(ctx context.Context) {
+	if ctx == nil {
+		return
+	}
+	if failed, ok := ctx.Value(upstreamFailureContextKey{}).(*atomic.Bool); ok && failed != nil {
+		failed.Store(true)
+	}
+}
+
+type upstreamErrorResponseWriter struct {
+	http.ResponseWriter
+	request  *http.Request
+	failed   *atomic.Bool
+	render   func(w io.Writer, r *http.Request) error
+	replaced bool
+}
+
+func (w *upstreamErrorResponseWriter) WriteHeader(statusCode int) {
+	if statusCode != http.StatusInternalServerError || !w.failed.Load() ||
+		errors.Is(w.request.Context().Err(), context.DeadlineExceeded) {
+		w.ResponseWriter.WriteHeader(statusCode)
+		return
+	}
+
+	w.replaced = true
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Del("Content-Length")
+	var body bytes.Buffer
+	if w.render == nil || w.render(&body, w.request) != nil {
+		body.Reset()
+		body.WriteString(http.StatusText(http.StatusBadGateway) + "\n")
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	}
+	w.ResponseWriter.WriteHeader(http.StatusBadGateway)
+	if w.request.Method != http.MethodHead {
+		_, _ = body.WriteTo(w.ResponseWriter)
+	}
+}
+
+// Write drops the server error body once it has been replaced.
+func (w *upstreamErrorResponseWriter) Write(p []byte) (int, error) {
+	if w.replaced {
+		return len(p), nil
+	}
+
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *upstreamErrorResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *upstreamErrorResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithUpstreamErrorsReplacesMarkedServerErrors(t *testing.T) {
+	t.Parallel()
+
+	render := func(w io.Writer, _ *http.Request) error {
+		_, err := w.Write([]byte("<h1>CMS unavailable</h1>"))
+		return err
+	}
+	marked := WithUpstreamErrors(render)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		MarkUpstreamFailure(r.Context())
+		http.Error(w, "failed", http.StatusInternalServerError)
+	}))
+
+	rec := httptest.NewRecorder()
+	marked.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusBadGateway, rec.Code)
+	require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	require.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, "<h1>CMS unavailable</h1>", rec.Body.String())
+
+	unmarked := WithUpstreamErrors(render)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "failed", http.StatusInternalServerError)
+	}))
+	rec = httptest.NewRecorder()
+	unmarked.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Equal(t, "failed\n", rec.Body.String())
+}
+
+func TestWithUpstreamErrorsFallsBackToPlainText(t *testing.T) {
+	t.Parallel()
+
+	handler := WithUpstreamErrors(nil)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		MarkUpstreamFailure(r.Context())
+		http.Error(w, "failed", http.StatusInternalServerError)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusBadGateway, rec.Code)
+	require.Equal(t, "Bad Gateway\n", rec.Body.String())
+}
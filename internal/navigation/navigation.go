@@ -0,0 +1,153 @@
+package navigation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const ConditionAnalytics = "analytics"
+
+type Label struct {
+	Key  string            `json:"key,omitempty"`
+	Text map[string]string `json:"text,omitempty"`
+}
+
+type TypeLink struct {
+	Type  string `json:"type"`
+	Label Label  `json:"label"`
+}
+
+type FooterLink struct {
+	Prefix Label  `json:"prefix"`
+	Label  Label  `json:"label"`
+	Href   string `json:"href"`
+	If     string `json:"if,omitempty"`
+}
+
+type Model struct {
+	ShowChannels bool         `json:"showChannels"`
+	Types        []TypeLink   `json:"types"`
+	Footer       []FooterLink `json:"footer"`
+	ShowStack    bool         `json:"showStack"`
+}
+
+func Default() Model {
+	return Model{
+		ShowChannels: true,
+		Types: []TypeLink{
+			{Type: "long", Label: Label{Key: "channel.tales"}},
+			{Type: "short", Label: Label{Key: "channel.microTales"}},
+		},
+		Footer: []FooterLink{
+			{
+				Prefix: Label{Key: "layout.footer.opensourcePrefix"},
+				Label:  Label{Key: "layout.footer.opensourceLink"},
+				Href:   "https://github.com/RevoTale/blog",
+			},
+			{
+				Prefix: Label{Key: "layout.footer.analyticsPrefix"},
+				Label:  Label{Key: "layout.footer.analyticsLink"},
+				Href:   "https://github.com/RevoTale/lovely-eye",
+				If:     ConditionAnalytics,
+			},
+		},
+		ShowStack: true,
+	}
+}
+
+func Load(path string) (Model, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return Default(), nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return Model{}, fmt.Errorf("read navigation file %q: %w", path, err)
+	}
+
+	model, err := Parse(content)
+	if err != nil {
+		return Model{}, fmt.Errorf("navigation file %q: %w", path, err)
+	}
+
+	return model, nil
+}
+
+type overrides struct {
+	ShowChannels *bool        `json:"showChannels"`
+	Types        []TypeLink   `json:"types"`
+	Footer       []FooterLink `json:"footer"`
+	ShowStack    *bool        `json:"showStack"`
+}
+
+func Parse(content []byte) (Model, error) {
+	var parsed overrides
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&parsed); err != nil {
+		return Model{}, fmt.Errorf("decode navigation: %w", err)
+	}
+
+	model := Default()
+	if parsed.ShowChannels != nil {
+		model.ShowChannels = *parsed.ShowChannels
+	}
+	if parsed.Types != nil {
+		model.Types = parsed.Types
+	}
+	if parsed.Footer != nil {
+		model.Footer = parsed.Footer
+	}
+	if parsed.ShowStack != nil {
+		model.ShowStack = *parsed.ShowStack
+	}
+	if err := model.Validate(); err != nil {
+		return Model{}, err
+	}
+
+	return model, nil
+}
+
+func (m Model) Validate() error {
+	for idx, link := range m.Types {
+		switch strings.TrimSpace(link.Type) {
+		case "long", "short":
+		default:
+			return fmt.Errorf("types[%d]: unsupported note type %q", idx, link.Type)
+		}
+		if link.Label.IsZero() {
+			return fmt.Errorf("types[%d]: label is required", idx)
+		}
+	}
+
+	for idx, link := range m.Footer {
+		if strings.TrimSpace(link.Href) == "" {
+			return fmt.Errorf("footer[%d]: href is required", idx)
+		}
+		if link.Label.IsZero() {
+			return fmt.Errorf("footer[%d]: label is required", idx)
+		}
+		if condition := strings.TrimSpace(link.If); condition != "" && condition != ConditionAnalytics {
+			return fmt.Errorf("footer[%d]: unsupported condition %q", idx, link.If)
+		}
+	}
+
+	return nil
+}
+
+func (l Label) IsZero() bool {
+	if strings.TrimSpace(l.Key) != "" {
+		return false
+	}
+	for _, text := range l.Text {
+		if strings.TrimSpace(text) != "" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,64 @@
+package navigation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadWithoutPathReturnsDefault(t *testing.T) {
+	t.Parallel()
+
+	model, err := Load("")
+	require.NoError(t, err)
+	require.Equal(t, Default(), model)
+	require.NoError(t, model.Validate())
+}
+
+func TestParseOverridesOnlyProvidedSections(t *testing.T) {
+	t.Parallel()
+
+	model, err := Parse([]byte(`{
+		"types": [{"type": "short", "label": {"text": {"en": "Shorts"}}}],
+		"showStack": false
+	}`))
+	require.NoError(t, err)
+	require.True(t, model.ShowChannels)
+	require.False(t, model.ShowStack)
+	require.Len(t, model.Types, 1)
+	require.Equal(t, "Shorts", model.Types[0].Label.Text["en"])
+	require.Equal(t, Default().Footer, model.Footer)
+}
+
+func TestParseRejectsInvalidModels(t *testing.T) {
+	t.Parallel()
+
+	cases := []string{
+		`{"types": [{"type": "video", "label": {"key": "channel.tales"}}]}`,
+		`{"types": [{"type": "long"}]}`,
+		`{"footer": [{"label": {"key": "layout.footer.opensourceLink"}}]}`,
+		`{"footer": [{"label": {"text": {"en": "x"}}, "href": "/", "if": "weekend"}]}`,
+		`{"unknown": true}`,
+	}
+	for _, content := range cases {
+		_, err := Parse([]byte(content))
+		require.Error(t, err, content)
+	}
+}
+
+func TestLoadReadsFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "navigation.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"showChannels": false, "footer": []}`), 0o600))
+
+	model, err := Load(path)
+	require.NoError(t, err)
+	require.False(t, model.ShowChannels)
+	require.Empty(t, model.Footer)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
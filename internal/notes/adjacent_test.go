@@ -0,0 +1,30 @@
+package notes
+
+import (
+	"context"
+	"testing"
+
+	"blog/internal/gqltest"
+	"blog/internal/imageloader"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAdjacentNotesComparesMillisecondTimestamps(t *testing.T) {
+	t.Parallel()
+
+	client := gqltest.New().
+		SetFixture("NoteBySlug", gqltest.Data(`{"Micro_posts":{"docs":[
+			{"id":"note-1","slug":"hello","publishedAt":"2024-01-02T10:00:00.250+02:00"}
+		]}}`)).
+		SetFixture("AdjacentNotes", gqltest.Data(`{"newer":{"docs":[]},"older":{"docs":[]}}`))
+	service := NewService(client, 12, imageloader.New(false))
+	note, err := service.GetNoteBySlug(context.Background(), "en", "hello", nil)
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-02T08:00:00Z", note.PublishedAtISO)
+
+	_, err = service.GetAdjacentNotes(context.Background(), "en", *note)
+	require.NoError(t, err)
+	calls := client.CallsTo("AdjacentNotes")
+	require.Len(t, calls, 1)
+	require.JSONEq(t, `"2024-01-02T08:00:00.25Z"`, string(calls[0].Variables["publishedAt"]))
+}
@@ -0,0 +1,160 @@
+package notes
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	gql "blog/internal/cmsgraphql"
+)
+
+const archiveDatesPageSize = 500
+const maxArchiveDatesPages = 40
+
+type ArchiveMonth struct {
+	Year  int
+	Month time.Month
+	Count int
+}
+
+type ArchiveYear struct {
+	Year   int
+	Count  int
+	Months []ArchiveMonth
+}
+
+type ArchiveIndex struct {
+	Years []ArchiveYear
+	Total int
+}
+
+func (index ArchiveIndex) Find(year int, month time.Month) (ArchiveMonth, bool) {
+	for _, archiveYear := range index.Years {
+		if archiveYear.Year != year {
+			continue
+		}
+		for _, archiveMonth := range archiveYear.Months {
+			if archiveMonth.Month == month {
+				return archiveMonth, true
+			}
+		}
+	}
+
+	return ArchiveMonth{}, false
+}
+
+// GetArchiveIndex counts published notes per month. The scan covers every note,
+// so its result is reused for aggregateCacheTTL.
+func (s *Service) GetArchiveIndex(ctx context.Context) (ArchiveIndex, error) {
+	return s.archiveIndex.get(ctx, "", s.scanArchiveIndex)
+}
+
+func (s *Service) scanArchiveIndex(ctx context.Context) (ArchiveIndex, error) {
+	counts := make(map[int]map[time.Month]int)
+	for page := 1; page <= maxArchiveDatesPages; page++ {
+		response, err := gql.ArchiveDates(ctx, s.client, page, archiveDatesPageSize, publishedUntilNow())
+		if err != nil {
+			return ArchiveIndex{}, err
+		}
+		if response == nil || response.Micro_posts == nil {
+			break
+		}
+
+		for _, doc := range response.Micro_posts.Docs {
+			publishedAt, ok := parsePublishedAt(doc.PublishedAt)
+			if !ok {
+				continue
+			}
+			if counts[publishedAt.Year()] == nil {
+				counts[publishedAt.Year()] = make(map[time.Month]int)
+			}
+			counts[publishedAt.Year()][publishedAt.Month()]++
+		}
+
+		if page >= response.Micro_posts.TotalPages {
+			break
+		}
+	}
+
+	return newArchiveIndex(counts), nil
+}
+
+// ListArchiveNotes returns one page of the notes published in the given month
+// together with the number of pages the month spans.
+func (s *Service) ListArchiveNotes(
+	ctx context.Context,
+	locale string,
+	year int,
+	month time.Month,
+	page int,
+) ([]NoteSummary, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	response, err := gql.NotesPublishedBetween(
+		ctx,
+		s.client,
+		from.Format(time.RFC3339),
+		to.Format(time.RFC3339),
+		page,
+		s.pageSize,
+		s.publishedUntil(ctx),
+		gql.LocaleInputFromCode(locale),
+		gql.FallbackLocaleInputFromCode(s.defaultLocale()),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	if response == nil || response.Micro_posts == nil {
+		return []NoteSummary{}, 0, nil
+	}
+
+	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
+	for _, doc := range response.Micro_posts.Docs {
+		items = append(items, mapNoteListDoc(doc.NoteListDoc, locale))
+	}
+
+	return items, response.Micro_posts.TotalPages, nil
+}
+
+func newArchiveIndex(counts map[int]map[time.Month]int) ArchiveIndex {
+	index := ArchiveIndex{Years: make([]ArchiveYear, 0, len(counts))}
+	for year, months := range counts {
+		archiveYear := ArchiveYear{Year: year, Months: make([]ArchiveMonth, 0, len(months))}
+		for month := time.December; month >= time.January; month-- {
+			count := months[month]
+			if count == 0 {
+				continue
+			}
+			archiveYear.Months = append(archiveYear.Months, ArchiveMonth{Year: year, Month: month, Count: count})
+			archiveYear.Count += count
+		}
+		index.Total += archiveYear.Count
+		index.Years = append(index.Years, archiveYear)
+	}
+
+	sort.Slice(index.Years, func(i int, j int) bool {
+		return index.Years[i].Year > index.Years[j].Year
+	})
+	return index
+}
+
+func parsePublishedAt(raw *string) (time.Time, bool) {
+	if raw == nil || strings.TrimSpace(*raw) == "" {
+		return time.Time{}, false
+	}
+
+	parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(*raw))
+	if err != nil {
+		parsed, err = time.Parse(time.RFC3339Nano, strings.TrimSpace(*raw))
+		if err != nil {
+			return time.Time{}, false
+		}
+	}
+
+	return parsed.UTC(), true
+}
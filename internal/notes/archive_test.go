@@ -0,0 +1,91 @@
+package notes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"blog/internal/imageloader"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+type pagedClient struct {
+	opName string
+	pages  []string
+	calls  int
+}
+
+func (c *pagedClient) MakeRequest(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+	if req.OpName != c.opName || c.calls >= len(c.pages) {
+		return fmt.Errorf("unexpected request %s", req.OpName)
+	}
+	payload := c.pages[c.calls]
+	c.calls++
+	return json.Unmarshal([]byte(payload), resp.Data)
+}
+
+func TestGetArchiveIndexGroupsAcrossPages(t *testing.T) {
+	t.Parallel()
+
+	client := &pagedClient{opName: "ArchiveDates", pages: []string{
+		`{"Micro_posts":{"totalPages":2,"docs":[
+			{"publishedAt":"2024-03-31T23:30:00-02:00"},
+			{"publishedAt":"2024-03-02T10:00:00.000Z"},
+			{"publishedAt":"not a date"}
+		]}}`,
+		`{"Micro_posts":{"totalPages":2,"docs":[
+			{"publishedAt":"2023-11-05T10:00:00Z"},
+			{"publishedAt":null}
+		]}}`,
+	}}
+
+	service := NewService(client, 12, imageloader.New(false))
+	index, err := service.GetArchiveIndex(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, client.calls)
+	require.Equal(t, ArchiveIndex{
+		Total: 3,
+		Years: []ArchiveYear{
+			{Year: 2024, Count: 2, Months: []ArchiveMonth{
+				{Year: 2024, Month: time.April, Count: 1},
+				{Year: 2024, Month: time.March, Count: 1},
+			}},
+			{Year: 2023, Count: 1, Months: []ArchiveMonth{
+				{Year: 2023, Month: time.November, Count: 1},
+			}},
+		},
+	}, index)
+
+	period, ok := index.Find(2024, time.March)
+	require.True(t, ok)
+	require.Equal(t, 1, period.Count)
+	_, ok = index.Find(2023, time.March)
+	require.False(t, ok)
+
+	cached, err := service.GetArchiveIndex(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, index, cached)
+	require.Equal(t, 2, client.calls)
+}
+
+func TestInvalidateArchiveIndexRescansNotes(t *testing.T) {
+	t.Parallel()
+
+	page := `{"Micro_posts":{"totalPages":1,"docs":[{"publishedAt":"2024-03-02T10:00:00Z"}]}}`
+	client := &pagedClient{opName: "ArchiveDates", pages: []string{page, page}}
+	service := NewService(client, 12, imageloader.New(false))
+
+	_, err := service.GetArchiveIndex(context.Background())
+	require.NoError(t, err)
+	_, err = service.GetArchiveIndex(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, client.calls)
+
+	service.InvalidateArchiveIndex()
+	_, err = service.GetArchiveIndex(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, client.calls)
+}
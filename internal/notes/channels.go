@@ -0,0 +1,119 @@
+package notes
+
+import (
+	"context"
+	"strings"
+
+	gql "blog/internal/cmsgraphql"
+)
+
+const channelCountsPageSize = 500
+const maxChannelCountsPages = 40
+
+// ChannelCounts is the number of published notes per author, tag and note
+// type, for the sidebar. A zero value knows no counts.
+type ChannelCounts struct {
+	authors map[string]int
+	tags    map[string]int
+	types   map[NoteType]int
+}
+
+func newChannelCounts() ChannelCounts {
+	return ChannelCounts{
+		authors: make(map[string]int),
+		tags:    make(map[string]int),
+		types:   make(map[NoteType]int),
+	}
+}
+
+// Author returns the number of notes by the author with slug.
+func (c ChannelCounts) Author(slug string) int {
+	return c.authors[strings.TrimSpace(slug)]
+}
+
+// Tag returns the number of notes tagged name.
+func (c ChannelCounts) Tag(name string) int {
+	return c.tags[strings.TrimSpace(name)]
+}
+
+// Type returns the number of notes of noteType; NoteTypeAll counts every note.
+func (c ChannelCounts) Type(noteType NoteType) int {
+	return c.types[noteType]
+}
+
+// add counts one note, once per distinct author and tag.
+func (c ChannelCounts) add(noteType NoteType, authorSlugs []string, tagNames []string) {
+	c.types[NoteTypeAll]++
+	if noteType == NoteTypeLong || noteType == NoteTypeShort {
+		c.types[noteType]++
+	}
+	countDistinct(c.authors, authorSlugs)
+	countDistinct(c.tags, tagNames)
+}
+
+func countDistinct(counts map[string]int, values []string) {
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		counts[value]++
+	}
+}
+
+// ChannelCounts counts published notes per sidebar channel in locale. Like the
+// tag counts it scans every note, so the result is reused for
+// aggregateCacheTTL. The returned counts are shared and read-only.
+func (s *Service) ChannelCounts(ctx context.Context, locale string) (ChannelCounts, error) {
+	return s.channelCounts.get(ctx, locale, func(ctx context.Context) (ChannelCounts, error) {
+		return s.scanChannelCounts(ctx, locale)
+	})
+}
+
+func (s *Service) scanChannelCounts(ctx context.Context, locale string) (ChannelCounts, error) {
+	counts := newChannelCounts()
+	for page := 1; page <= maxChannelCountsPages; page++ {
+		response, err := gql.ChannelCounts(
+			ctx,
+			s.client,
+			page,
+			channelCountsPageSize,
+			publishedUntilNow(),
+			gql.LocaleInputFromCode(locale),
+			gql.FallbackLocaleInputFromCode(s.defaultLocale()),
+		)
+		if err != nil {
+			return ChannelCounts{}, err
+		}
+		if response == nil || response.Micro_posts == nil {
+			break
+		}
+
+		for _, doc := range response.Micro_posts.Docs {
+			noteType := NoteTypeAll
+			if doc.Post_type != nil {
+				noteType = ParseNoteType(string(*doc.Post_type))
+			}
+			authorSlugs := make([]string, 0, len(doc.Authors))
+			for _, author := range doc.Authors {
+				authorSlugs = append(authorSlugs, author.Slug)
+			}
+			tagNames := make([]string, 0, len(doc.Tags))
+			for _, tag := range doc.Tags {
+				tagNames = append(tagNames, tag.Name)
+			}
+			counts.add(noteType, authorSlugs, tagNames)
+		}
+
+		if page >= response.Micro_posts.TotalPages {
+			break
+		}
+	}
+
+	return counts, nil
+}
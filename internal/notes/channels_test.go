@@ -0,0 +1,54 @@
+package notes
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"blog/internal/gqltest"
+	"blog/internal/imageloader"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelCountsAggregatesAcrossPages(t *testing.T) {
+	t.Parallel()
+
+	page := func(number string, docs string) gqltest.Case {
+		return gqltest.Case{
+			When: map[string]json.RawMessage{"page": json.RawMessage(number)},
+			Response: gqltest.Response{
+				Data: json.RawMessage(`{"Micro_posts":{"totalPages":2,"docs":[` + docs + `]}}`),
+			},
+		}
+	}
+	client := gqltest.New().SetFixture("ChannelCounts", gqltest.Fixture{
+		Cases: []gqltest.Case{
+			page("1", `{"post_type":"long","authors":[{"slug":"l-you"},{"slug":"l-you"}],"tags":[{"name":"go"}]},
+				{"post_type":"short","authors":[{"slug":"zed"}],"tags":[{"name":"go"},{"name":" "}]}`),
+			page("2", `{"post_type":null,"authors":[{"slug":"l-you"}],"tags":[{"name":"web"}]}`),
+		},
+	})
+
+	service := NewService(client, 12, imageloader.New(false))
+	counts, err := service.ChannelCounts(context.Background(), "en")
+	require.NoError(t, err)
+	require.Equal(t, 2, counts.Author("l-you"))
+	require.Equal(t, 1, counts.Author("zed"))
+	require.Equal(t, 0, counts.Author("missing"))
+	require.Equal(t, 2, counts.Tag("go"))
+	require.Equal(t, 1, counts.Tag("web"))
+	require.Equal(t, 0, counts.Tag(""))
+	require.Equal(t, 1, counts.Type(NoteTypeLong))
+	require.Equal(t, 1, counts.Type(NoteTypeShort))
+	require.Equal(t, 3, counts.Type(NoteTypeAll))
+	require.Len(t, client.CallsTo("ChannelCounts"), 2)
+
+	_, err = service.ChannelCounts(context.Background(), "en")
+	require.NoError(t, err)
+	require.Len(t, client.CallsTo("ChannelCounts"), 2, "counts are reused until they expire")
+
+	service.InvalidateChannelCounts()
+	_, err = service.ChannelCounts(context.Background(), "en")
+	require.NoError(t, err)
+	require.Len(t, client.CallsTo("ChannelCounts"), 4)
+}
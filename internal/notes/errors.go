@@ -0,0 +1,206 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	genqlientgraphql "github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type ErrorKind string
+
+const (
+	ErrorKindNotFound     ErrorKind = "not_found"
+	ErrorKindUnauthorized ErrorKind = "unauthorized"
+	ErrorKindValidation   ErrorKind = "validation"
+)
+
+var ErrUnauthorized = errors.New("unauthorized")
+var ErrValidation = errors.New("validation failed")
+
+// ErrUpstream marks requests the CMS itself failed: transport errors, error
+// responses and GraphQL errors of no known kind. Pages answer them with 502
+// rather than the 500 kept for bugs in the blog.
+var ErrUpstream = errors.New("cms unavailable")
+
+type GraphQLError struct {
+	Kind    ErrorKind
+	Code    string
+	Message string
+	Path    string
+	err     error
+}
+
+func (e *GraphQLError) Error() string {
+	message := "graphql " + string(e.Kind)
+	if e.Code != "" {
+		message += " (" + e.Code + ")"
+	}
+	if e.Path != "" {
+		message += " at " + e.Path
+	}
+	if e.Message != "" {
+		message += ": " + e.Message
+	}
+	return message
+}
+
+func (e *GraphQLError) Unwrap() error {
+	return e.err
+}
+
+func (e *GraphQLError) NotFound() bool {
+	return e.Kind == ErrorKindNotFound
+}
+
+func (e *GraphQLError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Kind == ErrorKindNotFound
+	case ErrUnauthorized:
+		return e.Kind == ErrorKindUnauthorized
+	case ErrValidation:
+		return e.Kind == ErrorKindValidation
+	default:
+		return false
+	}
+}
+
+var graphQLErrorKinds = map[string]ErrorKind{
+	"NOTFOUND":                ErrorKindNotFound,
+	"NOTFOUNDERROR":           ErrorKindNotFound,
+	"UNAUTHENTICATED":         ErrorKindUnauthorized,
+	"UNAUTHORIZED":            ErrorKindUnauthorized,
+	"UNAUTHORIZEDERROR":       ErrorKindUnauthorized,
+	"FORBIDDEN":               ErrorKindUnauthorized,
+	"FORBIDDENERROR":          ErrorKindUnauthorized,
+	"BADUSERINPUT":            ErrorKindValidation,
+	"GRAPHQLVALIDATIONFAILED": ErrorKindValidation,
+	"VALIDATIONERROR":         ErrorKindValidation,
+	"VALIDATIONFAILED":        ErrorKindValidation,
+}
+
+type errorMappingClient struct {
+	base genqlientgraphql.Client
+}
+
+func (c errorMappingClient) MakeRequest(
+	ctx context.Context,
+	req *genqlientgraphql.Request,
+	resp *genqlientgraphql.Response,
+) error {
+	return markUpstream(MapGraphQLError(c.base.MakeRequest(ctx, req, resp)))
+}
+
+// markUpstream wraps err with ErrUpstream unless it was mapped to a known kind
+// or the request's own context ended it.
+func markUpstream(err error) error {
+	var mapped *GraphQLError
+	switch {
+	case err == nil, errors.Is(err, ErrUpstream), errors.As(err, &mapped):
+		return err
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return err
+	default:
+		return fmt.Errorf("%w: %w", ErrUpstream, err)
+	}
+}
+
+func MapGraphQLError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var mapped *GraphQLError
+	if errors.As(err, &mapped) {
+		return err
+	}
+
+	var list gqlerror.List
+	if errors.As(err, &list) {
+		if mapped := mapGraphQLErrorList(list, err); mapped != nil {
+			return mapped
+		}
+		return err
+	}
+
+	var httpErr *genqlientgraphql.HTTPError
+	if errors.As(err, &httpErr) {
+		if mapped := mapGraphQLErrorList(httpErr.Response.Errors, err); mapped != nil {
+			return mapped
+		}
+	}
+
+	return err
+}
+
+func mapGraphQLErrorList(list gqlerror.List, cause error) *GraphQLError {
+	for _, item := range list {
+		if item == nil {
+			continue
+		}
+
+		code, kind := graphQLErrorKind(item.Extensions)
+		if kind == "" {
+			continue
+		}
+
+		return &GraphQLError{
+			Kind:    kind,
+			Code:    code,
+			Message: strings.TrimSpace(item.Message),
+			Path:    item.Path.String(),
+			err:     cause,
+		}
+	}
+
+	return nil
+}
+
+func graphQLErrorKind(extensions map[string]any) (string, ErrorKind) {
+	for _, key := range []string{"code", "name"} {
+		raw, ok := extensions[key]
+		if !ok {
+			continue
+		}
+
+		code := strings.TrimSpace(fmt.Sprint(raw))
+		if kind, ok := graphQLErrorKinds[normalizeGraphQLErrorCode(code)]; ok {
+			return code, kind
+		}
+	}
+
+	return "", ""
+}
+
+func normalizeGraphQLErrorCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '_', '-', ' ', '.':
+			return -1
+		default:
+			return r
+		}
+	}, strings.ToUpper(code))
+}
+
+// HTTPStatus is the response status a mapped CMS error should produce: 403
+// when the CMS refused access to the content, 400 when it rejected the query
+// and 502 when it failed. It returns 0 for errors that stay server errors;
+// not-found errors are answered by the framework itself.
+func HTTPStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrUnauthorized):
+		return http.StatusForbidden
+	case errors.Is(err, ErrValidation):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrUpstream):
+		return http.StatusBadGateway
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,161 @@
+package notes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"blog/internal/imageloader"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/RevoTale/no-js/framework"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type errorClient struct {
+	err error
+}
+
+func (c errorClient) MakeRequest(_ context.Context, _ *graphql.Request, _ *graphql.Response) error {
+	return c.err
+}
+
+func TestMapGraphQLErrorKinds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		extensions map[string]any
+		target     error
+		kind       ErrorKind
+	}{
+		{
+			name:       "payload not found",
+			extensions: map[string]any{"name": "NotFound", "statusCode": 404},
+			target:     ErrNotFound,
+			kind:       ErrorKindNotFound,
+		},
+		{
+			name:       "apollo unauthenticated",
+			extensions: map[string]any{"code": "UNAUTHENTICATED"},
+			target:     ErrUnauthorized,
+			kind:       ErrorKindUnauthorized,
+		},
+		{
+			name:       "payload forbidden",
+			extensions: map[string]any{"name": "Forbidden"},
+			target:     ErrUnauthorized,
+			kind:       ErrorKindUnauthorized,
+		},
+		{
+			name:       "bad user input",
+			extensions: map[string]any{"code": "BAD_USER_INPUT"},
+			target:     ErrValidation,
+			kind:       ErrorKindValidation,
+		},
+		{
+			name:       "payload validation",
+			extensions: map[string]any{"name": "ValidationError"},
+			target:     ErrValidation,
+			kind:       ErrorKindValidation,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			source := gqlerror.List{
+				{Message: "unrelated"},
+				{Message: " upstream message ", Extensions: tc.extensions},
+			}
+			err := MapGraphQLError(source)
+
+			var mapped *GraphQLError
+			require.ErrorAs(t, err, &mapped)
+			require.Equal(t, tc.kind, mapped.Kind)
+			require.Equal(t, "upstream message", mapped.Message)
+			require.ErrorIs(t, err, tc.target)
+			require.Equal(t, tc.kind == ErrorKindNotFound, framework.IsNotFound(err))
+
+			var list gqlerror.List
+			require.ErrorAs(t, err, &list)
+		})
+	}
+}
+
+func TestMapGraphQLErrorLeavesUnknownErrorsUntouched(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, MapGraphQLError(nil))
+
+	transport := fmt.Errorf("dial tcp: connection refused")
+	require.Same(t, transport, MapGraphQLError(transport))
+
+	unknown := gqlerror.List{{Message: "boom", Extensions: map[string]any{"code": "INTERNAL_SERVER_ERROR"}}}
+	err := MapGraphQLError(unknown)
+	require.False(t, framework.IsNotFound(err))
+	require.False(t, errors.Is(err, ErrUnauthorized))
+	require.False(t, errors.Is(err, ErrValidation))
+
+	httpErr := &graphql.HTTPError{
+		StatusCode: 404,
+		Response:   graphql.Response{Errors: gqlerror.List{{Message: "404 page not found"}}},
+	}
+	require.False(t, framework.IsNotFound(MapGraphQLError(httpErr)))
+}
+
+func TestServiceMapsGraphQLErrors(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(errorClient{err: gqlerror.List{{
+		Message:    "Not Found",
+		Extensions: map[string]any{"name": "NotFound"},
+	}}}, 12, imageloader.New(false))
+
+	_, err := service.GetNoteBySlug(context.Background(), "en", "removed", nil)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.True(t, framework.IsNotFound(err))
+}
+
+func TestHTTPStatusForMappedErrors(t *testing.T) {
+	t.Parallel()
+
+	forbidden := MapGraphQLError(gqlerror.List{{Extensions: map[string]any{"code": "FORBIDDEN"}}})
+	require.Equal(t, http.StatusForbidden, HTTPStatus(forbidden))
+
+	invalid := MapGraphQLError(gqlerror.List{{Extensions: map[string]any{"code": "BAD_USER_INPUT"}}})
+	require.Equal(t, http.StatusBadRequest, HTTPStatus(invalid))
+
+	require.Zero(t, HTTPStatus(ErrNotFound))
+	require.Zero(t, HTTPStatus(errors.New("connection refused")))
+	require.Equal(t, http.StatusBadGateway, HTTPStatus(markUpstream(errors.New("connection refused"))))
+}
+
+func TestServiceMarksCMSFailuresAsUpstream(t *testing.T) {
+	t.Parallel()
+
+	down := NewService(errorClient{err: errors.New("dial tcp: connection refused")}, 12, imageloader.New(false))
+	_, err := down.GetNoteBySlug(context.Background(), "en", "hello", nil)
+	require.ErrorIs(t, err, ErrUpstream)
+	require.ErrorContains(t, err, "connection refused")
+
+	failing := NewService(errorClient{err: gqlerror.List{{
+		Message:    "boom",
+		Extensions: map[string]any{"code": "INTERNAL_SERVER_ERROR"},
+	}}}, 12, imageloader.New(false))
+	_, err = failing.GetNoteBySlug(context.Background(), "en", "hello", nil)
+	require.ErrorIs(t, err, ErrUpstream)
+
+	restricted := NewService(errorClient{err: gqlerror.List{{
+		Extensions: map[string]any{"code": "FORBIDDEN"},
+	}}}, 12, imageloader.New(false))
+	_, err = restricted.GetNoteBySlug(context.Background(), "en", "hello", nil)
+	require.NotErrorIs(t, err, ErrUpstream)
+
+	timedOut := NewService(errorClient{err: context.DeadlineExceeded}, 12, imageloader.New(false))
+	_, err = timedOut.GetNoteBySlug(context.Background(), "en", "hello", nil)
+	require.NotErrorIs(t, err, ErrUpstream)
+}
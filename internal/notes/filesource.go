@@ -0,0 +1,747 @@
+package notes
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog/internal/imageloader"
+)
+
+// authorsDir holds one markdown file per author: front matter with the name,
+// and the body as the bio.
+const authorsDir = "authors"
+
+// FileSource serves notes from a directory of markdown files with front matter:
+//
+//	---
+//	title: Hello
+//	slug: hello
+//	date: 2024-01-02
+//	authors: Jane Doe, Guest
+//	tags: Go, Machine learning
+//	type: long
+//	description: A first note.
+//	featured: false
+//	series: Go generics
+//	part: 1
+//	draft: false
+//	---
+//	Markdown body.
+//
+// Only date is required; the slug defaults to the file name. Notes sharing a
+// series title form that series, ordered by part. Every locale gets
+// the same content. Files are read once, when the source is built.
+type FileSource struct {
+	pageSize    int
+	imageLoader imageloader.Loader
+	markdown    MarkdownSettings
+
+	// notes is ordered newest first and includes drafts.
+	notes   []fileNote
+	authors map[string]Author
+}
+
+type fileNote struct {
+	slug        string
+	title       string
+	content     string
+	description string
+	publishedAt time.Time
+	noteType    NoteType
+	featured    bool
+	draft       bool
+	series      string
+	seriesPart  int
+	authors     []Author
+	tags        []Tag
+}
+
+func NewFileSource(fsys fs.FS, pageSize int, imageLoader imageloader.Loader) (*FileSource, error) {
+	if pageSize < 1 {
+		pageSize = 12
+	}
+
+	source := &FileSource{
+		pageSize:    pageSize,
+		imageLoader: imageLoader,
+		authors:     make(map[string]Author),
+	}
+	seen := make(map[string]string)
+	err := fs.WalkDir(fsys, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || path.Ext(filePath) != ".md" {
+			return nil
+		}
+
+		raw, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return err
+		}
+		fields, body := splitFrontMatter(string(raw))
+		if path.Dir(filePath) == authorsDir {
+			source.addAuthor(filePath, fields, body)
+			return nil
+		}
+
+		note, err := parseFileNote(filePath, fields, body)
+		if err != nil {
+			return err
+		}
+		if previous, ok := seen[note.slug]; ok {
+			return fmt.Errorf("%s: slug %q is already used by %s", filePath, note.slug, previous)
+		}
+		seen[note.slug] = filePath
+		source.notes = append(source.notes, note)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load notes: %w", err)
+	}
+
+	for i := range source.notes {
+		for j, author := range source.notes[i].authors {
+			if known, ok := source.authors[author.Slug]; ok {
+				source.notes[i].authors[j] = known
+			}
+		}
+	}
+	sort.SliceStable(source.notes, func(i int, j int) bool {
+		if !source.notes[i].publishedAt.Equal(source.notes[j].publishedAt) {
+			return source.notes[i].publishedAt.After(source.notes[j].publishedAt)
+		}
+		return source.notes[i].slug < source.notes[j].slug
+	})
+
+	return source, nil
+}
+
+func (s *FileSource) WithMarkdownSettings(settings MarkdownSettings) *FileSource {
+	s.markdown = settings
+	return s
+}
+
+func (s *FileSource) addAuthor(filePath string, fields map[string]string, body string) {
+	slug := NormalizeSlug(strings.TrimSuffix(path.Base(filePath), ".md"))
+	if slug == "" {
+		return
+	}
+
+	s.authors[slug] = Author{
+		Name: firstNonEmpty(fields["name"], slug),
+		Slug: slug,
+		Bio:  strings.TrimSpace(body),
+	}
+}
+
+func parseFileNote(filePath string, fields map[string]string, body string) (fileNote, error) {
+	note := fileNote{
+		slug:        NormalizeSlug(firstNonEmpty(fields["slug"], strings.TrimSuffix(path.Base(filePath), ".md"))),
+		title:       fields["title"],
+		content:     strings.TrimSpace(body),
+		description: fields["description"],
+		featured:    fields["featured"] == "true",
+		draft:       fields["draft"] == "true",
+		series:      fields["series"],
+	}
+	if noteType := ParseNoteType(fields["type"]); noteType != NoteTypeAll {
+		note.noteType = noteType
+	}
+	if note.slug == "" {
+		return fileNote{}, fmt.Errorf("%s: empty slug", filePath)
+	}
+	if raw := fields["part"]; raw != "" {
+		part, err := strconv.Atoi(raw)
+		if err != nil || part < 1 {
+			return fileNote{}, fmt.Errorf("%s: invalid part %q", filePath, raw)
+		}
+		note.seriesPart = part
+	}
+
+	publishedAt, err := parseFileDate(fields["date"])
+	if err != nil {
+		return fileNote{}, fmt.Errorf("%s: %w", filePath, err)
+	}
+	note.publishedAt = publishedAt
+
+	for _, name := range splitFrontMatterList(fields["authors"]) {
+		if slug := NormalizeSlug(name); slug != "" {
+			note.authors = mergeAuthor(note.authors, Author{Name: name, Slug: slug})
+		}
+	}
+	for _, title := range splitFrontMatterList(fields["tags"]) {
+		if name := NormalizeSlug(title); name != "" {
+			note.tags = mergeTag(note.tags, Tag{Name: name, Title: title})
+		}
+	}
+
+	return note, nil
+}
+
+func parseFileDate(raw string) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, fmt.Errorf("missing date")
+	}
+	if parsed, err := time.Parse(time.RFC3339, raw); err == nil {
+		return parsed.UTC(), nil
+	}
+	parsed, err := time.Parse(time.DateOnly, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", raw)
+	}
+
+	return parsed, nil
+}
+
+// splitFrontMatter reads flat "key: value" lines between leading --- fences.
+// Content without front matter is all body.
+func splitFrontMatter(raw string) (map[string]string, string) {
+	fields := make(map[string]string)
+	raw = strings.ReplaceAll(strings.TrimPrefix(raw, "\ufeff"), "\r\n", "\n")
+	header, ok := strings.CutPrefix(raw, "---\n")
+	if !ok {
+		return fields, raw
+	}
+	frontMatter, body, ok := strings.Cut("\n"+header+"\n", "\n---\n")
+	if !ok {
+		return fields, raw
+	}
+
+	for line := range strings.SplitSeq(frontMatter, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		fields[strings.ToLower(strings.TrimSpace(key))] = unquote(strings.TrimSpace(value))
+	}
+
+	return fields, body
+}
+
+func splitFrontMatterList(raw string) []string {
+	raw = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(raw), "["), "]")
+	out := make([]string, 0)
+	for item := range strings.SplitSeq(raw, ",") {
+		if item = unquote(strings.TrimSpace(item)); item != "" {
+			out = append(out, item)
+		}
+	}
+
+	return out
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (s *FileSource) ListNotes(
+	_ context.Context,
+	locale string,
+	filter ListFilter,
+	options ListOptions,
+) (NotesListResult, error) {
+	filter = normalizeFilter(filter)
+	result := NotesListResult{
+		ActiveFilter: filter,
+		Page:         filter.Page,
+		TotalPages:   1,
+		Authors:      s.listAuthors(),
+		Tags:         s.listTags(filter.Type),
+	}
+
+	if len(filter.AuthorSlugs) > 0 {
+		authors := make([]*Author, len(filter.AuthorSlugs))
+		authorErrs := make([]error, len(filter.AuthorSlugs))
+		for i, slug := range filter.AuthorSlugs {
+			if author, ok := s.findAuthor(slug); ok {
+				authors[i] = &author
+			} else {
+				authorErrs[i] = ErrNotFound
+			}
+		}
+		activeAuthors, err := resolveFilterAuthors(authors, authorErrs)
+		if err != nil {
+			if options.RequireAuthor {
+				return NotesListResult{}, ErrNotFound
+			}
+			result.Notes = []NoteSummary{}
+			return result, nil
+		}
+		result.ActiveAuthors = activeAuthors
+		result.ActiveAuthor = &activeAuthors[0]
+	}
+	if len(filter.TagNames) > 0 {
+		allTags := s.listTags(NoteTypeAll)
+		tags := make([]*Tag, len(filter.TagNames))
+		tagErrs := make([]error, len(filter.TagNames))
+		for i, name := range filter.TagNames {
+			if tags[i] = findTagByName(allTags, name); tags[i] == nil {
+				tagErrs[i] = ErrNotFound
+			}
+		}
+		activeTags, err := resolveFilterTags(filter.TagMode, tags, tagErrs)
+		if err != nil {
+			if options.RequireTag {
+				return NotesListResult{}, ErrNotFound
+			}
+			result.Notes = []NoteSummary{}
+			return result, nil
+		}
+		result.ActiveTags = activeTags
+		result.ActiveTag = &activeTags[0]
+		for _, tag := range activeTags {
+			result.Tags = mergeTag(result.Tags, tag)
+		}
+	}
+
+	matches := s.published(func(note fileNote) bool {
+		return note.matches(filter)
+	})
+	result.Notes, result.TotalPages = s.page(locale, matches, filter.Page)
+	return result, nil
+}
+
+func (s *FileSource) GetAuthorBySlug(_ context.Context, _ string, slug string) (*Author, error) {
+	author, ok := s.findAuthor(slug)
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return &author, nil
+}
+
+func (s *FileSource) GetTagByName(_ context.Context, _ string, name string) (*Tag, error) {
+	tag := findTagByName(s.listTags(NoteTypeAll), name)
+	if tag == nil {
+		return nil, ErrNotFound
+	}
+
+	return tag, nil
+}
+
+func (s *FileSource) GetNoteBySlug(
+	ctx context.Context,
+	locale string,
+	slug string,
+	siteRootURLs []string,
+) (*NoteDetail, error) {
+	if draftsRequested(ctx) {
+		return s.GetNoteDraftBySlug(ctx, locale, slug, siteRootURLs)
+	}
+
+	note, ok := s.findNote(slug)
+	if !ok || !note.isPublished(time.Now()) {
+		return nil, ErrNotFound
+	}
+
+	return s.noteDetail(locale, siteRootURLs, note), nil
+}
+
+func (s *FileSource) GetNoteDraftBySlug(
+	_ context.Context,
+	locale string,
+	slug string,
+	siteRootURLs []string,
+) (*NoteDetail, error) {
+	note, ok := s.findNote(slug)
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return s.noteDetail(locale, siteRootURLs, note), nil
+}
+
+func (s *FileSource) GetAdjacentNotes(_ context.Context, _ string, current NoteDetail) (AdjacentNotes, error) {
+	published := s.published(nil)
+	adjacent := AdjacentNotes{}
+	for i, note := range published {
+		if note.slug != current.ID {
+			continue
+		}
+		if i > 0 {
+			adjacent.Newer = published[i-1].link()
+		}
+		if i+1 < len(published) {
+			adjacent.Older = published[i+1].link()
+		}
+		break
+	}
+
+	return adjacent, nil
+}
+
+func (s *FileSource) ListFeatured(_ context.Context, locale string, limit int) ([]NoteSummary, error) {
+	featured := s.published(func(note fileNote) bool {
+		return note.featured
+	})
+
+	items := make([]NoteSummary, 0, min(max(limit, 0), len(featured)))
+	for _, note := range featured[:cap(items)] {
+		items = append(items, note.summary(locale))
+	}
+
+	return items, nil
+}
+
+func (s *FileSource) GetSeries(_ context.Context, locale string, slug string) (*Series, error) {
+	members := s.seriesMembers(NormalizeSlug(slug))
+	if len(members) == 0 {
+		return nil, ErrNotFound
+	}
+
+	series := &Series{Slug: NormalizeSlug(members[0].series), Title: members[0].series}
+	for _, note := range members {
+		series.Notes = append(series.Notes, note.summary(locale))
+	}
+
+	return series, nil
+}
+
+func (s *FileSource) GetNoteSeries(ctx context.Context, locale string, noteID string) (*SeriesPosition, error) {
+	note, ok := s.findNote(noteID)
+	if !ok || NormalizeSlug(note.series) == "" {
+		return nil, nil
+	}
+
+	series, err := s.GetSeries(ctx, locale, note.series)
+	if err != nil {
+		return nil, err
+	}
+
+	return seriesPosition(series.Slug, series.Title, series.Notes, note.slug), nil
+}
+
+// seriesMembers returns the published notes of a series by part, oldest first
+// among notes without one.
+func (s *FileSource) seriesMembers(slug string) []fileNote {
+	if slug == "" {
+		return nil
+	}
+
+	members := s.published(func(note fileNote) bool {
+		return NormalizeSlug(note.series) == slug
+	})
+	slices.Reverse(members)
+	sort.SliceStable(members, func(i int, j int) bool {
+		if members[i].seriesPart == 0 || members[j].seriesPart == 0 {
+			return members[j].seriesPart == 0 && members[i].seriesPart != 0
+		}
+		return members[i].seriesPart < members[j].seriesPart
+	})
+
+	return members
+}
+
+// GetRelatedNotes ranks notes the way the CMS source does: two points per
+// shared tag and one for sharing an author, newest first among equals.
+func (s *FileSource) GetRelatedNotes(
+	_ context.Context,
+	locale string,
+	noteID string,
+	limit int,
+) ([]NoteSummary, error) {
+	current, ok := s.findNote(noteID)
+	if !ok || limit < 1 {
+		return []NoteSummary{}, nil
+	}
+
+	type scoredNote struct {
+		note  fileNote
+		score int
+	}
+	scored := make([]scoredNote, 0)
+	for _, note := range s.published(nil) {
+		if note.slug == current.slug {
+			continue
+		}
+		score := 0
+		for _, tag := range note.tags {
+			if findTagByName(current.tags, tag.Name) != nil {
+				score += 2
+			}
+		}
+		for _, author := range note.authors {
+			if hasAuthor(current.authors, author.Slug) {
+				score++
+				break
+			}
+		}
+		if score > 0 {
+			scored = append(scored, scoredNote{note: note, score: score})
+		}
+	}
+	sort.SliceStable(scored, func(i int, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+
+	items := make([]NoteSummary, 0, min(limit, len(scored)))
+	for _, item := range scored[:min(limit, len(scored))] {
+		items = append(items, item.note.summary(locale))
+	}
+
+	return items, nil
+}
+
+func (s *FileSource) GetArchiveIndex(context.Context) (ArchiveIndex, error) {
+	counts := make(map[int]map[time.Month]int)
+	for _, note := range s.published(nil) {
+		year := note.publishedAt.Year()
+		if counts[year] == nil {
+			counts[year] = make(map[time.Month]int)
+		}
+		counts[year][note.publishedAt.Month()]++
+	}
+
+	return newArchiveIndex(counts), nil
+}
+
+func (s *FileSource) ListArchiveNotes(
+	_ context.Context,
+	locale string,
+	year int,
+	month time.Month,
+	page int,
+) ([]NoteSummary, int, error) {
+	matches := s.published(func(note fileNote) bool {
+		return note.publishedAt.Year() == year && note.publishedAt.Month() == month
+	})
+	if len(matches) == 0 {
+		return []NoteSummary{}, 0, nil
+	}
+
+	items, totalPages := s.page(locale, matches, sanitizePage(page))
+	return items, totalPages, nil
+}
+
+func (s *FileSource) ListTagsWithCounts(context.Context, string) ([]TagCount, error) {
+	counts := make(map[string]*TagCount)
+	for _, note := range s.published(nil) {
+		for _, tag := range note.tags {
+			entry, ok := counts[tag.Name]
+			if !ok {
+				entry = &TagCount{Tag: tag}
+				counts[tag.Name] = entry
+			}
+			entry.Count++
+		}
+	}
+
+	return sortTagCounts(counts), nil
+}
+
+func (s *FileSource) ChannelCounts(context.Context, string) (ChannelCounts, error) {
+	counts := newChannelCounts()
+	for _, note := range s.published(nil) {
+		authorSlugs := make([]string, 0, len(note.authors))
+		for _, author := range note.authors {
+			authorSlugs = append(authorSlugs, author.Slug)
+		}
+		tagNames := make([]string, 0, len(note.tags))
+		for _, tag := range note.tags {
+			tagNames = append(tagNames, tag.Name)
+		}
+		counts.add(note.noteType, authorSlugs, tagNames)
+	}
+
+	return counts, nil
+}
+
+func (s *FileSource) published(keep func(fileNote) bool) []fileNote {
+	now := time.Now()
+	out := make([]fileNote, 0, len(s.notes))
+	for _, note := range s.notes {
+		if !note.isPublished(now) || (keep != nil && !keep(note)) {
+			continue
+		}
+		out = append(out, note)
+	}
+
+	return out
+}
+
+// isPublished reports whether the note is out at now: not a draft and not
+// dated in the future. Scheduled notes are only reachable as drafts.
+func (note fileNote) isPublished(now time.Time) bool {
+	return !note.draft && !note.publishedAt.After(now)
+}
+
+func (s *FileSource) page(locale string, matches []fileNote, page int) ([]NoteSummary, int) {
+	totalPages := max(1, (len(matches)+s.pageSize-1)/s.pageSize)
+	start := min((page-1)*s.pageSize, len(matches))
+	end := min(start+s.pageSize, len(matches))
+
+	items := make([]NoteSummary, 0, end-start)
+	for _, note := range matches[start:end] {
+		items = append(items, note.summary(locale))
+	}
+
+	return items, totalPages
+}
+
+func (s *FileSource) findNote(slug string) (fileNote, bool) {
+	slug = NormalizeSlug(slug)
+	for _, note := range s.notes {
+		if note.slug == slug {
+			return note, true
+		}
+	}
+
+	return fileNote{}, false
+}
+
+func (s *FileSource) findAuthor(slug string) (Author, bool) {
+	slug = NormalizeSlug(slug)
+	for _, author := range s.listAuthors() {
+		if author.Slug == slug {
+			return author, true
+		}
+	}
+
+	return Author{}, false
+}
+
+func (s *FileSource) listAuthors() []Author {
+	authors := make([]Author, 0, len(s.authors))
+	for _, author := range s.authors {
+		authors = append(authors, author)
+	}
+	for _, note := range s.published(nil) {
+		for _, author := range note.authors {
+			authors = mergeAuthor(authors, author)
+		}
+	}
+	sortAuthorsByName(authors)
+
+	return authors
+}
+
+// listTags returns the tags used by published notes of noteType, like the
+// CMS query behind the tag filter.
+func (s *FileSource) listTags(noteType NoteType) []Tag {
+	tags := make([]Tag, 0)
+	for _, note := range s.published(nil) {
+		if noteType != NoteTypeAll && note.noteType != noteType {
+			continue
+		}
+		for _, tag := range note.tags {
+			tags = mergeTag(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+func (s *FileSource) noteDetail(locale string, siteRootURLs []string, note fileNote) *NoteDetail {
+	publishedAt := note.publishedAt.Format(time.RFC3339)
+	doc := noteDocument{
+		ID:          note.slug,
+		Slug:        &note.slug,
+		Title:       &note.title,
+		Content:     &note.content,
+		PublishedAt: &publishedAt,
+		Authors:     note.authors,
+		Tags:        note.tags,
+	}
+	if note.description != "" {
+		doc.MetaDescription = &note.description
+	}
+
+	return renderNoteDetail(s.imageLoader, s.markdown, locale, note.slug, siteRootURLs, doc)
+}
+
+func (note fileNote) matches(filter ListFilter) bool {
+	if filter.Type != NoteTypeAll && note.noteType != filter.Type {
+		return false
+	}
+	if len(filter.AuthorSlugs) > 0 && !slices.ContainsFunc(filter.AuthorSlugs, func(slug string) bool {
+		return hasAuthor(note.authors, slug)
+	}) {
+		return false
+	}
+	if len(filter.TagNames) > 0 && !note.hasTags(filter.TagNames, filter.TagMode) {
+		return false
+	}
+	if !filter.From.IsZero() && note.publishedAt.Before(filter.From) {
+		return false
+	}
+	if !filter.To.IsZero() && !note.publishedAt.Before(filter.To) {
+		return false
+	}
+	if filter.Query != "" {
+		query := strings.ToLower(filter.Query)
+		return strings.Contains(strings.ToLower(note.title), query) ||
+			strings.Contains(strings.ToLower(note.content), query) ||
+			strings.Contains(strings.ToLower(note.description), query)
+	}
+
+	return true
+}
+
+func (note fileNote) hasTags(names []string, mode TagMatchMode) bool {
+	for _, name := range names {
+		found := findTagByName(note.tags, name) != nil
+		if mode == TagMatchAll && !found {
+			return false
+		}
+		if mode != TagMatchAll && found {
+			return true
+		}
+	}
+
+	return mode == TagMatchAll
+}
+
+func hasAuthor(authors []Author, slug string) bool {
+	for _, author := range authors {
+		if author.Slug == slug {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (note fileNote) summary(locale string) NoteSummary {
+	publishedAt := note.publishedAt.Format(time.RFC3339)
+	summary := summaryFromListDoc(
+		locale,
+		note.slug,
+		&note.slug,
+		&note.title,
+		&note.content,
+		&publishedAt,
+		note.description,
+		nil,
+		note.authors,
+		note.tags,
+	)
+	summary.Featured = note.featured
+
+	return summary
+}
+
+func (note fileNote) link() *NoteLink {
+	return newNoteLink(note.slug, &note.slug, &note.title)
+}
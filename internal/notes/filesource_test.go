@@ -0,0 +1,227 @@
+package notes
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"blog/internal/imageloader"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFileSource(t *testing.T) *FileSource {
+	t.Helper()
+
+	source, err := NewFileSource(fstest.MapFS{
+		"hello.md": {Data: []byte("---\ntitle: Hello\ndate: 2024-01-02\nauthors: Jane Doe\n" +
+			"tags: Go, Machine learning\ntype: long\ndescription: \"First note\"\nseries: Getting started\npart: 2\n---\n" +
+			"# Hello\n\nWelcome.\n")},
+		"2024/second.md": {Data: []byte("---\nslug: Second_Note\ndate: 2024-02-10T08:00:00Z\n" +
+			"authors: [Jane Doe, Guest]\ntags: Go\ntype: short\nfeatured: true\nseries: Getting started\npart: 1\n---\n" +
+			"A short one.\n")},
+		"draft.md":            {Data: []byte("---\ntitle: Draft\ndate: 2024-03-01\ndraft: true\n---\nNot yet.\n")},
+		"authors/jane-doe.md": {Data: []byte("---\nname: Jane Doe\n---\nWrites about Go.\n")},
+		"README.txt":          {Data: []byte("ignored")},
+	}, 1, imageloader.New(false))
+	require.NoError(t, err)
+	return source
+}
+
+func TestFileSourceListsPublishedNotesNewestFirst(t *testing.T) {
+	t.Parallel()
+
+	source := newTestFileSource(t)
+	ctx := context.Background()
+
+	result, err := source.ListNotes(ctx, "en", ListFilter{}, ListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.TotalPages)
+	require.Len(t, result.Notes, 1)
+	require.Equal(t, "second-note", result.Notes[0].Slug)
+	require.Equal(t, []string{"guest", "jane-doe"}, authorSlugs(result.Authors))
+
+	result, err = source.ListNotes(ctx, "en", ListFilter{Page: 2, TagNames: []string{"machine_learning"}}, ListOptions{})
+	require.NoError(t, err)
+	require.Empty(t, result.Notes)
+	require.Equal(t, "Machine learning", result.ActiveTag.Title)
+
+	result, err = source.ListNotes(ctx, "en", ListFilter{
+		TagNames: []string{"go", "machine-learning"},
+		TagMode:  TagMatchAll,
+	}, ListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.TotalPages)
+	require.Equal(t, "hello", result.Notes[0].Slug)
+
+	result, err = source.ListNotes(ctx, "en", ListFilter{TagNames: []string{"missing", "go"}}, ListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.TotalPages)
+	require.Equal(t, "Go", result.ActiveTag.Title)
+
+	filter := ListFilter{AuthorSlugs: []string{"jane-doe"}, Type: NoteTypeLong}
+	result, err = source.ListNotes(ctx, "en", filter, ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Notes, 1)
+	require.Equal(t, "First note", result.Notes[0].Description)
+	require.Equal(t, "Writes about Go.", result.ActiveAuthor.Bio)
+
+	result, err = source.ListNotes(ctx, "en", ListFilter{AuthorSlugs: []string{"nobody", "guest"}}, ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Notes, 1)
+	require.Equal(t, []string{"guest"}, authorSlugs(result.ActiveAuthors))
+
+	result, err = source.ListNotes(ctx, "en", ListFilter{Query: "WELCOME"}, ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Notes, 1)
+	require.Equal(t, "hello", result.Notes[0].Slug)
+
+	_, err = source.ListNotes(ctx, "en", ListFilter{AuthorSlugs: []string{"nobody"}}, ListOptions{RequireAuthor: true})
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestFileSourceServesNotesAndHidesDrafts(t *testing.T) {
+	t.Parallel()
+
+	source := newTestFileSource(t)
+	ctx := context.Background()
+
+	note, err := source.GetNoteBySlug(ctx, "en", "hello", nil)
+	require.NoError(t, err)
+	require.Contains(t, string(note.BodyHTML), "Welcome.")
+	require.Equal(t, "Jane Doe", note.Authors[0].Name)
+
+	adjacent, err := source.GetAdjacentNotes(ctx, "en", *note)
+	require.NoError(t, err)
+	require.Equal(t, "second-note", adjacent.Newer.Slug)
+	require.Nil(t, adjacent.Older)
+
+	related, err := source.GetRelatedNotes(ctx, "en", note.ID, 3)
+	require.NoError(t, err)
+	require.Len(t, related, 1)
+
+	featured, err := source.ListFeatured(ctx, "en", 3)
+	require.NoError(t, err)
+	require.Len(t, featured, 1)
+	require.True(t, featured[0].Featured)
+
+	_, err = source.GetNoteBySlug(ctx, "en", "draft", nil)
+	require.ErrorIs(t, err, ErrNotFound)
+	draft, err := source.GetNoteBySlug(WithDrafts(ctx), "en", "draft", nil)
+	require.NoError(t, err)
+	require.Equal(t, "Draft", draft.Title)
+}
+
+func TestFileSourceHidesScheduledNotes(t *testing.T) {
+	t.Parallel()
+
+	source, err := NewFileSource(fstest.MapFS{
+		"out.md":   {Data: []byte("---\ntitle: Out\ndate: 2024-01-02\n---\nBody.\n")},
+		"later.md": {Data: []byte("---\ntitle: Later\ndate: 2999-01-01\nfeatured: true\n---\nNot yet.\n")},
+	}, 12, imageloader.New(false))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	result, err := source.ListNotes(ctx, "en", ListFilter{}, ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, result.Notes, 1)
+	require.Equal(t, "out", result.Notes[0].Slug)
+
+	featured, err := source.ListFeatured(ctx, "en", 3)
+	require.NoError(t, err)
+	require.Empty(t, featured)
+
+	_, err = source.GetNoteBySlug(ctx, "en", "later", nil)
+	require.ErrorIs(t, err, ErrNotFound)
+	scheduled, err := source.GetNoteBySlug(WithDrafts(ctx), "en", "later", nil)
+	require.NoError(t, err)
+	require.Equal(t, "Later", scheduled.Title)
+}
+
+func TestFileSourceAggregatesArchiveAndTags(t *testing.T) {
+	t.Parallel()
+
+	source := newTestFileSource(t)
+	ctx := context.Background()
+
+	index, err := source.GetArchiveIndex(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, index.Total)
+	_, ok := index.Find(2024, time.March)
+	require.False(t, ok)
+
+	items, totalPages, err := source.ListArchiveNotes(ctx, "en", 2024, time.February, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, totalPages)
+	require.Len(t, items, 1)
+
+	tags, err := source.ListTagsWithCounts(ctx, "en")
+	require.NoError(t, err)
+	require.Equal(t, []TagCount{
+		{Tag: Tag{Name: "go", Title: "Go"}, Count: 2},
+		{Tag: Tag{Name: "machine-learning", Title: "Machine learning"}, Count: 1},
+	}, tags)
+
+	counts, err := source.ChannelCounts(ctx, "en")
+	require.NoError(t, err)
+	require.Equal(t, 2, counts.Author("jane-doe"))
+	require.Equal(t, 1, counts.Author("guest"))
+	require.Equal(t, 2, counts.Tag("go"))
+	require.Equal(t, 1, counts.Type(NoteTypeShort))
+	require.Equal(t, 2, counts.Type(NoteTypeAll))
+}
+
+func TestFileSourceOrdersSeriesByPart(t *testing.T) {
+	t.Parallel()
+
+	source := newTestFileSource(t)
+	ctx := context.Background()
+
+	series, err := source.GetSeries(ctx, "en", "getting-started")
+	require.NoError(t, err)
+	require.Equal(t, "Getting started", series.Title)
+	require.Equal(t, []string{"second-note", "hello"}, noteSlugs(series.Notes))
+
+	position, err := source.GetNoteSeries(ctx, "en", "hello")
+	require.NoError(t, err)
+	require.Equal(t, 2, position.Part)
+	require.Equal(t, "second-note", position.Previous.Slug)
+	require.Nil(t, position.Next)
+
+	_, err = source.GetSeries(ctx, "en", "missing")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestNewFileSourceRejectsInvalidNotes(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFileSource(fstest.MapFS{"a.md": {Data: []byte("---\ntitle: A\n---\nbody")}}, 12, imageloader.New(false))
+	require.ErrorContains(t, err, "a.md: missing date")
+
+	_, err = NewFileSource(fstest.MapFS{"a.md": {Data: []byte("---\ndate: 2024-01-01\npart: first\n---\n")}}, 12,
+		imageloader.New(false))
+	require.ErrorContains(t, err, `a.md: invalid part "first"`)
+
+	_, err = NewFileSource(fstest.MapFS{
+		"a.md":     {Data: []byte("---\ndate: 2024-01-01\n---\n")},
+		"old/a.md": {Data: []byte("---\ndate: 2023-01-01\n---\n")},
+	}, 12, imageloader.New(false))
+	require.ErrorContains(t, err, `slug "a" is already used`)
+}
+
+func TestFixtureSourceLoadsSampleNotes(t *testing.T) {
+	t.Parallel()
+
+	source, err := NewFixtureSource(12, imageloader.New(false))
+	require.NoError(t, err)
+
+	result, err := source.ListNotes(context.Background(), "en", ListFilter{}, ListOptions{})
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Notes)
+	require.NotEmpty(t, result.Authors)
+	require.NotEmpty(t, result.Tags)
+	for _, note := range result.Notes {
+		_, err := source.GetNoteBySlug(context.Background(), "en", note.Slug, nil)
+		require.NoError(t, err)
+	}
+}
@@ -0,0 +1,22 @@
+package notes
+
+import (
+	"embed"
+	"io/fs"
+
+	"blog/internal/imageloader"
+)
+
+//go:embed fixtures
+var fixtureFiles embed.FS
+
+// NewFixtureSource serves the sample notes compiled into the binary, so the
+// blog renders realistic pages offline, without a CMS.
+func NewFixtureSource(pageSize int, imageLoader imageloader.Loader) (*FileSource, error) {
+	fsys, err := fs.Sub(fixtureFiles, "fixtures")
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFileSource(fsys, pageSize, imageLoader)
+}
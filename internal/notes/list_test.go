@@ -0,0 +1,211 @@
+package notes
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"blog/internal/gqltest"
+	"blog/internal/imageloader"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListNotesLoadsUnfilteredPageInOneRequest(t *testing.T) {
+	t.Parallel()
+
+	client := &pagedClient{opName: "ListNotesPage", pages: []string{
+		`{
+			"Micro_posts":{"totalPages":3,"docs":[
+				{"id":"1","slug":"hello","title":"Hello","publishedAt":"2024-01-02T00:00:00.000Z",
+					"authors":[{"name":"Guest","slug":"guest"}],"tags":[{"id":"9","name":"misc","title":null}]}
+			]},
+			"Authors":{"docs":[{"id":"a2","name":"Zed","slug":"zed"},{"id":"a1","name":"Ann","slug":"ann"}]},
+			"availableTagsByMicroPostType":[{"id":"1","name":"go","title":"Go"}]
+		}`,
+	}}
+
+	service := NewService(client, 12, imageloader.New(false))
+	result, err := service.ListNotes(context.Background(), "en", ListFilter{Page: 2}, ListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, client.calls)
+	require.Equal(t, 2, result.Page)
+	require.Equal(t, 3, result.TotalPages)
+	require.Len(t, result.Notes, 1)
+	require.Equal(t, []string{"ann", "guest", "zed"}, authorSlugs(result.Authors))
+	require.Equal(t, []Tag{{Name: "go", Title: "Go"}, {Name: "misc", Title: "misc"}}, result.Tags)
+}
+
+func TestListNotesBoundsPublishDateRange(t *testing.T) {
+	t.Parallel()
+
+	client := &storedSpellingClient{}
+	service := NewService(client, 12, imageloader.New(false))
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	result, err := service.ListNotes(context.Background(), "en", ListFilter{
+		From: from,
+		To:   from.AddDate(0, 6, 0),
+	}, ListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, from, result.ActiveFilter.From)
+
+	var listRequest string
+	for _, requested := range client.requested {
+		if strings.HasPrefix(requested, "ListNotes ") {
+			listRequest = requested
+		}
+	}
+	require.Contains(t, listRequest, `"from":"2024-01-01T00:00:00Z"`)
+	require.Contains(t, listRequest, `"to":"2024-07-01T00:00:00Z"`)
+}
+
+func TestParseTagFilterReadsAnyAndAllModes(t *testing.T) {
+	t.Parallel()
+
+	names, mode := ParseTagFilter("Go, rust,go")
+	require.Equal(t, []string{"go", "rust"}, names)
+	require.Equal(t, TagMatchAny, mode)
+	require.Equal(t, "go,rust", TagFilterQueryValue(names, mode))
+
+	names, mode = ParseTagFilter("go rust")
+	require.Equal(t, []string{"go", "rust"}, names)
+	require.Equal(t, TagMatchAll, mode)
+	require.Equal(t, "go rust", TagFilterQueryValue(names, mode))
+
+	names, mode = ParseTagFilter(" machine_learning ")
+	require.Equal(t, []string{"machine-learning"}, names)
+	require.Equal(t, TagMatchAny, mode)
+}
+
+func TestListNotesMatchesAllTagsWithAllTagIDs(t *testing.T) {
+	t.Parallel()
+
+	client := &storedSpellingClient{}
+	service := NewService(client, 12, imageloader.New(false))
+	result, err := service.ListNotes(context.Background(), "en", ListFilter{
+		TagNames: []string{"go", "machine-learning"},
+		TagMode:  TagMatchAll,
+	}, ListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"go", "Machine learning"}, []string{result.ActiveTags[0].Name, result.ActiveTags[1].Name})
+
+	var listRequest string
+	for _, requested := range client.requested {
+		if strings.HasPrefix(requested, "ListNotes ") {
+			listRequest = requested
+		}
+	}
+	require.Contains(t, listRequest, `"allTagIDs":["1","2"]`)
+	require.NotContains(t, listRequest, `"tagIDs"`)
+}
+
+func TestListFeaturedMapsPinnedNotes(t *testing.T) {
+	t.Parallel()
+
+	client := &pagedClient{opName: "ListFeaturedNotes", pages: []string{
+		`{"Micro_posts":{"docs":[{"id":"1","slug":"pinned","title":"Pinned","featured":true}]}}`,
+	}}
+
+	featured, err := NewService(client, 12, imageloader.New(false)).ListFeatured(context.Background(), "en", 3)
+	require.NoError(t, err)
+	require.Len(t, featured, 1)
+	require.True(t, featured[0].Featured)
+	require.Equal(t, "pinned", featured[0].Slug)
+}
+
+func TestListQueriesHideScheduledNotes(t *testing.T) {
+	t.Parallel()
+
+	client := gqltest.New().SetFixture("ListFeaturedNotes", gqltest.Data(`{"Micro_posts":{"docs":[]}}`))
+	service := NewService(client, 12, imageloader.New(false))
+	ctx := context.Background()
+
+	before := time.Now().Add(-time.Second)
+	_, err := service.ListFeatured(ctx, "en", 3)
+	require.NoError(t, err)
+	_, err = service.ListFeatured(WithDrafts(ctx), "en", 3)
+	require.NoError(t, err)
+
+	service.WithScheduledPreview(true)
+	_, err = service.ListFeatured(ctx, "en", 3)
+	require.NoError(t, err)
+	_, err = service.ListFeatured(WithDrafts(ctx), "en", 3)
+	require.NoError(t, err)
+
+	calls := client.CallsTo("ListFeaturedNotes")
+	require.Len(t, calls, 4)
+	for _, call := range calls[:3] {
+		var bound time.Time
+		require.NoError(t, json.Unmarshal(call.Variables["now"], &bound))
+		require.False(t, bound.Before(before.Truncate(time.Second)), "the bound is the current time")
+		require.False(t, bound.After(time.Now()))
+	}
+	require.NotContains(t, calls[3].Variables, "now", "previews may list scheduled notes")
+}
+
+func TestFormatDateFollowsLocale(t *testing.T) {
+	t.Parallel()
+
+	raw := "2024-03-09T10:00:00.000Z"
+	require.Equal(t, "Mar 9, 2024", formatDate(&raw, "en"))
+	require.Equal(t, "09.03.2024", formatDate(&raw, "de"))
+	require.Equal(t, "2024年3月9日", formatDate(&raw, "ja"))
+	require.Equal(t, "2024-03-09", formatDate(&raw, "pt"))
+	require.Empty(t, formatDate(nil, "en"))
+}
+
+func authorSlugs(authors []Author) []string {
+	slugs := make([]string, 0, len(authors))
+	for _, author := range authors {
+		slugs = append(slugs, author.Slug)
+	}
+	return slugs
+}
+
+func TestListNotesMatchesAnyOfSeveralAuthors(t *testing.T) {
+	t.Parallel()
+
+	client := gqltest.New().
+		SetFixture("AvailableAuthors", gqltest.Data(`{"Authors":{"docs":[
+			{"name":"L You","slug":"l-you"},{"name":"Zed","slug":"zed"}
+		]}}`)).
+		SetFixture("AvailableTagsByPostType", gqltest.Data(`{"availableTagsByMicroPostType":[]}`)).
+		SetFixture("AuthorBySlug", gqltest.Fixture{
+			Response: gqltest.Data(`{"Authors":{"docs":[]}}`).Response,
+			Cases: []gqltest.Case{
+				{When: map[string]json.RawMessage{"slug": json.RawMessage(`"l-you"`)},
+					Response: gqltest.Data(`{"Authors":{"docs":[{"name":"L You","slug":"l-you"}]}}`).Response},
+				{When: map[string]json.RawMessage{"slug": json.RawMessage(`"zed"`)},
+					Response: gqltest.Data(`{"Authors":{"docs":[{"name":"Zed","slug":"zed"}]}}`).Response},
+			},
+		}).
+		SetFixture("ListNotes", gqltest.Data(`{"Micro_posts":{"totalPages":1,"docs":[]}}`))
+
+	service := NewService(client, 12, imageloader.New(false))
+	result, err := service.ListNotes(context.Background(), "en", ListFilter{
+		AuthorSlugs: []string{"Zed", "nobody", "l-you"},
+	}, ListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"zed", "l-you"}, authorSlugs(result.ActiveAuthors))
+	require.Equal(t, "zed", result.ActiveAuthor.Slug)
+
+	calls := client.CallsTo("ListNotes")
+	last := calls[len(calls)-1]
+	require.JSONEq(t, `["zed","l-you"]`, string(last.Variables["authorSlugs"]))
+	require.NotContains(t, last.Variables, "slug")
+
+	_, err = service.ListNotes(context.Background(), "en", ListFilter{
+		AuthorSlugs: []string{"nobody"},
+	}, ListOptions{RequireAuthor: true})
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestParseAuthorFilterReadsCommaSeparatedSlugs(t *testing.T) {
+	t.Parallel()
+
+	slugs := ParseAuthorFilter(" L_You,zed,,l-you")
+	require.Equal(t, []string{"l-you", "zed"}, slugs)
+	require.Equal(t, "l-you,zed", AuthorFilterQueryValue(slugs))
+	require.Empty(t, ParseAuthorFilter(""))
+}
@@ -0,0 +1,75 @@
+package notes
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// aggregateCacheTTL bounds how long results built by paging through every
+// published note are reused before the CMS is scanned again.
+const aggregateCacheTTL = 5 * time.Minute
+
+// memo holds per-key results of whole-collection scans. Failed loads are not
+// kept, and concurrent misses may load the same key more than once. A load that
+// was running when the memo was reset is returned but not kept.
+type memo[T any] struct {
+	mu         sync.Mutex
+	entries    map[string]memoEntry[T]
+	generation uint64
+}
+
+type memoEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+func (m *memo[T]) get(ctx context.Context, key string, load func(context.Context) (T, error)) (T, error) {
+	now := time.Now()
+
+	m.mu.Lock()
+	entry, ok := m.entries[key]
+	generation := m.generation
+	m.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.value, nil
+	}
+
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+
+	m.mu.Lock()
+	if m.generation == generation {
+		if m.entries == nil {
+			m.entries = make(map[string]memoEntry[T])
+		}
+		m.entries[key] = memoEntry[T]{value: value, expiresAt: now.Add(aggregateCacheTTL)}
+	}
+	m.mu.Unlock()
+	return value, nil
+}
+
+func (m *memo[T]) reset() {
+	m.mu.Lock()
+	m.entries = nil
+	m.generation++
+	m.mu.Unlock()
+}
+
+// InvalidateArchiveIndex drops the cached archive index, for when a note is
+// published, moved in time or removed.
+func (s *Service) InvalidateArchiveIndex() {
+	s.archiveIndex.reset()
+}
+
+// InvalidateTagCounts drops the cached tag counts of every locale.
+func (s *Service) InvalidateTagCounts() {
+	s.tagCounts.reset()
+}
+
+// InvalidateChannelCounts drops the cached sidebar counts of every locale.
+func (s *Service) InvalidateChannelCounts() {
+	s.channelCounts.reset()
+}
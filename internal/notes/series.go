@@ -0,0 +1,144 @@
+package notes
+
+import (
+	"context"
+	"strings"
+
+	gql "blog/internal/cmsgraphql"
+)
+
+// maxSeriesNotes caps how many parts of one series are loaded; series are
+// short, so every part comes back in a single request.
+const maxSeriesNotes = 100
+
+// Series is an ordered run of notes, such as a multi-part tutorial.
+type Series struct {
+	Slug        string
+	Title       string
+	Description string
+	// Notes are ordered by their position in the series, first part first.
+	Notes []NoteSummary
+}
+
+// SeriesPosition places one note within its series for "part N of M"
+// navigation.
+type SeriesPosition struct {
+	Slug     string
+	Title    string
+	Part     int
+	Total    int
+	Previous *NoteLink
+	Next     *NoteLink
+}
+
+// GetSeries returns the series stored under slug with its published notes in
+// series order.
+func (s *Service) GetSeries(ctx context.Context, locale string, slug string) (*Series, error) {
+	slug = NormalizeSlug(slug)
+	if slug == "" {
+		return nil, ErrNotFound
+	}
+
+	gqlLocale := gql.LocaleInputFromCode(locale)
+	gqlFallbackLocale := gql.FallbackLocaleInputFromCode(s.defaultLocale())
+	response, err := gql.SeriesBySlug(ctx, s.client, slug, gqlLocale, gqlFallbackLocale)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil || response.Series == nil || len(response.Series.Docs) == 0 {
+		return nil, ErrNotFound
+	}
+
+	doc := response.Series.Docs[0]
+	notes, err := s.seriesNotes(ctx, locale, doc.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Series{
+		Slug:        strOr(&doc.Slug, slug),
+		Title:       strOr(doc.Title, doc.Slug),
+		Description: strOr(doc.Description, ""),
+		Notes:       notes,
+	}, nil
+}
+
+// GetNoteSeries returns where noteID sits in its series, or nil when the note
+// is not part of one.
+func (s *Service) GetNoteSeries(ctx context.Context, locale string, noteID string) (*SeriesPosition, error) {
+	noteID = strings.TrimSpace(noteID)
+	if noteID == "" {
+		return nil, nil
+	}
+
+	response, err := gql.NoteSeries(
+		ctx,
+		s.client,
+		noteID,
+		gql.LocaleInputFromCode(locale),
+		gql.FallbackLocaleInputFromCode(s.defaultLocale()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil || response.Micro_posts == nil || len(response.Micro_posts.Docs) == 0 {
+		return nil, nil
+	}
+	series := response.Micro_posts.Docs[0].Series
+	if series == nil || strings.TrimSpace(series.Id) == "" {
+		return nil, nil
+	}
+
+	notes, err := s.seriesNotes(ctx, locale, series.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return seriesPosition(series.Slug, strOr(series.Title, series.Slug), notes, noteID), nil
+}
+
+func (s *Service) seriesNotes(ctx context.Context, locale string, seriesID string) ([]NoteSummary, error) {
+	response, err := gql.SeriesNotes(
+		ctx,
+		s.client,
+		seriesID,
+		maxSeriesNotes,
+		s.publishedUntil(ctx),
+		gql.LocaleInputFromCode(locale),
+		gql.FallbackLocaleInputFromCode(s.defaultLocale()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil || response.Micro_posts == nil {
+		return []NoteSummary{}, nil
+	}
+
+	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
+	for _, doc := range response.Micro_posts.Docs {
+		items = append(items, mapNoteListDoc(doc.NoteListDoc, locale))
+	}
+
+	return items, nil
+}
+
+// seriesPosition finds noteID among the ordered series notes. It returns nil
+// when the note is not among them, e.g. a draft previewed before publishing.
+func seriesPosition(slug string, title string, notes []NoteSummary, noteID string) *SeriesPosition {
+	for i, note := range notes {
+		if note.ID != noteID {
+			continue
+		}
+
+		position := &SeriesPosition{Slug: slug, Title: title, Part: i + 1, Total: len(notes)}
+		if i > 0 {
+			position.Previous = newNoteLink(notes[i-1].ID, &notes[i-1].Slug, &notes[i-1].Title)
+		}
+		if i+1 < len(notes) {
+			position.Next = newNoteLink(notes[i+1].ID, &notes[i+1].Slug, &notes[i+1].Title)
+		}
+		return position
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package notes
+
+import (
+	"context"
+	"testing"
+
+	"blog/internal/gqltest"
+	"blog/internal/imageloader"
+	"github.com/stretchr/testify/require"
+)
+
+func newSeriesCMS() *gqltest.Client {
+	return gqltest.New().
+		SetFixture("SeriesBySlug", gqltest.Data(`{"Series":{"docs":[
+			{"id":"s1","slug":"go-generics","title":"Go generics","description":null}
+		]}}`)).
+		SetFixture("NoteSeries", gqltest.Data(`{"Micro_posts":{"docs":[
+			{"id":"2","series":{"id":"s1","slug":"go-generics","title":"Go generics"}}
+		]}}`)).
+		SetFixture("SeriesNotes", gqltest.Data(`{"Micro_posts":{"docs":[
+			{"id":"1","slug":"intro","title":"Intro"},
+			{"id":"2","slug":"constraints","title":"Constraints"},
+			{"id":"3","slug":"inference","title":null}
+		]}}`))
+}
+
+func TestGetSeriesKeepsSeriesOrder(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(newSeriesCMS(), 12, imageloader.New(false))
+	series, err := service.GetSeries(context.Background(), "en", "Go_Generics")
+	require.NoError(t, err)
+	require.Equal(t, "Go generics", series.Title)
+	require.Equal(t, []string{"intro", "constraints", "inference"}, noteSlugs(series.Notes))
+
+	_, err = service.GetSeries(context.Background(), "en", " ")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGetNoteSeriesPlacesNoteWithinItsSeries(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(newSeriesCMS(), 12, imageloader.New(false))
+	position, err := service.GetNoteSeries(context.Background(), "en", "2")
+	require.NoError(t, err)
+	require.Equal(t, 2, position.Part)
+	require.Equal(t, 3, position.Total)
+	require.Equal(t, "intro", position.Previous.Slug)
+	require.Equal(t, "inference", position.Next.Title)
+}
+
+func noteSlugs(items []NoteSummary) []string {
+	slugs := make([]string, 0, len(items))
+	for _, item := range items {
+		slugs = append(slugs, item.Slug)
+	}
+	return slugs
+}
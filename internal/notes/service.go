@@ -6,6 +6,7 @@ import (
 	"html/template"
 	"net/url"
 	"path"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -29,6 +30,10 @@ func (notFoundError) NotFound() bool {
 
 var ErrNotFound error = notFoundError{}
 
+const relatedCandidatesFactor = 4
+const availableAuthorsLimit = 200
+const maxRelatedCandidates = 50
+
 type NoteType string
 
 const (
@@ -37,12 +42,31 @@ const (
 	NoteTypeShort NoteType = "short"
 )
 
+// TagMatchMode decides whether a note must carry any or all of the filtered
+// tags.
+type TagMatchMode string
+
+const (
+	TagMatchAny TagMatchMode = "any"
+	TagMatchAll TagMatchMode = "all"
+)
+
 type ListFilter struct {
-	Page       int
-	AuthorSlug string
-	TagName    string
-	Type       NoteType
-	Query      string
+	Page int
+	// AuthorSlugs keeps the notes written by any of the authors.
+	AuthorSlugs []string
+	TagNames    []string
+	TagMode     TagMatchMode
+	Type        NoteType
+	Query       string
+	// From and To bound the publish date, From inclusive and To exclusive.
+	// A zero bound leaves that side of the range open.
+	From time.Time
+	To   time.Time
+}
+
+func (f ListFilter) hasDateRange() bool {
+	return !f.From.IsZero() || !f.To.IsZero()
 }
 
 type ListOptions struct {
@@ -54,6 +78,22 @@ type Service struct {
 	client      genqlientgraphql.Client
 	pageSize    int
 	imageLoader imageloader.Loader
+	markdown    MarkdownSettings
+	// scheduledInPreview lets draft previews see notes whose publish date is
+	// still ahead.
+	scheduledInPreview bool
+
+	archiveIndex  memo[ArchiveIndex]
+	tagCounts     memo[[]TagCount]
+	channelCounts memo[ChannelCounts]
+}
+
+// MarkdownSettings are the site-wide rendering switches applied on top of the
+// per-locale markdown labels.
+type MarkdownSettings struct {
+	CodeHighlight md.CodeHighlight
+	RawHTML       md.RawHTMLMode
+	Typographer   bool
 }
 
 type AuthorMedia struct {
@@ -68,11 +108,29 @@ type Author struct {
 	Slug   string
 	Bio    string
 	Avatar *AuthorMedia
+	// Website, Location and Socials form the author profile. They are loaded
+	// with the author by slug and empty in note and sidebar listings.
+	Website  string
+	Location string
+	Socials  []AuthorSocial
+	// NoteCount is the number of published notes by the author, set where the
+	// page shows it and 0 otherwise.
+	NoteCount int
+}
+
+// AuthorSocial is the author's profile on another site.
+type AuthorSocial struct {
+	Platform string
+	Handle   string
+	URL      string
 }
 
 type Tag struct {
 	Name  string
 	Title string
+	// NoteCount is the number of published notes with the tag, set where the
+	// page shows it and 0 otherwise.
+	NoteCount int
 }
 
 type Attachment struct {
@@ -96,6 +154,8 @@ type NoteSummary struct {
 	Excerpt        string
 	PublishedAt    string
 	PublishedAtISO string
+	WordCount      int
+	ReadingMinutes int
 	MetaTitle      string
 	Description    string
 	MetaImage      *Attachment
@@ -103,6 +163,8 @@ type NoteSummary struct {
 	Mentions       []NoteMention
 	Authors        []Author
 	Tags           []Tag
+	// Featured notes are pinned above the chronological feed.
+	Featured bool
 }
 
 type NoteDetail struct {
@@ -110,8 +172,11 @@ type NoteDetail struct {
 	Slug           string
 	Title          string
 	BodyHTML       template.HTML
+	HasDiagrams    bool
 	PublishedAt    string
 	PublishedAtISO string
+	WordCount      int
+	ReadingMinutes int
 	MetaTitle      string
 	Description    string
 	MetaImage      *Attachment
@@ -119,6 +184,20 @@ type NoteDetail struct {
 	Mentions       []NoteMention
 	Authors        []Author
 	Tags           []Tag
+
+	// publishedAtExact keeps the milliseconds PublishedAtISO drops, so notes
+	// published within the same second still order correctly.
+	publishedAtExact string
+}
+
+type NoteLink struct {
+	Slug  string
+	Title string
+}
+
+type AdjacentNotes struct {
+	Newer *NoteLink
+	Older *NoteLink
 }
 
 type NotesListResult struct {
@@ -128,8 +207,13 @@ type NotesListResult struct {
 	ActiveFilter ListFilter
 	ActiveAuthor *Author
 	ActiveTag    *Tag
-	Page         int
-	TotalPages   int
+	// ActiveAuthors holds every filtered author; ActiveAuthor is the first of
+	// them.
+	ActiveAuthors []Author
+	// ActiveTags holds every filtered tag; ActiveTag is the first of them.
+	ActiveTags []Tag
+	Page       int
+	TotalPages int
 }
 
 type AuthorPageResult struct {
@@ -150,12 +234,25 @@ func NewService(
 	}
 
 	return &Service{
-		client:      client,
+		client:      errorMappingClient{base: client},
 		pageSize:    pageSize,
 		imageLoader: imageLoader,
 	}
 }
 
+func (s *Service) WithMarkdownSettings(settings MarkdownSettings) *Service {
+	s.markdown = settings
+	return s
+}
+
+// WithScheduledPreview makes requests carrying WithDrafts list scheduled notes
+// too, so a preview shows the neighbours and series a note will have once it
+// is out.
+func (s *Service) WithScheduledPreview(include bool) *Service {
+	s.scheduledInPreview = include
+	return s
+}
+
 func ParseNoteType(raw string) NoteType {
 	switch strings.ToLower(strings.TrimSpace(raw)) {
 	case "long":
@@ -175,6 +272,53 @@ func (t NoteType) QueryValue() string {
 	return ""
 }
 
+// ParseTagFilter reads a tag query value: "go,rust" keeps notes tagged with
+// any of the tags and "go rust", written go+rust in a URL, only notes tagged
+// with all of them.
+func ParseTagFilter(raw string) ([]string, TagMatchMode) {
+	mode := TagMatchAny
+	separator := ","
+	if !strings.Contains(raw, ",") && len(strings.Fields(raw)) > 1 {
+		mode = TagMatchAll
+		separator = " "
+	}
+
+	return normalizeSlugs(strings.Split(raw, separator)), mode
+}
+
+// TagFilterQueryValue is the inverse of ParseTagFilter.
+func TagFilterQueryValue(names []string, mode TagMatchMode) string {
+	names = normalizeSlugs(names)
+	if mode == TagMatchAll {
+		return strings.Join(names, " ")
+	}
+
+	return strings.Join(names, ",")
+}
+
+// ParseAuthorFilter reads an author query value: "l-you,zed" keeps the notes
+// written by any of the authors.
+func ParseAuthorFilter(raw string) []string {
+	return normalizeSlugs(strings.Split(raw, ","))
+}
+
+// AuthorFilterQueryValue is the inverse of ParseAuthorFilter.
+func AuthorFilterQueryValue(slugs []string) string {
+	return strings.Join(normalizeSlugs(slugs), ",")
+}
+
+func normalizeSlugs(values []string) []string {
+	normalized := make([]string, 0, len(values))
+	for _, value := range values {
+		value = NormalizeSlug(value)
+		if value != "" && !slices.Contains(normalized, value) {
+			normalized = append(normalized, value)
+		}
+	}
+
+	return normalized
+}
+
 func (s *Service) ListNotes(
 	ctx context.Context,
 	locale string,
@@ -190,6 +334,10 @@ func (s *Service) ListNotes(
 		Page:         filter.Page,
 		TotalPages:   1,
 	}
+	if len(filter.AuthorSlugs) == 0 && len(filter.TagNames) == 0 && filter.Type == NoteTypeAll && filter.Query == "" &&
+		!filter.hasDateRange() {
+		return s.listUnfilteredNotes(ctx, locale, result)
+	}
 
 	gqlLocale := gql.LocaleInputFromCode(locale)
 	gqlFallbackLocale := gql.FallbackLocaleInputFromCode(s.defaultLocale())
@@ -202,7 +350,7 @@ func (s *Service) ListNotes(
 	)
 	var coreWG sync.WaitGroup
 	coreWG.Go(func() {
-		authorsResponse, authorsErr = gql.AvailableAuthors(ctx, s.client, 200, gqlLocale, gqlFallbackLocale)
+		authorsResponse, authorsErr = gql.AvailableAuthors(ctx, s.client, availableAuthorsLimit, gqlLocale, gqlFallbackLocale)
 	})
 	coreWG.Go(func() {
 		tagsResponse, tagsErr = gql.AvailableTagsByPostType(
@@ -214,25 +362,27 @@ func (s *Service) ListNotes(
 	})
 
 	var (
-		author    *Author
-		authorErr error
-		tag       *Tag
-		tagErr    error
-		tagIDs    []string
-		tagIDsErr error
+		authors    = make([]*Author, len(filter.AuthorSlugs))
+		authorErrs = make([]error, len(filter.AuthorSlugs))
+		tags       = make([]*Tag, len(filter.TagNames))
+		tagErrs    = make([]error, len(filter.TagNames))
+		tagIDs     []string
+		tagIDsErr  error
 	)
 	var filterWG sync.WaitGroup
-	if filter.AuthorSlug != "" {
+	for i, slug := range filter.AuthorSlugs {
 		filterWG.Go(func() {
-			author, authorErr = s.GetAuthorBySlug(ctx, locale, filter.AuthorSlug)
+			authors[i], authorErrs[i] = s.GetAuthorBySlug(ctx, locale, slug)
 		})
 	}
-	if filter.TagName != "" {
+	for i, name := range filter.TagNames {
 		filterWG.Go(func() {
-			tag, tagErr = s.GetTagByName(ctx, locale, filter.TagName)
+			tags[i], tagErrs[i] = s.GetTagByName(ctx, locale, name)
 		})
+	}
+	if len(filter.TagNames) > 0 {
 		filterWG.Go(func() {
-			tagIDs, tagIDsErr = s.findTagIDs(ctx, locale, []string{filter.TagName})
+			tagIDs, tagIDsErr = s.findTagIDs(ctx, locale, filter.TagNames)
 		})
 	}
 
@@ -242,7 +392,7 @@ func (s *Service) ListNotes(
 		notesErr   error
 	)
 	var notesWG sync.WaitGroup
-	if filter.TagName == "" {
+	if len(filter.TagNames) == 0 {
 		notesWG.Go(func() {
 			notes, totalPages, notesErr = s.listNotesByFilter(ctx, locale, filter, nil)
 		})
@@ -259,36 +409,61 @@ func (s *Service) ListNotes(
 	result.Tags = mapAvailableTags(tagsResponse)
 
 	filterWG.Wait()
-	if filter.AuthorSlug != "" {
-		if authorErr != nil {
-			if errors.Is(authorErr, ErrNotFound) && !options.RequireAuthor {
+	if len(filter.AuthorSlugs) > 0 {
+		activeAuthors, err := resolveFilterAuthors(authors, authorErrs)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) && !options.RequireAuthor {
 				result.Notes = []NoteSummary{}
 				result.TotalPages = 1
 				return result, nil
 			}
 
-			return NotesListResult{}, authorErr
+			return NotesListResult{}, err
+		}
+		result.ActiveAuthors = activeAuthors
+		result.ActiveAuthor = &activeAuthors[0]
+		storedSlugs := make([]string, 0, len(activeAuthors))
+		for _, author := range activeAuthors {
+			result.Authors = mergeAuthor(result.Authors, author)
+			storedSlugs = append(storedSlugs, author.Slug)
+		}
+		if !slices.Equal(storedSlugs, filter.AuthorSlugs) {
+			filter.AuthorSlugs = storedSlugs
+			if len(filter.TagNames) == 0 {
+				notesWG.Wait()
+				notes, totalPages, notesErr = s.listNotesByFilter(ctx, locale, filter, nil)
+			}
 		}
-		result.ActiveAuthor = author
-		result.Authors = mergeAuthor(result.Authors, *author)
 	}
-	if filter.TagName != "" {
-		if tagErr != nil {
-			if errors.Is(tagErr, ErrNotFound) && !options.RequireTag {
+	if len(filter.TagNames) > 0 {
+		activeTags, err := resolveFilterTags(filter.TagMode, tags, tagErrs)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) && !options.RequireTag {
 				result.Notes = []NoteSummary{}
 				result.TotalPages = 1
 				return result, nil
 			}
 
-			return NotesListResult{}, tagErr
+			return NotesListResult{}, err
+		}
+		result.ActiveTags = activeTags
+		result.ActiveTag = &activeTags[0]
+		storedNames := make([]string, 0, len(activeTags))
+		for _, tag := range activeTags {
+			result.Tags = mergeTag(result.Tags, tag)
+			storedNames = append(storedNames, tag.Name)
 		}
-		result.ActiveTag = tag
-		result.Tags = mergeTag(result.Tags, *tag)
 
 		if tagIDsErr != nil {
 			return NotesListResult{}, tagIDsErr
 		}
-		if len(tagIDs) == 0 {
+		if len(tagIDs) < len(storedNames) && !slices.Equal(storedNames, filter.TagNames) {
+			tagIDs, tagIDsErr = s.findTagIDs(ctx, locale, storedNames)
+			if tagIDsErr != nil {
+				return NotesListResult{}, tagIDsErr
+			}
+		}
+		if len(tagIDs) == 0 || (filter.TagMode == TagMatchAll && len(tagIDs) < len(storedNames)) {
 			if options.RequireTag {
 				return NotesListResult{}, ErrNotFound
 			}
@@ -311,325 +486,183 @@ func (s *Service) ListNotes(
 	result.Notes = notes
 	result.TotalPages = totalPages
 
-	if result.ActiveTag == nil && filter.TagName != "" {
-		result.ActiveTag = findTagByName(result.Tags, filter.TagName)
-	}
-
 	result.Authors = mergeAuthorsFromNotes(result.Authors, notes)
 	result.Tags = mergeTagsFromNotes(result.Tags, notes)
 
 	return result, nil
 }
 
-func (s *Service) listNotesByFilter(
-	ctx context.Context,
-	locale string,
-	filter ListFilter,
-	tagIDs []string,
-) ([]NoteSummary, int, error) {
-	if filter.Query != "" {
-		return s.searchNotesByFilter(ctx, locale, filter, tagIDs)
-	}
-
-	hasAuthor := filter.AuthorSlug != ""
-	hasTag := len(tagIDs) > 0
-	hasType := filter.Type == NoteTypeLong || filter.Type == NoteTypeShort
-
-	postType, _ := toPostTypeInput(filter.Type)
-	gqlLocale := gql.LocaleInputFromCode(locale)
-	gqlFallbackLocale := gql.FallbackLocaleInputFromCode(s.defaultLocale())
+// resolveFilterAuthors keeps the filtered authors the CMS knows; only when
+// none of them exists is the filter not found.
+func resolveFilterAuthors(authors []*Author, errs []error) ([]Author, error) {
+	resolved := make([]Author, 0, len(authors))
+	for i, author := range authors {
+		if errs[i] != nil {
+			if errors.Is(errs[i], ErrNotFound) {
+				continue
+			}
 
-	switch {
-	case hasAuthor && hasTag && hasType:
-		response, err := gql.ListNotesByAuthorTagIDsAndType(
-			ctx,
-			s.client,
-			filter.AuthorSlug,
-			filter.Page,
-			s.pageSize,
-			tagIDs,
-			postType,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
+			return nil, errs[i]
 		}
-		notes, totalPages := mapNotesListByAuthorTagIDsAndType(response)
-		return notes, totalPages, nil
-
-	case hasAuthor && hasTag:
-		response, err := gql.ListNotesByAuthorAndTagIDs(
-			ctx,
-			s.client,
-			filter.AuthorSlug,
-			filter.Page,
-			s.pageSize,
-			tagIDs,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapNotesListByAuthorAndTagIDs(response)
-		return notes, totalPages, nil
+		resolved = append(resolved, *author)
+	}
+	if len(resolved) == 0 {
+		return nil, ErrNotFound
+	}
 
-	case hasAuthor && hasType:
-		response, err := gql.NotesByAuthorSlugAndType(
-			ctx,
-			s.client,
-			filter.AuthorSlug,
-			filter.Page,
-			s.pageSize,
-			postType,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapNotesByAuthorSlugAndType(response)
-		return notes, totalPages, nil
+	return resolved, nil
+}
 
-	case hasAuthor:
-		response, err := gql.NotesByAuthorSlug(
-			ctx,
-			s.client,
-			filter.AuthorSlug,
-			filter.Page,
-			s.pageSize,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapNotesByAuthorSlug(response)
-		return notes, totalPages, nil
+// resolveFilterTags keeps the filtered tags the CMS knows. Any missing tag
+// empties an all-tags filter, while an any-tags filter only needs one of them.
+func resolveFilterTags(mode TagMatchMode, tags []*Tag, errs []error) ([]Tag, error) {
+	resolved := make([]Tag, 0, len(tags))
+	for i, tag := range tags {
+		if errs[i] != nil {
+			if errors.Is(errs[i], ErrNotFound) && mode != TagMatchAll {
+				continue
+			}
 
-	case hasTag && hasType:
-		response, err := gql.ListNotesByTagIDsAndType(
-			ctx,
-			s.client,
-			filter.Page,
-			s.pageSize,
-			tagIDs,
-			postType,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
+			return nil, errs[i]
 		}
-		notes, totalPages := mapNotesListByTagIDsAndType(response)
-		return notes, totalPages, nil
+		resolved = append(resolved, *tag)
+	}
+	if len(resolved) == 0 {
+		return nil, ErrNotFound
+	}
 
-	case hasTag:
-		response, err := gql.ListNotesByTagIDs(
-			ctx,
-			s.client,
-			filter.Page,
-			s.pageSize,
-			tagIDs,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapNotesListByTags(response)
-		return notes, totalPages, nil
+	return resolved, nil
+}
 
-	case hasType:
-		response, err := gql.ListNotesByType(
-			ctx,
-			s.client,
-			filter.Page,
-			s.pageSize,
-			postType,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapNotesListByType(response)
-		return notes, totalPages, nil
+// listUnfilteredNotes loads the notes page together with the authors and tags
+// it offers as filters in a single CMS round trip.
+func (s *Service) listUnfilteredNotes(
+	ctx context.Context,
+	locale string,
+	result NotesListResult,
+) (NotesListResult, error) {
+	response, err := gql.ListNotesPage(
+		ctx,
+		s.client,
+		result.Page,
+		s.pageSize,
+		availableAuthorsLimit,
+		s.publishedUntil(ctx),
+		gql.LocaleInputFromCode(locale),
+		gql.FallbackLocaleInputFromCode(s.defaultLocale()),
+	)
+	if err != nil {
+		return NotesListResult{}, err
+	}
 
-	default:
-		response, err := gql.ListNotes(ctx, s.client, filter.Page, s.pageSize, gqlLocale, gqlFallbackLocale)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapNotesList(response)
-		return notes, totalPages, nil
+	notes, totalPages := mapNotesListPage(response, locale)
+	if totalPages < 1 {
+		totalPages = 1
 	}
+	result.Notes = notes
+	result.TotalPages = totalPages
+	result.Authors = mergeAuthorsFromNotes(mapListNotesPageAuthors(response), notes)
+	result.Tags = mergeTagsFromNotes(mapListNotesPageTags(response), notes)
+
+	return result, nil
 }
 
-func (s *Service) searchNotesByFilter(
+// listNotesByFilter runs one parameterized query for every combination of
+// filters; the filters left unset are omitted from the request, so the CMS
+// does not constrain on them.
+func (s *Service) listNotesByFilter(
 	ctx context.Context,
 	locale string,
 	filter ListFilter,
 	tagIDs []string,
 ) ([]NoteSummary, int, error) {
-	hasAuthor := filter.AuthorSlug != ""
-	hasTag := len(tagIDs) > 0
-	hasType := filter.Type == NoteTypeLong || filter.Type == NoteTypeShort
-
-	postType, _ := toPostTypeInput(filter.Type)
+	var authorSlug *string
+	var authorSlugs []string
+	if len(filter.AuthorSlugs) == 1 {
+		authorSlug = &filter.AuthorSlugs[0]
+	} else {
+		authorSlugs = filter.AuthorSlugs
+	}
+	var anyTagIDs, allTagIDs []string
+	if filter.TagMode == TagMatchAll {
+		allTagIDs = tagIDs
+	} else {
+		anyTagIDs = tagIDs
+	}
+	postType := toPostTypeInput(filter.Type)
+	from := dateBoundArg(filter.From)
+	to := dateBoundArg(filter.To)
+	now := s.publishedUntil(ctx)
 	gqlLocale := gql.LocaleInputFromCode(locale)
 	gqlFallbackLocale := gql.FallbackLocaleInputFromCode(s.defaultLocale())
 
-	switch {
-	case hasAuthor && hasTag && hasType:
-		response, err := gql.SearchNotesByAuthorTagIDsAndType(
-			ctx,
-			s.client,
-			filter.Query,
-			filter.AuthorSlug,
-			filter.Page,
-			s.pageSize,
-			tagIDs,
-			postType,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapSearchNotesByAuthorTagIDsAndType(response)
-		return notes, totalPages, nil
-
-	case hasAuthor && hasTag:
-		response, err := gql.SearchNotesByAuthorAndTagIDs(
-			ctx,
-			s.client,
-			filter.Query,
-			filter.AuthorSlug,
-			filter.Page,
-			s.pageSize,
-			tagIDs,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapSearchNotesByAuthorAndTagIDs(response)
-		return notes, totalPages, nil
-
-	case hasAuthor && hasType:
-		response, err := gql.SearchNotesByAuthorSlugAndType(
-			ctx,
-			s.client,
-			filter.Query,
-			filter.AuthorSlug,
-			filter.Page,
-			s.pageSize,
-			postType,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapSearchNotesByAuthorSlugAndType(response)
-		return notes, totalPages, nil
-
-	case hasAuthor:
-		response, err := gql.SearchNotesByAuthorSlug(
-			ctx,
-			s.client,
-			filter.Query,
-			filter.AuthorSlug,
-			filter.Page,
-			s.pageSize,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapSearchNotesByAuthorSlug(response)
-		return notes, totalPages, nil
-
-	case hasTag && hasType:
-		response, err := gql.SearchNotesByTagIDsAndType(
-			ctx,
-			s.client,
-			filter.Query,
-			filter.Page,
-			s.pageSize,
-			tagIDs,
-			postType,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapSearchNotesByTagIDsAndType(response)
-		return notes, totalPages, nil
-
-	case hasTag:
-		response, err := gql.SearchNotesByTagIDs(
-			ctx,
-			s.client,
-			filter.Query,
-			filter.Page,
-			s.pageSize,
-			tagIDs,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapSearchNotesByTagIDs(response)
-		return notes, totalPages, nil
-
-	case hasType:
-		response, err := gql.SearchNotesByType(
+	if filter.Query != "" {
+		response, err := gql.SearchNotes(
 			ctx,
 			s.client,
 			filter.Query,
 			filter.Page,
 			s.pageSize,
+			authorSlug,
+			authorSlugs,
+			anyTagIDs,
+			allTagIDs,
 			postType,
+			from,
+			to,
+			now,
 			gqlLocale,
 			gqlFallbackLocale,
 		)
 		if err != nil {
 			return nil, 0, err
 		}
-		notes, totalPages := mapSearchNotesByType(response)
+		notes, totalPages := mapSearchNotes(response, locale)
 		return notes, totalPages, nil
+	}
 
-	default:
-		response, err := gql.SearchNotes(
-			ctx,
-			s.client,
-			filter.Query,
-			filter.Page,
-			s.pageSize,
-			gqlLocale,
-			gqlFallbackLocale,
-		)
-		if err != nil {
-			return nil, 0, err
-		}
-		notes, totalPages := mapSearchNotes(response)
-		return notes, totalPages, nil
+	response, err := gql.ListNotes(
+		ctx,
+		s.client,
+		filter.Page,
+		s.pageSize,
+		authorSlug,
+		authorSlugs,
+		anyTagIDs,
+		allTagIDs,
+		postType,
+		from,
+		to,
+		now,
+		gqlLocale,
+		gqlFallbackLocale,
+	)
+	if err != nil {
+		return nil, 0, err
 	}
+	notes, totalPages := mapNotesList(response, locale)
+	return notes, totalPages, nil
 }
 
 func (s *Service) GetAuthorBySlug(ctx context.Context, locale string, slug string) (*Author, error) {
-	slug = strings.TrimSpace(slug)
+	slug = NormalizeSlug(slug)
 	if slug == "" {
 		return nil, ErrNotFound
 	}
 
+	author, err := s.authorByStoredSlug(ctx, locale, slug)
+	if !errors.Is(err, ErrNotFound) {
+		return author, err
+	}
+	storedSlug, err := s.storedAuthorSlug(ctx, locale, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.authorByStoredSlug(ctx, locale, storedSlug)
+}
+
+func (s *Service) authorByStoredSlug(ctx context.Context, locale string, slug string) (*Author, error) {
 	response, err := gql.AuthorBySlug(
 		ctx,
 		s.client,
@@ -653,11 +686,24 @@ func (s *Service) GetAuthorBySlug(ctx context.Context, locale string, slug strin
 }
 
 func (s *Service) GetTagByName(ctx context.Context, locale string, name string) (*Tag, error) {
-	name = strings.TrimSpace(name)
+	name = NormalizeSlug(name)
 	if name == "" {
 		return nil, ErrNotFound
 	}
 
+	tag, err := s.tagByStoredName(ctx, locale, name)
+	if !errors.Is(err, ErrNotFound) {
+		return tag, err
+	}
+	storedName, err := s.storedTagName(ctx, locale, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tagByStoredName(ctx, locale, storedName)
+}
+
+func (s *Service) tagByStoredName(ctx context.Context, locale string, name string) (*Tag, error) {
 	response, err := gql.TagByName(
 		ctx,
 		s.client,
@@ -680,6 +726,46 @@ func (s *Service) GetTagByName(ctx context.Context, locale string, name string)
 	return &tag, nil
 }
 
+// storedAuthorSlug finds the CMS spelling of a normalized author slug. The CMS
+// compares slugs exactly, so "l-you" does not match an author stored as "L_You".
+func (s *Service) storedAuthorSlug(ctx context.Context, locale string, slug string) (string, error) {
+	response, err := gql.AvailableAuthors(
+		ctx,
+		s.client,
+		availableAuthorsLimit,
+		gql.LocaleInputFromCode(locale),
+		gql.FallbackLocaleInputFromCode(s.defaultLocale()),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	for _, author := range mapAvailableAuthors(response) {
+		if author.Slug != slug && NormalizeSlug(author.Slug) == slug {
+			return author.Slug, nil
+		}
+	}
+
+	return "", ErrNotFound
+}
+
+// storedTagName finds the CMS spelling of a normalized tag name, such as
+// "Machine learning" for "machine-learning".
+func (s *Service) storedTagName(ctx context.Context, locale string, name string) (string, error) {
+	response, err := gql.AvailableTagsByPostType(ctx, s.client, nil, gql.LocaleInputFromCode(locale))
+	if err != nil {
+		return "", err
+	}
+
+	for _, tag := range mapAvailableTags(response) {
+		if tag.Name != name && NormalizeSlug(tag.Name) == name {
+			return tag.Name, nil
+		}
+	}
+
+	return "", ErrNotFound
+}
+
 func (s *Service) GetAuthorPage(
 	ctx context.Context,
 	locale string,
@@ -687,9 +773,9 @@ func (s *Service) GetAuthorPage(
 	page int,
 ) (*AuthorPageResult, error) {
 	filter := ListFilter{
-		Page:       sanitizePage(page),
-		AuthorSlug: strings.TrimSpace(slug),
-		Type:       NoteTypeAll,
+		Page:        sanitizePage(page),
+		AuthorSlugs: []string{NormalizeSlug(slug)},
+		Type:        NoteTypeAll,
 	}
 
 	result, err := s.ListNotes(ctx, locale, filter, ListOptions{RequireAuthor: true})
@@ -709,16 +795,50 @@ func (s *Service) GetAuthorPage(
 	}, nil
 }
 
+type draftsContextKey struct{}
+
+// WithDrafts makes GetNoteBySlug return the latest draft instead of the
+// published version, for signed preview links.
+func WithDrafts(ctx context.Context) context.Context {
+	return context.WithValue(ctx, draftsContextKey{}, true)
+}
+
+func draftsRequested(ctx context.Context) bool {
+	requested, _ := ctx.Value(draftsContextKey{}).(bool)
+	return requested
+}
+
+// publishedUntil is the upper publishedAt bound of note queries. Notes the CMS
+// holds as published but dates in the future stay hidden until they are due,
+// unless the request is a preview and the service shows scheduled notes there.
+func (s *Service) publishedUntil(ctx context.Context) *string {
+	if s.scheduledInPreview && draftsRequested(ctx) {
+		return nil
+	}
+	return publishedUntilNow()
+}
+
+// publishedUntilNow is the bound for aggregates, which are shared between
+// requests and so never include scheduled notes.
+func publishedUntilNow() *string {
+	return dateBoundArg(time.Now())
+}
+
 func (s *Service) GetNoteBySlug(
 	ctx context.Context,
 	locale string,
 	slug string,
 	siteRootURLs []string,
 ) (*NoteDetail, error) {
+	if draftsRequested(ctx) {
+		return s.GetNoteDraftBySlug(ctx, locale, slug, siteRootURLs)
+	}
+
 	response, err := gql.NoteBySlug(
 		ctx,
 		s.client,
 		slug,
+		s.publishedUntil(ctx),
 		gql.LocaleInputFromCode(locale),
 		gql.FallbackLocaleInputFromCode(s.defaultLocale()),
 	)
@@ -729,33 +849,244 @@ func (s *Service) GetNoteBySlug(
 	if response == nil || response.Micro_posts == nil || len(response.Micro_posts.Docs) == 0 {
 		return nil, ErrNotFound
 	}
-
-	doc := response.Micro_posts.Docs[0]
-	mentions := noteMentions(doc.ExternalLinks, doc.LinkedMicroPosts)
-	translateLinks := mentionTranslateLinks(mentions)
-	markdownOptions := markdownOptionsForLocale(locale, s.imageLoader)
-	markdownOptions.TranslateLinks = translateLinks
-	markdownOptions.RootURLs = siteRootURLs
-	note := NoteDetail{
-		ID:             doc.Id,
-		Slug:           strOr(doc.Slug, slug),
-		Title:          pickTitle(doc.Title),
-		BodyHTML:       md.ToHTML(strOr(doc.Content, ""), markdownOptions),
-		PublishedAt:    formatDate(doc.PublishedAt),
-		PublishedAtISO: formatDateISO(doc.PublishedAt),
-		Attachment:     mapNoteAttachment(doc.Attachment),
-		Mentions:       mentions,
-		Authors:        mapNoteAuthors(doc.Authors),
-		Tags:           mapNoteTags(doc.Tags),
+
+	doc := response.Micro_posts.Docs[0]
+	source := noteDocument{
+		ID:          doc.Id,
+		Slug:        doc.Slug,
+		Title:       doc.Title,
+		Content:     doc.Content,
+		PublishedAt: doc.PublishedAt,
+		Attachment:  mapNoteAttachment(doc.Attachment),
+		Mentions:    noteMentions(doc.ExternalLinks, doc.LinkedMicroPosts),
+		Authors:     mapNoteAuthors(doc.Authors),
+		Tags:        mapNoteTags(doc.Tags),
+	}
+	if doc.Meta != nil {
+		source.MetaTitle = doc.Meta.Title
+		source.MetaDescription = doc.Meta.Description
+		source.MetaImage = mapNoteMetaAttachment(doc.Meta.Image)
+	}
+
+	return s.mapNoteDetail(locale, slug, siteRootURLs, source), nil
+}
+
+func (s *Service) GetNoteDraftBySlug(
+	ctx context.Context,
+	locale string,
+	slug string,
+	siteRootURLs []string,
+) (*NoteDetail, error) {
+	response, err := gql.NoteDraftBySlug(
+		ctx,
+		s.client,
+		slug,
+		gql.LocaleInputFromCode(locale),
+		gql.FallbackLocaleInputFromCode(s.defaultLocale()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || response.Micro_posts == nil || len(response.Micro_posts.Docs) == 0 {
+		return nil, ErrNotFound
+	}
+
+	doc := response.Micro_posts.Docs[0].NoteListDoc
+	source := noteDocument{
+		ID:          doc.Id,
+		Slug:        doc.Slug,
+		Title:       doc.Title,
+		Content:     doc.Content,
+		PublishedAt: doc.PublishedAt,
+		Attachment:  mapListAttachment(doc.Attachment),
+		Mentions:    noteListMentions(doc.ExternalLinks, doc.LinkedMicroPosts),
+		Authors:     mapListAuthors(doc.Authors),
+		Tags:        mapListTags(doc.Tags),
+	}
+	if doc.Meta != nil {
+		source.MetaTitle = doc.Meta.Title
+		source.MetaDescription = doc.Meta.Description
+		source.MetaImage = mapListMetaAttachment(doc.Meta.Image)
+	}
+
+	return s.mapNoteDetail(locale, slug, siteRootURLs, source), nil
+}
+
+// noteDocument holds what NoteDetail is built from, mapped out of the
+// generated types of either the published note or the draft query.
+type noteDocument struct {
+	ID              string
+	Slug            *string
+	Title           *string
+	Content         *string
+	PublishedAt     *string
+	Attachment      *Attachment
+	Mentions        []NoteMention
+	Authors         []Author
+	Tags            []Tag
+	MetaTitle       *string
+	MetaDescription *string
+	MetaImage       *Attachment
+}
+
+func (s *Service) mapNoteDetail(locale string, slug string, siteRootURLs []string, doc noteDocument) *NoteDetail {
+	return renderNoteDetail(s.imageLoader, s.markdown, locale, slug, siteRootURLs, doc)
+}
+
+func renderNoteDetail(
+	imageLoader imageloader.Loader,
+	settings MarkdownSettings,
+	locale string,
+	slug string,
+	siteRootURLs []string,
+	doc noteDocument,
+) *NoteDetail {
+	markdownOptions := markdownOptionsForLocale(locale, imageLoader)
+	markdownOptions.CodeHighlight = settings.CodeHighlight
+	markdownOptions.RawHTML = settings.RawHTML
+	markdownOptions.Typographer = settings.Typographer
+	markdownOptions.TranslateLinks = mentionTranslateLinks(doc.Mentions)
+	markdownOptions.RootURLs = siteRootURLs
+	content := strOr(doc.Content, "")
+	reading := md.Reading(content)
+
+	return &NoteDetail{
+		ID:             doc.ID,
+		Slug:           strOr(doc.Slug, slug),
+		Title:          pickTitle(doc.Title),
+		BodyHTML:       md.ToHTML(content, markdownOptions),
+		HasDiagrams:    md.HasMermaid(content),
+		PublishedAt:    formatDate(doc.PublishedAt, locale),
+		PublishedAtISO: formatDateISO(doc.PublishedAt),
+		WordCount:      reading.Words,
+		ReadingMinutes: reading.Minutes,
+		MetaTitle:      strOr(doc.MetaTitle, ""),
+		Description:    strOr(doc.MetaDescription, ""),
+		MetaImage:      doc.MetaImage,
+		Attachment:     doc.Attachment,
+		Mentions:       doc.Mentions,
+		Authors:        doc.Authors,
+		Tags:           doc.Tags,
+
+		publishedAtExact: formatDateExact(doc.PublishedAt),
+	}
+}
+
+func (s *Service) GetAdjacentNotes(ctx context.Context, locale string, note NoteDetail) (AdjacentNotes, error) {
+	publishedAt := note.publishedAtExact
+	if publishedAt == "" {
+		publishedAt = note.PublishedAtISO
+	}
+	if strings.TrimSpace(note.ID) == "" || strings.TrimSpace(publishedAt) == "" {
+		return AdjacentNotes{}, nil
+	}
+
+	response, err := gql.AdjacentNotes(
+		ctx,
+		s.client,
+		note.ID,
+		publishedAt,
+		s.publishedUntil(ctx),
+		gql.LocaleInputFromCode(locale),
+		gql.FallbackLocaleInputFromCode(s.defaultLocale()),
+	)
+	if err != nil {
+		return AdjacentNotes{}, err
+	}
+
+	adjacent := AdjacentNotes{}
+	if response == nil {
+		return adjacent, nil
+	}
+	if response.Newer != nil && len(response.Newer.Docs) > 0 {
+		doc := response.Newer.Docs[0]
+		adjacent.Newer = newNoteLink(doc.Id, doc.Slug, doc.Title)
+	}
+	if response.Older != nil && len(response.Older.Docs) > 0 {
+		doc := response.Older.Docs[0]
+		adjacent.Older = newNoteLink(doc.Id, doc.Slug, doc.Title)
+	}
+
+	return adjacent, nil
+}
+
+// ListFeatured returns up to limit published notes the CMS marks as featured,
+// newest first.
+func (s *Service) ListFeatured(ctx context.Context, locale string, limit int) ([]NoteSummary, error) {
+	if limit < 1 {
+		return []NoteSummary{}, nil
+	}
+
+	response, err := gql.ListFeaturedNotes(
+		ctx,
+		s.client,
+		limit,
+		s.publishedUntil(ctx),
+		gql.LocaleInputFromCode(locale),
+		gql.FallbackLocaleInputFromCode(s.defaultLocale()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapFeaturedNotes(response, locale), nil
+}
+
+func (s *Service) GetRelatedNotes(
+	ctx context.Context,
+	locale string,
+	noteID string,
+	limit int,
+) ([]NoteSummary, error) {
+	noteID = strings.TrimSpace(noteID)
+	if noteID == "" || limit < 1 {
+		return []NoteSummary{}, nil
+	}
+
+	gqlLocale := gql.LocaleInputFromCode(locale)
+	gqlFallbackLocale := gql.FallbackLocaleInputFromCode(s.defaultLocale())
+	relations, err := gql.NoteRelations(ctx, s.client, noteID, gqlLocale, gqlFallbackLocale)
+	if err != nil {
+		return nil, err
+	}
+	if relations == nil || relations.Micro_posts == nil || len(relations.Micro_posts.Docs) == 0 {
+		return []NoteSummary{}, nil
+	}
+
+	source := relations.Micro_posts.Docs[0]
+	tagIDs := make([]string, 0, len(source.Tags))
+	for _, tag := range source.Tags {
+		if id := strings.TrimSpace(tag.Id); id != "" {
+			tagIDs = append(tagIDs, id)
+		}
+	}
+	authorSlugs := make([]string, 0, len(source.Authors))
+	for _, author := range source.Authors {
+		if slug := strings.TrimSpace(author.Slug); slug != "" {
+			authorSlugs = append(authorSlugs, slug)
+		}
+	}
+	if len(tagIDs) == 0 && len(authorSlugs) == 0 {
+		return []NoteSummary{}, nil
 	}
 
-	if doc.Meta != nil {
-		note.Description = strOr(doc.Meta.Description, "")
-		note.MetaTitle = strOr(doc.Meta.Title, "")
-		note.MetaImage = mapNoteMetaAttachment(doc.Meta.Image)
+	response, err := gql.RelatedNoteCandidates(
+		ctx,
+		s.client,
+		noteID,
+		tagIDs,
+		authorSlugs,
+		min(limit*relatedCandidatesFactor, maxRelatedCandidates),
+		s.publishedUntil(ctx),
+		gqlLocale,
+		gqlFallbackLocale,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return &note, nil
+	return mapRelatedNoteCandidates(response, locale, tagIDs, authorSlugs, limit), nil
 }
 
 func (s *Service) findTagIDs(ctx context.Context, locale string, tagNames []string) ([]string, error) {
@@ -797,6 +1128,10 @@ type markdownLabels struct {
 	codeBlockLabel string
 	tableLabel     string
 	imageLabel     string
+	footnoteLabel  string
+	youTubeLabel   string
+	vimeoLabel     string
+	gistLabel      string
 }
 
 var markdownLabelsByLocale = map[string]markdownLabels{
@@ -807,6 +1142,10 @@ var markdownLabelsByLocale = map[string]markdownLabels{
 		codeBlockLabel: "[code block]",
 		tableLabel:     "[table]",
 		imageLabel:     "[image]",
+		footnoteLabel:  "back to reference",
+		youTubeLabel:   "YouTube video",
+		vimeoLabel:     "Vimeo video",
+		gistLabel:      "GitHub gist",
 	},
 	"de": {
 		copyLabel:      "kopieren",
@@ -815,6 +1154,10 @@ var markdownLabelsByLocale = map[string]markdownLabels{
 		codeBlockLabel: "[codeblock]",
 		tableLabel:     "[tabelle]",
 		imageLabel:     "[bild]",
+		footnoteLabel:  "zurueck zum verweis",
+		youTubeLabel:   "YouTube-Video",
+		vimeoLabel:     "Vimeo-Video",
+		gistLabel:      "GitHub-Gist",
 	},
 	"uk": {
 		copyLabel:      "kopiyuvaty",
@@ -823,6 +1166,10 @@ var markdownLabelsByLocale = map[string]markdownLabels{
 		codeBlockLabel: "[blok kodu]",
 		tableLabel:     "[tablytsya]",
 		imageLabel:     "[zobrazhennya]",
+		footnoteLabel:  "nazad do posylannya",
+		youTubeLabel:   "video YouTube",
+		vimeoLabel:     "video Vimeo",
+		gistLabel:      "GitHub gist",
 	},
 	"hi": {
 		copyLabel:      "copy",
@@ -831,6 +1178,10 @@ var markdownLabelsByLocale = map[string]markdownLabels{
 		codeBlockLabel: "[code block]",
 		tableLabel:     "[table]",
 		imageLabel:     "[image]",
+		footnoteLabel:  "back to reference",
+		youTubeLabel:   "YouTube video",
+		vimeoLabel:     "Vimeo video",
+		gistLabel:      "GitHub gist",
 	},
 	"ru": {
 		copyLabel:      "kopirovat",
@@ -839,6 +1190,10 @@ var markdownLabelsByLocale = map[string]markdownLabels{
 		codeBlockLabel: "[blok koda]",
 		tableLabel:     "[tablitsa]",
 		imageLabel:     "[izobrazhenie]",
+		footnoteLabel:  "nazad k ssylke",
+		youTubeLabel:   "video YouTube",
+		vimeoLabel:     "video Vimeo",
+		gistLabel:      "GitHub gist",
 	},
 	"ja": {
 		copyLabel:      "copy",
@@ -847,6 +1202,10 @@ var markdownLabelsByLocale = map[string]markdownLabels{
 		codeBlockLabel: "[code block]",
 		tableLabel:     "[table]",
 		imageLabel:     "[image]",
+		footnoteLabel:  "back to reference",
+		youTubeLabel:   "YouTube video",
+		vimeoLabel:     "Vimeo video",
+		gistLabel:      "GitHub gist",
 	},
 	"fr": {
 		copyLabel:      "copier",
@@ -855,6 +1214,10 @@ var markdownLabelsByLocale = map[string]markdownLabels{
 		codeBlockLabel: "[bloc de code]",
 		tableLabel:     "[tableau]",
 		imageLabel:     "[image]",
+		footnoteLabel:  "retour a la reference",
+		youTubeLabel:   "video YouTube",
+		vimeoLabel:     "video Vimeo",
+		gistLabel:      "gist GitHub",
 	},
 	"es": {
 		copyLabel:      "copiar",
@@ -863,6 +1226,10 @@ var markdownLabelsByLocale = map[string]markdownLabels{
 		codeBlockLabel: "[bloque de codigo]",
 		tableLabel:     "[tabla]",
 		imageLabel:     "[imagen]",
+		footnoteLabel:  "volver a la referencia",
+		youTubeLabel:   "video de YouTube",
+		vimeoLabel:     "video de Vimeo",
+		gistLabel:      "gist de GitHub",
 	},
 }
 
@@ -877,11 +1244,16 @@ func markdownOptionsForLocale(locale string, imageLoader imageloader.Loader) md.
 		CodeCopyLabel:         labels.copyLabel,
 		CodeCopiedLabel:       labels.copiedLabel,
 		PlainTextLabel:        labels.plainTextLabel,
+		FootnoteBackLabel:     labels.footnoteLabel,
 		ExcerptCodeBlockLabel: labels.codeBlockLabel,
 		ExcerptTableLabel:     labels.tableLabel,
 		ExcerptImageLabel:     labels.imageLabel,
+		EmbedYouTubeTitle:     labels.youTubeLabel,
+		EmbedVimeoTitle:       labels.vimeoLabel,
+		EmbedGistTitle:        labels.gistLabel,
 		ImageLoader:           imageLoader,
 		ImageSizes:            imageloader.MarkdownSizes(),
+		EmbedProviders:        md.DefaultEmbedProviders(),
 	}
 }
 
@@ -921,472 +1293,203 @@ func mapAvailableAuthors(response *gql.AvailableAuthorsResponse) []Author {
 		})
 	}
 
-	sort.Slice(out, func(i int, j int) bool {
-		left := strings.ToLower(strings.TrimSpace(out[i].Name))
-		right := strings.ToLower(strings.TrimSpace(out[j].Name))
-		if left == right {
-			return out[i].Slug < out[j].Slug
-		}
-
-		return left < right
-	})
-
+	sortAuthorsByName(out)
 	return out
 }
 
-func mapTagFromTagDoc(doc gql.TagByNameTagsDocsTag) Tag {
-	return Tag{
-		Name:  doc.Name,
-		Title: strOr(doc.Title, doc.Name),
-	}
-}
-
-func mapNotesList(response *gql.ListNotesResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
+func mapListNotesPageAuthors(response *gql.ListNotesPageResponse) []Author {
+	if response == nil || response.Authors == nil {
+		return []Author{}
 	}
 
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
+	out := make([]Author, 0, len(response.Authors.Docs))
+	for _, item := range response.Authors.Docs {
+		var avatar *AuthorMedia
+		if item.Avatar != nil {
+			avatar = newAvatar(item.Avatar.Url, item.Avatar.Alt, item.Avatar.Width, item.Avatar.Height)
 		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapListAttachment(doc.Attachment),
-			mapListAuthors(doc.Authors),
-			mapListTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
+		out = append(out, Author{
+			Name:   strOr(item.Name, item.Slug),
+			Slug:   item.Slug,
+			Bio:    strOr(item.Bio, ""),
+			Avatar: avatar,
+		})
 	}
 
-	return items, response.Micro_posts.TotalPages
+	sortAuthorsByName(out)
+	return out
 }
 
-func mapSearchNotes(response *gql.SearchNotesResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
+func mapListNotesPageTags(response *gql.ListNotesPageResponse) []Tag {
+	if response == nil {
+		return []Tag{}
 	}
 
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
-		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapListAttachment(doc.Attachment),
-			mapListAuthors(doc.Authors),
-			mapListTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
+	out := make([]Tag, 0, len(response.AvailableTagsByMicroPostType))
+	for _, item := range response.AvailableTagsByMicroPostType {
+		out = append(out, Tag{
+			Name:  item.Name,
+			Title: strOr(item.Title, item.Name),
+		})
 	}
 
-	return items, response.Micro_posts.TotalPages
+	return out
 }
 
-func mapSearchNotesByType(response *gql.SearchNotesByTypeResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
-	}
-
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
+func sortAuthorsByName(authors []Author) {
+	sort.Slice(authors, func(i int, j int) bool {
+		left := strings.ToLower(strings.TrimSpace(authors[i].Name))
+		right := strings.ToLower(strings.TrimSpace(authors[j].Name))
+		if left == right {
+			return authors[i].Slug < authors[j].Slug
 		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapListByTypeAttachment(doc.Attachment),
-			mapListByTypeAuthors(doc.Authors),
-			mapListByTypeTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
-	}
 
-	return items, response.Micro_posts.TotalPages
+		return left < right
+	})
 }
 
-func mapSearchNotesByTagIDs(response *gql.SearchNotesByTagIDsResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
-	}
-
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
-		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapTagListAttachment(doc.Attachment),
-			mapTagListAuthors(doc.Authors),
-			mapTagListTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
+func mapTagFromTagDoc(doc gql.TagByNameTagsDocsTag) Tag {
+	return Tag{
+		Name:  doc.Name,
+		Title: strOr(doc.Title, doc.Name),
 	}
-
-	return items, response.Micro_posts.TotalPages
 }
 
-func mapSearchNotesByTagIDsAndType(response *gql.SearchNotesByTagIDsAndTypeResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
-	}
-
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
-		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapTagByTypeAttachment(doc.Attachment),
-			mapTagByTypeAuthors(doc.Authors),
-			mapTagByTypeTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
-	}
+// mapNoteListDoc maps the NoteListDoc fragment every note list query selects.
+func mapNoteListDoc(doc gql.NoteListDoc, locale string) NoteSummary {
+	description := ""
+	if doc.Meta != nil {
+		description = strOr(doc.Meta.Description, "")
+	}
+
+	summary := summaryFromListDoc(
+		locale,
+		doc.Id,
+		doc.Slug,
+		doc.Title,
+		doc.Content,
+		doc.PublishedAt,
+		description,
+		mapListAttachment(doc.Attachment),
+		mapListAuthors(doc.Authors),
+		mapListTags(doc.Tags),
+		summarySEOFieldsFromNoteListDoc(doc),
+	)
+	summary.Featured = doc.Featured != nil && *doc.Featured
 
-	return items, response.Micro_posts.TotalPages
+	return summary
 }
 
-func mapSearchNotesByAuthorSlug(response *gql.SearchNotesByAuthorSlugResponse) ([]NoteSummary, int) {
+func mapNotesList(response *gql.ListNotesResponse, locale string) ([]NoteSummary, int) {
 	if response == nil || response.Micro_posts == nil {
 		return []NoteSummary{}, 1
 	}
 
 	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
 	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
-		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapAuthorListAttachment(doc.Attachment),
-			mapAuthorListAuthors(doc.Authors),
-			mapAuthorListTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
+		items = append(items, mapNoteListDoc(doc.NoteListDoc, locale))
 	}
 
 	return items, response.Micro_posts.TotalPages
 }
 
-func mapSearchNotesByAuthorSlugAndType(response *gql.SearchNotesByAuthorSlugAndTypeResponse) ([]NoteSummary, int) {
+func mapNotesListPage(response *gql.ListNotesPageResponse, locale string) ([]NoteSummary, int) {
 	if response == nil || response.Micro_posts == nil {
 		return []NoteSummary{}, 1
 	}
 
 	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
 	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
-		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapAuthorByTypeAttachment(doc.Attachment),
-			mapAuthorByTypeAuthors(doc.Authors),
-			mapAuthorByTypeTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
+		items = append(items, mapNoteListDoc(doc.NoteListDoc, locale))
 	}
 
 	return items, response.Micro_posts.TotalPages
 }
 
-func mapSearchNotesByAuthorAndTagIDs(response *gql.SearchNotesByAuthorAndTagIDsResponse) ([]NoteSummary, int) {
+func mapFeaturedNotes(response *gql.ListFeaturedNotesResponse, locale string) []NoteSummary {
 	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
+		return []NoteSummary{}
 	}
 
 	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
 	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
-		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapAuthorTagAttachment(doc.Attachment),
-			mapAuthorTagAuthors(doc.Authors),
-			mapAuthorTagTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
+		items = append(items, mapNoteListDoc(doc.NoteListDoc, locale))
 	}
 
-	return items, response.Micro_posts.TotalPages
+	return items
 }
 
-func mapSearchNotesByAuthorTagIDsAndType(response *gql.SearchNotesByAuthorTagIDsAndTypeResponse) ([]NoteSummary, int) {
+func mapSearchNotes(response *gql.SearchNotesResponse, locale string) ([]NoteSummary, int) {
 	if response == nil || response.Micro_posts == nil {
 		return []NoteSummary{}, 1
 	}
 
 	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
 	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
-		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapAuthorTagTypeAttachment(doc.Attachment),
-			mapAuthorTagTypeAuthors(doc.Authors),
-			mapAuthorTagTypeTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
+		items = append(items, mapNoteListDoc(doc.NoteListDoc, locale))
 	}
 
 	return items, response.Micro_posts.TotalPages
 }
 
-func mapNotesListByType(response *gql.ListNotesByTypeResponse) ([]NoteSummary, int) {
+func mapRelatedNoteCandidates(
+	response *gql.RelatedNoteCandidatesResponse,
+	locale string,
+	tagIDs []string,
+	authorSlugs []string,
+	limit int,
+) []NoteSummary {
 	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
-	}
-
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
-		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapListByTypeAttachment(doc.Attachment),
-			mapListByTypeAuthors(doc.Authors),
-			mapListByTypeTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
+		return []NoteSummary{}
 	}
 
-	return items, response.Micro_posts.TotalPages
-}
-
-func mapNotesListByTags(response *gql.ListNotesByTagIDsResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
+	sharedTags := make(map[string]struct{}, len(tagIDs))
+	for _, id := range tagIDs {
+		sharedTags[id] = struct{}{}
 	}
-
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
-		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapTagListAttachment(doc.Attachment),
-			mapTagListAuthors(doc.Authors),
-			mapTagListTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
+	sharedAuthors := make(map[string]struct{}, len(authorSlugs))
+	for _, slug := range authorSlugs {
+		sharedAuthors[slug] = struct{}{}
 	}
 
-	return items, response.Micro_posts.TotalPages
-}
-
-func mapNotesListByTagIDsAndType(response *gql.ListNotesByTagIDsAndTypeResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
+	type scoredDoc struct {
+		doc   gql.NoteListDoc
+		score int
 	}
 
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
+	scored := make([]scoredDoc, 0, len(response.Micro_posts.Docs))
 	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
+		score := 0
+		for _, tag := range doc.Tags {
+			if _, ok := sharedTags[tag.Id]; ok {
+				score += 2
+			}
 		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapTagByTypeAttachment(doc.Attachment),
-			mapTagByTypeAuthors(doc.Authors),
-			mapTagByTypeTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
-	}
-
-	return items, response.Micro_posts.TotalPages
-}
-
-func mapNotesByAuthorSlug(response *gql.NotesByAuthorSlugResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
-	}
-
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
+		for _, author := range doc.Authors {
+			if _, ok := sharedAuthors[author.Slug]; ok {
+				score++
+				break
+			}
 		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapAuthorListAttachment(doc.Attachment),
-			mapAuthorListAuthors(doc.Authors),
-			mapAuthorListTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
-	}
-
-	return items, response.Micro_posts.TotalPages
-}
-
-func mapNotesByAuthorSlugAndType(response *gql.NotesByAuthorSlugAndTypeResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
-	}
-
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
+		if score > 0 {
+			scored = append(scored, scoredDoc{doc: doc.NoteListDoc, score: score})
 		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapAuthorByTypeAttachment(doc.Attachment),
-			mapAuthorByTypeAuthors(doc.Authors),
-			mapAuthorByTypeTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
 	}
 
-	return items, response.Micro_posts.TotalPages
-}
-
-func mapNotesListByAuthorAndTagIDs(response *gql.ListNotesByAuthorAndTagIDsResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
-	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
 
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
+	items := make([]NoteSummary, 0, min(limit, len(scored)))
+	for _, item := range scored {
+		if len(items) == limit {
+			break
 		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapAuthorTagAttachment(doc.Attachment),
-			mapAuthorTagAuthors(doc.Authors),
-			mapAuthorTagTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
-	}
-
-	return items, response.Micro_posts.TotalPages
-}
-
-func mapNotesListByAuthorTagIDsAndType(response *gql.ListNotesByAuthorTagIDsAndTypeResponse) ([]NoteSummary, int) {
-	if response == nil || response.Micro_posts == nil {
-		return []NoteSummary{}, 1
-	}
 
-	items := make([]NoteSummary, 0, len(response.Micro_posts.Docs))
-	for _, doc := range response.Micro_posts.Docs {
-		description := ""
-		if doc.Meta != nil {
-			description = strOr(doc.Meta.Description, "")
-		}
-		items = append(items, summaryFromListDoc(
-			doc.Id,
-			doc.Slug,
-			doc.Title,
-			doc.Content,
-			doc.PublishedAt,
-			description,
-			mapAuthorTagTypeAttachment(doc.Attachment),
-			mapAuthorTagTypeAuthors(doc.Authors),
-			mapAuthorTagTypeTags(doc.Tags),
-			summarySEOFieldsFromNoteListDoc(doc.NoteListDoc),
-		))
+		items = append(items, mapNoteListDoc(item.doc, locale))
 	}
 
-	return items, response.Micro_posts.TotalPages
+	return items
 }
 
 type summarySEOFields struct {
@@ -1396,6 +1499,7 @@ type summarySEOFields struct {
 }
 
 func summaryFromListDoc(
+	locale string,
 	id string,
 	slug *string,
 	title *string,
@@ -1416,14 +1520,17 @@ func summaryFromListDoc(
 	if len(seoFields) > 0 {
 		fields = seoFields[0]
 	}
+	reading := md.Reading(contentText)
 
 	return NoteSummary{
 		ID:             id,
 		Slug:           strOr(slug, id),
 		Title:          pickTitle(title),
 		Excerpt:        md.Excerpt(contentText, 260),
-		PublishedAt:    formatDate(publishedAt),
+		PublishedAt:    formatDate(publishedAt, locale),
 		PublishedAtISO: formatDateISO(publishedAt),
+		WordCount:      reading.Words,
+		ReadingMinutes: reading.Minutes,
 		MetaTitle:      strOr(fields.MetaTitle, ""),
 		Description:    description,
 		MetaImage:      fields.MetaImage,
@@ -1470,34 +1577,6 @@ func mapListAuthors(authors []gql.NoteListDocAuthorsAuthor) []Author {
 	return out
 }
 
-func mapListByTypeAuthors(authors []gql.NoteListDocAuthorsAuthor) []Author {
-	return mapListAuthors(authors)
-}
-
-func mapTagListAuthors(authors []gql.NoteListDocAuthorsAuthor) []Author {
-	return mapListAuthors(authors)
-}
-
-func mapTagByTypeAuthors(authors []gql.NoteListDocAuthorsAuthor) []Author {
-	return mapListAuthors(authors)
-}
-
-func mapAuthorListAuthors(authors []gql.NoteListDocAuthorsAuthor) []Author {
-	return mapListAuthors(authors)
-}
-
-func mapAuthorByTypeAuthors(authors []gql.NoteListDocAuthorsAuthor) []Author {
-	return mapListAuthors(authors)
-}
-
-func mapAuthorTagAuthors(authors []gql.NoteListDocAuthorsAuthor) []Author {
-	return mapListAuthors(authors)
-}
-
-func mapAuthorTagTypeAuthors(authors []gql.NoteListDocAuthorsAuthor) []Author {
-	return mapListAuthors(authors)
-}
-
 func mapNoteAttachment(attachment *gql.NoteBySlugMicro_postsDocsMicro_postAttachmentMedia) *Attachment {
 	if attachment == nil {
 		return nil
@@ -1560,34 +1639,6 @@ func mapListMetaAttachment(metaImage *gql.NoteListDocMetaMicro_post_MetaImageMed
 	)
 }
 
-func mapListByTypeAttachment(attachment *gql.NoteListDocAttachmentMedia) *Attachment {
-	return mapListAttachment(attachment)
-}
-
-func mapTagListAttachment(attachment *gql.NoteListDocAttachmentMedia) *Attachment {
-	return mapListAttachment(attachment)
-}
-
-func mapTagByTypeAttachment(attachment *gql.NoteListDocAttachmentMedia) *Attachment {
-	return mapListAttachment(attachment)
-}
-
-func mapAuthorListAttachment(attachment *gql.NoteListDocAttachmentMedia) *Attachment {
-	return mapListAttachment(attachment)
-}
-
-func mapAuthorByTypeAttachment(attachment *gql.NoteListDocAttachmentMedia) *Attachment {
-	return mapListAttachment(attachment)
-}
-
-func mapAuthorTagAttachment(attachment *gql.NoteListDocAttachmentMedia) *Attachment {
-	return mapListAttachment(attachment)
-}
-
-func mapAuthorTagTypeAttachment(attachment *gql.NoteListDocAttachmentMedia) *Attachment {
-	return mapListAttachment(attachment)
-}
-
 func mapNoteTags(tags []gql.NoteBySlugMicro_postsDocsMicro_postTagsTag) []Tag {
 	out := make([]Tag, 0, len(tags))
 	for _, item := range tags {
@@ -1606,34 +1657,6 @@ func mapListTags(tags []gql.NoteListDocTagsTag) []Tag {
 	return out
 }
 
-func mapListByTypeTags(tags []gql.NoteListDocTagsTag) []Tag {
-	return mapListTags(tags)
-}
-
-func mapTagListTags(tags []gql.NoteListDocTagsTag) []Tag {
-	return mapListTags(tags)
-}
-
-func mapTagByTypeTags(tags []gql.NoteListDocTagsTag) []Tag {
-	return mapListTags(tags)
-}
-
-func mapAuthorListTags(tags []gql.NoteListDocTagsTag) []Tag {
-	return mapListTags(tags)
-}
-
-func mapAuthorByTypeTags(tags []gql.NoteListDocTagsTag) []Tag {
-	return mapListTags(tags)
-}
-
-func mapAuthorTagTags(tags []gql.NoteListDocTagsTag) []Tag {
-	return mapListTags(tags)
-}
-
-func mapAuthorTagTypeTags(tags []gql.NoteListDocTagsTag) []Tag {
-	return mapListTags(tags)
-}
-
 func mapAuthorFromAuthorDoc(doc gql.AuthorBySlugAuthorsDocsAuthor) Author {
 	var avatar *AuthorMedia
 	if doc.Avatar != nil {
@@ -1641,11 +1664,44 @@ func mapAuthorFromAuthorDoc(doc gql.AuthorBySlugAuthorsDocsAuthor) Author {
 	}
 
 	return Author{
-		Name:   strOr(doc.Name, doc.Slug),
-		Slug:   doc.Slug,
-		Bio:    strOr(doc.Bio, ""),
-		Avatar: avatar,
+		Name:     strOr(doc.Name, doc.Slug),
+		Slug:     doc.Slug,
+		Bio:      strOr(doc.Bio, ""),
+		Avatar:   avatar,
+		Website:  profileURL(doc.Website),
+		Location: strOr(doc.Location, ""),
+		Socials:  mapAuthorSocials(doc.Socials),
+	}
+}
+
+// mapAuthorSocials drops the entries without a usable link.
+func mapAuthorSocials(socials []gql.AuthorBySlugAuthorsDocsAuthorSocialsAuthor_Socials) []AuthorSocial {
+	out := make([]AuthorSocial, 0, len(socials))
+	for _, item := range socials {
+		link := profileURL(item.Url)
+		if link == "" {
+			continue
+		}
+		out = append(out, AuthorSocial{
+			Platform: strOr(item.Platform, ""),
+			Handle:   strOr(item.Handle, ""),
+			URL:      link,
+		})
+	}
+
+	return out
+}
+
+// profileURL returns value when it is an absolute http(s) URL, so a typo in
+// the CMS never renders as a relative or script link.
+func profileURL(value *string) string {
+	raw := strOr(value, "")
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return ""
 	}
+
+	return raw
 }
 
 func noteMentions(
@@ -1800,13 +1856,13 @@ func mergeTagsFromNotes(tags []Tag, noteItems []NoteSummary) []Tag {
 }
 
 func findTagByName(tags []Tag, name string) *Tag {
-	name = strings.TrimSpace(name)
+	name = NormalizeSlug(name)
 	if name == "" {
 		return nil
 	}
 
 	for _, tag := range tags {
-		if tag.Name == name {
+		if NormalizeSlug(tag.Name) == name {
 			copy := tag
 			return &copy
 		}
@@ -1817,14 +1873,32 @@ func findTagByName(tags []Tag, name string) *Tag {
 
 func normalizeFilter(filter ListFilter) ListFilter {
 	filter.Page = sanitizePage(filter.Page)
-	filter.AuthorSlug = strings.TrimSpace(filter.AuthorSlug)
-	filter.TagName = strings.TrimSpace(filter.TagName)
+	filter.AuthorSlugs = normalizeSlugs(filter.AuthorSlugs)
+	filter.TagNames = normalizeSlugs(filter.TagNames)
+	if filter.TagMode != TagMatchAll || len(filter.TagNames) < 2 {
+		filter.TagMode = TagMatchAny
+	}
 	filter.Type = ParseNoteType(string(filter.Type))
 	filter.Query = strings.TrimSpace(filter.Query)
+	if !filter.From.IsZero() {
+		filter.From = filter.From.UTC()
+	}
+	if !filter.To.IsZero() {
+		filter.To = filter.To.UTC()
+	}
 
 	return filter
 }
 
+func dateBoundArg(bound time.Time) *string {
+	if bound.IsZero() {
+		return nil
+	}
+
+	value := bound.UTC().Format(time.RFC3339)
+	return &value
+}
+
 func postTypeFilterArg(noteType NoteType) *string {
 	if noteType == NoteTypeLong || noteType == NoteTypeShort {
 		value := string(noteType)
@@ -1834,15 +1908,18 @@ func postTypeFilterArg(noteType NoteType) *string {
 	return nil
 }
 
-func toPostTypeInput(noteType NoteType) (gql.Micro_post_post_type_Input, bool) {
+func toPostTypeInput(noteType NoteType) *gql.Micro_post_post_type_Input {
+	var value gql.Micro_post_post_type_Input
 	switch noteType {
 	case NoteTypeLong:
-		return gql.Micro_post_post_type_InputLong, true
+		value = gql.Micro_post_post_type_InputLong
 	case NoteTypeShort:
-		return gql.Micro_post_post_type_InputShort, true
+		value = gql.Micro_post_post_type_InputShort
 	default:
-		return "", false
+		return nil
 	}
+
+	return &value
 }
 
 func newAvatar(url *string, alt *string, width *float64, height *float64) *AuthorMedia {
@@ -1886,6 +1963,18 @@ func newAttachment(
 	}
 }
 
+func newNoteLink(id string, slug *string, title *string) *NoteLink {
+	link := &NoteLink{
+		Slug:  strOr(slug, id),
+		Title: pickTitle(title),
+	}
+	if link.Title == "" {
+		link.Title = link.Slug
+	}
+
+	return link
+}
+
 func filenameFromURL(rawURL string) string {
 	parsed, err := url.Parse(rawURL)
 	if err != nil {
@@ -1907,7 +1996,20 @@ func pickTitle(title *string) string {
 	return ""
 }
 
-func formatDate(raw *string) string {
+// dateLayouts spells a publish date the way each site locale writes short
+// dates. Other locales get ISO 8601.
+var dateLayouts = map[string]string{
+	"en": "Jan 2, 2006",
+	"de": "02.01.2006",
+	"es": "02/01/2006",
+	"fr": "02/01/2006",
+	"hi": "02/01/2006",
+	"ja": "2006年1月2日",
+	"ru": "02.01.2006",
+	"uk": "02.01.2006",
+}
+
+func formatDate(raw *string, locale string) string {
 	if raw == nil || strings.TrimSpace(*raw) == "" {
 		return ""
 	}
@@ -1920,7 +2022,16 @@ func formatDate(raw *string) string {
 		}
 	}
 
-	return parsed.Format("2006-01-02")
+	return parsed.Format(dateLayout(locale))
+}
+
+func dateLayout(locale string) string {
+	language, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(locale)), "-")
+	if layout, ok := dateLayouts[language]; ok {
+		return layout
+	}
+
+	return time.DateOnly
 }
 
 func formatDateISO(raw *string) string {
@@ -1939,6 +2050,19 @@ func formatDateISO(raw *string) string {
 	return parsed.UTC().Format(time.RFC3339)
 }
 
+func formatDateExact(raw *string) string {
+	if raw == nil {
+		return ""
+	}
+
+	parsed, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(*raw))
+	if err != nil {
+		return ""
+	}
+
+	return parsed.UTC().Format(time.RFC3339Nano)
+}
+
 func strOr(value *string, fallback string) string {
 	if value == nil {
 		return fallback
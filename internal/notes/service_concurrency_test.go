@@ -93,7 +93,7 @@ func TestServiceListNotes_StartsIndependentFetchesInParallel(t *testing.T) {
 
 	resultCh := make(chan error, 1)
 	go func() {
-		_, err := service.ListNotes(ctx, "en", ListFilter{}, ListOptions{})
+		_, err := service.ListNotes(ctx, "en", ListFilter{Type: NoteTypeLong}, ListOptions{})
 		resultCh <- err
 	}()
 
@@ -0,0 +1,32 @@
+package notes
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func NormalizeSlug(raw string) string {
+	value := norm.NFC.String(strings.TrimSpace(raw))
+	if value == "" {
+		return ""
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(value))
+	pendingDash := false
+	for _, r := range value {
+		if unicode.IsSpace(r) || r == '_' || r == '-' {
+			pendingDash = builder.Len() > 0
+			continue
+		}
+		if pendingDash {
+			builder.WriteByte('-')
+			pendingDash = false
+		}
+		builder.WriteRune(unicode.ToLower(r))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,109 @@
+package notes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"blog/internal/imageloader"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSlug(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"":                "",
+		"  go  ":          "go",
+		"Go":              "go",
+		"L_You":           "l-you",
+		"Hello  World":    "hello-world",
+		"--rust--":        "rust",
+		"Cafe\u0301":      "caf\u00e9",
+		"ПРИВІТ світ":     "привіт-світ",
+		"already-written": "already-written",
+	}
+
+	for input, expected := range cases {
+		require.Equal(t, expected, NormalizeSlug(input), input)
+	}
+}
+
+type storedSpellingClient struct {
+	requested []string
+}
+
+func (c *storedSpellingClient) MakeRequest(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+	variables, err := json.Marshal(req.Variables)
+	if err != nil {
+		return err
+	}
+	c.requested = append(c.requested, req.OpName+" "+string(variables))
+
+	switch req.OpName {
+	case "AvailableAuthors":
+		return decodeClientPayload(resp, `{"Authors":{"docs":[{"name":"L You","slug":"L_You"}]}}`)
+	case "AvailableTagsByPostType":
+		return decodeClientPayload(resp, `{"availableTagsByMicroPostType":[
+			{"id":"1","name":"Machine learning","title":null},
+			{"id":"2","name":"go","title":"Go"}
+		]}`)
+	case "AuthorBySlug":
+		if strings.Contains(string(variables), `"slug":"L_You"`) {
+			return decodeClientPayload(resp, `{"Authors":{"docs":[{"name":"L You","slug":"L_You"}]}}`)
+		}
+		return decodeClientPayload(resp, `{"Authors":{"docs":[]}}`)
+	case "TagByName":
+		if strings.Contains(string(variables), `"name":"Machine learning"`) {
+			return decodeClientPayload(resp, `{"Tags":{"docs":[{"id":"1","name":"Machine learning","title":null}]}}`)
+		}
+		if strings.Contains(string(variables), `"name":"go"`) {
+			return decodeClientPayload(resp, `{"Tags":{"docs":[{"id":"2","name":"go","title":"Go"}]}}`)
+		}
+		return decodeClientPayload(resp, `{"Tags":{"docs":[]}}`)
+	case "TagIDsByNames":
+		var docs []string
+		if strings.Contains(string(variables), `"Machine learning"`) {
+			docs = append(docs, `{"id":"1","name":"Machine learning"}`)
+		}
+		if strings.Contains(string(variables), `"go"`) {
+			docs = append(docs, `{"id":"2","name":"go"}`)
+		}
+		return decodeClientPayload(resp, `{"Tags":{"docs":[`+strings.Join(docs, ",")+`]}}`)
+	case "ListNotes":
+		return decodeClientPayload(resp, `{"Micro_posts":{"totalPages":1,"docs":[]}}`)
+	default:
+		return fmt.Errorf("unexpected operation %q", req.OpName)
+	}
+}
+
+func TestListNotesQueriesStoredSpellingOfNormalizedSlugs(t *testing.T) {
+	t.Parallel()
+
+	client := &storedSpellingClient{}
+	result, err := NewService(client, 12, imageloader.New(false)).ListNotes(
+		context.Background(),
+		"en",
+		ListFilter{AuthorSlugs: []string{"l-you"}, TagNames: []string{"machine-learning"}},
+		ListOptions{RequireAuthor: true, RequireTag: true},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "L_You", result.ActiveAuthor.Slug)
+	require.Equal(t, "Machine learning", result.ActiveTag.Name)
+	require.Equal(t, []string{"l-you"}, result.ActiveFilter.AuthorSlugs)
+	require.Equal(t, []string{"machine-learning"}, result.ActiveFilter.TagNames)
+
+	var listRequest string
+	for _, requested := range client.requested {
+		if strings.HasPrefix(requested, "ListNotes ") {
+			listRequest = requested
+		}
+	}
+	require.Contains(t, listRequest, `"slug":"L_You"`)
+	require.Contains(t, listRequest, `"tagIDs":["1"]`)
+	require.NotContains(t, listRequest, `"postType"`)
+	require.NotContains(t, listRequest, `"from"`)
+}
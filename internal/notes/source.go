@@ -0,0 +1,28 @@
+package notes
+
+import (
+	"context"
+	"time"
+)
+
+// Source is everything the site reads about notes. Service loads them from the
+// Payload CMS over GraphQL; FileSource reads a directory of markdown files.
+type Source interface {
+	ListNotes(ctx context.Context, locale string, filter ListFilter, options ListOptions) (NotesListResult, error)
+	ListFeatured(ctx context.Context, locale string, limit int) ([]NoteSummary, error)
+	GetAuthorBySlug(ctx context.Context, locale string, slug string) (*Author, error)
+	GetTagByName(ctx context.Context, locale string, name string) (*Tag, error)
+	GetNoteBySlug(ctx context.Context, locale string, slug string, siteRootURLs []string) (*NoteDetail, error)
+	GetNoteDraftBySlug(ctx context.Context, locale string, slug string, siteRootURLs []string) (*NoteDetail, error)
+	GetAdjacentNotes(ctx context.Context, locale string, note NoteDetail) (AdjacentNotes, error)
+	GetRelatedNotes(ctx context.Context, locale string, noteID string, limit int) ([]NoteSummary, error)
+	GetSeries(ctx context.Context, locale string, slug string) (*Series, error)
+	GetNoteSeries(ctx context.Context, locale string, noteID string) (*SeriesPosition, error)
+	GetArchiveIndex(ctx context.Context) (ArchiveIndex, error)
+	ListArchiveNotes(ctx context.Context, locale string, year int, month time.Month, page int) ([]NoteSummary, int, error)
+	ListTagsWithCounts(ctx context.Context, locale string) ([]TagCount, error)
+	ChannelCounts(ctx context.Context, locale string) (ChannelCounts, error)
+}
+
+var _ Source = (*Service)(nil)
+var _ Source = (*FileSource)(nil)
@@ -0,0 +1,95 @@
+package notes
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	gql "blog/internal/cmsgraphql"
+)
+
+const tagCountsPageSize = 500
+const maxTagCountsPages = 40
+
+type TagCount struct {
+	Tag
+	Count int
+}
+
+// ListTagsWithCounts counts published notes per tag in locale. The scan covers
+// every note, so its result is reused for aggregateCacheTTL.
+func (s *Service) ListTagsWithCounts(ctx context.Context, locale string) ([]TagCount, error) {
+	tags, err := s.tagCounts.get(ctx, locale, func(ctx context.Context) ([]TagCount, error) {
+		return s.scanTagCounts(ctx, locale)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(tags), nil
+}
+
+func (s *Service) scanTagCounts(ctx context.Context, locale string) ([]TagCount, error) {
+	counts := make(map[string]*TagCount)
+	for page := 1; page <= maxTagCountsPages; page++ {
+		response, err := gql.TagCounts(
+			ctx,
+			s.client,
+			page,
+			tagCountsPageSize,
+			publishedUntilNow(),
+			gql.LocaleInputFromCode(locale),
+			gql.FallbackLocaleInputFromCode(s.defaultLocale()),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if response == nil || response.Micro_posts == nil {
+			break
+		}
+
+		for _, doc := range response.Micro_posts.Docs {
+			seen := make(map[string]struct{}, len(doc.Tags))
+			for _, tag := range doc.Tags {
+				name := strings.TrimSpace(tag.Name)
+				if name == "" {
+					continue
+				}
+				if _, ok := seen[name]; ok {
+					continue
+				}
+				seen[name] = struct{}{}
+
+				entry, ok := counts[name]
+				if !ok {
+					entry = &TagCount{Tag: Tag{Name: name, Title: strOr(tag.Title, name)}}
+					counts[name] = entry
+				}
+				entry.Count++
+			}
+		}
+
+		if page >= response.Micro_posts.TotalPages {
+			break
+		}
+	}
+
+	return sortTagCounts(counts), nil
+}
+
+func sortTagCounts(counts map[string]*TagCount) []TagCount {
+	out := make([]TagCount, 0, len(counts))
+	for _, entry := range counts {
+		out = append(out, *entry)
+	}
+
+	sort.Slice(out, func(i int, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
@@ -0,0 +1,41 @@
+package notes
+
+import (
+	"context"
+	"testing"
+
+	"blog/internal/imageloader"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTagsWithCountsAggregatesAcrossPages(t *testing.T) {
+	t.Parallel()
+
+	client := &pagedClient{opName: "TagCounts", pages: []string{
+		`{"Micro_posts":{"totalPages":2,"docs":[
+			{"tags":[{"id":"1","name":"go","title":"Go"},{"id":"1","name":"go","title":"Go"}]},
+			{"tags":[{"id":"2","name":"web","title":null},{"id":"1","name":"go","title":"Go"}]},
+			{"tags":[]}
+		]}}`,
+		`{"Micro_posts":{"totalPages":2,"docs":[
+			{"tags":[{"id":"3","name":"athens","title":"Athens"},{"id":"4","name":" ","title":"Blank"}]},
+			{"tags":[{"id":"2","name":"web","title":"Web"}]}
+		]}}`,
+	}}
+
+	service := NewService(client, 12, imageloader.New(false))
+	tags, err := service.ListTagsWithCounts(context.Background(), "en")
+	require.NoError(t, err)
+	require.Equal(t, 2, client.calls)
+	require.Equal(t, []TagCount{
+		{Tag: Tag{Name: "go", Title: "Go"}, Count: 2},
+		{Tag: Tag{Name: "web", Title: "web"}, Count: 2},
+		{Tag: Tag{Name: "athens", Title: "Athens"}, Count: 1},
+	}, tags)
+
+	tags[0].Count = 0
+	cached, err := service.ListTagsWithCounts(context.Background(), "en")
+	require.NoError(t, err)
+	require.Equal(t, 2, client.calls)
+	require.Equal(t, 2, cached[0].Count)
+}
@@ -0,0 +1,69 @@
+// Package preview signs short-lived draft preview tokens. A token is
+// "<unix expiry>.<hex HMAC-SHA256 of slug and expiry>", so a link only opens the
+// draft of the note it was issued for and stops working once it expires.
+package preview
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const QueryKey = "preview"
+const DefaultTTL = 24 * time.Hour
+
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewSigner(secret string, ttl time.Duration) (*Signer, error) {
+	secret = strings.TrimSpace(secret)
+	if secret == "" {
+		return nil, errors.New("preview secret is required")
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	return &Signer{secret: []byte(secret), ttl: ttl}, nil
+}
+
+// Token issues a token for slug that expires one TTL after now.
+func (s *Signer) Token(slug string, now time.Time) string {
+	expiry := strconv.FormatInt(now.Add(s.ttl).Unix(), 10)
+	return expiry + "." + hex.EncodeToString(s.mac(slug, expiry))
+}
+
+// Valid reports whether token was issued for slug and has not expired. A nil
+// signer accepts nothing, so previews stay off unless a secret is configured.
+func (s *Signer) Valid(slug string, token string, now time.Time) bool {
+	if s == nil {
+		return false
+	}
+
+	expiry, signature, ok := strings.Cut(strings.TrimSpace(token), ".")
+	if !ok {
+		return false
+	}
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil || now.Unix() > expiresAt {
+		return false
+	}
+	decoded, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(decoded, s.mac(slug, expiry))
+}
+
+func (s *Signer) mac(slug string, expiry string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(strings.TrimSpace(slug) + "\n" + expiry))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,43 @@
+package preview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignerTokenIsBoundToSlugAndExpiry(t *testing.T) {
+	t.Parallel()
+
+	signer, err := NewSigner("secret", time.Hour)
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	token := signer.Token("hello-world", now)
+	require.True(t, signer.Valid("hello-world", token, now.Add(59*time.Minute)))
+	require.False(t, signer.Valid("hello-world", token, now.Add(61*time.Minute)))
+	require.False(t, signer.Valid("other-note", token, now))
+
+	other, err := NewSigner("other", time.Hour)
+	require.NoError(t, err)
+	require.False(t, other.Valid("hello-world", token, now))
+}
+
+func TestSignerRejectsMalformedTokens(t *testing.T) {
+	t.Parallel()
+
+	signer, err := NewSigner("secret", 0)
+	require.NoError(t, err)
+
+	now := time.Now()
+	for _, token := range []string{"", "abc", "123", "notanumber.abcd", "99999999999.zz"} {
+		require.False(t, signer.Valid("hello-world", token, now), token)
+	}
+
+	var disabled *Signer
+	require.False(t, disabled.Valid("hello-world", signer.Token("hello-world", now), now))
+
+	_, err = NewSigner("  ", time.Hour)
+	require.Error(t, err)
+}
@@ -0,0 +1,149 @@
+// Package readiness serves /readyz. Unlike the framework's /healthz liveness
+// check it asks every upstream dependency, so a load balancer can hold traffic
+// back while the CMS is unreachable without restarting the process.
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const Path = "/readyz"
+const DefaultTimeout = 3 * time.Second
+
+// reportTTL lets frequent load balancer polls share one round of probes instead
+// of each sending its own query upstream.
+const reportTTL = 2 * time.Second
+
+const (
+	StatusOK          = "ok"
+	StatusError       = "error"
+	StatusUnavailable = "unavailable"
+)
+
+type Probe struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type Handler struct {
+	probes   []Probe
+	timeout  time.Duration
+	logError func(error)
+
+	mu         sync.Mutex
+	report     Report
+	reportedAt time.Time
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// CheckResult leaves out why a probe failed; upstream errors can name internal
+// hosts, so they only go to the error log.
+type CheckResult struct {
+	Status     string `json:"status"`
+	DurationMS int64  `json:"durationMs"`
+}
+
+func NewHandler(timeout time.Duration, probes ...Probe) *Handler {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Handler{probes: probes, timeout: timeout}
+}
+
+// WithErrorLog reports why a probe failed.
+func (h *Handler) WithErrorLog(logError func(error)) *Handler {
+	h.logError = logError
+	return h
+}
+
+func (h *Handler) Register(mux *http.ServeMux) {
+	mux.Handle(Path, h)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	report := h.cachedCheck(r.Context())
+	status := http.StatusOK
+	if report.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if r.Method == http.MethodHead {
+		return
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
+
+func (h *Handler) cachedCheck(ctx context.Context) Report {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.reportedAt.IsZero() && time.Since(h.reportedAt) < reportTTL {
+		return h.report
+	}
+
+	h.report = h.Check(ctx)
+	h.reportedAt = time.Now()
+	return h.report
+}
+
+// Check runs every probe concurrently under the handler timeout.
+func (h *Handler) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(h.probes))}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, probe := range h.probes {
+		if probe.Check == nil {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := runProbe(ctx, probe)
+			if err != nil && h.logError != nil {
+				h.logError(fmt.Errorf("readiness probe %q failed: %w", probe.Name, err))
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[probe.Name] = result
+			if result.Status != StatusOK {
+				report.Status = StatusUnavailable
+			}
+		}()
+	}
+	wg.Wait()
+
+	return report
+}
+
+func runProbe(ctx context.Context, probe Probe) (CheckResult, error) {
+	started := time.Now()
+	err := probe.Check(ctx)
+	result := CheckResult{Status: StatusOK, DurationMS: time.Since(started).Milliseconds()}
+	if err != nil {
+		result.Status = StatusError
+	}
+
+	return result, err
+}
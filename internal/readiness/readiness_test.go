@@ -0,0 +1,96 @@
+package readiness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerReportsReadyWhenEveryProbePasses(t *testing.T) {
+	t.Parallel()
+
+	handler := NewHandler(time.Second, Probe{Name: "cms", Check: func(context.Context) error { return nil }})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	var report Report
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.Equal(t, StatusOK, report.Status)
+	require.Equal(t, StatusOK, report.Checks["cms"].Status)
+}
+
+func TestHandlerReportsFailingAndSlowProbes(t *testing.T) {
+	t.Parallel()
+
+	var logged []error
+	var mu sync.Mutex
+	handler := NewHandler(
+		20*time.Millisecond,
+		Probe{Name: "cms", Check: func(context.Context) error { return errors.New("dial tcp 10.0.0.5:443: refused") }},
+		Probe{Name: "slow", Check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+		Probe{Name: "cache", Check: func(context.Context) error { return nil }},
+	).WithErrorLog(func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		logged = append(logged, err)
+	})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var report Report
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.Equal(t, StatusUnavailable, report.Status)
+	require.Equal(t, CheckResult{Status: StatusError}, withoutDuration(report.Checks["cms"]))
+	require.Equal(t, StatusError, report.Checks["slow"].Status)
+	require.Equal(t, StatusOK, report.Checks["cache"].Status)
+	require.NotContains(t, rec.Body.String(), "10.0.0.5")
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, logged, 2)
+	require.ErrorContains(t, errors.Join(logged...), `readiness probe "cms" failed: dial tcp 10.0.0.5:443: refused`)
+	require.ErrorContains(t, errors.Join(logged...), "deadline exceeded")
+}
+
+func TestHandlerReusesRecentReport(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	handler := NewHandler(time.Second, Probe{Name: "cms", Check: func(context.Context) error {
+		calls.Add(1)
+		return nil
+	}})
+	for range 3 {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestHandlerRejectsWrites(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+	NewHandler(0).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, Path, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func withoutDuration(result CheckResult) CheckResult {
+	result.DurationMS = 0
+	return result
+}
@@ -0,0 +1,64 @@
+package staticfs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"github.com/RevoTale/no-js/framework/staticassets"
+)
+
+const manifestFile = "manifest.json"
+
+type Mount struct {
+	URLPrefix string
+	Files     fs.FS
+}
+
+func Load(files fs.FS, basePrefix string) (Mount, error) {
+	if files == nil {
+		return Mount{}, fmt.Errorf("static files are required")
+	}
+
+	data, err := fs.ReadFile(files, manifestFile)
+	if err != nil {
+		return Mount{}, fmt.Errorf("read static manifest: %w", err)
+	}
+
+	var manifest staticassets.Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return Mount{}, fmt.Errorf("parse static manifest: %w", err)
+	}
+	if strings.TrimSpace(manifest.Hash) == "" {
+		return Mount{}, fmt.Errorf("static manifest hash is required")
+	}
+
+	return Mount{
+		URLPrefix: manifest.VersionedURLPrefix(basePrefix),
+		Files:     files,
+	}, nil
+}
+
+func (m Mount) Handler(cachePolicy string) http.Handler {
+	files := http.StripPrefix(m.URLPrefix, http.FileServerFS(m.Files))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimSpace(cachePolicy) != "" {
+			w.Header().Set("Cache-Control", cachePolicy)
+		}
+		files.ServeHTTP(w, r)
+	})
+}
+
+func (m Mount) Register(mux *http.ServeMux, cachePolicy string) error {
+	if mux == nil {
+		return fmt.Errorf("static mux is required")
+	}
+	if strings.TrimSpace(m.URLPrefix) == "" || m.Files == nil {
+		return fmt.Errorf("static mount is not loaded")
+	}
+
+	mux.Handle("GET "+m.URLPrefix, m.Handler(cachePolicy))
+	return nil
+}
@@ -0,0 +1,49 @@
+package staticfs
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadUsesManifestHashForPrefix(t *testing.T) {
+	t.Parallel()
+
+	mount, err := Load(fstest.MapFS{
+		"manifest.json": {Data: []byte(`{"version":1,"hash":"abc123"}`)},
+	}, "/_assets/")
+	require.NoError(t, err)
+	require.Equal(t, "/_assets/abc123/", mount.URLPrefix)
+
+	_, err = Load(fstest.MapFS{}, "/_assets/")
+	require.Error(t, err)
+
+	_, err = Load(fstest.MapFS{"manifest.json": {Data: []byte(`{}`)}}, "/_assets/")
+	require.Error(t, err)
+}
+
+func TestRegisterServesFilesWithCachePolicy(t *testing.T) {
+	t.Parallel()
+
+	mount, err := Load(fstest.MapFS{
+		"manifest.json": {Data: []byte(`{"version":1,"hash":"abc123"}`)},
+		"app.js":        {Data: []byte("console.log(1)")},
+	}, "/_assets/")
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	require.NoError(t, mount.Register(mux, "public, max-age=60"))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/_assets/abc123/app.js", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+	require.Equal(t, "console.log(1)", rec.Body.String())
+
+	missing := httptest.NewRecorder()
+	mux.ServeHTTP(missing, httptest.NewRequest(http.MethodGet, "/_assets/other/app.js", nil))
+	require.Equal(t, http.StatusNotFound, missing.Code)
+}
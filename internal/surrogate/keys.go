@@ -0,0 +1,40 @@
+// Package surrogate names the CDN cache keys pages are tagged with, so the
+// handlers that declare them and the hooks that purge them agree on spelling.
+package surrogate
+
+import "blog/internal/notes"
+
+const (
+	ListNotes   = "list:notes"
+	ListArchive = "list:archive"
+	ListTags    = "list:tags"
+	ListSeries  = "list:series"
+	ListFeed    = "list:feed"
+)
+
+// Listings lists every key a newly published or removed note can show up
+// under, regardless of its authors and tags.
+func Listings() []string {
+	return []string{ListNotes, ListArchive, ListTags, ListSeries, ListFeed}
+}
+
+func Note(slug string) string {
+	return key("note", slug)
+}
+
+func Author(slug string) string {
+	return key("author", slug)
+}
+
+func Tag(name string) string {
+	return key("tag", name)
+}
+
+func key(kind string, value string) string {
+	value = notes.NormalizeSlug(value)
+	if value == "" {
+		return ""
+	}
+
+	return kind + ":" + value
+}
@@ -0,0 +1,155 @@
+// Package theme remembers whether a visitor chose the light or dark color
+// scheme. The choice lives in a cookie so pages render with it server-side;
+// without one they follow prefers-color-scheme.
+package theme
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"blog/internal/middleware"
+)
+
+const Path = "/.theme"
+const CookieName = "theme"
+
+// cookieMaxAge keeps the choice for a year; choosing "system" deletes it.
+const cookieMaxAge = 365 * 24 * time.Hour
+
+type Preference string
+
+const (
+	System Preference = ""
+	Light  Preference = "light"
+	Dark   Preference = "dark"
+)
+
+type preferenceContextKey struct{}
+
+// Parse reads a form or cookie value. Anything but light or dark means system.
+func Parse(raw string) Preference {
+	switch Preference(strings.ToLower(strings.TrimSpace(raw))) {
+	case Light:
+		return Light
+	case Dark:
+		return Dark
+	default:
+		return System
+	}
+}
+
+// FromRequest returns the preference stored in the theme cookie.
+func FromRequest(r *http.Request) Preference {
+	if r == nil {
+		return System
+	}
+	cookie, err := r.Cookie(CookieName)
+	if err != nil {
+		return System
+	}
+
+	return Parse(cookie.Value)
+}
+
+// FromContext returns the preference WithPreference stored for the request
+// being rendered.
+func FromContext(ctx context.Context) Preference {
+	if ctx == nil {
+		return System
+	}
+	preference, _ := ctx.Value(preferenceContextKey{}).(Preference)
+	return preference
+}
+
+// Class is the html element class that pins a theme, or "" for system.
+func (p Preference) Class() string {
+	if p == System {
+		return ""
+	}
+
+	return "theme-" + string(p)
+}
+
+// ColorScheme is the color-scheme meta value, so browser UI such as form
+// controls and scrollbars follows the chosen theme too.
+func (p Preference) ColorScheme() string {
+	if p == System {
+		return "light dark"
+	}
+
+	return string(p)
+}
+
+// WithPreference hands the theme cookie to templates through the request
+// context. Pages rendered for an explicit theme differ per visitor, so they
+// stay out of shared caches.
+func WithPreference(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if next == nil {
+			return
+		}
+		preference := FromRequest(r)
+		if preference == System {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), preferenceContextKey{}, preference)
+		next.ServeHTTP(middleware.PrivateResponseWriter(w), r.WithContext(ctx))
+	})
+}
+
+// Handler stores the theme posted by the footer switch and sends the visitor
+// back to the page they were on.
+type Handler struct{}
+
+func NewHandler() Handler {
+	return Handler{}
+}
+
+func (h Handler) Register(mux *http.ServeMux) {
+	mux.Handle(Path, middleware.WithSameOriginWrites(h))
+}
+
+func (Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	cookie := &http.Cookie{
+		Name:     CookieName,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	}
+	if preference := Parse(r.PostFormValue("theme")); preference != System {
+		cookie.Value = string(preference)
+		cookie.MaxAge = int(cookieMaxAge / time.Second)
+	} else {
+		cookie.MaxAge = -1
+	}
+	http.SetCookie(w, cookie)
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, returnPath(r), http.StatusSeeOther)
+}
+
+// returnPath is the same-site page the switch was used on. Referers from other
+// hosts fall back to the home page so the endpoint is no open redirect.
+func returnPath(r *http.Request) string {
+	referer, err := url.Parse(r.Referer())
+	if err != nil || referer.Host != r.Host || !strings.HasPrefix(referer.Path, "/") ||
+		strings.HasPrefix(referer.Path, "//") {
+		return "/"
+	}
+
+	referer.Scheme = ""
+	referer.Host = ""
+	referer.User = nil
+	return referer.String()
+}
@@ -0,0 +1,79 @@
+package theme
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFallsBackToSystem(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, Light, Parse("light"))
+	require.Equal(t, Dark, Parse(" DARK "))
+	require.Equal(t, System, Parse("sepia"))
+	require.Equal(t, "theme-dark", Dark.Class())
+	require.Empty(t, System.Class())
+	require.Equal(t, "light dark", System.ColorScheme())
+}
+
+func TestHandlerStoresChoiceAndReturnsToPage(t *testing.T) {
+	t.Parallel()
+
+	handler := NewHandler()
+	req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(url.Values{"theme": {"dark"}}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Referer", "http://example.com/note/hello?page=2")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusSeeOther, rec.Code)
+	require.Equal(t, "/note/hello?page=2", rec.Header().Get("Location"))
+	require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, "dark", cookies[0].Value)
+	require.True(t, cookies[0].HttpOnly)
+	require.Positive(t, cookies[0].MaxAge)
+
+	req = httptest.NewRequest(http.MethodPost, Path, strings.NewReader("theme=system"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Referer", "https://evil.example/phish")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, "/", rec.Header().Get("Location"))
+	require.Negative(t, rec.Result().Cookies()[0].MaxAge)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	require.Equal(t, http.MethodPost, rec.Header().Get("Allow"))
+}
+
+func TestWithPreferencePassesCookieToContext(t *testing.T) {
+	t.Parallel()
+
+	var got Preference
+	handler := WithPreference(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context())
+		w.Header().Set("Cache-Control", "public, max-age=60")
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: CookieName, Value: "light"})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, Light, got)
+	require.Equal(t, "private, no-store", rec.Header().Get("Cache-Control"))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, System, got)
+	require.Equal(t, "public, max-age=60", rec.Header().Get("Cache-Control"))
+}
@@ -1 +0,0 @@
-(()=>{const r=new WeakMap,s='[data-metagen-managed="true"]',i=t=>{const e=document.createElement("textarea");e.value=t,e.setAttribute("readonly",""),e.style.position="fixed",e.style.left="-9999px",e.style.opacity="0",document.body.appendChild(e),e.focus(),e.select(),e.setSelectionRange(0,e.value.length);try{return document.execCommand("copy")}catch{return!1}finally{document.body.removeChild(e)}},l=async t=>{if(navigator.clipboard&&typeof navigator.clipboard.writeText=="function")try{return await navigator.clipboard.writeText(t),!0}catch{return i(t)}return i(t)},d=(t,e)=>{const o=e==="copied"?t.dataset.copiedLabel||"copied":t.dataset.copyLabel||"copy",n=t.querySelector(".code-copy-button-label");n&&(n.textContent=o),t.dataset.copyState=e};document.addEventListener("click",async t=>{const e=t.target;if(!(e instanceof Element))return;const o=e.closest(".code-copy-button");if(!(o instanceof HTMLButtonElement))return;const n=o.closest(".code-block");if(!(n instanceof HTMLElement))return;const a=n.querySelector(".code-copy-source");if(!(a instanceof HTMLTextAreaElement)||!await l(a.value))return;d(o,"copied");const c=r.get(o);typeof c=="number"&&window.clearTimeout(c);const m=window.setTimeout(()=>{d(o,"idle"),r.delete(o)},2e3);r.set(o,m)}),document.addEventListener("htmx:afterSettle",t=>{const e=t&&t.detail,o=e&&e.target;o instanceof HTMLElement&&o.id==="notes-content"&&window.scrollTo({top:0,left:0,behavior:"smooth"})}),document.addEventListener("metagen:patch",t=>{const e=t&&t.detail;if(!e||typeof e!="object"||(typeof e.title=="string"&&e.title.trim()!==""&&(document.title=e.title),typeof e.head!="string"))return;document.head.querySelectorAll(s).forEach(c=>c.remove());const n=e.head.trim();if(n==="")return;const a=document.createElement("template");a.innerHTML=n,Array.from(a.content.childNodes).forEach(c=>{c.nodeType===Node.ELEMENT_NODE&&document.head.appendChild(c)})})})();
@@ -88,17 +88,51 @@
     copyTimers.set(button, timeoutID);
   });
 
+  const liveScrollMode = detail => {
+    const trigger = detail.requestConfig && detail.requestConfig.elt;
+    if (trigger instanceof HTMLElement && trigger.dataset.liveScroll) {
+      return trigger.dataset.liveScroll;
+    }
+
+    const target = detail.target;
+    if (target instanceof HTMLElement && target.id === "notes-list") {
+      return "top";
+    }
+
+    return "preserve";
+  };
+
   document.addEventListener("htmx:afterSettle", event => {
     const detail = event && event.detail;
-    const target = detail && detail.target;
-    if (!(target instanceof HTMLElement)) {
+    if (!detail) {
+      return;
+    }
+
+    const mode = liveScrollMode(detail);
+    if (mode === "top") {
+      window.scrollTo({ top: 0, left: 0, behavior: "smooth" });
+      return;
+    }
+    if (!mode.startsWith("#")) {
       return;
     }
-    if (target.id !== "notes-content") {
+
+    const anchor = document.getElementById(mode.slice(1));
+    if (anchor) {
+      anchor.scrollIntoView({ block: "start", behavior: "smooth" });
+    }
+  });
+
+  document.addEventListener("htmx:historyRestore", () => {
+    const list = document.getElementById("notes-list");
+    const channels = document.getElementById("channel-list");
+    if (!list || !channels) {
       return;
     }
 
-    window.scrollTo({ top: 0, left: 0, behavior: "smooth" });
+    if (list.dataset.filterState !== channels.dataset.filterState) {
+      window.location.reload();
+    }
   });
 
   document.addEventListener("metagen:patch", event => {
@@ -0,0 +1,25 @@
+(() => {
+  const script = document.currentScript;
+  const source = script instanceof HTMLScriptElement ? script.dataset.mermaidSrc : "";
+  if (!source) {
+    return;
+  }
+
+  const pinned = document.documentElement.classList;
+  const prefersDark = pinned.contains("theme-dark") ||
+    (!pinned.contains("theme-light") && window.matchMedia("(prefers-color-scheme: dark)").matches);
+
+  import(source)
+    .then(module => {
+      const mermaid = module.default;
+      mermaid.initialize({
+        startOnLoad: false,
+        securityLevel: "strict",
+        theme: prefersDark ? "dark" : "default",
+      });
+      return mermaid.run({ querySelector: "pre.mermaid:not([data-processed])" });
+    })
+    .catch(() => {
+      // Diagrams stay readable as source text when the module cannot load.
+    });
+})();
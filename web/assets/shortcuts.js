@@ -0,0 +1,77 @@
+(() => {
+  const feedItemSelector = '[data-shortcut="feed-item"]';
+  const newerNoteSelector = '[data-shortcut="newer-note"]';
+  const olderNoteSelector = '[data-shortcut="older-note"]';
+
+  const isTypingTarget = target => {
+    if (!(target instanceof HTMLElement)) {
+      return false;
+    }
+    if (target.isContentEditable) {
+      return true;
+    }
+
+    return target.closest("input, textarea, select, [contenteditable]") !== null;
+  };
+
+  const focusFeedItem = step => {
+    const items = Array.from(document.querySelectorAll(feedItemSelector));
+    if (items.length === 0) {
+      return false;
+    }
+
+    const current = items.indexOf(document.activeElement);
+    let next = current + step;
+    if (current < 0) {
+      next = step > 0 ? 0 : items.length - 1;
+    }
+    if (next < 0 || next >= items.length) {
+      return true;
+    }
+
+    const item = items[next];
+    item.focus({ preventScroll: true });
+    const card = item.closest("article") || item;
+    card.scrollIntoView({ block: "center", behavior: "smooth" });
+    return true;
+  };
+
+  const followLink = selector => {
+    const link = document.querySelector(selector);
+    if (!(link instanceof HTMLAnchorElement)) {
+      return false;
+    }
+
+    link.click();
+    return true;
+  };
+
+  document.addEventListener("keydown", event => {
+    if (event.defaultPrevented || event.altKey || event.ctrlKey || event.metaKey || event.shiftKey) {
+      return;
+    }
+    if (isTypingTarget(event.target)) {
+      return;
+    }
+
+    let handled = false;
+    switch (event.key) {
+      case "j":
+        handled = focusFeedItem(1);
+        break;
+      case "k":
+        handled = focusFeedItem(-1);
+        break;
+      case "ArrowLeft":
+        handled = followLink(newerNoteSelector);
+        break;
+      case "ArrowRight":
+        handled = followLink(olderNoteSelector);
+        break;
+    }
+
+    if (handled) {
+      event.preventDefault();
+    }
+  });
+})();
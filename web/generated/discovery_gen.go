@@ -3,6 +3,8 @@ package gen
 
 import (
 	route_conventions_root "blog/web/routes"
+	route_conventions_author__param__slug "blog/web/routes/author/_param__slug"
+	route_conventions_tag__param__slug "blog/web/routes/tag/_param__slug"
 	"blog/web/view"
 	"github.com/RevoTale/no-js/framework"
 	"github.com/RevoTale/no-js/framework/discovery"
@@ -20,6 +22,14 @@ func DiscoveryBundle() *discovery.Bundle[*runtime.Context] {
 			},
 		},
 		Feeds: []discovery.FeedRoute[*runtime.Context]{
+			{
+				RoutePattern: "/author/_param__slug",
+				Feed:         route_conventions_author__param__slug.Feed,
+			},
+			{
+				RoutePattern: "/tag/_param__slug",
+				Feed:         route_conventions_tag__param__slug.Feed,
+			},
 			{
 				RoutePattern: "/",
 				Feed:         route_conventions_root.Feed,
@@ -9,18 +9,59 @@ import (
 type Key string
 
 const (
+	ArchiveBack                   Key = "archive.back"
+	ArchiveEmpty                  Key = "archive.empty"
+	ArchiveMonthApril             Key = "archive.month.april"
+	ArchiveMonthAugust            Key = "archive.month.august"
+	ArchiveMonthDecember          Key = "archive.month.december"
+	ArchiveMonthFebruary          Key = "archive.month.february"
+	ArchiveMonthJanuary           Key = "archive.month.january"
+	ArchiveMonthJuly              Key = "archive.month.july"
+	ArchiveMonthJune              Key = "archive.month.june"
+	ArchiveMonthMarch             Key = "archive.month.march"
+	ArchiveMonthMay               Key = "archive.month.may"
+	ArchiveMonthNovember          Key = "archive.month.november"
+	ArchiveMonthOctober           Key = "archive.month.october"
+	ArchiveMonthSeptember         Key = "archive.month.september"
+	ArchiveSubtitle               Key = "archive.subtitle"
+	ArchiveTitle                  Key = "archive.title"
 	ChannelAll                    Key = "channel.all"
 	ChannelAny                    Key = "channel.any"
+	ChannelArchive                Key = "channel.archive"
 	ChannelMicroTales             Key = "channel.microTales"
 	ChannelSectionAuthors         Key = "channel.section.authors"
 	ChannelSectionChannels        Key = "channel.section.channels"
 	ChannelSectionNoteType        Key = "channel.section.noteType"
+	ChannelSectionPopular         Key = "channel.section.popular"
 	ChannelSectionTags            Key = "channel.section.tags"
+	ChannelTags                   Key = "channel.tags"
 	ChannelTales                  Key = "channel.tales"
 	ChannelsPageBack              Key = "channels.page.back"
 	ChannelsPageHint              Key = "channels.page.hint"
+	ChannelsPageOpml              Key = "channels.page.opml"
 	ChannelsPageTitle             Key = "channels.page.title"
+	CommentsEmpty                 Key = "comments.empty"
+	CommentsErrorInvalid          Key = "comments.error.invalid"
+	CommentsErrorRequired         Key = "comments.error.required"
+	CommentsErrorTooLong          Key = "comments.error.tooLong"
+	CommentsFormBackToNote        Key = "comments.form.backToNote"
+	CommentsFormBody              Key = "comments.form.body"
+	CommentsFormEmail             Key = "comments.form.email"
+	CommentsFormHeading           Key = "comments.form.heading"
+	CommentsFormModeration        Key = "comments.form.moderation"
+	CommentsFormName              Key = "comments.form.name"
+	CommentsFormPageTitle         Key = "comments.form.pageTitle"
+	CommentsFormSubmit            Key = "comments.form.submit"
+	CommentsFormWebsite           Key = "comments.form.website"
+	CommentsHeading               Key = "comments.heading"
+	CommentsLoadMore              Key = "comments.loadMore"
+	CommentsNoticeApproved        Key = "comments.notice.approved"
+	CommentsNoticePending         Key = "comments.notice.pending"
+	CommentsNoticeRejected        Key = "comments.notice.rejected"
+	CommentsUnavailable           Key = "comments.unavailable"
 	ComposerReadOnly              Key = "composer.readOnly"
+	ContextAuthorLinks            Key = "context.authorLinks"
+	ContextAuthorWebsite          Key = "context.authorWebsite"
 	ContextFeed                   Key = "context.feed"
 	ContextLongDescription        Key = "context.longDescription"
 	ContextShortDescription       Key = "context.shortDescription"
@@ -45,6 +86,10 @@ const (
 	LayoutFooterOpensourceLink    Key = "layout.footer.opensourceLink"
 	LayoutFooterOpensourcePrefix  Key = "layout.footer.opensourcePrefix"
 	LayoutFooterStackPrefix       Key = "layout.footer.stackPrefix"
+	LayoutFooterThemeDark         Key = "layout.footer.themeDark"
+	LayoutFooterThemeLight        Key = "layout.footer.themeLight"
+	LayoutFooterThemeSwitch       Key = "layout.footer.themeSwitch"
+	LayoutFooterThemeSystem       Key = "layout.footer.themeSystem"
 	LayoutGuildBlog               Key = "layout.guild.blog"
 	LayoutGuildOnline             Key = "layout.guild.online"
 	LayoutGuildServer             Key = "layout.guild.server"
@@ -55,20 +100,40 @@ const (
 	LayoutTitleMicroTales         Key = "layout.title.microTales"
 	LayoutTitleNotes              Key = "layout.title.notes"
 	LayoutTitleTales              Key = "layout.title.tales"
+	MaintenanceKicker             Key = "maintenance.kicker"
+	MaintenancePageTitle          Key = "maintenance.pageTitle"
+	MaintenanceSummary            Key = "maintenance.summary"
+	MaintenanceTitle              Key = "maintenance.title"
 	MarkdownCodeCopied            Key = "markdown.code.copied"
 	MarkdownCodeCopy              Key = "markdown.code.copy"
 	MarkdownCodePlainText         Key = "markdown.code.plainText"
 	MarkdownPlaceholderCodeBlock  Key = "markdown.placeholder.codeBlock"
 	MarkdownPlaceholderImage      Key = "markdown.placeholder.image"
 	MarkdownPlaceholderTable      Key = "markdown.placeholder.table"
+	NoteAdjacentLabel             Key = "note.adjacent.label"
+	NoteAdjacentNewer             Key = "note.adjacent.newer"
+	NoteAdjacentOlder             Key = "note.adjacent.older"
 	NoteAttachmentLabelPrefix     Key = "note.attachmentLabelPrefix"
 	NoteBack                      Key = "note.back"
+	NoteCoauthorsHeading          Key = "note.coauthors.heading"
+	NoteCoauthorsNotes            Key = "note.coauthors.notes"
+	NoteDraftPreview              Key = "note.draftPreview"
 	NoteFeaturedAttachment        Key = "note.featuredAttachment"
 	NoteOpenFull                  Key = "note.openFull"
 	NotePublishedPrefix           Key = "note.publishedPrefix"
+	NoteReadingTime               Key = "note.readingTime"
+	NoteRelatedHeading            Key = "note.related.heading"
 	NoteTitleFallback             Key = "note.title.fallback"
 	NoteUnknownAuthor             Key = "note.unknownAuthor"
+	NoteDiffDraft                 Key = "noteDiff.draft"
+	NoteDiffNotPublished          Key = "noteDiff.notPublished"
+	NoteDiffOpenPreview           Key = "noteDiff.openPreview"
+	NoteDiffOpenPublished         Key = "noteDiff.openPublished"
+	NoteDiffPageTitle             Key = "noteDiff.pageTitle"
+	NoteDiffPublished             Key = "noteDiff.published"
+	NoteDiffReadOnly              Key = "noteDiff.readOnly"
 	NotesAriaFeed                 Key = "notes.aria.feed"
+	NotesFeaturedTitle            Key = "notes.featured.title"
 	NotfoundBack                  Key = "notfound.back"
 	NotfoundKicker                Key = "notfound.kicker"
 	NotfoundOpenChannels          Key = "notfound.openChannels"
@@ -81,6 +146,8 @@ const (
 	PagerNext                     Key = "pager.next"
 	PagerPage                     Key = "pager.page"
 	PagerPrev                     Key = "pager.prev"
+	SeoArchiveDescription         Key = "seo.archive.description"
+	SeoArchiveMonthDescription    Key = "seo.archiveMonth.description"
 	SeoAuthorDescription          Key = "seo.author.description"
 	SeoChannelsDescription        Key = "seo.channels.description"
 	SeoMicroTalesDescription      Key = "seo.microTales.description"
@@ -90,25 +157,79 @@ const (
 	SeoPublisherName              Key = "seo.publisher.name"
 	SeoRootDescription            Key = "seo.root.description"
 	SeoRootTitle                  Key = "seo.root.title"
+	SeoSeriesDescription          Key = "seo.series.description"
 	SeoSiteDescription            Key = "seo.site.description"
 	SeoSiteName                   Key = "seo.site.name"
 	SeoTagDescription             Key = "seo.tag.description"
+	SeoTagsDescription            Key = "seo.tags.description"
 	SeoTalesDescription           Key = "seo.tales.description"
+	SeriesLabel                   Key = "series.label"
+	SeriesNext                    Key = "series.next"
+	SeriesPart                    Key = "series.part"
+	SeriesPrevious                Key = "series.previous"
+	TagsEmpty                     Key = "tags.empty"
+	TagsSubtitle                  Key = "tags.subtitle"
+	TagsTitle                     Key = "tags.title"
+	UpstreamKicker                Key = "upstream.kicker"
+	UpstreamPageTitle             Key = "upstream.pageTitle"
+	UpstreamSummary               Key = "upstream.summary"
+	UpstreamTitle                 Key = "upstream.title"
 )
 
 var Keys = []Key{
+	ArchiveBack,
+	ArchiveEmpty,
+	ArchiveMonthApril,
+	ArchiveMonthAugust,
+	ArchiveMonthDecember,
+	ArchiveMonthFebruary,
+	ArchiveMonthJanuary,
+	ArchiveMonthJuly,
+	ArchiveMonthJune,
+	ArchiveMonthMarch,
+	ArchiveMonthMay,
+	ArchiveMonthNovember,
+	ArchiveMonthOctober,
+	ArchiveMonthSeptember,
+	ArchiveSubtitle,
+	ArchiveTitle,
 	ChannelAll,
 	ChannelAny,
+	ChannelArchive,
 	ChannelMicroTales,
 	ChannelSectionAuthors,
 	ChannelSectionChannels,
 	ChannelSectionNoteType,
+	ChannelSectionPopular,
 	ChannelSectionTags,
+	ChannelTags,
 	ChannelTales,
 	ChannelsPageBack,
 	ChannelsPageHint,
+	ChannelsPageOpml,
 	ChannelsPageTitle,
+	CommentsEmpty,
+	CommentsErrorInvalid,
+	CommentsErrorRequired,
+	CommentsErrorTooLong,
+	CommentsFormBackToNote,
+	CommentsFormBody,
+	CommentsFormEmail,
+	CommentsFormHeading,
+	CommentsFormModeration,
+	CommentsFormName,
+	CommentsFormPageTitle,
+	CommentsFormSubmit,
+	CommentsFormWebsite,
+	CommentsHeading,
+	CommentsLoadMore,
+	CommentsNoticeApproved,
+	CommentsNoticePending,
+	CommentsNoticeRejected,
+	CommentsUnavailable,
 	ComposerReadOnly,
+	ContextAuthorLinks,
+	ContextAuthorWebsite,
 	ContextFeed,
 	ContextLongDescription,
 	ContextShortDescription,
@@ -133,6 +254,10 @@ var Keys = []Key{
 	LayoutFooterOpensourceLink,
 	LayoutFooterOpensourcePrefix,
 	LayoutFooterStackPrefix,
+	LayoutFooterThemeDark,
+	LayoutFooterThemeLight,
+	LayoutFooterThemeSwitch,
+	LayoutFooterThemeSystem,
 	LayoutGuildBlog,
 	LayoutGuildOnline,
 	LayoutGuildServer,
@@ -143,20 +268,40 @@ var Keys = []Key{
 	LayoutTitleMicroTales,
 	LayoutTitleNotes,
 	LayoutTitleTales,
+	MaintenanceKicker,
+	MaintenancePageTitle,
+	MaintenanceSummary,
+	MaintenanceTitle,
 	MarkdownCodeCopied,
 	MarkdownCodeCopy,
 	MarkdownCodePlainText,
 	MarkdownPlaceholderCodeBlock,
 	MarkdownPlaceholderImage,
 	MarkdownPlaceholderTable,
+	NoteAdjacentLabel,
+	NoteAdjacentNewer,
+	NoteAdjacentOlder,
 	NoteAttachmentLabelPrefix,
 	NoteBack,
+	NoteCoauthorsHeading,
+	NoteCoauthorsNotes,
+	NoteDraftPreview,
 	NoteFeaturedAttachment,
 	NoteOpenFull,
 	NotePublishedPrefix,
+	NoteReadingTime,
+	NoteRelatedHeading,
 	NoteTitleFallback,
 	NoteUnknownAuthor,
+	NoteDiffDraft,
+	NoteDiffNotPublished,
+	NoteDiffOpenPreview,
+	NoteDiffOpenPublished,
+	NoteDiffPageTitle,
+	NoteDiffPublished,
+	NoteDiffReadOnly,
 	NotesAriaFeed,
+	NotesFeaturedTitle,
 	NotfoundBack,
 	NotfoundKicker,
 	NotfoundOpenChannels,
@@ -169,6 +314,8 @@ var Keys = []Key{
 	PagerNext,
 	PagerPage,
 	PagerPrev,
+	SeoArchiveDescription,
+	SeoArchiveMonthDescription,
 	SeoAuthorDescription,
 	SeoChannelsDescription,
 	SeoMicroTalesDescription,
@@ -178,25 +325,79 @@ var Keys = []Key{
 	SeoPublisherName,
 	SeoRootDescription,
 	SeoRootTitle,
+	SeoSeriesDescription,
 	SeoSiteDescription,
 	SeoSiteName,
 	SeoTagDescription,
+	SeoTagsDescription,
 	SeoTalesDescription,
+	SeriesLabel,
+	SeriesNext,
+	SeriesPart,
+	SeriesPrevious,
+	TagsEmpty,
+	TagsSubtitle,
+	TagsTitle,
+	UpstreamKicker,
+	UpstreamPageTitle,
+	UpstreamSummary,
+	UpstreamTitle,
 }
 
 var defaultMessages = map[Key]string{
+	ArchiveBack:                   "All months",
+	ArchiveEmpty:                  "No published notes yet.",
+	ArchiveMonthApril:             "April",
+	ArchiveMonthAugust:            "August",
+	ArchiveMonthDecember:          "December",
+	ArchiveMonthFebruary:          "February",
+	ArchiveMonthJanuary:           "January",
+	ArchiveMonthJuly:              "July",
+	ArchiveMonthJune:              "June",
+	ArchiveMonthMarch:             "March",
+	ArchiveMonthMay:               "May",
+	ArchiveMonthNovember:          "November",
+	ArchiveMonthOctober:           "October",
+	ArchiveMonthSeptember:         "September",
+	ArchiveSubtitle:               "Notes by month",
+	ArchiveTitle:                  "Archive",
 	ChannelAll:                    "All",
 	ChannelAny:                    "All",
+	ChannelArchive:                "Archive",
 	ChannelMicroTales:             "Micro-tales",
 	ChannelSectionAuthors:         "authors",
 	ChannelSectionChannels:        "channels",
 	ChannelSectionNoteType:        "note type",
+	ChannelSectionPopular:         "popular this week",
 	ChannelSectionTags:            "tags",
+	ChannelTags:                   "Tags",
 	ChannelTales:                  "Tales",
 	ChannelsPageBack:              "Back to feed",
 	ChannelsPageHint:              "Use the left channel list to navigate.",
+	ChannelsPageOpml:              "All feeds as OPML",
 	ChannelsPageTitle:             "Channels",
+	CommentsEmpty:                 "No comments yet.",
+	CommentsErrorInvalid:          "This value is not valid.",
+	CommentsErrorRequired:         "This field is required.",
+	CommentsErrorTooLong:          "This value is too long.",
+	CommentsFormBackToNote:        "Back to the note",
+	CommentsFormBody:              "Comment",
+	CommentsFormEmail:             "Email (optional, never shown)",
+	CommentsFormHeading:           "Leave a comment",
+	CommentsFormModeration:        "Comments are reviewed before they appear.",
+	CommentsFormName:              "Name",
+	CommentsFormPageTitle:         "Check your comment",
+	CommentsFormSubmit:            "Post comment",
+	CommentsFormWebsite:           "Leave this field empty",
+	CommentsHeading:               "Comments",
+	CommentsLoadMore:              "Load more comments",
+	CommentsNoticeApproved:        "Thanks! Your comment is published.",
+	CommentsNoticePending:         "Thanks! Your comment is awaiting moderation.",
+	CommentsNoticeRejected:        "Your comment was not accepted.",
+	CommentsUnavailable:           "Comments can't be loaded right now.",
 	ComposerReadOnly:              "You do not have permission to send messages in this channel. It is READ-only! :)",
+	ContextAuthorLinks:            "Author profiles",
+	ContextAuthorWebsite:          "Website",
 	ContextFeed:                   "feed",
 	ContextLongDescription:        "long-form notes",
 	ContextShortDescription:       "short notes",
@@ -221,6 +422,10 @@ var defaultMessages = map[Key]string{
 	LayoutFooterOpensourceLink:    "Browse the source on GitHub",
 	LayoutFooterOpensourcePrefix:  "the code of this project is developed publicly:",
 	LayoutFooterStackPrefix:       "stack:",
+	LayoutFooterThemeDark:         "Dark",
+	LayoutFooterThemeLight:        "Light",
+	LayoutFooterThemeSwitch:       "Color theme:",
+	LayoutFooterThemeSystem:       "System",
 	LayoutGuildBlog:               "Blog",
 	LayoutGuildOnline:             "online",
 	LayoutGuildServer:             "Server",
@@ -231,20 +436,40 @@ var defaultMessages = map[Key]string{
 	LayoutTitleMicroTales:         "Micro-tales",
 	LayoutTitleNotes:              "Notes",
 	LayoutTitleTales:              "Tales",
+	MaintenanceKicker:             "error / 503",
+	MaintenancePageTitle:          "503 Under maintenance",
+	MaintenanceSummary:            "The blog is being updated. Please try again in a few minutes.",
+	MaintenanceTitle:              "Back shortly",
 	MarkdownCodeCopied:            "copied",
 	MarkdownCodeCopy:              "copy",
 	MarkdownCodePlainText:         "plain text",
 	MarkdownPlaceholderCodeBlock:  "[code block]",
 	MarkdownPlaceholderImage:      "[image]",
 	MarkdownPlaceholderTable:      "[table]",
+	NoteAdjacentLabel:             "Adjacent notes",
+	NoteAdjacentNewer:             "newer",
+	NoteAdjacentOlder:             "older",
 	NoteAttachmentLabelPrefix:     "attachment",
 	NoteBack:                      "Back to notes",
+	NoteCoauthorsHeading:          "Also written with",
+	NoteCoauthorsNotes:            "All notes by these authors",
+	NoteDraftPreview:              "Draft preview — this version is not published yet.",
 	NoteFeaturedAttachment:        "featured attachment",
 	NoteOpenFull:                  "Open full note",
 	NotePublishedPrefix:           "published",
+	NoteReadingTime:               "{{.Minutes}} min read",
+	NoteRelatedHeading:            "Read next",
 	NoteTitleFallback:             "Note",
 	NoteUnknownAuthor:             "unknown author",
+	NoteDiffDraft:                 "Latest draft",
+	NoteDiffNotPublished:          "This note has not been published yet.",
+	NoteDiffOpenPreview:           "Open signed preview link",
+	NoteDiffOpenPublished:         "Open published note",
+	NoteDiffPageTitle:             "Draft review",
+	NoteDiffPublished:             "Published",
+	NoteDiffReadOnly:              "read-only draft preview",
 	NotesAriaFeed:                 "notes feed",
+	NotesFeaturedTitle:            "Pinned",
 	NotfoundBack:                  "Back to notes",
 	NotfoundKicker:                "error / 404",
 	NotfoundOpenChannels:          "Open channels",
@@ -257,6 +482,8 @@ var defaultMessages = map[Key]string{
 	PagerNext:                     "next",
 	PagerPage:                     "page",
 	PagerPrev:                     "prev",
+	SeoArchiveDescription:         "Browse every published note by year and month.",
+	SeoArchiveMonthDescription:    "Notes published in {{.Period}}.",
 	SeoAuthorDescription:          "Browse notes by {{.Author}}.",
 	SeoChannelsDescription:        "Browse available channels and filters for the blog feed.",
 	SeoMicroTalesDescription:      "Read short-form micro-tales from the blog feed.",
@@ -266,10 +493,23 @@ var defaultMessages = map[Key]string{
 	SeoPublisherName:              "RevoTale",
 	SeoRootDescription:            "Dive into concise notes packed with actionable tips on coding, web-performance, SEO, AI workflows, book takeaways and more—updated regularly on RevoTale.",
 	SeoRootTitle:                  "Notes - Quick Coding, Experience, Open Source, SEO & Science Insights",
+	SeoSeriesDescription:          "Every part of the series “{{.Series}}”, in order.",
 	SeoSiteDescription:            "A multilingual note feed with tales and micro-tales.",
 	SeoSiteName:                   "RevoTale",
 	SeoTagDescription:             "Browse notes tagged {{.Tag}}.",
+	SeoTagsDescription:            "All blog tags with the number of published notes.",
 	SeoTalesDescription:           "Read long-form tales from the blog feed.",
+	SeriesLabel:                   "Series",
+	SeriesNext:                    "next part",
+	SeriesPart:                    "Part {{.Part}} of {{.Total}}",
+	SeriesPrevious:                "previous part",
+	TagsEmpty:                     "No tags yet.",
+	TagsSubtitle:                  "Every topic with its note count",
+	TagsTitle:                     "Tags",
+	UpstreamKicker:                "error / 502",
+	UpstreamPageTitle:             "502 CMS unavailable",
+	UpstreamSummary:               "The notes could not be loaded from the content server. Please try again shortly.",
+	UpstreamTitle:                 "CMS temporarily unavailable",
 }
 
 func translate(ctx frameworki18n.Context[Key], key Key, vars map[string]any) string {
@@ -279,6 +519,70 @@ func translate(ctx frameworki18n.Context[Key], key Key, vars map[string]any) str
 	return ctx.T(key, vars)
 }
 
+func TArchiveBack(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveBack, nil)
+}
+
+func TArchiveEmpty(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveEmpty, nil)
+}
+
+func TArchiveMonthApril(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthApril, nil)
+}
+
+func TArchiveMonthAugust(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthAugust, nil)
+}
+
+func TArchiveMonthDecember(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthDecember, nil)
+}
+
+func TArchiveMonthFebruary(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthFebruary, nil)
+}
+
+func TArchiveMonthJanuary(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthJanuary, nil)
+}
+
+func TArchiveMonthJuly(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthJuly, nil)
+}
+
+func TArchiveMonthJune(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthJune, nil)
+}
+
+func TArchiveMonthMarch(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthMarch, nil)
+}
+
+func TArchiveMonthMay(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthMay, nil)
+}
+
+func TArchiveMonthNovember(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthNovember, nil)
+}
+
+func TArchiveMonthOctober(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthOctober, nil)
+}
+
+func TArchiveMonthSeptember(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveMonthSeptember, nil)
+}
+
+func TArchiveSubtitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveSubtitle, nil)
+}
+
+func TArchiveTitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ArchiveTitle, nil)
+}
+
 func TChannelAll(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, ChannelAll, nil)
 }
@@ -287,6 +591,10 @@ func TChannelAny(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, ChannelAny, nil)
 }
 
+func TChannelArchive(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ChannelArchive, nil)
+}
+
 func TChannelMicroTales(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, ChannelMicroTales, nil)
 }
@@ -303,10 +611,18 @@ func TChannelSectionNoteType(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, ChannelSectionNoteType, nil)
 }
 
+func TChannelSectionPopular(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ChannelSectionPopular, nil)
+}
+
 func TChannelSectionTags(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, ChannelSectionTags, nil)
 }
 
+func TChannelTags(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ChannelTags, nil)
+}
+
 func TChannelTales(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, ChannelTales, nil)
 }
@@ -319,14 +635,102 @@ func TChannelsPageHint(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, ChannelsPageHint, nil)
 }
 
+func TChannelsPageOpml(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ChannelsPageOpml, nil)
+}
+
 func TChannelsPageTitle(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, ChannelsPageTitle, nil)
 }
 
+func TCommentsEmpty(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsEmpty, nil)
+}
+
+func TCommentsErrorInvalid(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsErrorInvalid, nil)
+}
+
+func TCommentsErrorRequired(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsErrorRequired, nil)
+}
+
+func TCommentsErrorTooLong(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsErrorTooLong, nil)
+}
+
+func TCommentsFormBackToNote(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsFormBackToNote, nil)
+}
+
+func TCommentsFormBody(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsFormBody, nil)
+}
+
+func TCommentsFormEmail(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsFormEmail, nil)
+}
+
+func TCommentsFormHeading(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsFormHeading, nil)
+}
+
+func TCommentsFormModeration(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsFormModeration, nil)
+}
+
+func TCommentsFormName(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsFormName, nil)
+}
+
+func TCommentsFormPageTitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsFormPageTitle, nil)
+}
+
+func TCommentsFormSubmit(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsFormSubmit, nil)
+}
+
+func TCommentsFormWebsite(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsFormWebsite, nil)
+}
+
+func TCommentsHeading(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsHeading, nil)
+}
+
+func TCommentsLoadMore(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsLoadMore, nil)
+}
+
+func TCommentsNoticeApproved(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsNoticeApproved, nil)
+}
+
+func TCommentsNoticePending(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsNoticePending, nil)
+}
+
+func TCommentsNoticeRejected(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsNoticeRejected, nil)
+}
+
+func TCommentsUnavailable(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, CommentsUnavailable, nil)
+}
+
 func TComposerReadOnly(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, ComposerReadOnly, nil)
 }
 
+func TContextAuthorLinks(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ContextAuthorLinks, nil)
+}
+
+func TContextAuthorWebsite(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, ContextAuthorWebsite, nil)
+}
+
 func TContextFeed(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, ContextFeed, nil)
 }
@@ -423,6 +827,22 @@ func TLayoutFooterStackPrefix(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, LayoutFooterStackPrefix, nil)
 }
 
+func TLayoutFooterThemeDark(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, LayoutFooterThemeDark, nil)
+}
+
+func TLayoutFooterThemeLight(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, LayoutFooterThemeLight, nil)
+}
+
+func TLayoutFooterThemeSwitch(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, LayoutFooterThemeSwitch, nil)
+}
+
+func TLayoutFooterThemeSystem(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, LayoutFooterThemeSystem, nil)
+}
+
 func TLayoutGuildBlog(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, LayoutGuildBlog, nil)
 }
@@ -463,6 +883,22 @@ func TLayoutTitleTales(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, LayoutTitleTales, nil)
 }
 
+func TMaintenanceKicker(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, MaintenanceKicker, nil)
+}
+
+func TMaintenancePageTitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, MaintenancePageTitle, nil)
+}
+
+func TMaintenanceSummary(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, MaintenanceSummary, nil)
+}
+
+func TMaintenanceTitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, MaintenanceTitle, nil)
+}
+
 func TMarkdownCodeCopied(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, MarkdownCodeCopied, nil)
 }
@@ -487,6 +923,18 @@ func TMarkdownPlaceholderTable(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, MarkdownPlaceholderTable, nil)
 }
 
+func TNoteAdjacentLabel(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteAdjacentLabel, nil)
+}
+
+func TNoteAdjacentNewer(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteAdjacentNewer, nil)
+}
+
+func TNoteAdjacentOlder(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteAdjacentOlder, nil)
+}
+
 func TNoteAttachmentLabelPrefix(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, NoteAttachmentLabelPrefix, nil)
 }
@@ -495,6 +943,18 @@ func TNoteBack(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, NoteBack, nil)
 }
 
+func TNoteCoauthorsHeading(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteCoauthorsHeading, nil)
+}
+
+func TNoteCoauthorsNotes(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteCoauthorsNotes, nil)
+}
+
+func TNoteDraftPreview(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteDraftPreview, nil)
+}
+
 func TNoteFeaturedAttachment(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, NoteFeaturedAttachment, nil)
 }
@@ -507,6 +967,20 @@ func TNotePublishedPrefix(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, NotePublishedPrefix, nil)
 }
 
+type NoteReadingTimeArgs struct {
+	Minutes int
+}
+
+func TNoteReadingTime(ctx frameworki18n.Context[Key], args NoteReadingTimeArgs) string {
+	return translate(ctx, NoteReadingTime, map[string]any{
+		"Minutes": args.Minutes,
+	})
+}
+
+func TNoteRelatedHeading(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteRelatedHeading, nil)
+}
+
 func TNoteTitleFallback(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, NoteTitleFallback, nil)
 }
@@ -515,10 +989,42 @@ func TNoteUnknownAuthor(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, NoteUnknownAuthor, nil)
 }
 
+func TNoteDiffDraft(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteDiffDraft, nil)
+}
+
+func TNoteDiffNotPublished(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteDiffNotPublished, nil)
+}
+
+func TNoteDiffOpenPreview(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteDiffOpenPreview, nil)
+}
+
+func TNoteDiffOpenPublished(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteDiffOpenPublished, nil)
+}
+
+func TNoteDiffPageTitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteDiffPageTitle, nil)
+}
+
+func TNoteDiffPublished(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteDiffPublished, nil)
+}
+
+func TNoteDiffReadOnly(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NoteDiffReadOnly, nil)
+}
+
 func TNotesAriaFeed(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, NotesAriaFeed, nil)
 }
 
+func TNotesFeaturedTitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, NotesFeaturedTitle, nil)
+}
+
 func TNotfoundBack(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, NotfoundBack, nil)
 }
@@ -567,6 +1073,20 @@ func TPagerPrev(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, PagerPrev, nil)
 }
 
+func TSeoArchiveDescription(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, SeoArchiveDescription, nil)
+}
+
+type SeoArchiveMonthDescriptionArgs struct {
+	Period string
+}
+
+func TSeoArchiveMonthDescription(ctx frameworki18n.Context[Key], args SeoArchiveMonthDescriptionArgs) string {
+	return translate(ctx, SeoArchiveMonthDescription, map[string]any{
+		"Period": args.Period,
+	})
+}
+
 type SeoAuthorDescriptionArgs struct {
 	Author string
 }
@@ -609,6 +1129,16 @@ func TSeoRootTitle(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, SeoRootTitle, nil)
 }
 
+type SeoSeriesDescriptionArgs struct {
+	Series string
+}
+
+func TSeoSeriesDescription(ctx frameworki18n.Context[Key], args SeoSeriesDescriptionArgs) string {
+	return translate(ctx, SeoSeriesDescription, map[string]any{
+		"Series": args.Series,
+	})
+}
+
 func TSeoSiteDescription(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, SeoSiteDescription, nil)
 }
@@ -627,6 +1157,62 @@ func TSeoTagDescription(ctx frameworki18n.Context[Key], args SeoTagDescriptionAr
 	})
 }
 
+func TSeoTagsDescription(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, SeoTagsDescription, nil)
+}
+
 func TSeoTalesDescription(ctx frameworki18n.Context[Key]) string {
 	return translate(ctx, SeoTalesDescription, nil)
 }
+
+func TSeriesLabel(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, SeriesLabel, nil)
+}
+
+func TSeriesNext(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, SeriesNext, nil)
+}
+
+type SeriesPartArgs struct {
+	Part  int
+	Total int
+}
+
+func TSeriesPart(ctx frameworki18n.Context[Key], args SeriesPartArgs) string {
+	return translate(ctx, SeriesPart, map[string]any{
+		"Part":  args.Part,
+		"Total": args.Total,
+	})
+}
+
+func TSeriesPrevious(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, SeriesPrevious, nil)
+}
+
+func TTagsEmpty(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, TagsEmpty, nil)
+}
+
+func TTagsSubtitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, TagsSubtitle, nil)
+}
+
+func TTagsTitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, TagsTitle, nil)
+}
+
+func TUpstreamKicker(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, UpstreamKicker, nil)
+}
+
+func TUpstreamPageTitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, UpstreamPageTitle, nil)
+}
+
+func TUpstreamSummary(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, UpstreamSummary, nil)
+}
+
+func TUpstreamTitle(ctx frameworki18n.Context[Key]) string {
+	return translate(ctx, UpstreamTitle, nil)
+}
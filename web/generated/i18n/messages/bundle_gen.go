@@ -10,18 +10,59 @@ import (
 )
 
 var defaultMessages = map[i18n.Key]string{
+	i18n.ArchiveBack:                   "All months",
+	i18n.ArchiveEmpty:                  "No published notes yet.",
+	i18n.ArchiveMonthApril:             "April",
+	i18n.ArchiveMonthAugust:            "August",
+	i18n.ArchiveMonthDecember:          "December",
+	i18n.ArchiveMonthFebruary:          "February",
+	i18n.ArchiveMonthJanuary:           "January",
+	i18n.ArchiveMonthJuly:              "July",
+	i18n.ArchiveMonthJune:              "June",
+	i18n.ArchiveMonthMarch:             "March",
+	i18n.ArchiveMonthMay:               "May",
+	i18n.ArchiveMonthNovember:          "November",
+	i18n.ArchiveMonthOctober:           "October",
+	i18n.ArchiveMonthSeptember:         "September",
+	i18n.ArchiveSubtitle:               "Notes by month",
+	i18n.ArchiveTitle:                  "Archive",
 	i18n.ChannelAll:                    "All",
 	i18n.ChannelAny:                    "All",
+	i18n.ChannelArchive:                "Archive",
 	i18n.ChannelMicroTales:             "Micro-tales",
 	i18n.ChannelSectionAuthors:         "authors",
 	i18n.ChannelSectionChannels:        "channels",
 	i18n.ChannelSectionNoteType:        "note type",
+	i18n.ChannelSectionPopular:         "popular this week",
 	i18n.ChannelSectionTags:            "tags",
+	i18n.ChannelTags:                   "Tags",
 	i18n.ChannelTales:                  "Tales",
 	i18n.ChannelsPageBack:              "Back to feed",
 	i18n.ChannelsPageHint:              "Use the left channel list to navigate.",
+	i18n.ChannelsPageOpml:              "All feeds as OPML",
 	i18n.ChannelsPageTitle:             "Channels",
+	i18n.CommentsEmpty:                 "No comments yet.",
+	i18n.CommentsErrorInvalid:          "This value is not valid.",
+	i18n.CommentsErrorRequired:         "This field is required.",
+	i18n.CommentsErrorTooLong:          "This value is too long.",
+	i18n.CommentsFormBackToNote:        "Back to the note",
+	i18n.CommentsFormBody:              "Comment",
+	i18n.CommentsFormEmail:             "Email (optional, never shown)",
+	i18n.CommentsFormHeading:           "Leave a comment",
+	i18n.CommentsFormModeration:        "Comments are reviewed before they appear.",
+	i18n.CommentsFormName:              "Name",
+	i18n.CommentsFormPageTitle:         "Check your comment",
+	i18n.CommentsFormSubmit:            "Post comment",
+	i18n.CommentsFormWebsite:           "Leave this field empty",
+	i18n.CommentsHeading:               "Comments",
+	i18n.CommentsLoadMore:              "Load more comments",
+	i18n.CommentsNoticeApproved:        "Thanks! Your comment is published.",
+	i18n.CommentsNoticePending:         "Thanks! Your comment is awaiting moderation.",
+	i18n.CommentsNoticeRejected:        "Your comment was not accepted.",
+	i18n.CommentsUnavailable:           "Comments can't be loaded right now.",
 	i18n.ComposerReadOnly:              "You do not have permission to send messages in this channel. It is READ-only! :)",
+	i18n.ContextAuthorLinks:            "Author profiles",
+	i18n.ContextAuthorWebsite:          "Website",
 	i18n.ContextFeed:                   "feed",
 	i18n.ContextLongDescription:        "long-form notes",
 	i18n.ContextShortDescription:       "short notes",
@@ -46,6 +87,10 @@ var defaultMessages = map[i18n.Key]string{
 	i18n.LayoutFooterOpensourceLink:    "Browse the source on GitHub",
 	i18n.LayoutFooterOpensourcePrefix:  "the code of this project is developed publicly:",
 	i18n.LayoutFooterStackPrefix:       "stack:",
+	i18n.LayoutFooterThemeDark:         "Dark",
+	i18n.LayoutFooterThemeLight:        "Light",
+	i18n.LayoutFooterThemeSwitch:       "Color theme:",
+	i18n.LayoutFooterThemeSystem:       "System",
 	i18n.LayoutGuildBlog:               "Blog",
 	i18n.LayoutGuildOnline:             "online",
 	i18n.LayoutGuildServer:             "Server",
@@ -56,20 +101,40 @@ var defaultMessages = map[i18n.Key]string{
 	i18n.LayoutTitleMicroTales:         "Micro-tales",
 	i18n.LayoutTitleNotes:              "Notes",
 	i18n.LayoutTitleTales:              "Tales",
+	i18n.MaintenanceKicker:             "error / 503",
+	i18n.MaintenancePageTitle:          "503 Under maintenance",
+	i18n.MaintenanceSummary:            "The blog is being updated. Please try again in a few minutes.",
+	i18n.MaintenanceTitle:              "Back shortly",
 	i18n.MarkdownCodeCopied:            "copied",
 	i18n.MarkdownCodeCopy:              "copy",
 	i18n.MarkdownCodePlainText:         "plain text",
 	i18n.MarkdownPlaceholderCodeBlock:  "[code block]",
 	i18n.MarkdownPlaceholderImage:      "[image]",
 	i18n.MarkdownPlaceholderTable:      "[table]",
+	i18n.NoteAdjacentLabel:             "Adjacent notes",
+	i18n.NoteAdjacentNewer:             "newer",
+	i18n.NoteAdjacentOlder:             "older",
 	i18n.NoteAttachmentLabelPrefix:     "attachment",
 	i18n.NoteBack:                      "Back to notes",
+	i18n.NoteCoauthorsHeading:          "Also written with",
+	i18n.NoteCoauthorsNotes:            "All notes by these authors",
+	i18n.NoteDraftPreview:              "Draft preview — this version is not published yet.",
 	i18n.NoteFeaturedAttachment:        "featured attachment",
 	i18n.NoteOpenFull:                  "Open full note",
 	i18n.NotePublishedPrefix:           "published",
+	i18n.NoteReadingTime:               "{{.Minutes}} min read",
+	i18n.NoteRelatedHeading:            "Read next",
 	i18n.NoteTitleFallback:             "Note",
 	i18n.NoteUnknownAuthor:             "unknown author",
+	i18n.NoteDiffDraft:                 "Latest draft",
+	i18n.NoteDiffNotPublished:          "This note has not been published yet.",
+	i18n.NoteDiffOpenPreview:           "Open signed preview link",
+	i18n.NoteDiffOpenPublished:         "Open published note",
+	i18n.NoteDiffPageTitle:             "Draft review",
+	i18n.NoteDiffPublished:             "Published",
+	i18n.NoteDiffReadOnly:              "read-only draft preview",
 	i18n.NotesAriaFeed:                 "notes feed",
+	i18n.NotesFeaturedTitle:            "Pinned",
 	i18n.NotfoundBack:                  "Back to notes",
 	i18n.NotfoundKicker:                "error / 404",
 	i18n.NotfoundOpenChannels:          "Open channels",
@@ -82,6 +147,8 @@ var defaultMessages = map[i18n.Key]string{
 	i18n.PagerNext:                     "next",
 	i18n.PagerPage:                     "page",
 	i18n.PagerPrev:                     "prev",
+	i18n.SeoArchiveDescription:         "Browse every published note by year and month.",
+	i18n.SeoArchiveMonthDescription:    "Notes published in {{.Period}}.",
 	i18n.SeoAuthorDescription:          "Browse notes by {{.Author}}.",
 	i18n.SeoChannelsDescription:        "Browse available channels and filters for the blog feed.",
 	i18n.SeoMicroTalesDescription:      "Read short-form micro-tales from the blog feed.",
@@ -91,10 +158,23 @@ var defaultMessages = map[i18n.Key]string{
 	i18n.SeoPublisherName:              "RevoTale",
 	i18n.SeoRootDescription:            "Dive into concise notes packed with actionable tips on coding, web-performance, SEO, AI workflows, book takeaways and more—updated regularly on RevoTale.",
 	i18n.SeoRootTitle:                  "Notes - Quick Coding, Experience, Open Source, SEO & Science Insights",
+	i18n.SeoSeriesDescription:          "Every part of the series “{{.Series}}”, in order.",
 	i18n.SeoSiteDescription:            "A multilingual note feed with tales and micro-tales.",
 	i18n.SeoSiteName:                   "RevoTale",
 	i18n.SeoTagDescription:             "Browse notes tagged {{.Tag}}.",
+	i18n.SeoTagsDescription:            "All blog tags with the number of published notes.",
 	i18n.SeoTalesDescription:           "Read long-form tales from the blog feed.",
+	i18n.SeriesLabel:                   "Series",
+	i18n.SeriesNext:                    "next part",
+	i18n.SeriesPart:                    "Part {{.Part}} of {{.Total}}",
+	i18n.SeriesPrevious:                "previous part",
+	i18n.TagsEmpty:                     "No tags yet.",
+	i18n.TagsSubtitle:                  "Every topic with its note count",
+	i18n.TagsTitle:                     "Tags",
+	i18n.UpstreamKicker:                "error / 502",
+	i18n.UpstreamPageTitle:             "502 CMS unavailable",
+	i18n.UpstreamSummary:               "The notes could not be loaded from the content server. Please try again shortly.",
+	i18n.UpstreamTitle:                 "CMS temporarily unavailable",
 }
 
 var bundle = func() *frameworki18n.Bundle[i18n.Key] {
@@ -102,18 +182,59 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 		webi18n.Config(),
 		map[string]map[i18n.Key]frameworki18n.CompiledMessage{
 			"de": {
+				i18n.ArchiveBack:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Alle Monate", Arg: ""}}},
+				i18n.ArchiveEmpty:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Noch keine veröffentlichten Notizen.", Arg: ""}}},
+				i18n.ArchiveMonthApril:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "April", Arg: ""}}},
+				i18n.ArchiveMonthAugust:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "August", Arg: ""}}},
+				i18n.ArchiveMonthDecember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Dezember", Arg: ""}}},
+				i18n.ArchiveMonthFebruary:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Februar", Arg: ""}}},
+				i18n.ArchiveMonthJanuary:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Januar", Arg: ""}}},
+				i18n.ArchiveMonthJuly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Juli", Arg: ""}}},
+				i18n.ArchiveMonthJune:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Juni", Arg: ""}}},
+				i18n.ArchiveMonthMarch:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "März", Arg: ""}}},
+				i18n.ArchiveMonthMay:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Mai", Arg: ""}}},
+				i18n.ArchiveMonthNovember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "November", Arg: ""}}},
+				i18n.ArchiveMonthOctober:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Oktober", Arg: ""}}},
+				i18n.ArchiveMonthSeptember:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "September", Arg: ""}}},
+				i18n.ArchiveSubtitle:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notizen nach Monat", Arg: ""}}},
+				i18n.ArchiveTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Archiv", Arg: ""}}},
 				i18n.ChannelAll:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Alle", Arg: ""}}},
 				i18n.ChannelAny:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Alle", Arg: ""}}},
+				i18n.ChannelArchive:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "Archiv", Arg: ""}}},
 				i18n.ChannelMicroTales:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Mikro-Geschichten", Arg: ""}}},
 				i18n.ChannelSectionAuthors:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "autorinnen und autoren", Arg: ""}}},
 				i18n.ChannelSectionChannels:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "kanäle", Arg: ""}}},
 				i18n.ChannelSectionNoteType:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "notiztyp", Arg: ""}}},
+				i18n.ChannelSectionPopular:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "diese woche beliebt", Arg: ""}}},
 				i18n.ChannelSectionTags:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "tags", Arg: ""}}},
+				i18n.ChannelTags:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tags", Arg: ""}}},
 				i18n.ChannelTales:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Geschichten", Arg: ""}}},
 				i18n.ChannelsPageBack:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Zurück zum Feed", Arg: ""}}},
 				i18n.ChannelsPageHint:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Nutze die linke Kanalliste zur Navigation.", Arg: ""}}},
+				i18n.ChannelsPageOpml:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Alle Feeds als OPML", Arg: ""}}},
 				i18n.ChannelsPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Kanäle", Arg: ""}}},
+				i18n.CommentsEmpty:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Noch keine Kommentare.", Arg: ""}}},
+				i18n.CommentsErrorInvalid:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Dieser Wert ist ungültig.", Arg: ""}}},
+				i18n.CommentsErrorRequired:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Dieses Feld ist erforderlich.", Arg: ""}}},
+				i18n.CommentsErrorTooLong:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Dieser Wert ist zu lang.", Arg: ""}}},
+				i18n.CommentsFormBackToNote:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Zurück zur Notiz", Arg: ""}}},
+				i18n.CommentsFormBody:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Kommentar", Arg: ""}}},
+				i18n.CommentsFormEmail:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "E-Mail (optional, wird nie angezeigt)", Arg: ""}}},
+				i18n.CommentsFormHeading:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Kommentar schreiben", Arg: ""}}},
+				i18n.CommentsFormModeration:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Kommentare werden vor der Veröffentlichung geprüft.", Arg: ""}}},
+				i18n.CommentsFormName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Name", Arg: ""}}},
+				i18n.CommentsFormPageTitle:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Kommentar prüfen", Arg: ""}}},
+				i18n.CommentsFormSubmit:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Kommentar senden", Arg: ""}}},
+				i18n.CommentsFormWebsite:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Dieses Feld leer lassen", Arg: ""}}},
+				i18n.CommentsHeading:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Kommentare", Arg: ""}}},
+				i18n.CommentsLoadMore:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Weitere Kommentare laden", Arg: ""}}},
+				i18n.CommentsNoticeApproved:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Danke! Dein Kommentar ist veröffentlicht.", Arg: ""}}},
+				i18n.CommentsNoticePending:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Danke! Dein Kommentar wartet auf Freigabe.", Arg: ""}}},
+				i18n.CommentsNoticeRejected:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Dein Kommentar wurde nicht angenommen.", Arg: ""}}},
+				i18n.CommentsUnavailable:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Kommentare können gerade nicht geladen werden.", Arg: ""}}},
 				i18n.ComposerReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Du hast keine Berechtigung, in diesem Kanal Nachrichten zu senden. Er ist NUR LESEN! :)", Arg: ""}}},
+				i18n.ContextAuthorLinks:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Profile des Autors", Arg: ""}}},
+				i18n.ContextAuthorWebsite:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Website", Arg: ""}}},
 				i18n.ContextFeed:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "feed", Arg: ""}}},
 				i18n.ContextLongDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "ausführliche Notizen", Arg: ""}}},
 				i18n.ContextShortDescription:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "kurze Notizen", Arg: ""}}},
@@ -138,6 +259,10 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutFooterOpensourceLink:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Quellcode auf GitHub ansehen", Arg: ""}}},
 				i18n.LayoutFooterOpensourcePrefix:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Der Code dieses Projekts wird öffentlich entwickelt:", Arg: ""}}},
 				i18n.LayoutFooterStackPrefix:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Stack:", Arg: ""}}},
+				i18n.LayoutFooterThemeDark:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Dunkel", Arg: ""}}},
+				i18n.LayoutFooterThemeLight:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Hell", Arg: ""}}},
+				i18n.LayoutFooterThemeSwitch:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Farbschema:", Arg: ""}}},
+				i18n.LayoutFooterThemeSystem:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "System", Arg: ""}}},
 				i18n.LayoutGuildBlog:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Blog", Arg: ""}}},
 				i18n.LayoutGuildOnline:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "online", Arg: ""}}},
 				i18n.LayoutGuildServer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Server", Arg: ""}}},
@@ -148,20 +273,40 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutTitleMicroTales:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Mikro-Geschichten", Arg: ""}}},
 				i18n.LayoutTitleNotes:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notizen", Arg: ""}}},
 				i18n.LayoutTitleTales:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Geschichten", Arg: ""}}},
+				i18n.MaintenanceKicker:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "fehler / 503", Arg: ""}}},
+				i18n.MaintenancePageTitle:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "503 Wartungsarbeiten", Arg: ""}}},
+				i18n.MaintenanceSummary:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Der Blog wird gerade aktualisiert. Bitte versuche es in ein paar Minuten erneut.", Arg: ""}}},
+				i18n.MaintenanceTitle:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Gleich wieder da", Arg: ""}}},
 				i18n.MarkdownCodeCopied:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "kopiert", Arg: ""}}},
 				i18n.MarkdownCodeCopy:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "kopieren", Arg: ""}}},
 				i18n.MarkdownCodePlainText:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Klartext", Arg: ""}}},
 				i18n.MarkdownPlaceholderCodeBlock:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "[codeblock]", Arg: ""}}},
 				i18n.MarkdownPlaceholderImage:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[bild]", Arg: ""}}},
 				i18n.MarkdownPlaceholderTable:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[tabelle]", Arg: ""}}},
+				i18n.NoteAdjacentLabel:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Benachbarte Notizen", Arg: ""}}},
+				i18n.NoteAdjacentNewer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "neuer", Arg: ""}}},
+				i18n.NoteAdjacentOlder:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "älter", Arg: ""}}},
 				i18n.NoteAttachmentLabelPrefix:     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Anhang", Arg: ""}}},
 				i18n.NoteBack:                      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Zurück zu den Notizen", Arg: ""}}},
+				i18n.NoteCoauthorsHeading:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Gemeinsam geschrieben mit", Arg: ""}}},
+				i18n.NoteCoauthorsNotes:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Alle Notizen dieser Autoren", Arg: ""}}},
+				i18n.NoteDraftPreview:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Entwurfsvorschau – diese Version ist noch nicht veröffentlicht.", Arg: ""}}},
 				i18n.NoteFeaturedAttachment:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "hervorgehobener Anhang", Arg: ""}}},
 				i18n.NoteOpenFull:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Vollständige Notiz öffnen", Arg: ""}}},
 				i18n.NotePublishedPrefix:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "veröffentlicht", Arg: ""}}},
+				i18n.NoteReadingTime:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Minutes"}, {Text: " Min. Lesezeit", Arg: ""}}},
+				i18n.NoteRelatedHeading:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Weiterlesen", Arg: ""}}},
 				i18n.NoteTitleFallback:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notiz", Arg: ""}}},
 				i18n.NoteUnknownAuthor:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "unbekannter Autor", Arg: ""}}},
+				i18n.NoteDiffDraft:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Neuester Entwurf", Arg: ""}}},
+				i18n.NoteDiffNotPublished:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Diese Notiz wurde noch nicht veröffentlicht.", Arg: ""}}},
+				i18n.NoteDiffOpenPreview:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Signierten Vorschaulink öffnen", Arg: ""}}},
+				i18n.NoteDiffOpenPublished:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Veröffentlichte Notiz öffnen", Arg: ""}}},
+				i18n.NoteDiffPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Entwurfsprüfung", Arg: ""}}},
+				i18n.NoteDiffPublished:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Veröffentlicht", Arg: ""}}},
+				i18n.NoteDiffReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "schreibgeschützte Entwurfsvorschau", Arg: ""}}},
 				i18n.NotesAriaFeed:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notiz-Feed", Arg: ""}}},
+				i18n.NotesFeaturedTitle:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Angeheftet", Arg: ""}}},
 				i18n.NotfoundBack:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Zurück zu den Notizen", Arg: ""}}},
 				i18n.NotfoundKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "fehler / 404", Arg: ""}}},
 				i18n.NotfoundOpenChannels:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Kanäle öffnen", Arg: ""}}},
@@ -174,6 +319,8 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.PagerNext:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "nächste", Arg: ""}}},
 				i18n.PagerPage:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Seite", Arg: ""}}},
 				i18n.PagerPrev:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "vorherige", Arg: ""}}},
+				i18n.SeoArchiveDescription:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Alle veröffentlichten Notizen nach Jahr und Monat durchsuchen.", Arg: ""}}},
+				i18n.SeoArchiveMonthDescription:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Im ", Arg: ""}, {Text: "", Arg: "Period"}, {Text: " veröffentlichte Notizen.", Arg: ""}}},
 				i18n.SeoAuthorDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes by ", Arg: ""}, {Text: "", Arg: "Author"}, {Text: ".", Arg: ""}}},
 				i18n.SeoChannelsDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse available channels and filters for the blog feed.", Arg: ""}}},
 				i18n.SeoMicroTalesDescription:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read short-form micro-tales from the blog feed.", Arg: ""}}},
@@ -183,24 +330,78 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.SeoPublisherName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoRootDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tauchen Sie ein in prägnante Notizen, vollgepackt mit umsetzbaren Tipps zu Kodierung, Webperformance, SEO, KI-Workflows, Buchzusammenfassungen und mehr - regelmäßig aktualisiert auf RevoTale.", Arg: ""}}},
 				i18n.SeoRootTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notizen - Kodierung, Erfahrung, Open Source, SEO & wissenschaftliche Erkenntnisse", Arg: ""}}},
+				i18n.SeoSeriesDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Alle Teile der Serie „", Arg: ""}, {Text: "", Arg: "Series"}, {Text: "“ in Reihenfolge.", Arg: ""}}},
 				i18n.SeoSiteDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "A multilingual note feed with tales and micro-tales.", Arg: ""}}},
 				i18n.SeoSiteName:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoTagDescription:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes tagged ", Arg: ""}, {Text: "", Arg: "Tag"}, {Text: ".", Arg: ""}}},
+				i18n.SeoTagsDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Alle Tags des Blogs mit der Anzahl veröffentlichter Notizen.", Arg: ""}}},
 				i18n.SeoTalesDescription:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read long-form tales from the blog feed.", Arg: ""}}},
+				i18n.SeriesLabel:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Serie", Arg: ""}}},
+				i18n.SeriesNext:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "nächster Teil", Arg: ""}}},
+				i18n.SeriesPart:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Teil ", Arg: ""}, {Text: "", Arg: "Part"}, {Text: " von ", Arg: ""}, {Text: "", Arg: "Total"}}},
+				i18n.SeriesPrevious:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "vorheriger Teil", Arg: ""}}},
+				i18n.TagsEmpty:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Noch keine Tags.", Arg: ""}}},
+				i18n.TagsSubtitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Alle Themen mit Anzahl der Notizen", Arg: ""}}},
+				i18n.TagsTitle:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tags", Arg: ""}}},
+				i18n.UpstreamKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "fehler / 502", Arg: ""}}},
+				i18n.UpstreamPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "502 CMS nicht erreichbar", Arg: ""}}},
+				i18n.UpstreamSummary:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Die Notizen konnten nicht vom Content-Server geladen werden. Bitte versuche es gleich noch einmal.", Arg: ""}}},
+				i18n.UpstreamTitle:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "CMS vorübergehend nicht erreichbar", Arg: ""}}},
 			},
 			"en": {
+				i18n.ArchiveBack:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "All months", Arg: ""}}},
+				i18n.ArchiveEmpty:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "No published notes yet.", Arg: ""}}},
+				i18n.ArchiveMonthApril:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "April", Arg: ""}}},
+				i18n.ArchiveMonthAugust:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "August", Arg: ""}}},
+				i18n.ArchiveMonthDecember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "December", Arg: ""}}},
+				i18n.ArchiveMonthFebruary:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "February", Arg: ""}}},
+				i18n.ArchiveMonthJanuary:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "January", Arg: ""}}},
+				i18n.ArchiveMonthJuly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "July", Arg: ""}}},
+				i18n.ArchiveMonthJune:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "June", Arg: ""}}},
+				i18n.ArchiveMonthMarch:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "March", Arg: ""}}},
+				i18n.ArchiveMonthMay:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "May", Arg: ""}}},
+				i18n.ArchiveMonthNovember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "November", Arg: ""}}},
+				i18n.ArchiveMonthOctober:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "October", Arg: ""}}},
+				i18n.ArchiveMonthSeptember:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "September", Arg: ""}}},
+				i18n.ArchiveSubtitle:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notes by month", Arg: ""}}},
+				i18n.ArchiveTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Archive", Arg: ""}}},
 				i18n.ChannelAll:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "All", Arg: ""}}},
 				i18n.ChannelAny:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "All", Arg: ""}}},
+				i18n.ChannelArchive:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "Archive", Arg: ""}}},
 				i18n.ChannelMicroTales:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Micro-tales", Arg: ""}}},
 				i18n.ChannelSectionAuthors:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "authors", Arg: ""}}},
 				i18n.ChannelSectionChannels:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "channels", Arg: ""}}},
 				i18n.ChannelSectionNoteType:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "note type", Arg: ""}}},
+				i18n.ChannelSectionPopular:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "popular this week", Arg: ""}}},
 				i18n.ChannelSectionTags:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "tags", Arg: ""}}},
+				i18n.ChannelTags:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tags", Arg: ""}}},
 				i18n.ChannelTales:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tales", Arg: ""}}},
 				i18n.ChannelsPageBack:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Back to feed", Arg: ""}}},
 				i18n.ChannelsPageHint:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Use the left channel list to navigate.", Arg: ""}}},
+				i18n.ChannelsPageOpml:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "All feeds as OPML", Arg: ""}}},
 				i18n.ChannelsPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Channels", Arg: ""}}},
+				i18n.CommentsEmpty:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "No comments yet.", Arg: ""}}},
+				i18n.CommentsErrorInvalid:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "This value is not valid.", Arg: ""}}},
+				i18n.CommentsErrorRequired:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "This field is required.", Arg: ""}}},
+				i18n.CommentsErrorTooLong:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "This value is too long.", Arg: ""}}},
+				i18n.CommentsFormBackToNote:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Back to the note", Arg: ""}}},
+				i18n.CommentsFormBody:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Comment", Arg: ""}}},
+				i18n.CommentsFormEmail:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Email (optional, never shown)", Arg: ""}}},
+				i18n.CommentsFormHeading:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Leave a comment", Arg: ""}}},
+				i18n.CommentsFormModeration:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Comments are reviewed before they appear.", Arg: ""}}},
+				i18n.CommentsFormName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Name", Arg: ""}}},
+				i18n.CommentsFormPageTitle:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Check your comment", Arg: ""}}},
+				i18n.CommentsFormSubmit:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Post comment", Arg: ""}}},
+				i18n.CommentsFormWebsite:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Leave this field empty", Arg: ""}}},
+				i18n.CommentsHeading:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Comments", Arg: ""}}},
+				i18n.CommentsLoadMore:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Load more comments", Arg: ""}}},
+				i18n.CommentsNoticeApproved:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Thanks! Your comment is published.", Arg: ""}}},
+				i18n.CommentsNoticePending:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Thanks! Your comment is awaiting moderation.", Arg: ""}}},
+				i18n.CommentsNoticeRejected:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Your comment was not accepted.", Arg: ""}}},
+				i18n.CommentsUnavailable:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Comments can't be loaded right now.", Arg: ""}}},
 				i18n.ComposerReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "You do not have permission to send messages in this channel. It is READ-only! :)", Arg: ""}}},
+				i18n.ContextAuthorLinks:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Author profiles", Arg: ""}}},
+				i18n.ContextAuthorWebsite:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Website", Arg: ""}}},
 				i18n.ContextFeed:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "feed", Arg: ""}}},
 				i18n.ContextLongDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "long-form notes", Arg: ""}}},
 				i18n.ContextShortDescription:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "short notes", Arg: ""}}},
@@ -225,6 +426,10 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutFooterOpensourceLink:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse the source on GitHub", Arg: ""}}},
 				i18n.LayoutFooterOpensourcePrefix:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "the code of this project is developed publicly:", Arg: ""}}},
 				i18n.LayoutFooterStackPrefix:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "stack:", Arg: ""}}},
+				i18n.LayoutFooterThemeDark:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Dark", Arg: ""}}},
+				i18n.LayoutFooterThemeLight:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Light", Arg: ""}}},
+				i18n.LayoutFooterThemeSwitch:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Color theme:", Arg: ""}}},
+				i18n.LayoutFooterThemeSystem:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "System", Arg: ""}}},
 				i18n.LayoutGuildBlog:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Blog", Arg: ""}}},
 				i18n.LayoutGuildOnline:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "online", Arg: ""}}},
 				i18n.LayoutGuildServer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Server", Arg: ""}}},
@@ -235,20 +440,40 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutTitleMicroTales:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Micro-tales", Arg: ""}}},
 				i18n.LayoutTitleNotes:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notes", Arg: ""}}},
 				i18n.LayoutTitleTales:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tales", Arg: ""}}},
+				i18n.MaintenanceKicker:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "error / 503", Arg: ""}}},
+				i18n.MaintenancePageTitle:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "503 Under maintenance", Arg: ""}}},
+				i18n.MaintenanceSummary:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "The blog is being updated. Please try again in a few minutes.", Arg: ""}}},
+				i18n.MaintenanceTitle:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Back shortly", Arg: ""}}},
 				i18n.MarkdownCodeCopied:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "copied", Arg: ""}}},
 				i18n.MarkdownCodeCopy:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "copy", Arg: ""}}},
 				i18n.MarkdownCodePlainText:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "plain text", Arg: ""}}},
 				i18n.MarkdownPlaceholderCodeBlock:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "[code block]", Arg: ""}}},
 				i18n.MarkdownPlaceholderImage:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[image]", Arg: ""}}},
 				i18n.MarkdownPlaceholderTable:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[table]", Arg: ""}}},
+				i18n.NoteAdjacentLabel:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Adjacent notes", Arg: ""}}},
+				i18n.NoteAdjacentNewer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "newer", Arg: ""}}},
+				i18n.NoteAdjacentOlder:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "older", Arg: ""}}},
 				i18n.NoteAttachmentLabelPrefix:     {Parts: []frameworki18n.CompiledMessagePart{{Text: "attachment", Arg: ""}}},
 				i18n.NoteBack:                      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Back to notes", Arg: ""}}},
+				i18n.NoteCoauthorsHeading:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Also written with", Arg: ""}}},
+				i18n.NoteCoauthorsNotes:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "All notes by these authors", Arg: ""}}},
+				i18n.NoteDraftPreview:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Draft preview — this version is not published yet.", Arg: ""}}},
 				i18n.NoteFeaturedAttachment:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "featured attachment", Arg: ""}}},
 				i18n.NoteOpenFull:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Open full note", Arg: ""}}},
 				i18n.NotePublishedPrefix:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "published", Arg: ""}}},
+				i18n.NoteReadingTime:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Minutes"}, {Text: " min read", Arg: ""}}},
+				i18n.NoteRelatedHeading:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read next", Arg: ""}}},
 				i18n.NoteTitleFallback:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Note", Arg: ""}}},
 				i18n.NoteUnknownAuthor:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "unknown author", Arg: ""}}},
+				i18n.NoteDiffDraft:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Latest draft", Arg: ""}}},
+				i18n.NoteDiffNotPublished:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "This note has not been published yet.", Arg: ""}}},
+				i18n.NoteDiffOpenPreview:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Open signed preview link", Arg: ""}}},
+				i18n.NoteDiffOpenPublished:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Open published note", Arg: ""}}},
+				i18n.NoteDiffPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Draft review", Arg: ""}}},
+				i18n.NoteDiffPublished:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Published", Arg: ""}}},
+				i18n.NoteDiffReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "read-only draft preview", Arg: ""}}},
 				i18n.NotesAriaFeed:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "notes feed", Arg: ""}}},
+				i18n.NotesFeaturedTitle:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Pinned", Arg: ""}}},
 				i18n.NotfoundBack:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Back to notes", Arg: ""}}},
 				i18n.NotfoundKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "error / 404", Arg: ""}}},
 				i18n.NotfoundOpenChannels:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Open channels", Arg: ""}}},
@@ -261,6 +486,8 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.PagerNext:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "next", Arg: ""}}},
 				i18n.PagerPage:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "page", Arg: ""}}},
 				i18n.PagerPrev:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "prev", Arg: ""}}},
+				i18n.SeoArchiveDescription:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse every published note by year and month.", Arg: ""}}},
+				i18n.SeoArchiveMonthDescription:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notes published in ", Arg: ""}, {Text: "", Arg: "Period"}, {Text: ".", Arg: ""}}},
 				i18n.SeoAuthorDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes by ", Arg: ""}, {Text: "", Arg: "Author"}, {Text: ".", Arg: ""}}},
 				i18n.SeoChannelsDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse available channels and filters for the blog feed.", Arg: ""}}},
 				i18n.SeoMicroTalesDescription:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read short-form micro-tales from the blog feed.", Arg: ""}}},
@@ -270,24 +497,78 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.SeoPublisherName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoRootDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Dive into concise notes packed with actionable tips on coding, web-performance, SEO, AI workflows, book takeaways and more—updated regularly on RevoTale.", Arg: ""}}},
 				i18n.SeoRootTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notes - Quick Coding, Experience, Open Source, SEO & Science Insights", Arg: ""}}},
+				i18n.SeoSeriesDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Every part of the series “", Arg: ""}, {Text: "", Arg: "Series"}, {Text: "”, in order.", Arg: ""}}},
 				i18n.SeoSiteDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "A multilingual note feed with tales and micro-tales.", Arg: ""}}},
 				i18n.SeoSiteName:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoTagDescription:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes tagged ", Arg: ""}, {Text: "", Arg: "Tag"}, {Text: ".", Arg: ""}}},
+				i18n.SeoTagsDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "All blog tags with the number of published notes.", Arg: ""}}},
 				i18n.SeoTalesDescription:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read long-form tales from the blog feed.", Arg: ""}}},
+				i18n.SeriesLabel:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Series", Arg: ""}}},
+				i18n.SeriesNext:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "next part", Arg: ""}}},
+				i18n.SeriesPart:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Part ", Arg: ""}, {Text: "", Arg: "Part"}, {Text: " of ", Arg: ""}, {Text: "", Arg: "Total"}}},
+				i18n.SeriesPrevious:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "previous part", Arg: ""}}},
+				i18n.TagsEmpty:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "No tags yet.", Arg: ""}}},
+				i18n.TagsSubtitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Every topic with its note count", Arg: ""}}},
+				i18n.TagsTitle:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tags", Arg: ""}}},
+				i18n.UpstreamKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "error / 502", Arg: ""}}},
+				i18n.UpstreamPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "502 CMS unavailable", Arg: ""}}},
+				i18n.UpstreamSummary:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "The notes could not be loaded from the content server. Please try again shortly.", Arg: ""}}},
+				i18n.UpstreamTitle:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "CMS temporarily unavailable", Arg: ""}}},
 			},
 			"es": {
+				i18n.ArchiveBack:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Todos los meses", Arg: ""}}},
+				i18n.ArchiveEmpty:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Todavía no hay notas publicadas.", Arg: ""}}},
+				i18n.ArchiveMonthApril:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Abril", Arg: ""}}},
+				i18n.ArchiveMonthAugust:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Agosto", Arg: ""}}},
+				i18n.ArchiveMonthDecember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Diciembre", Arg: ""}}},
+				i18n.ArchiveMonthFebruary:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Febrero", Arg: ""}}},
+				i18n.ArchiveMonthJanuary:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Enero", Arg: ""}}},
+				i18n.ArchiveMonthJuly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Julio", Arg: ""}}},
+				i18n.ArchiveMonthJune:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Junio", Arg: ""}}},
+				i18n.ArchiveMonthMarch:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Marzo", Arg: ""}}},
+				i18n.ArchiveMonthMay:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Mayo", Arg: ""}}},
+				i18n.ArchiveMonthNovember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Noviembre", Arg: ""}}},
+				i18n.ArchiveMonthOctober:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Octubre", Arg: ""}}},
+				i18n.ArchiveMonthSeptember:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Septiembre", Arg: ""}}},
+				i18n.ArchiveSubtitle:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notas por mes", Arg: ""}}},
+				i18n.ArchiveTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Archivo", Arg: ""}}},
 				i18n.ChannelAll:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Todo", Arg: ""}}},
 				i18n.ChannelAny:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Todo", Arg: ""}}},
+				i18n.ChannelArchive:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "Archivo", Arg: ""}}},
 				i18n.ChannelMicroTales:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Microrrelatos", Arg: ""}}},
 				i18n.ChannelSectionAuthors:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "autores", Arg: ""}}},
 				i18n.ChannelSectionChannels:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "canales", Arg: ""}}},
 				i18n.ChannelSectionNoteType:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "tipo de nota", Arg: ""}}},
+				i18n.ChannelSectionPopular:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "populares esta semana", Arg: ""}}},
 				i18n.ChannelSectionTags:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "etiquetas", Arg: ""}}},
+				i18n.ChannelTags:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Etiquetas", Arg: ""}}},
 				i18n.ChannelTales:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Relatos", Arg: ""}}},
 				i18n.ChannelsPageBack:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Volver al feed", Arg: ""}}},
 				i18n.ChannelsPageHint:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Usa la lista de canales de la izquierda para navegar.", Arg: ""}}},
+				i18n.ChannelsPageOpml:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Todos los feeds en OPML", Arg: ""}}},
 				i18n.ChannelsPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Canales", Arg: ""}}},
+				i18n.CommentsEmpty:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Aún no hay comentarios.", Arg: ""}}},
+				i18n.CommentsErrorInvalid:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Este valor no es válido.", Arg: ""}}},
+				i18n.CommentsErrorRequired:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Este campo es obligatorio.", Arg: ""}}},
+				i18n.CommentsErrorTooLong:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Este valor es demasiado largo.", Arg: ""}}},
+				i18n.CommentsFormBackToNote:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Volver a la nota", Arg: ""}}},
+				i18n.CommentsFormBody:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Comentario", Arg: ""}}},
+				i18n.CommentsFormEmail:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Correo (opcional, nunca se muestra)", Arg: ""}}},
+				i18n.CommentsFormHeading:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Deja un comentario", Arg: ""}}},
+				i18n.CommentsFormModeration:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Los comentarios se revisan antes de publicarse.", Arg: ""}}},
+				i18n.CommentsFormName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Nombre", Arg: ""}}},
+				i18n.CommentsFormPageTitle:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Revisa tu comentario", Arg: ""}}},
+				i18n.CommentsFormSubmit:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Publicar comentario", Arg: ""}}},
+				i18n.CommentsFormWebsite:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Deja este campo vacío", Arg: ""}}},
+				i18n.CommentsHeading:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Comentarios", Arg: ""}}},
+				i18n.CommentsLoadMore:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Cargar más comentarios", Arg: ""}}},
+				i18n.CommentsNoticeApproved:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "¡Gracias! Tu comentario está publicado.", Arg: ""}}},
+				i18n.CommentsNoticePending:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "¡Gracias! Tu comentario está pendiente de moderación.", Arg: ""}}},
+				i18n.CommentsNoticeRejected:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tu comentario no fue aceptado.", Arg: ""}}},
+				i18n.CommentsUnavailable:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Ahora mismo no se pueden cargar los comentarios.", Arg: ""}}},
 				i18n.ComposerReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "No tienes permiso para enviar mensajes en este canal. ¡Es solo de LECTURA! :)", Arg: ""}}},
+				i18n.ContextAuthorLinks:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Perfiles del autor", Arg: ""}}},
+				i18n.ContextAuthorWebsite:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Sitio web", Arg: ""}}},
 				i18n.ContextFeed:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "feed", Arg: ""}}},
 				i18n.ContextLongDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "notas largas", Arg: ""}}},
 				i18n.ContextShortDescription:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "notas cortas", Arg: ""}}},
@@ -312,6 +593,10 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutFooterOpensourceLink:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Ver el código fuente en GitHub", Arg: ""}}},
 				i18n.LayoutFooterOpensourcePrefix:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "el código de este proyecto se desarrolla públicamente:", Arg: ""}}},
 				i18n.LayoutFooterStackPrefix:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "stack:", Arg: ""}}},
+				i18n.LayoutFooterThemeDark:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Oscuro", Arg: ""}}},
+				i18n.LayoutFooterThemeLight:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Claro", Arg: ""}}},
+				i18n.LayoutFooterThemeSwitch:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tema de color:", Arg: ""}}},
+				i18n.LayoutFooterThemeSystem:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Sistema", Arg: ""}}},
 				i18n.LayoutGuildBlog:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Blog", Arg: ""}}},
 				i18n.LayoutGuildOnline:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "en línea", Arg: ""}}},
 				i18n.LayoutGuildServer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Servidor", Arg: ""}}},
@@ -322,20 +607,40 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutTitleMicroTales:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Microrrelatos", Arg: ""}}},
 				i18n.LayoutTitleNotes:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notas", Arg: ""}}},
 				i18n.LayoutTitleTales:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Relatos", Arg: ""}}},
+				i18n.MaintenanceKicker:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "error / 503", Arg: ""}}},
+				i18n.MaintenancePageTitle:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "503 En mantenimiento", Arg: ""}}},
+				i18n.MaintenanceSummary:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "El blog se está actualizando. Vuelve a intentarlo en unos minutos.", Arg: ""}}},
+				i18n.MaintenanceTitle:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Volvemos enseguida", Arg: ""}}},
 				i18n.MarkdownCodeCopied:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "copiado", Arg: ""}}},
 				i18n.MarkdownCodeCopy:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "copiar", Arg: ""}}},
 				i18n.MarkdownCodePlainText:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "texto plano", Arg: ""}}},
 				i18n.MarkdownPlaceholderCodeBlock:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "[bloque de código]", Arg: ""}}},
 				i18n.MarkdownPlaceholderImage:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[imagen]", Arg: ""}}},
 				i18n.MarkdownPlaceholderTable:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[tabla]", Arg: ""}}},
+				i18n.NoteAdjacentLabel:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notas adyacentes", Arg: ""}}},
+				i18n.NoteAdjacentNewer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "más reciente", Arg: ""}}},
+				i18n.NoteAdjacentOlder:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "más antigua", Arg: ""}}},
 				i18n.NoteAttachmentLabelPrefix:     {Parts: []frameworki18n.CompiledMessagePart{{Text: "adjunto", Arg: ""}}},
 				i18n.NoteBack:                      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Volver a notas", Arg: ""}}},
+				i18n.NoteCoauthorsHeading:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "También escrito con", Arg: ""}}},
+				i18n.NoteCoauthorsNotes:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Todas las notas de estos autores", Arg: ""}}},
+				i18n.NoteDraftPreview:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Vista previa del borrador: esta versión aún no está publicada.", Arg: ""}}},
 				i18n.NoteFeaturedAttachment:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "adjunto destacado", Arg: ""}}},
 				i18n.NoteOpenFull:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Abrir nota completa", Arg: ""}}},
 				i18n.NotePublishedPrefix:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "publicado", Arg: ""}}},
+				i18n.NoteReadingTime:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Minutes"}, {Text: " min de lectura", Arg: ""}}},
+				i18n.NoteRelatedHeading:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Seguir leyendo", Arg: ""}}},
 				i18n.NoteTitleFallback:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Nota", Arg: ""}}},
 				i18n.NoteUnknownAuthor:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "autor desconocido", Arg: ""}}},
+				i18n.NoteDiffDraft:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Último borrador", Arg: ""}}},
+				i18n.NoteDiffNotPublished:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Esta nota aún no se ha publicado.", Arg: ""}}},
+				i18n.NoteDiffOpenPreview:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Abrir enlace de vista previa firmado", Arg: ""}}},
+				i18n.NoteDiffOpenPublished:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Abrir nota publicada", Arg: ""}}},
+				i18n.NoteDiffPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Revisión del borrador", Arg: ""}}},
+				i18n.NoteDiffPublished:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Publicado", Arg: ""}}},
+				i18n.NoteDiffReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "vista previa del borrador de solo lectura", Arg: ""}}},
 				i18n.NotesAriaFeed:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "feed de notas", Arg: ""}}},
+				i18n.NotesFeaturedTitle:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Fijadas", Arg: ""}}},
 				i18n.NotfoundBack:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Volver a notas", Arg: ""}}},
 				i18n.NotfoundKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "error / 404", Arg: ""}}},
 				i18n.NotfoundOpenChannels:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Abrir canales", Arg: ""}}},
@@ -348,6 +653,8 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.PagerNext:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "siguiente", Arg: ""}}},
 				i18n.PagerPage:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "página", Arg: ""}}},
 				i18n.PagerPrev:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "anterior", Arg: ""}}},
+				i18n.SeoArchiveDescription:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Explora todas las notas publicadas por año y mes.", Arg: ""}}},
+				i18n.SeoArchiveMonthDescription:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notas publicadas en ", Arg: ""}, {Text: "", Arg: "Period"}, {Text: ".", Arg: ""}}},
 				i18n.SeoAuthorDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes by ", Arg: ""}, {Text: "", Arg: "Author"}, {Text: ".", Arg: ""}}},
 				i18n.SeoChannelsDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse available channels and filters for the blog feed.", Arg: ""}}},
 				i18n.SeoMicroTalesDescription:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read short-form micro-tales from the blog feed.", Arg: ""}}},
@@ -357,24 +664,78 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.SeoPublisherName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoRootDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Sumérgete en notas concisas llenas de consejos prácticos sobre codificación, rendimiento web, SEO, flujos de trabajo de IA, resúmenes de libros y más—actualizados regularmente en RevoTale.", Arg: ""}}},
 				i18n.SeoRootTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notas - Codificación, Experiencia, Código Abierto, SEO y Conocimientos Científicos", Arg: ""}}},
+				i18n.SeoSeriesDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Todas las partes de la serie «", Arg: ""}, {Text: "", Arg: "Series"}, {Text: "», en orden.", Arg: ""}}},
 				i18n.SeoSiteDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "A multilingual note feed with tales and micro-tales.", Arg: ""}}},
 				i18n.SeoSiteName:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoTagDescription:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes tagged ", Arg: ""}, {Text: "", Arg: "Tag"}, {Text: ".", Arg: ""}}},
+				i18n.SeoTagsDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Todas las etiquetas del blog con el número de notas publicadas.", Arg: ""}}},
 				i18n.SeoTalesDescription:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read long-form tales from the blog feed.", Arg: ""}}},
+				i18n.SeriesLabel:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Serie", Arg: ""}}},
+				i18n.SeriesNext:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "parte siguiente", Arg: ""}}},
+				i18n.SeriesPart:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Parte ", Arg: ""}, {Text: "", Arg: "Part"}, {Text: " de ", Arg: ""}, {Text: "", Arg: "Total"}}},
+				i18n.SeriesPrevious:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "parte anterior", Arg: ""}}},
+				i18n.TagsEmpty:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Aún no hay etiquetas.", Arg: ""}}},
+				i18n.TagsSubtitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Todos los temas con su número de notas", Arg: ""}}},
+				i18n.TagsTitle:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Etiquetas", Arg: ""}}},
+				i18n.UpstreamKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "error / 502", Arg: ""}}},
+				i18n.UpstreamPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "502 CMS no disponible", Arg: ""}}},
+				i18n.UpstreamSummary:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "No se pudieron cargar las notas desde el servidor de contenido. Vuelve a intentarlo en breve.", Arg: ""}}},
+				i18n.UpstreamTitle:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "CMS no disponible temporalmente", Arg: ""}}},
 			},
 			"fr": {
+				i18n.ArchiveBack:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tous les mois", Arg: ""}}},
+				i18n.ArchiveEmpty:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Aucune note publiée pour le moment.", Arg: ""}}},
+				i18n.ArchiveMonthApril:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Avril", Arg: ""}}},
+				i18n.ArchiveMonthAugust:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Août", Arg: ""}}},
+				i18n.ArchiveMonthDecember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Décembre", Arg: ""}}},
+				i18n.ArchiveMonthFebruary:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Février", Arg: ""}}},
+				i18n.ArchiveMonthJanuary:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Janvier", Arg: ""}}},
+				i18n.ArchiveMonthJuly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Juillet", Arg: ""}}},
+				i18n.ArchiveMonthJune:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Juin", Arg: ""}}},
+				i18n.ArchiveMonthMarch:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Mars", Arg: ""}}},
+				i18n.ArchiveMonthMay:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Mai", Arg: ""}}},
+				i18n.ArchiveMonthNovember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Novembre", Arg: ""}}},
+				i18n.ArchiveMonthOctober:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Octobre", Arg: ""}}},
+				i18n.ArchiveMonthSeptember:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Septembre", Arg: ""}}},
+				i18n.ArchiveSubtitle:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notes par mois", Arg: ""}}},
+				i18n.ArchiveTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Archives", Arg: ""}}},
 				i18n.ChannelAll:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tout", Arg: ""}}},
 				i18n.ChannelAny:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tout", Arg: ""}}},
+				i18n.ChannelArchive:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "Archives", Arg: ""}}},
 				i18n.ChannelMicroTales:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Micro-contes", Arg: ""}}},
 				i18n.ChannelSectionAuthors:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "auteurs", Arg: ""}}},
 				i18n.ChannelSectionChannels:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "canaux", Arg: ""}}},
 				i18n.ChannelSectionNoteType:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "type de note", Arg: ""}}},
+				i18n.ChannelSectionPopular:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "populaires cette semaine", Arg: ""}}},
 				i18n.ChannelSectionTags:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "tags", Arg: ""}}},
+				i18n.ChannelTags:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tags", Arg: ""}}},
 				i18n.ChannelTales:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Contes", Arg: ""}}},
 				i18n.ChannelsPageBack:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Retour au flux", Arg: ""}}},
 				i18n.ChannelsPageHint:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Utilisez la liste des canaux à gauche pour naviguer.", Arg: ""}}},
+				i18n.ChannelsPageOpml:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tous les flux en OPML", Arg: ""}}},
 				i18n.ChannelsPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Canaux", Arg: ""}}},
+				i18n.CommentsEmpty:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Pas encore de commentaires.", Arg: ""}}},
+				i18n.CommentsErrorInvalid:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Cette valeur n'est pas valide.", Arg: ""}}},
+				i18n.CommentsErrorRequired:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Ce champ est obligatoire.", Arg: ""}}},
+				i18n.CommentsErrorTooLong:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Cette valeur est trop longue.", Arg: ""}}},
+				i18n.CommentsFormBackToNote:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Retour à la note", Arg: ""}}},
+				i18n.CommentsFormBody:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Commentaire", Arg: ""}}},
+				i18n.CommentsFormEmail:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "E-mail (facultatif, jamais affiché)", Arg: ""}}},
+				i18n.CommentsFormHeading:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Laisser un commentaire", Arg: ""}}},
+				i18n.CommentsFormModeration:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Les commentaires sont relus avant publication.", Arg: ""}}},
+				i18n.CommentsFormName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Nom", Arg: ""}}},
+				i18n.CommentsFormPageTitle:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Vérifiez votre commentaire", Arg: ""}}},
+				i18n.CommentsFormSubmit:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Publier le commentaire", Arg: ""}}},
+				i18n.CommentsFormWebsite:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Laissez ce champ vide", Arg: ""}}},
+				i18n.CommentsHeading:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Commentaires", Arg: ""}}},
+				i18n.CommentsLoadMore:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Charger plus de commentaires", Arg: ""}}},
+				i18n.CommentsNoticeApproved:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Merci ! Votre commentaire est publié.", Arg: ""}}},
+				i18n.CommentsNoticePending:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Merci ! Votre commentaire est en attente de modération.", Arg: ""}}},
+				i18n.CommentsNoticeRejected:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Votre commentaire n'a pas été accepté.", Arg: ""}}},
+				i18n.CommentsUnavailable:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Les commentaires ne peuvent pas être chargés pour le moment.", Arg: ""}}},
 				i18n.ComposerReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Vous n'avez pas la permission d'envoyer des messages dans ce canal. Il est en lecture seule ! :)", Arg: ""}}},
+				i18n.ContextAuthorLinks:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Profils de l’auteur", Arg: ""}}},
+				i18n.ContextAuthorWebsite:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Site web", Arg: ""}}},
 				i18n.ContextFeed:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "flux", Arg: ""}}},
 				i18n.ContextLongDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "notes longues", Arg: ""}}},
 				i18n.ContextShortDescription:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "notes courtes", Arg: ""}}},
@@ -399,6 +760,10 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutFooterOpensourceLink:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Voir le code source sur GitHub", Arg: ""}}},
 				i18n.LayoutFooterOpensourcePrefix:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "le code de ce projet est développé publiquement :", Arg: ""}}},
 				i18n.LayoutFooterStackPrefix:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "stack :", Arg: ""}}},
+				i18n.LayoutFooterThemeDark:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Sombre", Arg: ""}}},
+				i18n.LayoutFooterThemeLight:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Clair", Arg: ""}}},
+				i18n.LayoutFooterThemeSwitch:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Thème de couleur :", Arg: ""}}},
+				i18n.LayoutFooterThemeSystem:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Système", Arg: ""}}},
 				i18n.LayoutGuildBlog:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Blog", Arg: ""}}},
 				i18n.LayoutGuildOnline:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "en ligne", Arg: ""}}},
 				i18n.LayoutGuildServer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Serveur", Arg: ""}}},
@@ -409,20 +774,40 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutTitleMicroTales:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Micro-contes", Arg: ""}}},
 				i18n.LayoutTitleNotes:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notes", Arg: ""}}},
 				i18n.LayoutTitleTales:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Contes", Arg: ""}}},
+				i18n.MaintenanceKicker:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "erreur / 503", Arg: ""}}},
+				i18n.MaintenancePageTitle:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "503 En maintenance", Arg: ""}}},
+				i18n.MaintenanceSummary:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Le blog est en cours de mise à jour. Réessayez dans quelques minutes.", Arg: ""}}},
+				i18n.MaintenanceTitle:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "De retour bientôt", Arg: ""}}},
 				i18n.MarkdownCodeCopied:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "copié", Arg: ""}}},
 				i18n.MarkdownCodeCopy:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "copier", Arg: ""}}},
 				i18n.MarkdownCodePlainText:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "texte brut", Arg: ""}}},
 				i18n.MarkdownPlaceholderCodeBlock:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "[bloc de code]", Arg: ""}}},
 				i18n.MarkdownPlaceholderImage:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[image]", Arg: ""}}},
 				i18n.MarkdownPlaceholderTable:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[tableau]", Arg: ""}}},
+				i18n.NoteAdjacentLabel:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notes adjacentes", Arg: ""}}},
+				i18n.NoteAdjacentNewer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "plus récente", Arg: ""}}},
+				i18n.NoteAdjacentOlder:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "plus ancienne", Arg: ""}}},
 				i18n.NoteAttachmentLabelPrefix:     {Parts: []frameworki18n.CompiledMessagePart{{Text: "pièce jointe", Arg: ""}}},
 				i18n.NoteBack:                      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Retour aux notes", Arg: ""}}},
+				i18n.NoteCoauthorsHeading:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Également écrit avec", Arg: ""}}},
+				i18n.NoteCoauthorsNotes:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Toutes les notes de ces auteurs", Arg: ""}}},
+				i18n.NoteDraftPreview:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Aperçu du brouillon — cette version n’est pas encore publiée.", Arg: ""}}},
 				i18n.NoteFeaturedAttachment:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "pièce jointe mise en avant", Arg: ""}}},
 				i18n.NoteOpenFull:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Ouvrir la note complète", Arg: ""}}},
 				i18n.NotePublishedPrefix:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "publié", Arg: ""}}},
+				i18n.NoteReadingTime:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Minutes"}, {Text: " min de lecture", Arg: ""}}},
+				i18n.NoteRelatedHeading:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "À lire ensuite", Arg: ""}}},
 				i18n.NoteTitleFallback:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Note", Arg: ""}}},
 				i18n.NoteUnknownAuthor:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "auteur inconnu", Arg: ""}}},
+				i18n.NoteDiffDraft:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Dernier brouillon", Arg: ""}}},
+				i18n.NoteDiffNotPublished:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Cette note n'a pas encore été publiée.", Arg: ""}}},
+				i18n.NoteDiffOpenPreview:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Ouvrir le lien d’aperçu signé", Arg: ""}}},
+				i18n.NoteDiffOpenPublished:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Ouvrir la note publiée", Arg: ""}}},
+				i18n.NoteDiffPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Relecture du brouillon", Arg: ""}}},
+				i18n.NoteDiffPublished:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Publié", Arg: ""}}},
+				i18n.NoteDiffReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "aperçu du brouillon en lecture seule", Arg: ""}}},
 				i18n.NotesAriaFeed:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "flux des notes", Arg: ""}}},
+				i18n.NotesFeaturedTitle:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Épinglées", Arg: ""}}},
 				i18n.NotfoundBack:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Retour aux notes", Arg: ""}}},
 				i18n.NotfoundKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "erreur / 404", Arg: ""}}},
 				i18n.NotfoundOpenChannels:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Ouvrir les canaux", Arg: ""}}},
@@ -435,6 +820,8 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.PagerNext:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "suivante", Arg: ""}}},
 				i18n.PagerPage:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "page", Arg: ""}}},
 				i18n.PagerPrev:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "précédente", Arg: ""}}},
+				i18n.SeoArchiveDescription:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Parcourez toutes les notes publiées par année et par mois.", Arg: ""}}},
+				i18n.SeoArchiveMonthDescription:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Notes publiées en ", Arg: ""}, {Text: "", Arg: "Period"}, {Text: ".", Arg: ""}}},
 				i18n.SeoAuthorDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes by ", Arg: ""}, {Text: "", Arg: "Author"}, {Text: ".", Arg: ""}}},
 				i18n.SeoChannelsDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse available channels and filters for the blog feed.", Arg: ""}}},
 				i18n.SeoMicroTalesDescription:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read short-form micro-tales from the blog feed.", Arg: ""}}},
@@ -444,24 +831,78 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.SeoPublisherName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoRootDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Plongez dans des notes concises remplies de conseils pratiques sur le codage, la performance web, le SEO, les workflows IA, les résumés de livres et plus - mises à jour régulièrement sur RevoTale.", Arg: ""}}},
 				i18n.SeoRootTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tech - Codage, Expérience, Open Source, SEO & Aperçus Scientifiques", Arg: ""}}},
+				i18n.SeoSeriesDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Toutes les parties de la série « ", Arg: ""}, {Text: "", Arg: "Series"}, {Text: " », dans l’ordre.", Arg: ""}}},
 				i18n.SeoSiteDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "A multilingual note feed with tales and micro-tales.", Arg: ""}}},
 				i18n.SeoSiteName:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoTagDescription:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes tagged ", Arg: ""}, {Text: "", Arg: "Tag"}, {Text: ".", Arg: ""}}},
+				i18n.SeoTagsDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tous les tags du blog avec le nombre de notes publiées.", Arg: ""}}},
 				i18n.SeoTalesDescription:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read long-form tales from the blog feed.", Arg: ""}}},
+				i18n.SeriesLabel:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Série", Arg: ""}}},
+				i18n.SeriesNext:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "partie suivante", Arg: ""}}},
+				i18n.SeriesPart:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Partie ", Arg: ""}, {Text: "", Arg: "Part"}, {Text: " sur ", Arg: ""}, {Text: "", Arg: "Total"}}},
+				i18n.SeriesPrevious:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "partie précédente", Arg: ""}}},
+				i18n.TagsEmpty:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Aucun tag pour le moment.", Arg: ""}}},
+				i18n.TagsSubtitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tous les sujets avec leur nombre de notes", Arg: ""}}},
+				i18n.TagsTitle:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Tags", Arg: ""}}},
+				i18n.UpstreamKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "erreur / 502", Arg: ""}}},
+				i18n.UpstreamPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "502 CMS indisponible", Arg: ""}}},
+				i18n.UpstreamSummary:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Les notes n'ont pas pu être chargées depuis le serveur de contenu. Réessayez dans un instant.", Arg: ""}}},
+				i18n.UpstreamTitle:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "CMS temporairement indisponible", Arg: ""}}},
 			},
 			"hi": {
+				i18n.ArchiveBack:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "सभी महीने", Arg: ""}}},
+				i18n.ArchiveEmpty:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "अभी तक कोई प्रकाशित नोट नहीं है।", Arg: ""}}},
+				i18n.ArchiveMonthApril:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "अप्रैल", Arg: ""}}},
+				i18n.ArchiveMonthAugust:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "अगस्त", Arg: ""}}},
+				i18n.ArchiveMonthDecember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "दिसंबर", Arg: ""}}},
+				i18n.ArchiveMonthFebruary:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "फ़रवरी", Arg: ""}}},
+				i18n.ArchiveMonthJanuary:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "जनवरी", Arg: ""}}},
+				i18n.ArchiveMonthJuly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "जुलाई", Arg: ""}}},
+				i18n.ArchiveMonthJune:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "जून", Arg: ""}}},
+				i18n.ArchiveMonthMarch:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "मार्च", Arg: ""}}},
+				i18n.ArchiveMonthMay:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "मई", Arg: ""}}},
+				i18n.ArchiveMonthNovember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "नवंबर", Arg: ""}}},
+				i18n.ArchiveMonthOctober:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "अक्टूबर", Arg: ""}}},
+				i18n.ArchiveMonthSeptember:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "सितंबर", Arg: ""}}},
+				i18n.ArchiveSubtitle:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "महीने के अनुसार नोट्स", Arg: ""}}},
+				i18n.ArchiveTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "संग्रह", Arg: ""}}},
 				i18n.ChannelAll:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "सभी", Arg: ""}}},
 				i18n.ChannelAny:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "सभी", Arg: ""}}},
+				i18n.ChannelArchive:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "संग्रह", Arg: ""}}},
 				i18n.ChannelMicroTales:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "सूक्ष्म-कथाएँ", Arg: ""}}},
 				i18n.ChannelSectionAuthors:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "लेखक", Arg: ""}}},
 				i18n.ChannelSectionChannels:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "चैनल", Arg: ""}}},
 				i18n.ChannelSectionNoteType:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "नोट प्रकार", Arg: ""}}},
+				i18n.ChannelSectionPopular:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "इस हफ़्ते लोकप्रिय", Arg: ""}}},
 				i18n.ChannelSectionTags:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "टैग", Arg: ""}}},
+				i18n.ChannelTags:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "टैग", Arg: ""}}},
 				i18n.ChannelTales:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "कथाएँ", Arg: ""}}},
 				i18n.ChannelsPageBack:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "फ़ीड पर वापस", Arg: ""}}},
 				i18n.ChannelsPageHint:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "नेविगेट करने के लिए बाईं चैनल सूची का उपयोग करें।", Arg: ""}}},
+				i18n.ChannelsPageOpml:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "सभी फ़ीड OPML में", Arg: ""}}},
 				i18n.ChannelsPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "चैनल", Arg: ""}}},
+				i18n.CommentsEmpty:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "अभी तक कोई टिप्पणी नहीं।", Arg: ""}}},
+				i18n.CommentsErrorInvalid:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "यह मान मान्य नहीं है।", Arg: ""}}},
+				i18n.CommentsErrorRequired:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "यह फ़ील्ड आवश्यक है।", Arg: ""}}},
+				i18n.CommentsErrorTooLong:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "यह मान बहुत लंबा है।", Arg: ""}}},
+				i18n.CommentsFormBackToNote:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "नोट पर वापस जाएँ", Arg: ""}}},
+				i18n.CommentsFormBody:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "टिप्पणी", Arg: ""}}},
+				i18n.CommentsFormEmail:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "ईमेल (वैकल्पिक, कभी नहीं दिखाया जाता)", Arg: ""}}},
+				i18n.CommentsFormHeading:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "टिप्पणी करें", Arg: ""}}},
+				i18n.CommentsFormModeration:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "टिप्पणियाँ प्रकाशित होने से पहले जाँची जाती हैं।", Arg: ""}}},
+				i18n.CommentsFormName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "नाम", Arg: ""}}},
+				i18n.CommentsFormPageTitle:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "अपनी टिप्पणी जाँचें", Arg: ""}}},
+				i18n.CommentsFormSubmit:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "टिप्पणी भेजें", Arg: ""}}},
+				i18n.CommentsFormWebsite:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "इस फ़ील्ड को खाली छोड़ें", Arg: ""}}},
+				i18n.CommentsHeading:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "टिप्पणियाँ", Arg: ""}}},
+				i18n.CommentsLoadMore:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "और टिप्पणियाँ लोड करें", Arg: ""}}},
+				i18n.CommentsNoticeApproved:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "धन्यवाद! आपकी टिप्पणी प्रकाशित हो गई है।", Arg: ""}}},
+				i18n.CommentsNoticePending:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "धन्यवाद! आपकी टिप्पणी समीक्षा की प्रतीक्षा में है।", Arg: ""}}},
+				i18n.CommentsNoticeRejected:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "आपकी टिप्पणी स्वीकार नहीं की गई।", Arg: ""}}},
+				i18n.CommentsUnavailable:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "टिप्पणियाँ अभी लोड नहीं हो सकतीं।", Arg: ""}}},
 				i18n.ComposerReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "आपको इस चैनल में संदेश भेजने की अनुमति नहीं है। यह केवल पढ़ने के लिए है! :)", Arg: ""}}},
+				i18n.ContextAuthorLinks:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "लेखक की प्रोफ़ाइल", Arg: ""}}},
+				i18n.ContextAuthorWebsite:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "वेबसाइट", Arg: ""}}},
 				i18n.ContextFeed:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "फ़ीड", Arg: ""}}},
 				i18n.ContextLongDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "लंबे नोट्स", Arg: ""}}},
 				i18n.ContextShortDescription:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "छोटे नोट्स", Arg: ""}}},
@@ -486,6 +927,10 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutFooterOpensourceLink:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "GitHub पर स्रोत देखें", Arg: ""}}},
 				i18n.LayoutFooterOpensourcePrefix:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "इस प्रोजेक्ट का कोड सार्वजनिक रूप से विकसित किया जाता है:", Arg: ""}}},
 				i18n.LayoutFooterStackPrefix:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "स्टैक:", Arg: ""}}},
+				i18n.LayoutFooterThemeDark:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "गहरा", Arg: ""}}},
+				i18n.LayoutFooterThemeLight:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "हल्का", Arg: ""}}},
+				i18n.LayoutFooterThemeSwitch:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "रंग थीम:", Arg: ""}}},
+				i18n.LayoutFooterThemeSystem:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "सिस्टम", Arg: ""}}},
 				i18n.LayoutGuildBlog:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "ब्लॉग", Arg: ""}}},
 				i18n.LayoutGuildOnline:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "ऑनलाइन", Arg: ""}}},
 				i18n.LayoutGuildServer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "सर्वर", Arg: ""}}},
@@ -496,20 +941,40 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutTitleMicroTales:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "सूक्ष्म-कथाएँ", Arg: ""}}},
 				i18n.LayoutTitleNotes:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "नोट्स", Arg: ""}}},
 				i18n.LayoutTitleTales:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "कथाएँ", Arg: ""}}},
+				i18n.MaintenanceKicker:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "त्रुटि / 503", Arg: ""}}},
+				i18n.MaintenancePageTitle:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "503 रखरखाव जारी है", Arg: ""}}},
+				i18n.MaintenanceSummary:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "ब्लॉग अपडेट हो रहा है। कृपया कुछ मिनट बाद फिर कोशिश करें।", Arg: ""}}},
+				i18n.MaintenanceTitle:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "जल्द लौटेंगे", Arg: ""}}},
 				i18n.MarkdownCodeCopied:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "कॉपी हो गया", Arg: ""}}},
 				i18n.MarkdownCodeCopy:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "कॉपी", Arg: ""}}},
 				i18n.MarkdownCodePlainText:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "सादा पाठ", Arg: ""}}},
 				i18n.MarkdownPlaceholderCodeBlock:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "[कोड ब्लॉक]", Arg: ""}}},
 				i18n.MarkdownPlaceholderImage:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[छवि]", Arg: ""}}},
 				i18n.MarkdownPlaceholderTable:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[तालिका]", Arg: ""}}},
+				i18n.NoteAdjacentLabel:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "आसपास के नोट्स", Arg: ""}}},
+				i18n.NoteAdjacentNewer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "नया", Arg: ""}}},
+				i18n.NoteAdjacentOlder:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "पुराना", Arg: ""}}},
 				i18n.NoteAttachmentLabelPrefix:     {Parts: []frameworki18n.CompiledMessagePart{{Text: "अटैचमेंट", Arg: ""}}},
 				i18n.NoteBack:                      {Parts: []frameworki18n.CompiledMessagePart{{Text: "नोट्स पर वापस", Arg: ""}}},
+				i18n.NoteCoauthorsHeading:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "इनके साथ भी लिखा गया", Arg: ""}}},
+				i18n.NoteCoauthorsNotes:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "इन लेखकों के सभी नोट्स", Arg: ""}}},
+				i18n.NoteDraftPreview:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "ड्राफ़्ट पूर्वावलोकन — यह संस्करण अभी प्रकाशित नहीं हुआ है।", Arg: ""}}},
 				i18n.NoteFeaturedAttachment:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "मुख्य अटैचमेंट", Arg: ""}}},
 				i18n.NoteOpenFull:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "पूरा नोट खोलें", Arg: ""}}},
 				i18n.NotePublishedPrefix:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "प्रकाशित", Arg: ""}}},
+				i18n.NoteReadingTime:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Minutes"}, {Text: " मिनट का पठन", Arg: ""}}},
+				i18n.NoteRelatedHeading:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "आगे पढ़ें", Arg: ""}}},
 				i18n.NoteTitleFallback:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "नोट", Arg: ""}}},
 				i18n.NoteUnknownAuthor:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "अज्ञात लेखक", Arg: ""}}},
+				i18n.NoteDiffDraft:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "नवीनतम ड्राफ़्ट", Arg: ""}}},
+				i18n.NoteDiffNotPublished:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "यह नोट अभी प्रकाशित नहीं हुआ है।", Arg: ""}}},
+				i18n.NoteDiffOpenPreview:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "हस्ताक्षरित पूर्वावलोकन लिंक खोलें", Arg: ""}}},
+				i18n.NoteDiffOpenPublished:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "प्रकाशित नोट खोलें", Arg: ""}}},
+				i18n.NoteDiffPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "ड्राफ़्ट समीक्षा", Arg: ""}}},
+				i18n.NoteDiffPublished:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "प्रकाशित", Arg: ""}}},
+				i18n.NoteDiffReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "केवल-पढ़ने योग्य ड्राफ़्ट पूर्वावलोकन", Arg: ""}}},
 				i18n.NotesAriaFeed:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "नोट्स फ़ीड", Arg: ""}}},
+				i18n.NotesFeaturedTitle:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "पिन की गई", Arg: ""}}},
 				i18n.NotfoundBack:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "नोट्स पर वापस", Arg: ""}}},
 				i18n.NotfoundKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "त्रुटि / 404", Arg: ""}}},
 				i18n.NotfoundOpenChannels:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "चैनल खोलें", Arg: ""}}},
@@ -522,6 +987,8 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.PagerNext:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "अगला", Arg: ""}}},
 				i18n.PagerPage:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "पृष्ठ", Arg: ""}}},
 				i18n.PagerPrev:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "पिछला", Arg: ""}}},
+				i18n.SeoArchiveDescription:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "वर्ष और महीने के अनुसार सभी प्रकाशित नोट्स देखें।", Arg: ""}}},
+				i18n.SeoArchiveMonthDescription:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Period"}, {Text: " में प्रकाशित नोट्स।", Arg: ""}}},
 				i18n.SeoAuthorDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes by ", Arg: ""}, {Text: "", Arg: "Author"}, {Text: ".", Arg: ""}}},
 				i18n.SeoChannelsDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse available channels and filters for the blog feed.", Arg: ""}}},
 				i18n.SeoMicroTalesDescription:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read short-form micro-tales from the blog feed.", Arg: ""}}},
@@ -531,24 +998,78 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.SeoPublisherName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoRootDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "कोडिंग, वेब प्रदर्शन, SEO, AI वर्कफ्लो, पुस्तक सारांश और बहुत कुछ पर क्रियाशील सुझावों से भरे संक्षिप्त नोट्स में डूबें - RevoTale पर नियमित रूप से अपडेट किए जाते हैं।", Arg: ""}}},
 				i18n.SeoRootTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "तकनीकी नोट्स - त्वरित कोडिंग, अनुभव, ओपन सोर्स, SEO और वैज्ञानिक अंतर्दृष्टि", Arg: ""}}},
+				i18n.SeoSeriesDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "शृंखला “", Arg: ""}, {Text: "", Arg: "Series"}, {Text: "” के सभी भाग, क्रम से।", Arg: ""}}},
 				i18n.SeoSiteDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "A multilingual note feed with tales and micro-tales.", Arg: ""}}},
 				i18n.SeoSiteName:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoTagDescription:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes tagged ", Arg: ""}, {Text: "", Arg: "Tag"}, {Text: ".", Arg: ""}}},
+				i18n.SeoTagsDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "प्रकाशित नोट्स की संख्या के साथ ब्लॉग के सभी टैग।", Arg: ""}}},
 				i18n.SeoTalesDescription:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read long-form tales from the blog feed.", Arg: ""}}},
+				i18n.SeriesLabel:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "शृंखला", Arg: ""}}},
+				i18n.SeriesNext:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "अगला भाग", Arg: ""}}},
+				i18n.SeriesPart:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Total"}, {Text: " में से भाग ", Arg: ""}, {Text: "", Arg: "Part"}}},
+				i18n.SeriesPrevious:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "पिछला भाग", Arg: ""}}},
+				i18n.TagsEmpty:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "अभी कोई टैग नहीं।", Arg: ""}}},
+				i18n.TagsSubtitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "सभी विषय और उनके नोट्स की संख्या", Arg: ""}}},
+				i18n.TagsTitle:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "टैग", Arg: ""}}},
+				i18n.UpstreamKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "त्रुटि / 502", Arg: ""}}},
+				i18n.UpstreamPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "502 CMS उपलब्ध नहीं", Arg: ""}}},
+				i18n.UpstreamSummary:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "कंटेंट सर्वर से नोट्स लोड नहीं हो सके। कृपया थोड़ी देर बाद फिर कोशिश करें।", Arg: ""}}},
+				i18n.UpstreamTitle:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "CMS अस्थायी रूप से उपलब्ध नहीं", Arg: ""}}},
 			},
 			"ja": {
+				i18n.ArchiveBack:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "すべての月", Arg: ""}}},
+				i18n.ArchiveEmpty:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "公開済みのノートはまだありません。", Arg: ""}}},
+				i18n.ArchiveMonthApril:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "4月", Arg: ""}}},
+				i18n.ArchiveMonthAugust:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "8月", Arg: ""}}},
+				i18n.ArchiveMonthDecember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "12月", Arg: ""}}},
+				i18n.ArchiveMonthFebruary:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "2月", Arg: ""}}},
+				i18n.ArchiveMonthJanuary:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "1月", Arg: ""}}},
+				i18n.ArchiveMonthJuly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "7月", Arg: ""}}},
+				i18n.ArchiveMonthJune:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "6月", Arg: ""}}},
+				i18n.ArchiveMonthMarch:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "3月", Arg: ""}}},
+				i18n.ArchiveMonthMay:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "5月", Arg: ""}}},
+				i18n.ArchiveMonthNovember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "11月", Arg: ""}}},
+				i18n.ArchiveMonthOctober:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "10月", Arg: ""}}},
+				i18n.ArchiveMonthSeptember:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "9月", Arg: ""}}},
+				i18n.ArchiveSubtitle:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "月別のノート", Arg: ""}}},
+				i18n.ArchiveTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "アーカイブ", Arg: ""}}},
 				i18n.ChannelAll:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "すべて", Arg: ""}}},
 				i18n.ChannelAny:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "すべて", Arg: ""}}},
+				i18n.ChannelArchive:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "アーカイブ", Arg: ""}}},
 				i18n.ChannelMicroTales:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "マイクロ物語", Arg: ""}}},
 				i18n.ChannelSectionAuthors:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "著者", Arg: ""}}},
 				i18n.ChannelSectionChannels:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "チャンネル", Arg: ""}}},
 				i18n.ChannelSectionNoteType:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "ノート種別", Arg: ""}}},
+				i18n.ChannelSectionPopular:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "今週の人気", Arg: ""}}},
 				i18n.ChannelSectionTags:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "タグ", Arg: ""}}},
+				i18n.ChannelTags:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "タグ", Arg: ""}}},
 				i18n.ChannelTales:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "物語", Arg: ""}}},
 				i18n.ChannelsPageBack:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "フィードに戻る", Arg: ""}}},
 				i18n.ChannelsPageHint:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "左側のチャンネル一覧で移動します。", Arg: ""}}},
+				i18n.ChannelsPageOpml:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "すべてのフィードを OPML で", Arg: ""}}},
 				i18n.ChannelsPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "チャンネル", Arg: ""}}},
+				i18n.CommentsEmpty:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "まだコメントはありません。", Arg: ""}}},
+				i18n.CommentsErrorInvalid:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "入力が正しくありません。", Arg: ""}}},
+				i18n.CommentsErrorRequired:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "この項目は必須です。", Arg: ""}}},
+				i18n.CommentsErrorTooLong:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "入力が長すぎます。", Arg: ""}}},
+				i18n.CommentsFormBackToNote:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "ノートに戻る", Arg: ""}}},
+				i18n.CommentsFormBody:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "コメント", Arg: ""}}},
+				i18n.CommentsFormEmail:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "メール（任意・非公開）", Arg: ""}}},
+				i18n.CommentsFormHeading:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "コメントを書く", Arg: ""}}},
+				i18n.CommentsFormModeration:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "コメントは確認後に公開されます。", Arg: ""}}},
+				i18n.CommentsFormName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "名前", Arg: ""}}},
+				i18n.CommentsFormPageTitle:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "コメントを確認してください", Arg: ""}}},
+				i18n.CommentsFormSubmit:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "コメントを送信", Arg: ""}}},
+				i18n.CommentsFormWebsite:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "この欄は空のままにしてください", Arg: ""}}},
+				i18n.CommentsHeading:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "コメント", Arg: ""}}},
+				i18n.CommentsLoadMore:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "コメントをさらに読み込む", Arg: ""}}},
+				i18n.CommentsNoticeApproved:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "ありがとうございます。コメントが公開されました。", Arg: ""}}},
+				i18n.CommentsNoticePending:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "ありがとうございます。コメントは承認待ちです。", Arg: ""}}},
+				i18n.CommentsNoticeRejected:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "コメントは承認されませんでした。", Arg: ""}}},
+				i18n.CommentsUnavailable:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "現在コメントを読み込めません。", Arg: ""}}},
 				i18n.ComposerReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "このチャンネルでメッセージを送信する権限がありません。読み取り専用です！ :)", Arg: ""}}},
+				i18n.ContextAuthorLinks:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "著者のプロフィール", Arg: ""}}},
+				i18n.ContextAuthorWebsite:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "ウェブサイト", Arg: ""}}},
 				i18n.ContextFeed:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "フィード", Arg: ""}}},
 				i18n.ContextLongDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "長文ノート", Arg: ""}}},
 				i18n.ContextShortDescription:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "短文ノート", Arg: ""}}},
@@ -573,6 +1094,10 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutFooterOpensourceLink:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "GitHub でソースを見る", Arg: ""}}},
 				i18n.LayoutFooterOpensourcePrefix:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "このプロジェクトのコードは公開で開発されています:", Arg: ""}}},
 				i18n.LayoutFooterStackPrefix:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "スタック:", Arg: ""}}},
+				i18n.LayoutFooterThemeDark:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "ダーク", Arg: ""}}},
+				i18n.LayoutFooterThemeLight:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "ライト", Arg: ""}}},
+				i18n.LayoutFooterThemeSwitch:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "カラーテーマ:", Arg: ""}}},
+				i18n.LayoutFooterThemeSystem:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "システム", Arg: ""}}},
 				i18n.LayoutGuildBlog:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "ブログ", Arg: ""}}},
 				i18n.LayoutGuildOnline:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "オンライン", Arg: ""}}},
 				i18n.LayoutGuildServer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "サーバー", Arg: ""}}},
@@ -583,20 +1108,40 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutTitleMicroTales:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "マイクロ物語", Arg: ""}}},
 				i18n.LayoutTitleNotes:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "ノート", Arg: ""}}},
 				i18n.LayoutTitleTales:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "物語", Arg: ""}}},
+				i18n.MaintenanceKicker:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "エラー / 503", Arg: ""}}},
+				i18n.MaintenancePageTitle:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "503 メンテナンス中", Arg: ""}}},
+				i18n.MaintenanceSummary:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "ブログを更新しています。数分後にもう一度お試しください。", Arg: ""}}},
+				i18n.MaintenanceTitle:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "まもなく再開します", Arg: ""}}},
 				i18n.MarkdownCodeCopied:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "コピーしました", Arg: ""}}},
 				i18n.MarkdownCodeCopy:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "コピー", Arg: ""}}},
 				i18n.MarkdownCodePlainText:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "プレーンテキスト", Arg: ""}}},
 				i18n.MarkdownPlaceholderCodeBlock:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "[コードブロック]", Arg: ""}}},
 				i18n.MarkdownPlaceholderImage:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[画像]", Arg: ""}}},
 				i18n.MarkdownPlaceholderTable:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[表]", Arg: ""}}},
+				i18n.NoteAdjacentLabel:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "前後のノート", Arg: ""}}},
+				i18n.NoteAdjacentNewer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "新しい", Arg: ""}}},
+				i18n.NoteAdjacentOlder:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "古い", Arg: ""}}},
 				i18n.NoteAttachmentLabelPrefix:     {Parts: []frameworki18n.CompiledMessagePart{{Text: "添付", Arg: ""}}},
 				i18n.NoteBack:                      {Parts: []frameworki18n.CompiledMessagePart{{Text: "ノートに戻る", Arg: ""}}},
+				i18n.NoteCoauthorsHeading:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "共著者", Arg: ""}}},
+				i18n.NoteCoauthorsNotes:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "これらの著者のすべてのノート", Arg: ""}}},
+				i18n.NoteDraftPreview:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "下書きプレビュー — このバージョンはまだ公開されていません。", Arg: ""}}},
 				i18n.NoteFeaturedAttachment:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "注目の添付", Arg: ""}}},
 				i18n.NoteOpenFull:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "ノート全文を開く", Arg: ""}}},
 				i18n.NotePublishedPrefix:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "公開", Arg: ""}}},
+				i18n.NoteReadingTime:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Minutes"}, {Text: "分で読めます", Arg: ""}}},
+				i18n.NoteRelatedHeading:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "次に読む", Arg: ""}}},
 				i18n.NoteTitleFallback:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "ノート", Arg: ""}}},
 				i18n.NoteUnknownAuthor:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "不明な著者", Arg: ""}}},
+				i18n.NoteDiffDraft:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "最新の下書き", Arg: ""}}},
+				i18n.NoteDiffNotPublished:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "このノートはまだ公開されていません。", Arg: ""}}},
+				i18n.NoteDiffOpenPreview:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "署名付きプレビューリンクを開く", Arg: ""}}},
+				i18n.NoteDiffOpenPublished:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "公開中のノートを開く", Arg: ""}}},
+				i18n.NoteDiffPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "下書きレビュー", Arg: ""}}},
+				i18n.NoteDiffPublished:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "公開版", Arg: ""}}},
+				i18n.NoteDiffReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "読み取り専用の下書きプレビュー", Arg: ""}}},
 				i18n.NotesAriaFeed:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "ノート フィード", Arg: ""}}},
+				i18n.NotesFeaturedTitle:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "ピン留め", Arg: ""}}},
 				i18n.NotfoundBack:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "ノートに戻る", Arg: ""}}},
 				i18n.NotfoundKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "エラー / 404", Arg: ""}}},
 				i18n.NotfoundOpenChannels:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "チャンネルを開く", Arg: ""}}},
@@ -609,6 +1154,8 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.PagerNext:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "次", Arg: ""}}},
 				i18n.PagerPage:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "ページ", Arg: ""}}},
 				i18n.PagerPrev:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "前", Arg: ""}}},
+				i18n.SeoArchiveDescription:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "公開済みのすべてのノートを年と月で閲覧できます。", Arg: ""}}},
+				i18n.SeoArchiveMonthDescription:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Period"}, {Text: "に公開されたノート。", Arg: ""}}},
 				i18n.SeoAuthorDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes by ", Arg: ""}, {Text: "", Arg: "Author"}, {Text: ".", Arg: ""}}},
 				i18n.SeoChannelsDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse available channels and filters for the blog feed.", Arg: ""}}},
 				i18n.SeoMicroTalesDescription:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read short-form micro-tales from the blog feed.", Arg: ""}}},
@@ -618,24 +1165,78 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.SeoPublisherName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoRootDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "コーディング、ウェブパフォーマンス、SEO、AIワークフロー、書籍の要点など、実用的なヒントが詰まった簡潔なメモをRevoTaleで定期的に更新しています。", Arg: ""}}},
 				i18n.SeoRootTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "技術メモ - クイックコーディング、経験、オープンソース、SEOおよび科学的な洞察", Arg: ""}}},
+				i18n.SeoSeriesDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "シリーズ「", Arg: ""}, {Text: "", Arg: "Series"}, {Text: "」の全回を順番に。", Arg: ""}}},
 				i18n.SeoSiteDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "A multilingual note feed with tales and micro-tales.", Arg: ""}}},
 				i18n.SeoSiteName:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoTagDescription:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes tagged ", Arg: ""}, {Text: "", Arg: "Tag"}, {Text: ".", Arg: ""}}},
+				i18n.SeoTagsDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "公開済みノート数付きのブログの全タグ。", Arg: ""}}},
 				i18n.SeoTalesDescription:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read long-form tales from the blog feed.", Arg: ""}}},
+				i18n.SeriesLabel:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "シリーズ", Arg: ""}}},
+				i18n.SeriesNext:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "次の回", Arg: ""}}},
+				i18n.SeriesPart:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "全", Arg: ""}, {Text: "", Arg: "Total"}, {Text: "回中の第", Arg: ""}, {Text: "", Arg: "Part"}, {Text: "回", Arg: ""}}},
+				i18n.SeriesPrevious:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "前の回", Arg: ""}}},
+				i18n.TagsEmpty:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "まだタグはありません。", Arg: ""}}},
+				i18n.TagsSubtitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "すべてのトピックとノート数", Arg: ""}}},
+				i18n.TagsTitle:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "タグ", Arg: ""}}},
+				i18n.UpstreamKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "エラー / 502", Arg: ""}}},
+				i18n.UpstreamPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "502 CMSに接続できません", Arg: ""}}},
+				i18n.UpstreamSummary:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "コンテンツサーバーからノートを読み込めませんでした。しばらくしてからもう一度お試しください。", Arg: ""}}},
+				i18n.UpstreamTitle:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "CMSが一時的に利用できません", Arg: ""}}},
 			},
 			"ru": {
+				i18n.ArchiveBack:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Все месяцы", Arg: ""}}},
+				i18n.ArchiveEmpty:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Опубликованных заметок пока нет.", Arg: ""}}},
+				i18n.ArchiveMonthApril:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Апрель", Arg: ""}}},
+				i18n.ArchiveMonthAugust:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Август", Arg: ""}}},
+				i18n.ArchiveMonthDecember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Декабрь", Arg: ""}}},
+				i18n.ArchiveMonthFebruary:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Февраль", Arg: ""}}},
+				i18n.ArchiveMonthJanuary:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Январь", Arg: ""}}},
+				i18n.ArchiveMonthJuly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Июль", Arg: ""}}},
+				i18n.ArchiveMonthJune:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Июнь", Arg: ""}}},
+				i18n.ArchiveMonthMarch:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Март", Arg: ""}}},
+				i18n.ArchiveMonthMay:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Май", Arg: ""}}},
+				i18n.ArchiveMonthNovember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Ноябрь", Arg: ""}}},
+				i18n.ArchiveMonthOctober:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Октябрь", Arg: ""}}},
+				i18n.ArchiveMonthSeptember:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Сентябрь", Arg: ""}}},
+				i18n.ArchiveSubtitle:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Заметки по месяцам", Arg: ""}}},
+				i18n.ArchiveTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Архив", Arg: ""}}},
 				i18n.ChannelAll:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Все", Arg: ""}}},
 				i18n.ChannelAny:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Все", Arg: ""}}},
+				i18n.ChannelArchive:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "Архив", Arg: ""}}},
 				i18n.ChannelMicroTales:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Микро-истории", Arg: ""}}},
 				i18n.ChannelSectionAuthors:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "авторы", Arg: ""}}},
 				i18n.ChannelSectionChannels:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "каналы", Arg: ""}}},
 				i18n.ChannelSectionNoteType:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "тип заметки", Arg: ""}}},
+				i18n.ChannelSectionPopular:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "популярное за неделю", Arg: ""}}},
 				i18n.ChannelSectionTags:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "теги", Arg: ""}}},
+				i18n.ChannelTags:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Теги", Arg: ""}}},
 				i18n.ChannelTales:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Истории", Arg: ""}}},
 				i18n.ChannelsPageBack:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Назад к ленте", Arg: ""}}},
 				i18n.ChannelsPageHint:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Используйте список каналов слева для навигации.", Arg: ""}}},
+				i18n.ChannelsPageOpml:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Все ленты в OPML", Arg: ""}}},
 				i18n.ChannelsPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Каналы", Arg: ""}}},
+				i18n.CommentsEmpty:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Комментариев пока нет.", Arg: ""}}},
+				i18n.CommentsErrorInvalid:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Недопустимое значение.", Arg: ""}}},
+				i18n.CommentsErrorRequired:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Это поле обязательно.", Arg: ""}}},
+				i18n.CommentsErrorTooLong:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Слишком длинное значение.", Arg: ""}}},
+				i18n.CommentsFormBackToNote:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Вернуться к заметке", Arg: ""}}},
+				i18n.CommentsFormBody:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Комментарий", Arg: ""}}},
+				i18n.CommentsFormEmail:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Email (необязательно, не публикуется)", Arg: ""}}},
+				i18n.CommentsFormHeading:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Оставить комментарий", Arg: ""}}},
+				i18n.CommentsFormModeration:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Комментарии публикуются после проверки.", Arg: ""}}},
+				i18n.CommentsFormName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Имя", Arg: ""}}},
+				i18n.CommentsFormPageTitle:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Проверьте комментарий", Arg: ""}}},
+				i18n.CommentsFormSubmit:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Отправить комментарий", Arg: ""}}},
+				i18n.CommentsFormWebsite:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Оставьте это поле пустым", Arg: ""}}},
+				i18n.CommentsHeading:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Комментарии", Arg: ""}}},
+				i18n.CommentsLoadMore:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Показать ещё комментарии", Arg: ""}}},
+				i18n.CommentsNoticeApproved:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Спасибо! Комментарий опубликован.", Arg: ""}}},
+				i18n.CommentsNoticePending:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Спасибо! Комментарий ожидает проверки.", Arg: ""}}},
+				i18n.CommentsNoticeRejected:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Комментарий не был принят.", Arg: ""}}},
+				i18n.CommentsUnavailable:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Сейчас не удаётся загрузить комментарии.", Arg: ""}}},
 				i18n.ComposerReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "У вас нет прав отправлять сообщения в этом канале. Он только для ЧТЕНИЯ! :)", Arg: ""}}},
+				i18n.ContextAuthorLinks:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Профили автора", Arg: ""}}},
+				i18n.ContextAuthorWebsite:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Сайт", Arg: ""}}},
 				i18n.ContextFeed:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "лента", Arg: ""}}},
 				i18n.ContextLongDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "длинные заметки", Arg: ""}}},
 				i18n.ContextShortDescription:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "короткие заметки", Arg: ""}}},
@@ -660,6 +1261,10 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutFooterOpensourceLink:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Посмотреть исходный код на GitHub", Arg: ""}}},
 				i18n.LayoutFooterOpensourcePrefix:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "код этого проекта разрабатывается публично:", Arg: ""}}},
 				i18n.LayoutFooterStackPrefix:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "стек:", Arg: ""}}},
+				i18n.LayoutFooterThemeDark:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Тёмная", Arg: ""}}},
+				i18n.LayoutFooterThemeLight:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Светлая", Arg: ""}}},
+				i18n.LayoutFooterThemeSwitch:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Цветовая тема:", Arg: ""}}},
+				i18n.LayoutFooterThemeSystem:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Системная", Arg: ""}}},
 				i18n.LayoutGuildBlog:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Блог", Arg: ""}}},
 				i18n.LayoutGuildOnline:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "в сети", Arg: ""}}},
 				i18n.LayoutGuildServer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Сервер", Arg: ""}}},
@@ -670,20 +1275,40 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutTitleMicroTales:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Микро-истории", Arg: ""}}},
 				i18n.LayoutTitleNotes:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Заметки", Arg: ""}}},
 				i18n.LayoutTitleTales:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Истории", Arg: ""}}},
+				i18n.MaintenanceKicker:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "ошибка / 503", Arg: ""}}},
+				i18n.MaintenancePageTitle:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "503 Технические работы", Arg: ""}}},
+				i18n.MaintenanceSummary:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Блог обновляется. Попробуйте снова через несколько минут.", Arg: ""}}},
+				i18n.MaintenanceTitle:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Скоро вернёмся", Arg: ""}}},
 				i18n.MarkdownCodeCopied:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "скопировано", Arg: ""}}},
 				i18n.MarkdownCodeCopy:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "копировать", Arg: ""}}},
 				i18n.MarkdownCodePlainText:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "обычный текст", Arg: ""}}},
 				i18n.MarkdownPlaceholderCodeBlock:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "[блок кода]", Arg: ""}}},
 				i18n.MarkdownPlaceholderImage:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[изображение]", Arg: ""}}},
 				i18n.MarkdownPlaceholderTable:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[таблица]", Arg: ""}}},
+				i18n.NoteAdjacentLabel:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Соседние заметки", Arg: ""}}},
+				i18n.NoteAdjacentNewer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "новее", Arg: ""}}},
+				i18n.NoteAdjacentOlder:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "старее", Arg: ""}}},
 				i18n.NoteAttachmentLabelPrefix:     {Parts: []frameworki18n.CompiledMessagePart{{Text: "вложение", Arg: ""}}},
 				i18n.NoteBack:                      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Назад к заметкам", Arg: ""}}},
+				i18n.NoteCoauthorsHeading:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Также написано с", Arg: ""}}},
+				i18n.NoteCoauthorsNotes:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Все заметки этих авторов", Arg: ""}}},
+				i18n.NoteDraftPreview:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Предпросмотр черновика — эта версия ещё не опубликована.", Arg: ""}}},
 				i18n.NoteFeaturedAttachment:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "основное вложение", Arg: ""}}},
 				i18n.NoteOpenFull:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Открыть заметку полностью", Arg: ""}}},
 				i18n.NotePublishedPrefix:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "опубликовано", Arg: ""}}},
+				i18n.NoteReadingTime:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Minutes"}, {Text: " мин чтения", Arg: ""}}},
+				i18n.NoteRelatedHeading:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Читать дальше", Arg: ""}}},
 				i18n.NoteTitleFallback:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Заметка", Arg: ""}}},
 				i18n.NoteUnknownAuthor:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "неизвестный автор", Arg: ""}}},
+				i18n.NoteDiffDraft:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Последний черновик", Arg: ""}}},
+				i18n.NoteDiffNotPublished:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Эта заметка ещё не опубликована.", Arg: ""}}},
+				i18n.NoteDiffOpenPreview:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Открыть подписанную ссылку предпросмотра", Arg: ""}}},
+				i18n.NoteDiffOpenPublished:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Открыть опубликованную заметку", Arg: ""}}},
+				i18n.NoteDiffPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Проверка черновика", Arg: ""}}},
+				i18n.NoteDiffPublished:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Опубликовано", Arg: ""}}},
+				i18n.NoteDiffReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "предпросмотр черновика только для чтения", Arg: ""}}},
 				i18n.NotesAriaFeed:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "лента заметок", Arg: ""}}},
+				i18n.NotesFeaturedTitle:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Закреплённые", Arg: ""}}},
 				i18n.NotfoundBack:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Назад к заметкам", Arg: ""}}},
 				i18n.NotfoundKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "ошибка / 404", Arg: ""}}},
 				i18n.NotfoundOpenChannels:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Открыть каналы", Arg: ""}}},
@@ -696,6 +1321,8 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.PagerNext:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "след.", Arg: ""}}},
 				i18n.PagerPage:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "страница", Arg: ""}}},
 				i18n.PagerPrev:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "пред.", Arg: ""}}},
+				i18n.SeoArchiveDescription:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Все опубликованные заметки по годам и месяцам.", Arg: ""}}},
+				i18n.SeoArchiveMonthDescription:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Заметки, опубликованные: ", Arg: ""}, {Text: "", Arg: "Period"}, {Text: ".", Arg: ""}}},
 				i18n.SeoAuthorDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes by ", Arg: ""}, {Text: "", Arg: "Author"}, {Text: ".", Arg: ""}}},
 				i18n.SeoChannelsDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse available channels and filters for the blog feed.", Arg: ""}}},
 				i18n.SeoMicroTalesDescription:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read short-form micro-tales from the blog feed.", Arg: ""}}},
@@ -705,24 +1332,78 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.SeoPublisherName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoRootDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Погрузитесь в лаконичные заметки, наполненные практическими советами по кодированию, веб-производительности, SEO, рабочим процессам AI, выводам из книг и многому другому — регулярно обновляемые на RevoTale.", Arg: ""}}},
 				i18n.SeoRootTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Заметки - Быстрая разработка, опыт, открытый исходный код, SEO и научные идеи", Arg: ""}}},
+				i18n.SeoSeriesDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Все части серии «", Arg: ""}, {Text: "", Arg: "Series"}, {Text: "» по порядку.", Arg: ""}}},
 				i18n.SeoSiteDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "A multilingual note feed with tales and micro-tales.", Arg: ""}}},
 				i18n.SeoSiteName:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoTagDescription:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes tagged ", Arg: ""}, {Text: "", Arg: "Tag"}, {Text: ".", Arg: ""}}},
+				i18n.SeoTagsDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Все теги блога с количеством опубликованных заметок.", Arg: ""}}},
 				i18n.SeoTalesDescription:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read long-form tales from the blog feed.", Arg: ""}}},
+				i18n.SeriesLabel:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Серия", Arg: ""}}},
+				i18n.SeriesNext:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "следующая часть", Arg: ""}}},
+				i18n.SeriesPart:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Часть ", Arg: ""}, {Text: "", Arg: "Part"}, {Text: " из ", Arg: ""}, {Text: "", Arg: "Total"}}},
+				i18n.SeriesPrevious:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "предыдущая часть", Arg: ""}}},
+				i18n.TagsEmpty:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Пока нет тегов.", Arg: ""}}},
+				i18n.TagsSubtitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Все темы с количеством заметок", Arg: ""}}},
+				i18n.TagsTitle:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Теги", Arg: ""}}},
+				i18n.UpstreamKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "ошибка / 502", Arg: ""}}},
+				i18n.UpstreamPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "502 CMS недоступна", Arg: ""}}},
+				i18n.UpstreamSummary:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Не удалось загрузить заметки с контент-сервера. Попробуйте ещё раз чуть позже.", Arg: ""}}},
+				i18n.UpstreamTitle:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "CMS временно недоступна", Arg: ""}}},
 			},
 			"uk": {
+				i18n.ArchiveBack:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Усі місяці", Arg: ""}}},
+				i18n.ArchiveEmpty:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Опублікованих нотаток поки немає.", Arg: ""}}},
+				i18n.ArchiveMonthApril:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Квітень", Arg: ""}}},
+				i18n.ArchiveMonthAugust:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Серпень", Arg: ""}}},
+				i18n.ArchiveMonthDecember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Грудень", Arg: ""}}},
+				i18n.ArchiveMonthFebruary:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Лютий", Arg: ""}}},
+				i18n.ArchiveMonthJanuary:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Січень", Arg: ""}}},
+				i18n.ArchiveMonthJuly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Липень", Arg: ""}}},
+				i18n.ArchiveMonthJune:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Червень", Arg: ""}}},
+				i18n.ArchiveMonthMarch:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Березень", Arg: ""}}},
+				i18n.ArchiveMonthMay:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Травень", Arg: ""}}},
+				i18n.ArchiveMonthNovember:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Листопад", Arg: ""}}},
+				i18n.ArchiveMonthOctober:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Жовтень", Arg: ""}}},
+				i18n.ArchiveMonthSeptember:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Вересень", Arg: ""}}},
+				i18n.ArchiveSubtitle:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Нотатки за місяцями", Arg: ""}}},
+				i18n.ArchiveTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Архів", Arg: ""}}},
 				i18n.ChannelAll:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Усі", Arg: ""}}},
 				i18n.ChannelAny:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Усі", Arg: ""}}},
+				i18n.ChannelArchive:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "Архів", Arg: ""}}},
 				i18n.ChannelMicroTales:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Мікроісторії", Arg: ""}}},
 				i18n.ChannelSectionAuthors:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "автори", Arg: ""}}},
 				i18n.ChannelSectionChannels:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "канали", Arg: ""}}},
 				i18n.ChannelSectionNoteType:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "тип нотатки", Arg: ""}}},
+				i18n.ChannelSectionPopular:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "популярне за тиждень", Arg: ""}}},
 				i18n.ChannelSectionTags:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "теги", Arg: ""}}},
+				i18n.ChannelTags:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Теги", Arg: ""}}},
 				i18n.ChannelTales:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Історії", Arg: ""}}},
 				i18n.ChannelsPageBack:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Назад до стрічки", Arg: ""}}},
 				i18n.ChannelsPageHint:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Використовуйте список каналів ліворуч для навігації.", Arg: ""}}},
+				i18n.ChannelsPageOpml:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Усі стрічки в OPML", Arg: ""}}},
 				i18n.ChannelsPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Канали", Arg: ""}}},
+				i18n.CommentsEmpty:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Коментарів поки немає.", Arg: ""}}},
+				i18n.CommentsErrorInvalid:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Неприпустиме значення.", Arg: ""}}},
+				i18n.CommentsErrorRequired:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Це поле обов'язкове.", Arg: ""}}},
+				i18n.CommentsErrorTooLong:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Занадто довге значення.", Arg: ""}}},
+				i18n.CommentsFormBackToNote:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Повернутися до нотатки", Arg: ""}}},
+				i18n.CommentsFormBody:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Коментар", Arg: ""}}},
+				i18n.CommentsFormEmail:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Email (необов'язково, не публікується)", Arg: ""}}},
+				i18n.CommentsFormHeading:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Залишити коментар", Arg: ""}}},
+				i18n.CommentsFormModeration:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Коментарі публікуються після перевірки.", Arg: ""}}},
+				i18n.CommentsFormName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Ім'я", Arg: ""}}},
+				i18n.CommentsFormPageTitle:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Перевірте коментар", Arg: ""}}},
+				i18n.CommentsFormSubmit:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Надіслати коментар", Arg: ""}}},
+				i18n.CommentsFormWebsite:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Залиште це поле порожнім", Arg: ""}}},
+				i18n.CommentsHeading:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Коментарі", Arg: ""}}},
+				i18n.CommentsLoadMore:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Показати ще коментарі", Arg: ""}}},
+				i18n.CommentsNoticeApproved:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Дякуємо! Коментар опубліковано.", Arg: ""}}},
+				i18n.CommentsNoticePending:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Дякуємо! Коментар очікує на перевірку.", Arg: ""}}},
+				i18n.CommentsNoticeRejected:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Коментар не було прийнято.", Arg: ""}}},
+				i18n.CommentsUnavailable:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Зараз не вдається завантажити коментарі.", Arg: ""}}},
 				i18n.ComposerReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "У вас немає дозволу надсилати повідомлення в цьому каналі. Він лише для ЧИТАННЯ! :)", Arg: ""}}},
+				i18n.ContextAuthorLinks:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Профілі автора", Arg: ""}}},
+				i18n.ContextAuthorWebsite:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Сайт", Arg: ""}}},
 				i18n.ContextFeed:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "стрічка", Arg: ""}}},
 				i18n.ContextLongDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "довгі нотатки", Arg: ""}}},
 				i18n.ContextShortDescription:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "короткі нотатки", Arg: ""}}},
@@ -747,6 +1428,10 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutFooterOpensourceLink:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Переглянути код на GitHub", Arg: ""}}},
 				i18n.LayoutFooterOpensourcePrefix:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "код цього проєкту розробляється публічно:", Arg: ""}}},
 				i18n.LayoutFooterStackPrefix:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "стек:", Arg: ""}}},
+				i18n.LayoutFooterThemeDark:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Темна", Arg: ""}}},
+				i18n.LayoutFooterThemeLight:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Світла", Arg: ""}}},
+				i18n.LayoutFooterThemeSwitch:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Колірна тема:", Arg: ""}}},
+				i18n.LayoutFooterThemeSystem:       {Parts: []frameworki18n.CompiledMessagePart{{Text: "Системна", Arg: ""}}},
 				i18n.LayoutGuildBlog:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Блог", Arg: ""}}},
 				i18n.LayoutGuildOnline:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "онлайн", Arg: ""}}},
 				i18n.LayoutGuildServer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Сервер", Arg: ""}}},
@@ -757,20 +1442,40 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.LayoutTitleMicroTales:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Мікроісторії", Arg: ""}}},
 				i18n.LayoutTitleNotes:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Нотатки", Arg: ""}}},
 				i18n.LayoutTitleTales:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Історії", Arg: ""}}},
+				i18n.MaintenanceKicker:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "помилка / 503", Arg: ""}}},
+				i18n.MaintenancePageTitle:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "503 Технічні роботи", Arg: ""}}},
+				i18n.MaintenanceSummary:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Блог оновлюється. Спробуйте ще раз за кілька хвилин.", Arg: ""}}},
+				i18n.MaintenanceTitle:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Скоро повернемося", Arg: ""}}},
 				i18n.MarkdownCodeCopied:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "скопійовано", Arg: ""}}},
 				i18n.MarkdownCodeCopy:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "копіювати", Arg: ""}}},
 				i18n.MarkdownCodePlainText:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "звичайний текст", Arg: ""}}},
 				i18n.MarkdownPlaceholderCodeBlock:  {Parts: []frameworki18n.CompiledMessagePart{{Text: "[блок коду]", Arg: ""}}},
 				i18n.MarkdownPlaceholderImage:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[зображення]", Arg: ""}}},
 				i18n.MarkdownPlaceholderTable:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "[таблиця]", Arg: ""}}},
+				i18n.NoteAdjacentLabel:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Сусідні нотатки", Arg: ""}}},
+				i18n.NoteAdjacentNewer:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "новіша", Arg: ""}}},
+				i18n.NoteAdjacentOlder:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "старіша", Arg: ""}}},
 				i18n.NoteAttachmentLabelPrefix:     {Parts: []frameworki18n.CompiledMessagePart{{Text: "вкладення", Arg: ""}}},
 				i18n.NoteBack:                      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Назад до нотаток", Arg: ""}}},
+				i18n.NoteCoauthorsHeading:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Також написано з", Arg: ""}}},
+				i18n.NoteCoauthorsNotes:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Усі нотатки цих авторів", Arg: ""}}},
+				i18n.NoteDraftPreview:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "Попередній перегляд чернетки — ця версія ще не опублікована.", Arg: ""}}},
 				i18n.NoteFeaturedAttachment:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "основне вкладення", Arg: ""}}},
 				i18n.NoteOpenFull:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Відкрити повну нотатку", Arg: ""}}},
 				i18n.NotePublishedPrefix:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "опубліковано", Arg: ""}}},
+				i18n.NoteReadingTime:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "", Arg: "Minutes"}, {Text: " хв читання", Arg: ""}}},
+				i18n.NoteRelatedHeading:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Читати далі", Arg: ""}}},
 				i18n.NoteTitleFallback:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Нотатка", Arg: ""}}},
 				i18n.NoteUnknownAuthor:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "невідомий автор", Arg: ""}}},
+				i18n.NoteDiffDraft:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "Остання чернетка", Arg: ""}}},
+				i18n.NoteDiffNotPublished:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Ця нотатка ще не опублікована.", Arg: ""}}},
+				i18n.NoteDiffOpenPreview:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Відкрити підписане посилання попереднього перегляду", Arg: ""}}},
+				i18n.NoteDiffOpenPublished:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Відкрити опубліковану нотатку", Arg: ""}}},
+				i18n.NoteDiffPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Перевірка чернетки", Arg: ""}}},
+				i18n.NoteDiffPublished:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Опубліковано", Arg: ""}}},
+				i18n.NoteDiffReadOnly:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "попередній перегляд чернетки лише для читання", Arg: ""}}},
 				i18n.NotesAriaFeed:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "стрічка нотаток", Arg: ""}}},
+				i18n.NotesFeaturedTitle:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Закріплені", Arg: ""}}},
 				i18n.NotfoundBack:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Назад до нотаток", Arg: ""}}},
 				i18n.NotfoundKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "помилка / 404", Arg: ""}}},
 				i18n.NotfoundOpenChannels:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Відкрити канали", Arg: ""}}},
@@ -783,6 +1488,8 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.PagerNext:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "наст.", Arg: ""}}},
 				i18n.PagerPage:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "сторінка", Arg: ""}}},
 				i18n.PagerPrev:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "попер.", Arg: ""}}},
+				i18n.SeoArchiveDescription:         {Parts: []frameworki18n.CompiledMessagePart{{Text: "Усі опубліковані нотатки за роками та місяцями.", Arg: ""}}},
+				i18n.SeoArchiveMonthDescription:    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Нотатки, опубліковані: ", Arg: ""}, {Text: "", Arg: "Period"}, {Text: ".", Arg: ""}}},
 				i18n.SeoAuthorDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes by ", Arg: ""}, {Text: "", Arg: "Author"}, {Text: ".", Arg: ""}}},
 				i18n.SeoChannelsDescription:        {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse available channels and filters for the blog feed.", Arg: ""}}},
 				i18n.SeoMicroTalesDescription:      {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read short-form micro-tales from the blog feed.", Arg: ""}}},
@@ -792,10 +1499,23 @@ var bundle = func() *frameworki18n.Bundle[i18n.Key] {
 				i18n.SeoPublisherName:              {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoRootDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Занурюйтесь у короткі нотатки, наповнені практичними порадами з програмування, веб-продуктивності, SEO, робочих процесів AI, висновків з книг та багато іншого — регулярно оновлюється на RevoTale.", Arg: ""}}},
 				i18n.SeoRootTitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Нотатки - програмування, досвід, відкритий код, SEO та наукові ідеї", Arg: ""}}},
+				i18n.SeoSeriesDescription:          {Parts: []frameworki18n.CompiledMessagePart{{Text: "Усі частини серії «", Arg: ""}, {Text: "", Arg: "Series"}, {Text: "» по порядку.", Arg: ""}}},
 				i18n.SeoSiteDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "A multilingual note feed with tales and micro-tales.", Arg: ""}}},
 				i18n.SeoSiteName:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "RevoTale", Arg: ""}}},
 				i18n.SeoTagDescription:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "Browse notes tagged ", Arg: ""}, {Text: "", Arg: "Tag"}, {Text: ".", Arg: ""}}},
+				i18n.SeoTagsDescription:            {Parts: []frameworki18n.CompiledMessagePart{{Text: "Усі теги блогу з кількістю опублікованих нотаток.", Arg: ""}}},
 				i18n.SeoTalesDescription:           {Parts: []frameworki18n.CompiledMessagePart{{Text: "Read long-form tales from the blog feed.", Arg: ""}}},
+				i18n.SeriesLabel:                   {Parts: []frameworki18n.CompiledMessagePart{{Text: "Серія", Arg: ""}}},
+				i18n.SeriesNext:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "наступна частина", Arg: ""}}},
+				i18n.SeriesPart:                    {Parts: []frameworki18n.CompiledMessagePart{{Text: "Частина ", Arg: ""}, {Text: "", Arg: "Part"}, {Text: " з ", Arg: ""}, {Text: "", Arg: "Total"}}},
+				i18n.SeriesPrevious:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "попередня частина", Arg: ""}}},
+				i18n.TagsEmpty:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Поки немає тегів.", Arg: ""}}},
+				i18n.TagsSubtitle:                  {Parts: []frameworki18n.CompiledMessagePart{{Text: "Усі теми з кількістю нотаток", Arg: ""}}},
+				i18n.TagsTitle:                     {Parts: []frameworki18n.CompiledMessagePart{{Text: "Теги", Arg: ""}}},
+				i18n.UpstreamKicker:                {Parts: []frameworki18n.CompiledMessagePart{{Text: "помилка / 502", Arg: ""}}},
+				i18n.UpstreamPageTitle:             {Parts: []frameworki18n.CompiledMessagePart{{Text: "502 CMS недоступна", Arg: ""}}},
+				i18n.UpstreamSummary:               {Parts: []frameworki18n.CompiledMessagePart{{Text: "Не вдалося завантажити нотатки з контент-сервера. Спробуйте ще раз трохи згодом.", Arg: ""}}},
+				i18n.UpstreamTitle:                 {Parts: []frameworki18n.CompiledMessagePart{{Text: "CMS тимчасово недоступна", Arg: ""}}},
 			},
 		},
 		defaultMessages,
@@ -0,0 +1,25 @@
+// Code generated by cmd/approutegen. DO NOT EDIT.
+package r_source_author_param_slug
+
+import (
+	"github.com/RevoTale/no-js/framework/router"
+	"strings"
+)
+
+type AuthorParamSlugParams struct {
+	Slug string
+}
+
+func ParseParams(requestPath string) (AuthorParamSlugParams, bool) {
+	params, ok := router.MatchPathPattern("/author/_param__slug", requestPath)
+	if !ok {
+		return AuthorParamSlugParams{}, false
+	}
+	out := AuthorParamSlugParams{}
+	SlugValue, exists := params["slug"]
+	if !exists || len(SlugValue) == 0 {
+		return AuthorParamSlugParams{}, false
+	}
+	out.Slug = strings.TrimSpace(SlugValue[0])
+	return out, true
+}
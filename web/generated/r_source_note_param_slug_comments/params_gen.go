@@ -0,0 +1,25 @@
+// Code generated by cmd/approutegen. DO NOT EDIT.
+package r_source_note_param_slug_comments
+
+import (
+	"github.com/RevoTale/no-js/framework/router"
+	"strings"
+)
+
+type NoteParamSlugCommentsParams struct {
+	Slug string
+}
+
+func ParseParams(requestPath string) (NoteParamSlugCommentsParams, bool) {
+	params, ok := router.MatchPathPattern("/note/_param__slug/comments", requestPath)
+	if !ok {
+		return NoteParamSlugCommentsParams{}, false
+	}
+	out := NoteParamSlugCommentsParams{}
+	SlugValue, exists := params["slug"]
+	if !exists || len(SlugValue) == 0 {
+		return NoteParamSlugCommentsParams{}, false
+	}
+	out.Slug = strings.TrimSpace(SlugValue[0])
+	return out, true
+}
@@ -7,6 +7,7 @@ import (
 
 	blogdiscovery "blog/internal/discovery"
 	"blog/internal/notes"
+	"blog/internal/surrogate"
 	runtimeview "blog/web/view"
 	"github.com/RevoTale/no-js/framework"
 	frameworkdiscovery "github.com/RevoTale/no-js/framework/discovery"
@@ -23,15 +24,12 @@ func Feed(
 	}
 
 	locale := appCtx.LocaleFromRequest(r.URL.Query().Get("locale"))
-	listResult, err := service.ListNotes(
-		r.Context(),
-		locale,
-		blogdiscovery.FeedListFilterFromQuery(r.URL.Query()),
-		notes.ListOptions{},
-	)
+	filter := blogdiscovery.FeedListFilterFromQuery(r.URL.Query())
+	listResult, err := service.ListNotes(r.Context(), locale, filter, notes.ListOptions{})
 	if err != nil {
 		return frameworkdiscovery.FeedDocument{}, err
 	}
+	runtimeview.DeclareListSurrogateKeys(r.Context(), filter, listResult.Notes, surrogate.ListFeed)
 
 	return blogdiscovery.BuildFeedDocument(
 		resolveDiscoveryRootURL(runtime, r),
@@ -0,0 +1,25 @@
+// Code generated by cmd/approutegen. DO NOT EDIT.
+package r_source_tag_param_slug
+
+import (
+	"github.com/RevoTale/no-js/framework/router"
+	"strings"
+)
+
+type TagParamSlugParams struct {
+	Slug string
+}
+
+func ParseParams(requestPath string) (TagParamSlugParams, bool) {
+	params, ok := router.MatchPathPattern("/tag/_param__slug", requestPath)
+	if !ok {
+		return TagParamSlugParams{}, false
+	}
+	out := TagParamSlugParams{}
+	SlugValue, exists := params["slug"]
+	if !exists || len(SlugValue) == 0 {
+		return TagParamSlugParams{}, false
+	}
+	out.Slug = strings.TrimSpace(SlugValue[0])
+	return out, true
+}
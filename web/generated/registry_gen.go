@@ -6,14 +6,22 @@ import (
 	r_layout_author_param_slug "blog/web/generated/r_layout_author_param_slug"
 	r_layout_root "blog/web/generated/r_layout_root"
 	r_not_found_root "blog/web/generated/r_not_found_root"
+	r_page_admin_preview_diff_param_slug "blog/web/generated/r_page_admin_preview_diff_param_slug"
+	r_page_archive "blog/web/generated/r_page_archive"
+	r_page_archive_param_year_param_month "blog/web/generated/r_page_archive_param_year_param_month"
 	r_page_author_param_slug "blog/web/generated/r_page_author_param_slug"
+	r_page_author_param_slug_micro_tales "blog/web/generated/r_page_author_param_slug_micro_tales"
+	r_page_author_param_slug_tales "blog/web/generated/r_page_author_param_slug_tales"
 	r_page_channels "blog/web/generated/r_page_channels"
 	r_page_micro_tales "blog/web/generated/r_page_micro_tales"
 	r_page_note_param_slug "blog/web/generated/r_page_note_param_slug"
 	r_page_root "blog/web/generated/r_page_root"
+	r_page_series_param_slug "blog/web/generated/r_page_series_param_slug"
 	r_page_tag_param_slug "blog/web/generated/r_page_tag_param_slug"
+	r_page_tags "blog/web/generated/r_page_tags"
 	r_page_tales "blog/web/generated/r_page_tales"
 	r_root_root "blog/web/generated/r_root_root"
+	route_conventions_note__param__slug_comments "blog/web/generated/r_source_note_param_slug_comments"
 	route_resolvers "blog/web/resolvers"
 	"blog/web/view"
 	"context"
@@ -28,11 +36,18 @@ import (
 type RouteResolvers = route_resolvers.RouteResolver
 
 type RootParams = route_resolvers.RootParams
+type AdminPreviewDiffParamSlugParams = route_resolvers.AdminPreviewDiffParamSlugParams
+type ArchiveParams = route_resolvers.ArchiveParams
+type ArchiveParamYearParamMonthParams = route_resolvers.ArchiveParamYearParamMonthParams
 type AuthorParamSlugParams = route_resolvers.AuthorParamSlugParams
+type AuthorParamSlugMicroTalesParams = route_resolvers.AuthorParamSlugMicroTalesParams
+type AuthorParamSlugTalesParams = route_resolvers.AuthorParamSlugTalesParams
 type ChannelsParams = route_resolvers.ChannelsParams
 type MicroTalesParams = route_resolvers.MicroTalesParams
 type NoteParamSlugParams = route_resolvers.NoteParamSlugParams
+type SeriesParamSlugParams = route_resolvers.SeriesParamSlugParams
 type TagParamSlugParams = route_resolvers.TagParamSlugParams
+type TagsParams = route_resolvers.TagsParams
 type TalesParams = route_resolvers.TalesParams
 
 func NewRouteResolvers() RouteResolvers {
@@ -93,6 +108,162 @@ func Handlers(resolvers RouteResolvers) []framework.RouteHandler[*runtime.Contex
 				},
 			},
 		},
+		framework.PageOnlyRouteHandler[*runtime.Context, AdminPreviewDiffParamSlugParams, runtime.NoteDiffPageView]{
+			Page: framework.PageModule[*runtime.Context, AdminPreviewDiffParamSlugParams, runtime.NoteDiffPageView]{
+				RouteID:     ".admin/preview-diff/_param__slug",
+				Pattern:     "/.admin/preview-diff/_param__slug",
+				ParseParams: parseAdminPreviewDiffParamSlugParams,
+				MetaGenContext: func(meta framework.MetaContext[*runtime.Context], params AdminPreviewDiffParamSlugParams) (metagen.Metadata, error) {
+					return resolvers.MetaGenAdminPreviewDiffParamSlugPage(meta, params)
+				},
+				MetaGenName: "route_resolvers.Resolver.MetaGenAdminPreviewDiffParamSlugPage",
+				MetaGenChainNames: []string{
+					"route_resolvers.Resolver.MetaGenRootLayout",
+					"route_resolvers.Resolver.MetaGenAdminPreviewDiffParamSlugPage",
+				},
+				MetaGenContextChain: []framework.PageMetaGenContext[*runtime.Context, AdminPreviewDiffParamSlugParams]{
+					func(meta framework.MetaContext[*runtime.Context], _ AdminPreviewDiffParamSlugParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenRootLayout(meta)
+					},
+					func(meta framework.MetaContext[*runtime.Context], params AdminPreviewDiffParamSlugParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenAdminPreviewDiffParamSlugPage(meta, params)
+					},
+				},
+				Load: func(ctx context.Context, appCtx *runtime.Context, r *http.Request, params AdminPreviewDiffParamSlugParams) (runtime.NoteDiffPageView, error) {
+					return resolvers.ResolveAdminPreviewDiffParamSlugPage(ctx, appCtx, r, params)
+				},
+				LoadName: "route_resolvers.Resolver.ResolveAdminPreviewDiffParamSlugPage",
+				Compose: func(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.NoteDiffPageView, params AdminPreviewDiffParamSlugParams, partial bool) (templ.Component, error) {
+					return composeAdminPreviewDiffParamSlugPage(ctx, runtime, r, meta, view, params, partial, resolvers)
+				},
+				Render:     r_page_admin_preview_diff_param_slug.Page,
+				RootLayout: r_root_root.RootLayout,
+				ErrorPage: func(appCtx *runtime.Context, r *http.Request) templ.Component {
+					pathValue := "/"
+					if r != nil && r.URL != nil {
+						pathValue = strings.TrimSpace(r.URL.Path)
+						if pathValue == "" {
+							pathValue = "/"
+						}
+					}
+					view := runtime.NewErrorView(appCtx.I18n(r))
+					meta := metagen.Metadata{
+						Title: view.LayoutPageTitle(),
+						Robots: &metagen.Robots{
+							Index:  metagen.Bool(false),
+							Follow: metagen.Bool(false),
+						},
+					}
+					component := r_error_root.Error(view, pathValue)
+					component = r_layout_root.Layout(meta, view, component)
+					return component
+				},
+			},
+		},
+		framework.PageOnlyRouteHandler[*runtime.Context, ArchiveParams, runtime.ArchivePageView]{
+			Page: framework.PageModule[*runtime.Context, ArchiveParams, runtime.ArchivePageView]{
+				RouteID:     "archive",
+				Pattern:     "/archive",
+				ParseParams: parseArchiveParams,
+				MetaGenContext: func(meta framework.MetaContext[*runtime.Context], params ArchiveParams) (metagen.Metadata, error) {
+					return resolvers.MetaGenArchivePage(meta, params)
+				},
+				MetaGenName: "route_resolvers.Resolver.MetaGenArchivePage",
+				MetaGenChainNames: []string{
+					"route_resolvers.Resolver.MetaGenRootLayout",
+					"route_resolvers.Resolver.MetaGenArchivePage",
+				},
+				MetaGenContextChain: []framework.PageMetaGenContext[*runtime.Context, ArchiveParams]{
+					func(meta framework.MetaContext[*runtime.Context], _ ArchiveParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenRootLayout(meta)
+					},
+					func(meta framework.MetaContext[*runtime.Context], params ArchiveParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenArchivePage(meta, params)
+					},
+				},
+				Load: func(ctx context.Context, appCtx *runtime.Context, r *http.Request, params ArchiveParams) (runtime.ArchivePageView, error) {
+					return resolvers.ResolveArchivePage(ctx, appCtx, r, params)
+				},
+				LoadName: "route_resolvers.Resolver.ResolveArchivePage",
+				Compose: func(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.ArchivePageView, params ArchiveParams, partial bool) (templ.Component, error) {
+					return composeArchivePage(ctx, runtime, r, meta, view, params, partial, resolvers)
+				},
+				Render:     r_page_archive.Page,
+				RootLayout: r_root_root.RootLayout,
+				ErrorPage: func(appCtx *runtime.Context, r *http.Request) templ.Component {
+					pathValue := "/"
+					if r != nil && r.URL != nil {
+						pathValue = strings.TrimSpace(r.URL.Path)
+						if pathValue == "" {
+							pathValue = "/"
+						}
+					}
+					view := runtime.NewErrorView(appCtx.I18n(r))
+					meta := metagen.Metadata{
+						Title: view.LayoutPageTitle(),
+						Robots: &metagen.Robots{
+							Index:  metagen.Bool(false),
+							Follow: metagen.Bool(false),
+						},
+					}
+					component := r_error_root.Error(view, pathValue)
+					component = r_layout_root.Layout(meta, view, component)
+					return component
+				},
+			},
+		},
+		framework.PageOnlyRouteHandler[*runtime.Context, ArchiveParamYearParamMonthParams, runtime.ArchivePageView]{
+			Page: framework.PageModule[*runtime.Context, ArchiveParamYearParamMonthParams, runtime.ArchivePageView]{
+				RouteID:     "archive/_param__year/_param__month",
+				Pattern:     "/archive/_param__year/_param__month",
+				ParseParams: parseArchiveParamYearParamMonthParams,
+				MetaGenContext: func(meta framework.MetaContext[*runtime.Context], params ArchiveParamYearParamMonthParams) (metagen.Metadata, error) {
+					return resolvers.MetaGenArchiveParamYearParamMonthPage(meta, params)
+				},
+				MetaGenName: "route_resolvers.Resolver.MetaGenArchiveParamYearParamMonthPage",
+				MetaGenChainNames: []string{
+					"route_resolvers.Resolver.MetaGenRootLayout",
+					"route_resolvers.Resolver.MetaGenArchiveParamYearParamMonthPage",
+				},
+				MetaGenContextChain: []framework.PageMetaGenContext[*runtime.Context, ArchiveParamYearParamMonthParams]{
+					func(meta framework.MetaContext[*runtime.Context], _ ArchiveParamYearParamMonthParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenRootLayout(meta)
+					},
+					func(meta framework.MetaContext[*runtime.Context], params ArchiveParamYearParamMonthParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenArchiveParamYearParamMonthPage(meta, params)
+					},
+				},
+				Load: func(ctx context.Context, appCtx *runtime.Context, r *http.Request, params ArchiveParamYearParamMonthParams) (runtime.ArchivePageView, error) {
+					return resolvers.ResolveArchiveParamYearParamMonthPage(ctx, appCtx, r, params)
+				},
+				LoadName: "route_resolvers.Resolver.ResolveArchiveParamYearParamMonthPage",
+				Compose: func(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.ArchivePageView, params ArchiveParamYearParamMonthParams, partial bool) (templ.Component, error) {
+					return composeArchiveParamYearParamMonthPage(ctx, runtime, r, meta, view, params, partial, resolvers)
+				},
+				Render:     r_page_archive_param_year_param_month.Page,
+				RootLayout: r_root_root.RootLayout,
+				ErrorPage: func(appCtx *runtime.Context, r *http.Request) templ.Component {
+					pathValue := "/"
+					if r != nil && r.URL != nil {
+						pathValue = strings.TrimSpace(r.URL.Path)
+						if pathValue == "" {
+							pathValue = "/"
+						}
+					}
+					view := runtime.NewErrorView(appCtx.I18n(r))
+					meta := metagen.Metadata{
+						Title: view.LayoutPageTitle(),
+						Robots: &metagen.Robots{
+							Index:  metagen.Bool(false),
+							Follow: metagen.Bool(false),
+						},
+					}
+					component := r_error_root.Error(view, pathValue)
+					component = r_layout_root.Layout(meta, view, component)
+					return component
+				},
+			},
+		},
 		framework.PageOnlyRouteHandler[*runtime.Context, AuthorParamSlugParams, runtime.AuthorPageView]{
 			Page: framework.PageModule[*runtime.Context, AuthorParamSlugParams, runtime.AuthorPageView]{
 				RouteID:     "author/_param__slug",
@@ -151,6 +322,122 @@ func Handlers(resolvers RouteResolvers) []framework.RouteHandler[*runtime.Contex
 				},
 			},
 		},
+		framework.PageOnlyRouteHandler[*runtime.Context, AuthorParamSlugMicroTalesParams, runtime.AuthorPageView]{
+			Page: framework.PageModule[*runtime.Context, AuthorParamSlugMicroTalesParams, runtime.AuthorPageView]{
+				RouteID:     "author/_param__slug/micro-tales",
+				Pattern:     "/author/_param__slug/micro-tales",
+				ParseParams: parseAuthorParamSlugMicroTalesParams,
+				MetaGenContext: func(meta framework.MetaContext[*runtime.Context], params AuthorParamSlugMicroTalesParams) (metagen.Metadata, error) {
+					return resolvers.MetaGenAuthorParamSlugMicroTalesPage(meta, params)
+				},
+				MetaGenName: "route_resolvers.Resolver.MetaGenAuthorParamSlugMicroTalesPage",
+				MetaGenChainNames: []string{
+					"route_resolvers.Resolver.MetaGenRootLayout",
+					"route_resolvers.Resolver.MetaGenAuthorParamSlugLayout",
+					"route_resolvers.Resolver.MetaGenAuthorParamSlugMicroTalesPage",
+				},
+				MetaGenContextChain: []framework.PageMetaGenContext[*runtime.Context, AuthorParamSlugMicroTalesParams]{
+					func(meta framework.MetaContext[*runtime.Context], _ AuthorParamSlugMicroTalesParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenRootLayout(meta)
+					},
+					func(meta framework.MetaContext[*runtime.Context], params AuthorParamSlugMicroTalesParams) (metagen.Metadata, error) {
+						layoutParams := route_resolvers.AuthorParamSlugParams{}
+						layoutParams.Slug = params.Slug
+						return resolvers.MetaGenAuthorParamSlugLayout(meta, layoutParams)
+					},
+					func(meta framework.MetaContext[*runtime.Context], params AuthorParamSlugMicroTalesParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenAuthorParamSlugMicroTalesPage(meta, params)
+					},
+				},
+				Load: func(ctx context.Context, appCtx *runtime.Context, r *http.Request, params AuthorParamSlugMicroTalesParams) (runtime.AuthorPageView, error) {
+					return resolvers.ResolveAuthorParamSlugMicroTalesPage(ctx, appCtx, r, params)
+				},
+				LoadName: "route_resolvers.Resolver.ResolveAuthorParamSlugMicroTalesPage",
+				Compose: func(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.AuthorPageView, params AuthorParamSlugMicroTalesParams, partial bool) (templ.Component, error) {
+					return composeAuthorParamSlugMicroTalesPage(ctx, runtime, r, meta, view, params, partial, resolvers)
+				},
+				Render:     r_page_author_param_slug_micro_tales.Page,
+				RootLayout: r_root_root.RootLayout,
+				ErrorPage: func(appCtx *runtime.Context, r *http.Request) templ.Component {
+					pathValue := "/"
+					if r != nil && r.URL != nil {
+						pathValue = strings.TrimSpace(r.URL.Path)
+						if pathValue == "" {
+							pathValue = "/"
+						}
+					}
+					view := runtime.NewErrorView(appCtx.I18n(r))
+					meta := metagen.Metadata{
+						Title: view.LayoutPageTitle(),
+						Robots: &metagen.Robots{
+							Index:  metagen.Bool(false),
+							Follow: metagen.Bool(false),
+						},
+					}
+					component := r_error_root.Error(view, pathValue)
+					component = r_layout_root.Layout(meta, view, component)
+					return component
+				},
+			},
+		},
+		framework.PageOnlyRouteHandler[*runtime.Context, AuthorParamSlugTalesParams, runtime.AuthorPageView]{
+			Page: framework.PageModule[*runtime.Context, AuthorParamSlugTalesParams, runtime.AuthorPageView]{
+				RouteID:     "author/_param__slug/tales",
+				Pattern:     "/author/_param__slug/tales",
+				ParseParams: parseAuthorParamSlugTalesParams,
+				MetaGenContext: func(meta framework.MetaContext[*runtime.Context], params AuthorParamSlugTalesParams) (metagen.Metadata, error) {
+					return resolvers.MetaGenAuthorParamSlugTalesPage(meta, params)
+				},
+				MetaGenName: "route_resolvers.Resolver.MetaGenAuthorParamSlugTalesPage",
+				MetaGenChainNames: []string{
+					"route_resolvers.Resolver.MetaGenRootLayout",
+					"route_resolvers.Resolver.MetaGenAuthorParamSlugLayout",
+					"route_resolvers.Resolver.MetaGenAuthorParamSlugTalesPage",
+				},
+				MetaGenContextChain: []framework.PageMetaGenContext[*runtime.Context, AuthorParamSlugTalesParams]{
+					func(meta framework.MetaContext[*runtime.Context], _ AuthorParamSlugTalesParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenRootLayout(meta)
+					},
+					func(meta framework.MetaContext[*runtime.Context], params AuthorParamSlugTalesParams) (metagen.Metadata, error) {
+						layoutParams := route_resolvers.AuthorParamSlugParams{}
+						layoutParams.Slug = params.Slug
+						return resolvers.MetaGenAuthorParamSlugLayout(meta, layoutParams)
+					},
+					func(meta framework.MetaContext[*runtime.Context], params AuthorParamSlugTalesParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenAuthorParamSlugTalesPage(meta, params)
+					},
+				},
+				Load: func(ctx context.Context, appCtx *runtime.Context, r *http.Request, params AuthorParamSlugTalesParams) (runtime.AuthorPageView, error) {
+					return resolvers.ResolveAuthorParamSlugTalesPage(ctx, appCtx, r, params)
+				},
+				LoadName: "route_resolvers.Resolver.ResolveAuthorParamSlugTalesPage",
+				Compose: func(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.AuthorPageView, params AuthorParamSlugTalesParams, partial bool) (templ.Component, error) {
+					return composeAuthorParamSlugTalesPage(ctx, runtime, r, meta, view, params, partial, resolvers)
+				},
+				Render:     r_page_author_param_slug_tales.Page,
+				RootLayout: r_root_root.RootLayout,
+				ErrorPage: func(appCtx *runtime.Context, r *http.Request) templ.Component {
+					pathValue := "/"
+					if r != nil && r.URL != nil {
+						pathValue = strings.TrimSpace(r.URL.Path)
+						if pathValue == "" {
+							pathValue = "/"
+						}
+					}
+					view := runtime.NewErrorView(appCtx.I18n(r))
+					meta := metagen.Metadata{
+						Title: view.LayoutPageTitle(),
+						Robots: &metagen.Robots{
+							Index:  metagen.Bool(false),
+							Follow: metagen.Bool(false),
+						},
+					}
+					component := r_error_root.Error(view, pathValue)
+					component = r_layout_root.Layout(meta, view, component)
+					return component
+				},
+			},
+		},
 		framework.PageOnlyRouteHandler[*runtime.Context, ChannelsParams, runtime.NotesPageView]{
 			Page: framework.PageModule[*runtime.Context, ChannelsParams, runtime.NotesPageView]{
 				RouteID:     "channels",
@@ -307,6 +594,58 @@ func Handlers(resolvers RouteResolvers) []framework.RouteHandler[*runtime.Contex
 				},
 			},
 		},
+		framework.PageOnlyRouteHandler[*runtime.Context, SeriesParamSlugParams, runtime.SeriesPageView]{
+			Page: framework.PageModule[*runtime.Context, SeriesParamSlugParams, runtime.SeriesPageView]{
+				RouteID:     "series/_param__slug",
+				Pattern:     "/series/_param__slug",
+				ParseParams: parseSeriesParamSlugParams,
+				MetaGenContext: func(meta framework.MetaContext[*runtime.Context], params SeriesParamSlugParams) (metagen.Metadata, error) {
+					return resolvers.MetaGenSeriesParamSlugPage(meta, params)
+				},
+				MetaGenName: "route_resolvers.Resolver.MetaGenSeriesParamSlugPage",
+				MetaGenChainNames: []string{
+					"route_resolvers.Resolver.MetaGenRootLayout",
+					"route_resolvers.Resolver.MetaGenSeriesParamSlugPage",
+				},
+				MetaGenContextChain: []framework.PageMetaGenContext[*runtime.Context, SeriesParamSlugParams]{
+					func(meta framework.MetaContext[*runtime.Context], _ SeriesParamSlugParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenRootLayout(meta)
+					},
+					func(meta framework.MetaContext[*runtime.Context], params SeriesParamSlugParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenSeriesParamSlugPage(meta, params)
+					},
+				},
+				Load: func(ctx context.Context, appCtx *runtime.Context, r *http.Request, params SeriesParamSlugParams) (runtime.SeriesPageView, error) {
+					return resolvers.ResolveSeriesParamSlugPage(ctx, appCtx, r, params)
+				},
+				LoadName: "route_resolvers.Resolver.ResolveSeriesParamSlugPage",
+				Compose: func(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.SeriesPageView, params SeriesParamSlugParams, partial bool) (templ.Component, error) {
+					return composeSeriesParamSlugPage(ctx, runtime, r, meta, view, params, partial, resolvers)
+				},
+				Render:     r_page_series_param_slug.Page,
+				RootLayout: r_root_root.RootLayout,
+				ErrorPage: func(appCtx *runtime.Context, r *http.Request) templ.Component {
+					pathValue := "/"
+					if r != nil && r.URL != nil {
+						pathValue = strings.TrimSpace(r.URL.Path)
+						if pathValue == "" {
+							pathValue = "/"
+						}
+					}
+					view := runtime.NewErrorView(appCtx.I18n(r))
+					meta := metagen.Metadata{
+						Title: view.LayoutPageTitle(),
+						Robots: &metagen.Robots{
+							Index:  metagen.Bool(false),
+							Follow: metagen.Bool(false),
+						},
+					}
+					component := r_error_root.Error(view, pathValue)
+					component = r_layout_root.Layout(meta, view, component)
+					return component
+				},
+			},
+		},
 		framework.PageOnlyRouteHandler[*runtime.Context, TagParamSlugParams, runtime.NotesPageView]{
 			Page: framework.PageModule[*runtime.Context, TagParamSlugParams, runtime.NotesPageView]{
 				RouteID:     "tag/_param__slug",
@@ -359,6 +698,58 @@ func Handlers(resolvers RouteResolvers) []framework.RouteHandler[*runtime.Contex
 				},
 			},
 		},
+		framework.PageOnlyRouteHandler[*runtime.Context, TagsParams, runtime.TagsPageView]{
+			Page: framework.PageModule[*runtime.Context, TagsParams, runtime.TagsPageView]{
+				RouteID:     "tags",
+				Pattern:     "/tags",
+				ParseParams: parseTagsParams,
+				MetaGenContext: func(meta framework.MetaContext[*runtime.Context], params TagsParams) (metagen.Metadata, error) {
+					return resolvers.MetaGenTagsPage(meta, params)
+				},
+				MetaGenName: "route_resolvers.Resolver.MetaGenTagsPage",
+				MetaGenChainNames: []string{
+					"route_resolvers.Resolver.MetaGenRootLayout",
+					"route_resolvers.Resolver.MetaGenTagsPage",
+				},
+				MetaGenContextChain: []framework.PageMetaGenContext[*runtime.Context, TagsParams]{
+					func(meta framework.MetaContext[*runtime.Context], _ TagsParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenRootLayout(meta)
+					},
+					func(meta framework.MetaContext[*runtime.Context], params TagsParams) (metagen.Metadata, error) {
+						return resolvers.MetaGenTagsPage(meta, params)
+					},
+				},
+				Load: func(ctx context.Context, appCtx *runtime.Context, r *http.Request, params TagsParams) (runtime.TagsPageView, error) {
+					return resolvers.ResolveTagsPage(ctx, appCtx, r, params)
+				},
+				LoadName: "route_resolvers.Resolver.ResolveTagsPage",
+				Compose: func(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.TagsPageView, params TagsParams, partial bool) (templ.Component, error) {
+					return composeTagsPage(ctx, runtime, r, meta, view, params, partial, resolvers)
+				},
+				Render:     r_page_tags.Page,
+				RootLayout: r_root_root.RootLayout,
+				ErrorPage: func(appCtx *runtime.Context, r *http.Request) templ.Component {
+					pathValue := "/"
+					if r != nil && r.URL != nil {
+						pathValue = strings.TrimSpace(r.URL.Path)
+						if pathValue == "" {
+							pathValue = "/"
+						}
+					}
+					view := runtime.NewErrorView(appCtx.I18n(r))
+					meta := metagen.Metadata{
+						Title: view.LayoutPageTitle(),
+						Robots: &metagen.Robots{
+							Index:  metagen.Bool(false),
+							Follow: metagen.Bool(false),
+						},
+					}
+					component := r_error_root.Error(view, pathValue)
+					component = r_layout_root.Layout(meta, view, component)
+					return component
+				},
+			},
+		},
 		framework.PageOnlyRouteHandler[*runtime.Context, TalesParams, runtime.NotesPageView]{
 			Page: framework.PageModule[*runtime.Context, TalesParams, runtime.NotesPageView]{
 				RouteID:     "tales",
@@ -411,6 +802,14 @@ func Handlers(resolvers RouteResolvers) []framework.RouteHandler[*runtime.Contex
 				},
 			},
 		},
+		framework.MethodOnlyRouteHandler[*runtime.Context, route_conventions_note__param__slug_comments.NoteParamSlugCommentsParams]{
+			Route: framework.MethodRouteModule[*runtime.Context, route_conventions_note__param__slug_comments.NoteParamSlugCommentsParams]{
+				RouteID:     "note/_param__slug/comments",
+				Pattern:     "/note/_param__slug/comments",
+				ParseParams: route_conventions_note__param__slug_comments.ParseParams,
+				POST:        route_conventions_note__param__slug_comments.POST,
+			},
+		},
 	}
 }
 
@@ -530,6 +929,47 @@ func parseRootParams(requestPath string) (RootParams, bool) {
 	return RootParams{}, true
 }
 
+func parseAdminPreviewDiffParamSlugParams(requestPath string) (AdminPreviewDiffParamSlugParams, bool) {
+	params, ok := router.MatchPathPattern("/.admin/preview-diff/_param__slug", requestPath)
+	if !ok {
+		return AdminPreviewDiffParamSlugParams{}, false
+	}
+	out := AdminPreviewDiffParamSlugParams{}
+	SlugValue, exists := params["slug"]
+	if !exists || len(SlugValue) == 0 {
+		return AdminPreviewDiffParamSlugParams{}, false
+	}
+	out.Slug = strings.TrimSpace(SlugValue[0])
+	return out, true
+}
+
+func parseArchiveParams(requestPath string) (ArchiveParams, bool) {
+	_, ok := router.MatchPathPattern("/archive", requestPath)
+	if !ok {
+		return ArchiveParams{}, false
+	}
+	return ArchiveParams{}, true
+}
+
+func parseArchiveParamYearParamMonthParams(requestPath string) (ArchiveParamYearParamMonthParams, bool) {
+	params, ok := router.MatchPathPattern("/archive/_param__year/_param__month", requestPath)
+	if !ok {
+		return ArchiveParamYearParamMonthParams{}, false
+	}
+	out := ArchiveParamYearParamMonthParams{}
+	YearValue, exists := params["year"]
+	if !exists || len(YearValue) == 0 {
+		return ArchiveParamYearParamMonthParams{}, false
+	}
+	out.Year = strings.TrimSpace(YearValue[0])
+	MonthValue, exists := params["month"]
+	if !exists || len(MonthValue) == 0 {
+		return ArchiveParamYearParamMonthParams{}, false
+	}
+	out.Month = strings.TrimSpace(MonthValue[0])
+	return out, true
+}
+
 func parseAuthorParamSlugParams(requestPath string) (AuthorParamSlugParams, bool) {
 	params, ok := router.MatchPathPattern("/author/_param__slug", requestPath)
 	if !ok {
@@ -544,6 +984,34 @@ func parseAuthorParamSlugParams(requestPath string) (AuthorParamSlugParams, bool
 	return out, true
 }
 
+func parseAuthorParamSlugMicroTalesParams(requestPath string) (AuthorParamSlugMicroTalesParams, bool) {
+	params, ok := router.MatchPathPattern("/author/_param__slug/micro-tales", requestPath)
+	if !ok {
+		return AuthorParamSlugMicroTalesParams{}, false
+	}
+	out := AuthorParamSlugMicroTalesParams{}
+	SlugValue, exists := params["slug"]
+	if !exists || len(SlugValue) == 0 {
+		return AuthorParamSlugMicroTalesParams{}, false
+	}
+	out.Slug = strings.TrimSpace(SlugValue[0])
+	return out, true
+}
+
+func parseAuthorParamSlugTalesParams(requestPath string) (AuthorParamSlugTalesParams, bool) {
+	params, ok := router.MatchPathPattern("/author/_param__slug/tales", requestPath)
+	if !ok {
+		return AuthorParamSlugTalesParams{}, false
+	}
+	out := AuthorParamSlugTalesParams{}
+	SlugValue, exists := params["slug"]
+	if !exists || len(SlugValue) == 0 {
+		return AuthorParamSlugTalesParams{}, false
+	}
+	out.Slug = strings.TrimSpace(SlugValue[0])
+	return out, true
+}
+
 func parseChannelsParams(requestPath string) (ChannelsParams, bool) {
 	_, ok := router.MatchPathPattern("/channels", requestPath)
 	if !ok {
@@ -574,6 +1042,20 @@ func parseNoteParamSlugParams(requestPath string) (NoteParamSlugParams, bool) {
 	return out, true
 }
 
+func parseSeriesParamSlugParams(requestPath string) (SeriesParamSlugParams, bool) {
+	params, ok := router.MatchPathPattern("/series/_param__slug", requestPath)
+	if !ok {
+		return SeriesParamSlugParams{}, false
+	}
+	out := SeriesParamSlugParams{}
+	SlugValue, exists := params["slug"]
+	if !exists || len(SlugValue) == 0 {
+		return SeriesParamSlugParams{}, false
+	}
+	out.Slug = strings.TrimSpace(SlugValue[0])
+	return out, true
+}
+
 func parseTagParamSlugParams(requestPath string) (TagParamSlugParams, bool) {
 	params, ok := router.MatchPathPattern("/tag/_param__slug", requestPath)
 	if !ok {
@@ -588,6 +1070,14 @@ func parseTagParamSlugParams(requestPath string) (TagParamSlugParams, bool) {
 	return out, true
 }
 
+func parseTagsParams(requestPath string) (TagsParams, bool) {
+	_, ok := router.MatchPathPattern("/tags", requestPath)
+	if !ok {
+		return TagsParams{}, false
+	}
+	return TagsParams{}, true
+}
+
 func parseTalesParams(requestPath string) (TalesParams, bool) {
 	_, ok := router.MatchPathPattern("/tales", requestPath)
 	if !ok {
@@ -606,6 +1096,36 @@ func composeRootPage(ctx context.Context, runtime framework.RuntimeContext[*runt
 	return component, nil
 }
 
+func composeAdminPreviewDiffParamSlugPage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.NoteDiffPageView, params AdminPreviewDiffParamSlugParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
+	_ = params
+	component := r_page_admin_preview_diff_param_slug.Page(view)
+	if partial {
+		return component, nil
+	}
+	component = r_layout_root.Layout(meta, view, component)
+	return component, nil
+}
+
+func composeArchivePage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.ArchivePageView, params ArchiveParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
+	_ = params
+	component := r_page_archive.Page(view)
+	if partial {
+		return component, nil
+	}
+	component = r_layout_root.Layout(meta, view, component)
+	return component, nil
+}
+
+func composeArchiveParamYearParamMonthPage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.ArchivePageView, params ArchiveParamYearParamMonthParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
+	_ = params
+	component := r_page_archive_param_year_param_month.Page(view)
+	if partial {
+		return component, nil
+	}
+	component = r_layout_root.Layout(meta, view, component)
+	return component, nil
+}
+
 func composeAuthorParamSlugPage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.AuthorPageView, params AuthorParamSlugParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
 	_ = params
 	component := r_page_author_param_slug.Page(view)
@@ -617,6 +1137,28 @@ func composeAuthorParamSlugPage(ctx context.Context, runtime framework.RuntimeCo
 	return component, nil
 }
 
+func composeAuthorParamSlugMicroTalesPage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.AuthorPageView, params AuthorParamSlugMicroTalesParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
+	_ = params
+	component := r_page_author_param_slug_micro_tales.Page(view)
+	if partial {
+		return component, nil
+	}
+	component = r_layout_author_param_slug.Layout(view, component)
+	component = r_layout_root.Layout(meta, view, component)
+	return component, nil
+}
+
+func composeAuthorParamSlugTalesPage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.AuthorPageView, params AuthorParamSlugTalesParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
+	_ = params
+	component := r_page_author_param_slug_tales.Page(view)
+	if partial {
+		return component, nil
+	}
+	component = r_layout_author_param_slug.Layout(view, component)
+	component = r_layout_root.Layout(meta, view, component)
+	return component, nil
+}
+
 func composeChannelsPage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.NotesPageView, params ChannelsParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
 	_ = params
 	component := r_page_channels.Page(view)
@@ -647,6 +1189,16 @@ func composeNoteParamSlugPage(ctx context.Context, runtime framework.RuntimeCont
 	return component, nil
 }
 
+func composeSeriesParamSlugPage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.SeriesPageView, params SeriesParamSlugParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
+	_ = params
+	component := r_page_series_param_slug.Page(view)
+	if partial {
+		return component, nil
+	}
+	component = r_layout_root.Layout(meta, view, component)
+	return component, nil
+}
+
 func composeTagParamSlugPage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.NotesPageView, params TagParamSlugParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
 	_ = params
 	component := r_page_tag_param_slug.Page(view)
@@ -657,6 +1209,16 @@ func composeTagParamSlugPage(ctx context.Context, runtime framework.RuntimeConte
 	return component, nil
 }
 
+func composeTagsPage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.TagsPageView, params TagsParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
+	_ = params
+	component := r_page_tags.Page(view)
+	if partial {
+		return component, nil
+	}
+	component = r_layout_root.Layout(meta, view, component)
+	return component, nil
+}
+
 func composeTalesPage(ctx context.Context, runtime framework.RuntimeContext[*runtime.Context], r *http.Request, meta metagen.Metadata, view runtime.NotesPageView, params TalesParams, partial bool, resolvers RouteResolvers) (templ.Component, error) {
 	_ = params
 	component := r_page_tales.Page(view)
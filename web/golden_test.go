@@ -0,0 +1,106 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"testing"
+
+	"blog/web/components"
+	messages "blog/web/generated/i18n/messages"
+	runtime "blog/web/view"
+	"github.com/a-h/templ"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+)
+
+// updateGolden rewrites the files under testdata/golden instead of comparing
+// against them: go test ./web -run Golden -update.
+var updateGolden = flag.Bool("update", false, "rewrite web/testdata/golden files")
+
+// TestPagesMatchGolden renders whole routes through the handler with the fake
+// CMS client, so template refactors show up as a reviewable golden diff.
+func TestPagesMatchGolden(t *testing.T) {
+	testSrv := newTestServer(t)
+
+	cases := []struct {
+		name string
+		path string
+		code int
+	}{
+		{name: "note", path: "/note/hello-world", code: http.StatusOK},
+		{name: "tags", path: "/tags", code: http.StatusOK},
+		{name: "channels", path: "/channels", code: http.StatusOK},
+		{name: "not_found", path: "/missing-route", code: http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := performRequest(testSrv.handler, http.MethodGet, tc.path)
+			require.Equal(t, tc.code, rec.Code)
+			body := strings.ReplaceAll(requireBody(t, rec.Body), testSrv.bundle.hash, "ASSETHASH")
+			requireGolden(t, "page_"+tc.name, normalizeGoldenHTML(t, body))
+		})
+	}
+}
+
+// TestComponentsMatchGolden renders single components without a route.
+func TestComponentsMatchGolden(t *testing.T) {
+	i18nCtx := messages.NewContext(nil, nil)
+	view := runtime.UnavailablePageView{Locale: "en", I18nCtx: i18nCtx, Reason: runtime.UnavailableUpstream}
+
+	requireGolden(t, "component_unavailable", renderGoldenComponent(t, components.UnavailablePage(view)))
+}
+
+func renderGoldenComponent(t *testing.T, component templ.Component) string {
+	t.Helper()
+
+	var body bytes.Buffer
+	require.NoError(t, component.Render(context.Background(), &body))
+	return normalizeGoldenHTML(t, body.String())
+}
+
+// normalizeGoldenHTML re-serializes body through the HTML parser, so attribute
+// quoting and void tags are canonical, and puts each tag on its own line to
+// keep golden diffs readable.
+func normalizeGoldenHTML(t *testing.T, body string) string {
+	t.Helper()
+
+	nodes, err := html.ParseFragment(strings.NewReader(body), nil)
+	if strings.HasPrefix(strings.TrimSpace(strings.ToLower(body)), "<!doctype") {
+		var document *html.Node
+		document, err = html.Parse(strings.NewReader(body))
+		nodes = []*html.Node{document}
+	}
+	require.NoError(t, err)
+
+	var rendered bytes.Buffer
+	for _, node := range nodes {
+		require.NoError(t, html.Render(&rendered, node))
+	}
+	return strings.ReplaceAll(rendered.String(), "><", ">\n<") + "\n"
+}
+
+func requireGolden(t *testing.T, name string, got string) {
+	t.Helper()
+
+	// The test server changes the working directory, so resolve testdata next
+	// to this file.
+	_, currentFile, _, ok := goruntime.Caller(0)
+	require.True(t, ok)
+	goldenPath := filepath.Join(filepath.Dir(currentFile), "testdata", "golden", name+".html")
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll(filepath.Dir(goldenPath), 0o755))
+		require.NoError(t, os.WriteFile(goldenPath, []byte(got), 0o644))
+		return
+	}
+
+	want, err := os.ReadFile(goldenPath)
+	require.NoError(t, err, "missing golden file; run go test ./web -run Golden -update")
+	require.Equal(t, string(want), got, "golden mismatch for %s; rerun with -update if the change is intended", name)
+}
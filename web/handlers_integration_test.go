@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	goruntime "runtime"
@@ -15,11 +17,21 @@ import (
 	"testing"
 	"time"
 
+	"blog/internal/analytics"
+	"blog/internal/comments"
 	"blog/internal/config"
+	"blog/internal/discovery"
+	"blog/internal/gqltest"
 	"blog/internal/imageloader"
+	"blog/internal/middleware"
+	"blog/internal/navigation"
 	"blog/internal/notes"
+	"blog/internal/preview"
 	"blog/internal/site"
+	"blog/internal/staticfs"
+	"blog/internal/theme"
 	generated "blog/web/generated"
+	"blog/web/static"
 	"blog/web/view"
 	"github.com/Khan/genqlient/graphql"
 	"github.com/RevoTale/no-js/framework/httpserver"
@@ -32,281 +44,66 @@ const testRootURL = "https://revotale.com/blog/notes"
 const testLovelyEyeTrackerURL = "https://analytics.example/tracker.js"
 const testLovelyEyeSiteID = "site-key-123"
 
-type fakeGraphQLClient struct{}
+// newFakeCMS answers the CMS operations from the fixtures in testdata/gql.
+// The few requests that need code are handled here: the combined listing
+// query, author-only listings and notes that stall or panic.
+func newFakeCMS(t testing.TB) *gqltest.Client {
+	t.Helper()
 
-func (fakeGraphQLClient) MakeRequest(
-	_ context.Context,
-	req *graphql.Request,
-	resp *graphql.Response,
-) error {
-	if err := requireLocaleVariables(req); err != nil {
-		return err
-	}
+	_, currentFile, _, ok := goruntime.Caller(0)
+	require.True(t, ok)
+	cms, err := gqltest.Load(os.DirFS(filepath.Join(filepath.Dir(currentFile), "testdata", "gql")))
+	require.NoError(t, err)
 
-	slug := requestVarString(req, "slug")
-	name := requestVarString(req, "name")
-	queryValue := requestVarString(req, "query")
-
-	switch req.OpName {
-	case "AvailableTagsByPostType":
-		return decodeGraphQLData(resp, `{
-			"availableTagsByMicroPostType": [
-				{"id":"tag-1","name":"go","title":"Go"},
-				{"id":"tag-2","name":"rust","title":"Rust"}
-			]
-		}`)
-	case "AvailableAuthors":
-		return decodeGraphQLData(resp, `{
-			"Authors": {
-				"docs": [
-					{"id":"author-1","name":"L You","slug":"l-you","bio":"writer"},
-					{"id":"author-2","name":"Zed","slug":"zed","bio":"guest"}
-				]
-			}
-		}`)
-	case "TagIDsByNames":
-		return decodeGraphQLData(resp, `{
-			"Tags": {
-				"docs": [
-					{"id":"tag-1","name":"go","title":"Go"},
-					{"id":"tag-2","name":"rust","title":"Rust"}
-				]
-			}
-		}`)
-	case "TagByName":
-		if name == "missing" {
-			return decodeGraphQLData(resp, `{"Tags": {"docs": []}}`)
-		}
-		if name == "rust" {
-			return decodeGraphQLData(resp, `{
-				"Tags": {"docs": [{"id":"tag-2","name":"rust","title":"Rust"}]}
-			}`)
+	listNotes := func(_ context.Context, req *graphql.Request, resp *graphql.Response) error {
+		if gqltest.VarString(req, "slug") != "" && !gqltest.HasVar(req, "tagIDs") {
+			return cms.Respond("AuthorNotes", req, resp)
 		}
-		return decodeGraphQLData(resp, `{
-			"Tags": {"docs": [{"id":"tag-1","name":"go","title":"Go"}]}
-		}`)
-	case "ListNotes":
-		fallthrough
-	case "ListNotesByType":
-		fallthrough
-	case "ListNotesByTagIDs":
-		fallthrough
-	case "ListNotesByTagIDsAndType":
-		fallthrough
-	case "ListNotesByAuthorAndTagIDs":
-		fallthrough
-	case "ListNotesByAuthorTagIDsAndType":
-		fallthrough
-	case "SearchNotes":
-		fallthrough
-	case "SearchNotesByType":
-		fallthrough
-	case "SearchNotesByTagIDs":
-		fallthrough
-	case "SearchNotesByTagIDsAndType":
-		fallthrough
-	case "SearchNotesByAuthorAndTagIDs":
-		fallthrough
-	case "SearchNotesByAuthorTagIDsAndType":
-		if queryValue == "nomatch" {
-			return decodeGraphQLData(resp, `{
-				"Micro_posts": {
-					"totalPages": 1,
-					"docs": []
+		return cms.Respond("ListNotes", req, resp)
+	}
+
+	return cms.
+		Check(requireLocaleVariables).
+		Handle("ListNotes", listNotes).
+		Handle("SearchNotes", listNotes).
+		Handle("ListNotesPage", func(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+			for _, opName := range []string{"ListNotes", "AvailableAuthors", "AvailableTagsByPostType"} {
+				part := *req
+				part.OpName = opName
+				if err := cms.MakeRequest(ctx, &part, resp); err != nil {
+					return err
 				}
-			}`)
-		}
-		return decodeGraphQLData(resp, `{
-			"Micro_posts": {
-				"totalPages": 2,
-				"docs": [
-					{
-						"id": "note-1",
-						"slug": "hello-world",
-						"title": "Hello World",
-						"content": "# Hello",
-						"publishedAt": "2024-01-02T00:00:00.000Z",
-						"authors": [{"name":"L You","slug":"l-you","bio":"writer"}],
-						"tags": [{"id":"tag-1","name":"go","title":"Go"}],
-						"externalLinks": [{"id":"ext-1","target_url":"https://example.com/docs"}],
-						"linkedMicroPosts": [{"id":"linked-1","slug":"hello-linked"}],
-						"meta": {
-							"title":"Hello World Meta",
-							"description":"hello note",
-							"image":{"url":"/images/meta-hello.webp","description":"hello image","width":1200,"height":630}
-						}
-					}
-				]
-			}
-		}`)
-	case "NotesByAuthorSlug":
-		fallthrough
-	case "SearchNotesByAuthorSlug":
-		if queryValue == "nomatch" {
-			return decodeGraphQLData(resp, `{"Micro_posts": {"totalPages": 1, "docs": []}}`)
-		}
-		if slug == "missing" {
-			return decodeGraphQLData(resp, `{"Micro_posts": {"totalPages": 1, "docs": []}}`)
-		}
-		return decodeGraphQLData(resp, `{
-			"Micro_posts": {
-				"totalPages": 1,
-				"docs": [
-					{
-						"id": "note-1",
-						"slug": "hello-world",
-						"title": "Hello World",
-						"content": "# Hello",
-						"publishedAt": "2024-01-02T00:00:00.000Z",
-						"authors": [{"name":"L You","slug":"l-you","bio":"writer"}],
-						"tags": [{"id":"tag-1","name":"go","title":"Go"}],
-						"externalLinks": [{"id":"ext-1","target_url":"https://example.com/docs"}],
-						"linkedMicroPosts": [{"id":"linked-1","slug":"hello-linked"}],
-						"meta": {
-							"title":"Hello World Meta",
-							"description":"hello note",
-							"image":{"url":"/images/meta-hello.webp","description":"hello image","width":1200,"height":630}
-						}
-					}
-				]
-			}
-		}`)
-	case "NotesByAuthorSlugAndType":
-		fallthrough
-	case "SearchNotesByAuthorSlugAndType":
-		if queryValue == "nomatch" {
-			return decodeGraphQLData(resp, `{"Micro_posts": {"totalPages": 1, "docs": []}}`)
-		}
-		if slug == "missing" {
-			return decodeGraphQLData(resp, `{"Micro_posts": {"totalPages": 1, "docs": []}}`)
-		}
-		return decodeGraphQLData(resp, `{
-			"Micro_posts": {
-				"totalPages": 1,
-				"docs": [
-					{
-						"id": "note-1",
-						"slug": "hello-world",
-						"title": "Hello World",
-						"content": "# Hello",
-						"publishedAt": "2024-01-02T00:00:00.000Z",
-						"authors": [{"name":"L You","slug":"l-you","bio":"writer"}],
-						"tags": [{"id":"tag-1","name":"go","title":"Go"}],
-						"externalLinks": [{"id":"ext-1","target_url":"https://example.com/docs"}],
-						"linkedMicroPosts": [{"id":"linked-1","slug":"hello-linked"}],
-						"meta": {
-							"title":"Hello World Meta",
-							"description":"hello note",
-							"image":{"url":"/images/meta-hello.webp","description":"hello image","width":1200,"height":630}
-						}
-					}
-				]
 			}
-		}`)
-	case "NoteBySlug":
-		if slug == "missing" {
-			return decodeGraphQLData(resp, `{"Micro_posts": {"docs": []}}`)
-		}
-		return decodeGraphQLData(resp, `{
-			"Micro_posts": {
-				"docs": [
-					{
-						"id": "note-1",
-						"slug": "hello-world",
-						"title": "Hello World",
-						"content": "# Hello",
-						"publishedAt": "2024-01-02T00:00:00.000Z",
-						"authors": [{"name":"L You","slug":"l-you","bio":"writer"}],
-						"tags": [{"id":"tag-1","name":"go","title":"Go"}],
-						"externalLinks": [{"id":"ext-1","target_url":"https://example.com/docs"}],
-						"linkedMicroPosts": [{"id":"linked-1","slug":"hello-linked"}],
-						"meta": {
-							"title":"Hello World",
-							"description":"hello note",
-							"image":{"url":"/images/meta-hello.webp","description":"hello image","width":1200,"height":630}
-						}
-					}
-				]
-			}
-		}`)
-	case "AuthorBySlug":
-		if slug == "missing" {
-			return decodeGraphQLData(resp, `{"Authors": {"docs": []}}`)
-		}
-		if slug == "zed" {
-			return decodeGraphQLData(resp, `{
-				"Authors": {
-					"docs": [
-						{"id":"author-2","name":"Zed","slug":"zed","bio":"guest"}
-					]
-				}
-			}`)
-		}
-		return decodeGraphQLData(resp, `{
-			"Authors": {
-				"docs": [
-					{"id":"author-1","name":"L You","slug":"l-you","bio":"writer"}
-				]
+			return nil
+		}).
+		Handle("NoteBySlug", func(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+			switch gqltest.VarString(req, "slug") {
+			case "explode":
+				panic("fake CMS client panicked")
+			case "stalled":
+				<-ctx.Done()
+				return ctx.Err()
 			}
-		}`)
-	default:
-		return decodeGraphQLData(resp, `{}`)
-	}
-}
-
-func decodeGraphQLData(resp *graphql.Response, payload string) error {
-	return json.Unmarshal([]byte(payload), resp.Data)
-}
-
-func requestVarString(req *graphql.Request, key string) string {
-	if req == nil || req.Variables == nil {
-		return ""
-	}
-
-	raw, err := json.Marshal(req.Variables)
-	if err != nil {
-		return ""
-	}
-
-	values := make(map[string]json.RawMessage)
-	if err := json.Unmarshal(raw, &values); err != nil {
-		return ""
-	}
-
-	entry, ok := values[key]
-	if !ok {
-		return ""
-	}
-
-	var value string
-	if err := json.Unmarshal(entry, &value); err != nil {
-		return ""
-	}
-	return strings.TrimSpace(value)
+			return cms.Respond("NoteBySlug", req, resp)
+		})
 }
 
 var operationsWithLocaleAndFallback = map[string]struct{}{
-	"AuthorBySlug":                     {},
-	"AvailableAuthors":                 {},
-	"ListNotes":                        {},
-	"ListNotesByType":                  {},
-	"ListNotesByTagIDs":                {},
-	"ListNotesByTagIDsAndType":         {},
-	"ListNotesByAuthorAndTagIDs":       {},
-	"ListNotesByAuthorTagIDsAndType":   {},
-	"NoteBySlug":                       {},
-	"NotesByAuthorSlug":                {},
-	"NotesByAuthorSlugAndType":         {},
-	"SearchNotes":                      {},
-	"SearchNotesByType":                {},
-	"SearchNotesByTagIDs":              {},
-	"SearchNotesByTagIDsAndType":       {},
-	"SearchNotesByAuthorSlug":          {},
-	"SearchNotesByAuthorSlugAndType":   {},
-	"SearchNotesByAuthorAndTagIDs":     {},
-	"SearchNotesByAuthorTagIDsAndType": {},
-	"TagByName":                        {},
-	"TagIDsByNames":                    {},
+	"AdjacentNotes":         {},
+	"AuthorBySlug":          {},
+	"AvailableAuthors":      {},
+	"ChannelCounts":         {},
+	"ListNotes":             {},
+	"ListNotesPage":         {},
+	"NoteBySlug":            {},
+	"NoteRelations":         {},
+	"NotesPublishedBetween": {},
+	"TagCounts":             {},
+	"RelatedNoteCandidates": {},
+	"NoteDraftBySlug":       {},
+	"SearchNotes":           {},
+	"TagByName":             {},
+	"TagIDsByNames":         {},
 }
 
 var allowedGraphQLLocales = map[string]struct{}{
@@ -326,7 +123,7 @@ func requireLocaleVariables(req *graphql.Request) error {
 	}
 
 	if req.OpName == "AvailableTagsByPostType" {
-		locale := requestVarString(req, "locale")
+		locale := gqltest.VarString(req, "locale")
 		if locale == "" {
 			return fmt.Errorf("missing locale variable for %s", req.OpName)
 		}
@@ -340,7 +137,7 @@ func requireLocaleVariables(req *graphql.Request) error {
 		return nil
 	}
 
-	locale := requestVarString(req, "locale")
+	locale := gqltest.VarString(req, "locale")
 	if locale == "" {
 		return fmt.Errorf("missing locale variable for %s", req.OpName)
 	}
@@ -348,7 +145,7 @@ func requireLocaleVariables(req *graphql.Request) error {
 		return fmt.Errorf("unexpected locale variable %q for %s", locale, req.OpName)
 	}
 
-	fallbackLocale := requestVarString(req, "fallbackLocale")
+	fallbackLocale := gqltest.VarString(req, "fallbackLocale")
 	if fallbackLocale == "" {
 		return fmt.Errorf("missing fallbackLocale variable for %s", req.OpName)
 	}
@@ -377,11 +174,20 @@ type testServerOptions struct {
 	enableImageLoader  bool
 	lovelyEyeScriptURL string
 	lovelyEyeSiteID    string
+	adminToken         string
+	previewSigner      *preview.Signer
+	embedStatic        bool
+	navigation         *navigation.Model
+	enableComments     bool
 	mountExtraRoutes   func(*http.ServeMux) error
 	siteResolver       frameworksite.Resolver
+	robots             discovery.RobotsConfig
+	requestTimeout     time.Duration
+	maintenance        bool
+	views              *analytics.ViewCounter
 }
 
-func newTestServer(t *testing.T) testServer {
+func newTestServer(t testing.TB) testServer {
 	return newTestServerWithOptions(t, testServerOptions{})
 }
 
@@ -400,7 +206,7 @@ func newTestServerWithLovelyEye(t *testing.T, scriptURL string, siteID string) t
 	})
 }
 
-func newTestServerWithOptions(t *testing.T, options testServerOptions) testServer {
+func newTestServerWithOptions(t testing.TB, options testServerOptions) testServer {
 	t.Helper()
 
 	handler, bundle := newTestHandler(t, options)
@@ -411,7 +217,7 @@ func newTestServerWithOptions(t *testing.T, options testServerOptions) testServe
 	}
 }
 
-func newTestHandler(t *testing.T, options testServerOptions) (http.Handler, testStaticBundle) {
+func newTestHandler(t testing.TB, options testServerOptions) (http.Handler, testStaticBundle) {
 	t.Helper()
 
 	const staticURLPrefix = "/_assets/"
@@ -419,7 +225,7 @@ func newTestHandler(t *testing.T, options testServerOptions) (http.Handler, test
 	require.True(t, ok)
 	t.Chdir(filepath.Dir(filepath.Dir(currentFile)))
 
-	manifestPath := "web/assets-build/manifest.json"
+	manifestPath := path.Join(static.BuildDir, "manifest.json")
 	manifest, err := frameworkstaticassets.ReadManifest(manifestPath)
 	require.NoError(t, err)
 
@@ -430,31 +236,69 @@ func newTestHandler(t *testing.T, options testServerOptions) (http.Handler, test
 		require.NoError(t, err)
 	}
 	imageLoader := imageloader.New(options.enableImageLoader)
-	noteService := notes.NewService(fakeGraphQLClient{}, 12, imageLoader)
+	cms := newFakeCMS(t)
+	noteService := notes.NewService(cms, 12, imageLoader)
+	var commentService *comments.Service
+	if options.enableComments {
+		commentService = comments.NewService(cms, 0)
+	}
 	appContext, err := runtime.NewContext(runtime.Config{
 		Notes:              noteService,
+		Comments:           commentService,
 		SiteResolver:       siteResolver,
 		ImageLoader:        imageLoader,
 		LovelyEyeScriptURL: options.lovelyEyeScriptURL,
 		LovelyEyeSiteID:    options.lovelyEyeSiteID,
+		Navigation:         options.navigation,
+		Robots:             options.robots,
+		Preview:            options.previewSigner,
+		Views:              options.views,
 	})
 	require.NoError(t, err)
 
 	cachePolicies := httpserver.DefaultCachePolicies()
 	cachePolicies.Static = "public, max-age=31536000, immutable"
 
+	extraRoutes := options.mountExtraRoutes
+	staticAssets := &httpserver.StaticAssetsConfig{ManifestPath: manifestPath, URLPrefix: staticURLPrefix}
+	if options.embedStatic {
+		embedded, err := static.Assets()
+		require.NoError(t, err)
+		mount, err := staticfs.Load(embedded, staticURLPrefix)
+		require.NoError(t, err)
+		staticAssets = &httpserver.StaticAssetsConfig{URLPrefix: mount.URLPrefix}
+		extraRoutes = func(mux *http.ServeMux) error {
+			return mount.Register(mux, cachePolicies.Static)
+		}
+	}
+
 	handler, err := httpserver.NewApp(httpserver.Config[*runtime.Context]{
 		App: generated.Bundle(appContext),
 		Custom: httpserver.CustomConfig{
-			ExtraRoutes: options.mountExtraRoutes,
+			ExtraRoutes:  extraRoutes,
+			StaticAssets: staticAssets,
 			MainMiddlewares: []func(http.Handler) http.Handler{
+				middleware.WithErrorStatus,
+				middleware.WithUpstreamErrors(RenderUpstreamErrorPage(appContext)),
+				middleware.WithRequestTimeout(middleware.RequestTimeoutConfig{Default: options.requestTimeout}),
+				middleware.WithSurrogateKeys,
+				middleware.WithLinkHeader,
 				runtime.WithCanonicalNotesRedirects,
+				middleware.WithAdminAuth(options.adminToken),
+				middleware.WithPrivateQuery(preview.QueryKey),
+				theme.WithPreference,
 			},
 			CachePolicies:  cachePolicies,
 			LogServerError: func(error) {},
 		},
 	})
 	require.NoError(t, err)
+	handler = middleware.WithMaintenance(middleware.MaintenanceConfig{
+		Enabled:      options.maintenance,
+		OpenPrefixes: []string{"/healthz", staticURLPrefix},
+		RenderPage:   RenderMaintenancePage(appContext),
+	})(handler)
+	handler = middleware.WithRecovery(func(error) {})(handler)
 
 	return handler, testStaticBundle{
 		hash:      manifest.Hash,
@@ -625,6 +469,58 @@ func TestHandlerPageRoutesRenderHTML(t *testing.T) {
 	}
 }
 
+func TestAuthorPageRendersProfileLinks(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/author/l-you")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `<span class="author-location">Kyiv</span>`)
+	require.Contains(t, body,
+		`<a class="author-link" href="https://www.revotale.com/" rel="me noopener" title="Website">revotale.com</a>`)
+	require.Contains(t, body,
+		`<a class="author-link" href="https://github.com/l-you" rel="me noopener" title="GitHub">l-you</a>`)
+	require.Contains(t, body,
+		`<a class="author-link" href="https://mastodon.social/@l_you" rel="me noopener" title="Mastodon">Mastodon</a>`)
+	require.NotContains(t, body, "javascript:alert")
+	require.Contains(t, body,
+		`"sameAs":["https://www.revotale.com/","https://github.com/l-you","https://mastodon.social/@l_you"]`)
+
+	rec = performRequest(mux, http.MethodGet, "/author/zed")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotContains(t, requireBody(t, rec.Body), `class="author-profile"`)
+}
+
+func TestNotesListFiltersBySeveralAuthors(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/?author=l-you,zed")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, "<h1>L You, Zed</h1>")
+	require.Contains(t, body, `<p class="muted">@l-you, @zed</p>`)
+	require.NotContains(t, body, `class="author-profile"`)
+	require.Contains(t, body, `class="channel-link active" href="/author/zed"`)
+	require.Contains(t, body, `class="channel-link active" href="/author/l-you"`)
+}
+
+func TestNotePageLinksCoAuthors(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/note/co-written")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `<p class="note-coauthors">Also written with <a href="/author/zed">@Zed</a>`)
+	require.Contains(t, body, `<a class="note-coauthors-notes" href="/?author=l-you%2Czed">`)
+
+	rec = performRequest(mux, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotContains(t, requireBody(t, rec.Body), `class="note-coauthors"`)
+}
+
 func TestCanonicalListingQueryRedirects(t *testing.T) {
 	testSrv := newTestServer(t)
 	mux := testSrv.handler
@@ -642,6 +538,10 @@ func TestCanonicalListingQueryRedirects(t *testing.T) {
 		{path: "/tag/go?tag=rust", location: "/tag/go"},
 		{path: "/tales?type=short", location: "/tales"},
 		{path: "/uk?author=l-you", location: "/uk/author/l-you"},
+		{path: "/?author=l-you&type=long", location: "/author/l-you/tales"},
+		{path: "/author/l-you?type=short", location: "/author/l-you/micro-tales"},
+		{path: "/tales?author=l-you&page=2", location: "/author/l-you/tales?page=2"},
+		{path: "/author/l-you/tales?type=short", location: "/author/l-you/tales"},
 	}
 
 	for _, tc := range cases {
@@ -651,6 +551,342 @@ func TestCanonicalListingQueryRedirects(t *testing.T) {
 	}
 }
 
+func TestAuthorTypeRoutesRenderCanonicalListings(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	cases := []struct {
+		path  string
+		title string
+	}{
+		{
+			path:  "/author/l-you/tales",
+			title: "<title data-metagen-managed=\"true\">Tales | L You | Author | RevoTale</title>",
+		},
+		{
+			path:  "/author/l-you/micro-tales",
+			title: "<title data-metagen-managed=\"true\">Micro-tales | L You | Author | RevoTale</title>",
+		},
+	}
+
+	for _, tc := range cases {
+		rec := performRequest(mux, http.MethodGet, tc.path)
+		require.Equal(t, http.StatusOK, rec.Code, tc.path)
+		body := requireBody(t, rec.Body)
+		require.Contains(t, body, tc.title)
+		require.Contains(t, body, `rel="canonical" href="https://revotale.com/blog/notes`+tc.path+`"`)
+		require.Contains(t, body, `name="robots" content="index, follow"`)
+		require.Contains(t, body, `class="channel-link active" href="`+tc.path+`"`)
+		require.Contains(t, body, "<h1>L You</h1>")
+	}
+}
+
+func TestListingCanonicalDropsPaginationAndSearch(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	cases := []struct {
+		path      string
+		canonical string
+	}{
+		{path: "/?page=2", canonical: "https://revotale.com/blog/notes"},
+		{path: "/?q=hello&utm_source=x", canonical: "https://revotale.com/blog/notes"},
+		{path: "/tag/go?page=3", canonical: "https://revotale.com/blog/notes/tag/go"},
+		{path: "/author/l-you?page=2&tag=go", canonical: "https://revotale.com/blog/notes/author/l-you?tag=go"},
+		{path: "/uk/note/hello-world?ref=feed", canonical: "https://revotale.com/blog/notes/uk/note/hello-world"},
+	}
+
+	for _, tc := range cases {
+		rec := performRequest(mux, http.MethodGet, tc.path)
+		require.Equal(t, http.StatusOK, rec.Code, tc.path)
+		body := requireBody(t, rec.Body)
+		require.Contains(t, body, `rel="canonical" href="`+tc.canonical+`"`, tc.path)
+		require.Contains(t, body, `property="og:url" content="`+tc.canonical+`"`, tc.path)
+	}
+}
+
+func TestNonCanonicalSlugsRedirectPermanently(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	cases := []struct {
+		path     string
+		status   int
+		location string
+	}{
+		{path: "/tag/Go", status: http.StatusMovedPermanently, location: "/tag/go"},
+		{path: "/author/L_You", status: http.StatusMovedPermanently, location: "/author/l-you"},
+		{path: "/tag/Go?page=2", status: http.StatusMovedPermanently, location: "/tag/go?page=2"},
+		{path: "/uk/tag/GO", status: http.StatusMovedPermanently, location: "/uk/tag/go"},
+		{path: "/author/L_You/tales", status: http.StatusMovedPermanently, location: "/author/l-you/tales"},
+		{path: "/?tag=Go", status: http.StatusPermanentRedirect, location: "/tag/go"},
+		{path: "/?author=L%20You", status: http.StatusPermanentRedirect, location: "/author/l-you"},
+	}
+
+	for _, tc := range cases {
+		rec := performRequest(mux, http.MethodGet, tc.path)
+		require.Equal(t, tc.status, rec.Code, tc.path)
+		require.Equal(t, tc.location, rec.Header().Get("Location"), tc.path)
+	}
+}
+
+func TestAdminPreviewDiffRequiresToken(t *testing.T) {
+	disabled := newTestServer(t)
+	rec := performRequest(disabled.handler, http.MethodGet, "/.admin/preview-diff/hello-world")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	testSrv := newTestServerWithOptions(t, testServerOptions{adminToken: "secret"})
+	mux := testSrv.handler
+
+	rec = performRequest(mux, http.MethodGet, "/.admin/preview-diff/hello-world")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Contains(t, rec.Header().Get("WWW-Authenticate"), "Basic")
+
+	req := httptest.NewRequest(http.MethodGet, "/.admin/preview-diff/hello-world", nil)
+	req.SetBasicAuth("editor", "wrong")
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestAdminPreviewDiffRendersPublishedAndDraft(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{adminToken: "secret"})
+	mux := testSrv.handler
+
+	req := httptest.NewRequest(http.MethodGet, "/.admin/preview-diff/hello-world", nil)
+	req.SetBasicAuth("editor", "secret")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "private, no-store", rec.Header().Get("Cache-Control"))
+	require.Equal(t, "noindex, nofollow", rec.Header().Get("X-Robots-Tag"))
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, "Hello World")
+	require.Contains(t, body, "Hello World Revised")
+	require.Contains(t, body, `name="robots" content="noindex, nofollow"`)
+
+	req = httptest.NewRequest(http.MethodGet, "/.admin/preview-diff/missing", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestSignedPreviewRendersDraftOnNotePage(t *testing.T) {
+	signer, err := preview.NewSigner("preview-secret", time.Hour)
+	require.NoError(t, err)
+	testSrv := newTestServerWithOptions(t, testServerOptions{adminToken: "secret", previewSigner: signer})
+	mux := testSrv.handler
+
+	token := signer.Token("hello-world", time.Now())
+	rec := performRequest(mux, http.MethodGet, "/note/hello-world?preview="+token)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "private, no-store", rec.Header().Get("Cache-Control"))
+	require.Equal(t, "noindex, nofollow", rec.Header().Get("X-Robots-Tag"))
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `<p class="note-preview-banner" role="status">`)
+	require.Contains(t, body, "Hello World Revised")
+	require.NotContains(t, body, `application/ld+json`)
+
+	forged := performRequest(mux, http.MethodGet, "/note/hello-world?preview="+signer.Token("other-note", time.Now()))
+	require.Equal(t, http.StatusOK, forged.Code)
+	forgedBody := requireBody(t, forged.Body)
+	require.NotContains(t, forgedBody, "note-preview-banner")
+	require.NotContains(t, forgedBody, "Hello World Revised")
+
+	req := httptest.NewRequest(http.MethodGet, "/.admin/preview-diff/hello-world", nil)
+	req.SetBasicAuth("editor", "secret")
+	adminRec := httptest.NewRecorder()
+	mux.ServeHTTP(adminRec, req)
+	require.Equal(t, http.StatusOK, adminRec.Code)
+	require.Contains(t, requireBody(t, adminRec.Body), `href="/note/hello-world?preview=`)
+}
+
+func TestKeyboardShortcutLinksAreRendered(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `data-shortcut="feed-item"`)
+	require.Contains(t, body, testSrv.bundle.URL("shortcuts.js"))
+
+	rec = performRequest(mux, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, `data-shortcut="older-note"`)
+	require.Contains(t, body, `href="/note/hello-older"`)
+	require.Contains(t, body, "Hello Older")
+	require.NotContains(t, body, `data-shortcut="newer-note"`)
+}
+
+func TestNotePageRendersRelatedNotesRankedBySharedTags(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `class="panel note-related"`)
+	require.Contains(t, body, ">Read next</h2>")
+
+	sameTag := strings.Index(body, `href="/note/same-tag"`)
+	sameAuthor := strings.Index(body, `href="/note/same-author"`)
+	require.Positive(t, sameTag)
+	require.Positive(t, sameAuthor)
+	require.Less(t, sameTag, sameAuthor)
+
+	rec = performRequest(mux, http.MethodGet, "/uk/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, ">Читати далі</h2>")
+	require.Contains(t, body, `href="/uk/note/same-tag"`)
+}
+
+func TestNoteViewsShowReadingTime(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `<p class="muted note-reading-time">1 min read</p>`)
+
+	rec = performRequest(mux, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, `<span class="message-reading-time">1 min read</span>`)
+
+	rec = performRequest(mux, http.MethodGet, "/uk/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, ">1 хв читання</p>")
+}
+
+func TestArchiveRoutesGroupNotesByMonth(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/archive")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, ">Archive | RevoTale</title>")
+	require.Contains(t, body, `<h2>2024 <span class="archive-count">2</span></h2>`)
+	require.Contains(t, body, `href="/archive/2024/01"`)
+	require.Contains(t, body, `href="/archive/2023/12"`)
+	require.Less(t, strings.Index(body, `href="/archive/2024/01"`), strings.Index(body, `href="/archive/2023/12"`))
+	require.Contains(t, body, `<a class="channel-link active" href="/archive">`)
+	require.NotContains(t, body, `class="message-list archive-notes"`)
+
+	rec = performRequest(mux, http.MethodGet, "/archive/2024/01")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, ">January 2024 | RevoTale</title>")
+	require.Contains(t, body, `name="description" content="Notes published in January 2024."`)
+	require.Contains(t, body, `class="archive-month is-active" href="/archive/2024/01"`)
+	require.Contains(t, body, `href="/note/hello-world"`)
+	require.Contains(t, body, `href="/author/l-you"`)
+	require.Contains(t, body, `class="pager-link" href="/archive/2024/01?page=2"`)
+	require.Contains(t, body, `<span class="pager-link pager-number" aria-current="page">1</span>`)
+	require.Contains(t, body, `<a class="pager-link pager-number" href="/archive/2024/01?page=2">2</a>`)
+	require.Contains(t, body, `<link rel="next" href="https://revotale.com/blog/notes/archive/2024/01?page=2">`)
+	require.NotContains(t, body, `<link rel="prev"`)
+	require.Equal(t, `<https://revotale.com/blog/notes/archive/2024/01?page=2>; rel="next"`, rec.Header().Get("Link"))
+
+	rec = performRequest(mux, http.MethodGet, "/archive/2024/01?page=2")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, `href="/note/january-second-page"`)
+	require.NotContains(t, body, `href="/note/hello-world"`)
+	require.Contains(t, body, `class="pager-link" href="/archive/2024/01"`)
+	require.Equal(t, `<https://revotale.com/blog/notes/archive/2024/01>; rel="prev"`, rec.Header().Get("Link"))
+
+	rec = performRequest(mux, http.MethodGet, "/uk/archive/2024/01")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, "Січень 2024")
+	require.Contains(t, body, `href="/uk/archive"`)
+
+	for _, path := range []string{
+		"/archive/2024/02",
+		"/archive/2023/12",
+		"/archive/2024/1",
+		"/archive/20x4/01",
+		"/archive/2024/13",
+		"/archive/2024/01?page=3",
+	} {
+		rec = performRequest(mux, http.MethodGet, path)
+		require.Equal(t, http.StatusNotFound, rec.Code, path)
+	}
+}
+
+func TestTagsIndexListsTagsWithCounts(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/tags")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, ">Tags | RevoTale</title>")
+	require.Contains(t, body, `<a class="channel-link active" href="/tags">`)
+	require.Contains(t, body, `class="tag-cloud-link weight-4" href="/tag/go"`)
+	require.Contains(t, body, `class="tag-cloud-link weight-1" href="/tag/web"`)
+	require.Contains(t, body, `#Go <span class="archive-count">3</span>`)
+	require.Less(t, strings.Index(body, `href="/tag/go"`), strings.Index(body, `href="/tag/web"`))
+
+	rec = performRequest(mux, http.MethodGet, "/uk/tags")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, `href="/uk/tag/go"`)
+	require.Contains(t, body, "Усі теми з кількістю нотаток")
+}
+
+func TestSidebarChannelsShowNoteCounts(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `>Micro-tales<span class="channel-count">2</span></a>`)
+	require.Contains(t, body, `>@L You<span class="channel-count">2</span></a>`)
+	require.Contains(t, body, `>@Zed<span class="channel-count">1</span></a>`)
+	require.Contains(t, body, `>#Go<span class="channel-count">2</span></a>`)
+
+	rec = performRequest(mux, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotContains(t, requireBody(t, rec.Body), `class="channel-count"`)
+}
+
+func TestSidebarListsPopularNotesThisWeek(t *testing.T) {
+	views := analytics.NewViewCounter(analytics.NewMemoryRecorder(), nil)
+	testSrv := newTestServerWithOptions(t, testServerOptions{views: views})
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotContains(t, requireBody(t, rec.Body), "popular this week")
+
+	for range 2 {
+		rec = performRequest(mux, http.MethodGet, "/note/hello-world")
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+	rec = performRequest(mux, http.MethodHead, "/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = performRequest(mux, http.MethodGet, "/note/hello-world?__live=navigation")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, views.Flush(context.Background()))
+
+	rec = performRequest(mux, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `<p class="channel-panel-label">popular this week</p>`)
+	require.Contains(t, body, `href="/note/hello-world"><span class="channel-popular-title">Hello World</span>`+
+		`<span class="channel-count">2</span></a>`)
+}
+
 func TestRobotsRulesWithAndWithoutQuery(t *testing.T) {
 	testSrv := newTestServer(t)
 	mux := testSrv.handler
@@ -716,7 +952,11 @@ func TestSidebarLinkBehavior(t *testing.T) {
 
 	search := performRequest(mux, http.MethodGet, "/?q=hello")
 	searchBody := requireBody(t, search.Body)
-	require.Contains(t, searchBody, `<form class="topbar-search" role="search" method="get" action="/">`)
+	require.Contains(
+		t,
+		searchBody,
+		`<form id="topbar-search" class="topbar-search" role="search" method="get" action="/">`,
+	)
 	require.Contains(t, searchBody, `name="q"`)
 	require.Contains(t, searchBody, `value="hello"`)
 	require.Contains(t, searchBody, `class="topbar-rss-link" href="/feed.xml?locale=en&amp;q=hello"`)
@@ -736,7 +976,7 @@ func TestSidebarLinkBehavior(t *testing.T) {
 	require.Contains(t, filteredBody, `href="/channels?author=l-you&amp;tag=go&amp;type=short"`)
 	require.Contains(t, filteredBody, `href="/"`)
 	require.Contains(t, filteredBody, `href="/?tag=go&amp;type=short"`)
-	require.Contains(t, filteredBody, `href="/?author=l-you&amp;type=short"`)
+	require.Contains(t, filteredBody, `href="/author/l-you/micro-tales"`)
 	require.Contains(t, filteredBody, `href="/?author=l-you&amp;tag=go"`)
 	require.Contains(
 		t,
@@ -747,7 +987,7 @@ func TestSidebarLinkBehavior(t *testing.T) {
 	require.Contains(t, filteredBody, `href="/?author=l-you&amp;tag=rust&amp;type=short"`)
 	require.Contains(t, filteredBody, `href="/?author=l-you&amp;tag=go&amp;type=long"`)
 	require.Contains(t, filteredBody, `href="/?tag=go&amp;type=short"`)
-	require.Contains(t, filteredBody, `href="/?author=l-you&amp;type=short"`)
+	require.Contains(t, filteredBody, `href="/author/l-you/micro-tales"`)
 
 	channelsFiltered := performRequest(mux, http.MethodGet, "/channels?author=l-you&tag=go&type=short")
 	channelsFilteredBody := requireBody(t, channelsFiltered.Body)
@@ -761,6 +1001,38 @@ func TestSidebarLinkBehavior(t *testing.T) {
 	require.Contains(t, channelsSingleBody, `class="back-link channels-back-button" href="/author/l-you"`)
 }
 
+func TestNavigationModelDrivesChannelAndFooterLinks(t *testing.T) {
+	model, err := navigation.Parse([]byte(`{
+		"showChannels": false,
+		"types": [{"type": "short", "label": {"text": {"en": "Quick notes", "uk": "Швидкі нотатки"}}}],
+		"footer": [{
+			"prefix": {"key": "layout.footer.opensourcePrefix"},
+			"label": {"text": {"en": "Mirror"}},
+			"href": "https://example.com/mirror"
+		}],
+		"showStack": false
+	}`))
+	require.NoError(t, err)
+	testSrv := newTestServerWithOptions(t, testServerOptions{navigation: &model})
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, "Quick notes")
+	require.Contains(t, body, `href="/micro-tales"`)
+	require.NotContains(t, body, `href="/tales"`)
+	require.NotContains(t, body, `class="mobile-channels-button"`)
+	require.Contains(t, body, `href="https://example.com/mirror"`)
+	require.Contains(t, body, "Mirror")
+	require.NotContains(t, body, "https://github.com/RevoTale/blog")
+	require.NotContains(t, body, "stack:")
+
+	recUK := performRequest(mux, http.MethodGet, "/uk")
+	require.Equal(t, http.StatusOK, recUK.Code)
+	require.Contains(t, requireBody(t, recUK.Body), "Швидкі нотатки")
+}
+
 func TestI18nRoutingAndLocalizedURLs(t *testing.T) {
 	testSrv := newTestServer(t)
 	mux := testSrv.handler
@@ -799,11 +1071,11 @@ func TestHandlerHTMXRoutesReturnPartial(t *testing.T) {
 		path        string
 		mustContain string
 	}{
-		{path: "/", mustContain: "<section class=\"context-panel\">"},
-		{path: "/author/l-you", mustContain: "<section class=\"context-panel\">"},
-		{path: "/tag/go", mustContain: "<section class=\"context-panel\">"},
-		{path: "/tales", mustContain: "<section class=\"context-panel\">"},
-		{path: "/micro-tales", mustContain: "<section class=\"context-panel\">"},
+		{path: "/", mustContain: "<section id=\"notes-context\" class=\"context-panel\">"},
+		{path: "/author/l-you", mustContain: "<section id=\"notes-context\" class=\"context-panel\">"},
+		{path: "/tag/go", mustContain: "<section id=\"notes-context\" class=\"context-panel\">"},
+		{path: "/tales", mustContain: "<section id=\"notes-context\" class=\"context-panel\">"},
+		{path: "/micro-tales", mustContain: "<section id=\"notes-context\" class=\"context-panel\">"},
 	}
 
 	for _, tc := range cases {
@@ -820,6 +1092,44 @@ func TestHandlerHTMXRoutesReturnPartial(t *testing.T) {
 	}
 }
 
+func TestLiveFilterTogglePatchesFragmentsOnly(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	root := performRequest(mux, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, root.Code)
+	rootBody := requireBody(t, root.Body)
+	require.Contains(t, rootBody, `hx-get="/tag/go?__live=filter"`)
+	require.Contains(
+		t,
+		rootBody,
+		`hx-select-oob="#notes-context,#notes-pagination,#notes-more,#channel-list,#topbar-context,#topbar-search"`,
+	)
+	require.Equal(t, 1, strings.Count(rootBody, `id="channel-list"`))
+
+	navigation := performRequestWithHeaders(mux, http.MethodGet, "/?__live=navigation", map[string]string{
+		"HX-Request": "true",
+	})
+	require.Equal(t, http.StatusOK, navigation.Code)
+	navigationBody := requireBody(t, navigation.Body)
+	require.Contains(t, navigationBody, `id="notes-list"`)
+	require.Contains(t, navigationBody, `id="notes-pagination"`)
+	require.NotContains(t, navigationBody, `id="channel-list"`)
+
+	filter := performRequestWithHeaders(mux, http.MethodGet, "/tag/go?__live=filter", map[string]string{
+		"HX-Request": "true",
+	})
+	require.Equal(t, http.StatusOK, filter.Code)
+	filterBody := requireBody(t, filter.Body)
+	require.Contains(t, filterBody, `id="notes-context"`)
+	require.Contains(t, filterBody, `data-filter-state="|go|all"`)
+	require.Contains(t, filterBody, `<div id="channel-list" class="channel-scroll" data-filter-state="|go|all">`)
+	require.Contains(t, filterBody, `id="topbar-context"`)
+	require.Contains(t, filterBody, `id="topbar-search"`)
+	require.NotContains(t, filterBody, `class="server-rail"`)
+	require.NotContains(t, filterBody, `class="guild-header"`)
+}
+
 func TestHandlerSEOMetadataAndHTMXPatchHeaders(t *testing.T) {
 	testSrv := newTestServer(t)
 	mux := testSrv.handler
@@ -904,6 +1214,7 @@ func TestHandlerSEOMetadataAndHTMXPatchHeaders(t *testing.T) {
 	require.Equal(t, http.StatusOK, recChannels.Code)
 	channelsBody := requireBody(t, recChannels.Body)
 	require.Contains(t, channelsBody, `name="robots" content="noindex, follow"`)
+	require.Contains(t, channelsBody, `href="/channels.opml?locale=en" type="text/x-opml">All feeds as OPML</a>`)
 	channelsDocs := parseJSONLDScripts(t, channelsBody)
 	require.Len(t, channelsDocs, 0)
 
@@ -1002,6 +1313,27 @@ func TestHandlerLovelyEyeAnalyticsRequiresBothValues(t *testing.T) {
 	}
 }
 
+func TestSocialCardsFollowPageImage(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `property="og:type" content="article"`)
+	require.Contains(t, body, `property="og:image"`)
+	require.Contains(t, body, `name="twitter:card" content="summary_large_image"`)
+	require.Contains(t, body, `name="twitter:site" content="@RevoTale"`)
+
+	rec = performRequest(mux, http.MethodGet, "/tags")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, `property="og:type" content="website"`)
+	require.Contains(t, body, `property="og:url" content="https://revotale.com/blog/notes/tags"`)
+	require.Contains(t, body, `name="twitter:card" content="summary"`)
+	require.NotContains(t, body, `property="og:image"`)
+}
+
 func TestHandlerImageLoaderEnabledTransformsTemplateAndSEOImages(t *testing.T) {
 	testSrv := newTestServerWithImageLoader(t, true)
 	mux := testSrv.handler
@@ -1039,8 +1371,10 @@ func TestPagerLinksIncludeHTMXNavigationActions(t *testing.T) {
 	require.Equal(t, http.StatusOK, recNext.Code)
 	nextBody := requireBody(t, recNext.Body)
 	require.Contains(t, nextBody, `hx-get="/?__live=navigation&amp;author=l-you&amp;page=2&amp;tag=go&amp;type=short"`)
-	require.Contains(t, nextBody, `hx-target="#notes-content"`)
-	require.Contains(t, nextBody, `hx-select="#notes-content"`)
+	require.Contains(t, nextBody, `hx-target="#notes-list"`)
+	require.Contains(t, nextBody, `hx-select="#notes-list"`)
+	require.Contains(t, nextBody, `data-live-scroll="top"`)
+	require.Contains(t, nextBody, `hx-select-oob="#notes-context,#notes-pagination,#notes-more"`)
 	require.Contains(t, nextBody, `hx-swap="outerHTML"`)
 
 	recSearch := performRequest(mux, http.MethodGet, "/?q=hello&author=l-you&tag=go&type=short")
@@ -1057,6 +1391,120 @@ func TestPagerLinksIncludeHTMXNavigationActions(t *testing.T) {
 	require.Contains(t, nextBody, testSrv.bundle.URL("app.js"))
 }
 
+func TestRootPagePinsFeaturedNotesAboveTheFeed(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	featuredAt := strings.Index(body, `id="notes-featured"`)
+	require.Positive(t, featuredAt)
+	require.Less(t, featuredAt, strings.Index(body, `id="notes-list"`))
+	require.Contains(t, body, `href="/note/pinned-note"`)
+
+	for _, target := range []string{"/?page=2", "/tag/go"} {
+		rec = performRequest(mux, http.MethodGet, target)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NotContains(t, requireBody(t, rec.Body), `id="notes-featured"`, target)
+	}
+}
+
+func TestSeriesPageListsPartsInOrder(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/series/go-basics")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, "<h1>Go Basics</h1>")
+	require.Contains(t, body, "Start here.")
+	olderAt := strings.Index(body, `href="/note/hello-older"`)
+	require.Positive(t, olderAt)
+	require.Less(t, olderAt, strings.Index(body, `href="/note/hello-next"`))
+
+	rec = performRequest(mux, http.MethodGet, "/series/missing")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestNotePageShowsSeriesNavigation(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `href="/series/go-basics"`)
+	require.Contains(t, body, "Part 2 of 3")
+	require.Contains(t, body, `class="note-series-previous" href="/note/hello-older"`)
+	require.Contains(t, body, `class="note-series-next" href="/note/hello-next"`)
+
+	rec = performRequest(mux, http.MethodGet, "/note/isolated")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotContains(t, requireBody(t, rec.Body), `class="note-series"`)
+}
+
+func TestPagesRenderTheChosenTheme(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `<html lang="en">`)
+	require.Contains(t, body, `<meta name="color-scheme" content="light dark">`)
+	require.Contains(t, body, `<form class="footer-themes" method="post" action="/.theme">`)
+
+	rec = performRequestWithHeaders(mux, http.MethodGet, "/", map[string]string{"Cookie": "theme=light"})
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, `<html lang="en" class="theme-light">`)
+	require.Contains(t, body, `<meta name="color-scheme" content="light">`)
+	require.Contains(t, body, `value="light" aria-pressed="true"`)
+	require.Equal(t, "private, no-store", rec.Header().Get("Cache-Control"))
+}
+
+func TestNotesListAppendsNextPageOnScroll(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/?author=l-you&tag=go&type=short")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `<div id="notes-more" class="notes-more" aria-hidden="true"><div class="notes-more-trigger"`)
+	require.Contains(t, body, `hx-get="/?__live=append&amp;author=l-you&amp;page=2&amp;tag=go&amp;type=short"`)
+	require.Contains(t, body, `hx-trigger="revealed"`)
+	require.Contains(t, body, `hx-select="#notes-list > *"`)
+	require.Contains(t, body, `hx-select-oob="#notes-more,#notes-pagination"`)
+	require.Contains(t, body, `hx-swap="beforeend"`)
+	require.Contains(t, body, `class="pager-link" href="/?author=l-you&amp;page=2&amp;tag=go&amp;type=short"`)
+
+	last := performRequest(mux, http.MethodGet, "/?page=2&author=l-you&tag=go&type=short")
+	require.Equal(t, http.StatusOK, last.Code)
+	require.Contains(t, requireBody(t, last.Body), `<div id="notes-more" class="notes-more" aria-hidden="true"></div>`)
+}
+
+func TestPagesDeclareSurrogateKeys(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	note := performRequest(mux, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, note.Code)
+	require.Equal(t, "note:hello-world author:l-you tag:go list:series", note.Header().Get("Surrogate-Key"))
+	require.Equal(t, "note:hello-world,author:l-you,tag:go,list:series", note.Header().Get("Cache-Tag"))
+
+	tag := performRequest(mux, http.MethodGet, "/tag/go")
+	require.Equal(t, http.StatusOK, tag.Code)
+	tagKeys := strings.Fields(tag.Header().Get("Surrogate-Key"))
+	require.Contains(t, tagKeys, "list:notes")
+	require.Contains(t, tagKeys, "tag:go")
+	require.Contains(t, tagKeys, "note:hello-world")
+
+	missing := performRequest(mux, http.MethodGet, "/note/missing")
+	require.Equal(t, http.StatusNotFound, missing.Code)
+	require.Empty(t, missing.Header().Get("Surrogate-Key"))
+}
+
 func TestHandlerNotFoundAndHealth(t *testing.T) {
 	testSrv := newTestServer(t)
 	mux := testSrv.handler
@@ -1089,6 +1537,13 @@ func TestHandlerNotFoundAndHealth(t *testing.T) {
 	require.Contains(t, missingNoteBody, "404 Not Found</title>")
 	require.Contains(t, missingNoteBody, "/note/missing")
 
+	recRemovedNote := performRequest(mux, http.MethodGet, "/note/removed")
+	require.Equal(t, http.StatusNotFound, recRemovedNote.Code)
+	require.Contains(t, requireBody(t, recRemovedNote.Body), "404 Not Found</title>")
+
+	recRestrictedNote := performRequest(mux, http.MethodGet, "/note/restricted")
+	require.Equal(t, http.StatusForbidden, recRestrictedNote.Code)
+
 	recMissingAuthor := performRequest(mux, http.MethodGet, "/author/missing")
 	require.Equal(t, http.StatusNotFound, recMissingAuthor.Code)
 	missingAuthorBody := requireBody(t, recMissingAuthor.Body)
@@ -1126,6 +1581,22 @@ func TestHTTPServerSupportsAppOwnedEndpoints(t *testing.T) {
 	require.Contains(t, feedBody, "<rss")
 	require.Contains(t, feedBody, "Hello World")
 
+	recAuthorFeed := performRequest(mux, http.MethodGet, "/author/l-you/feed.xml?locale=en")
+	require.Equal(t, http.StatusOK, recAuthorFeed.Code)
+	require.Contains(t, recAuthorFeed.Header().Get("Content-Type"), "application/rss+xml")
+	authorFeedBody := requireBody(t, recAuthorFeed.Body)
+	require.Contains(t, authorFeedBody, "RevoTale Notes by L You")
+	require.Contains(t, authorFeedBody, "https://revotale.com/blog/notes/author/l-you/feed.xml?locale=en")
+	require.Contains(t, authorFeedBody, "Hello World")
+
+	recTagFeed := performRequest(mux, http.MethodGet, "/tag/go/feed.xml")
+	require.Equal(t, http.StatusOK, recTagFeed.Code)
+	require.Contains(t, requireBody(t, recTagFeed.Body), "RevoTale Notes in #Go")
+
+	recMissingFeed := performRequest(mux, http.MethodGet, "/author/missing/feed.xml")
+	require.Equal(t, http.StatusOK, recMissingFeed.Code)
+	require.NotContains(t, requireBody(t, recMissingFeed.Body), "<item>")
+
 	recSitemap := performRequest(mux, http.MethodGet, "/sitemap-index.xml")
 	require.Equal(t, http.StatusOK, recSitemap.Code)
 	require.Contains(t, recSitemap.Header().Get("Content-Type"), "application/xml")
@@ -1141,6 +1612,76 @@ func TestHTTPServerSupportsAppOwnedEndpoints(t *testing.T) {
 	require.Contains(t, robotsBody, "Sitemap: https://revotale.com/blog/notes/sitemap-index.xml")
 }
 
+func TestRobotsTXTHonorsConfiguredRulesAndNoIndex(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{
+		robots: discovery.RobotsConfig{Allow: []string{"/"}, Disallow: []string{"/.admin/"}},
+	})
+	rec := performRequest(testSrv.handler, http.MethodGet, "/robots.txt")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, "Allow: /\n")
+	require.Contains(t, body, "Disallow: /.admin/")
+	require.Contains(t, body, "Sitemap: https://revotale.com/blog/notes/sitemap-index.xml")
+
+	testSrv = newTestServerWithOptions(t, testServerOptions{
+		robots: discovery.RobotsConfig{Allow: []string{"/"}, NoIndex: true},
+	})
+	rec = performRequest(middleware.WithNoIndex(true)(testSrv.handler), http.MethodGet, "/robots.txt")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "noindex, nofollow", rec.Header().Get("X-Robots-Tag"))
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, "Disallow: /\n")
+	require.NotContains(t, body, "Allow: /")
+	require.NotContains(t, body, "Sitemap:")
+}
+
+func TestEmbeddedStaticAssetsAreServedWithoutDiskManifest(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{embedStatic: true})
+	mux := testSrv.handler
+
+	home := performRequest(mux, http.MethodGet, "/")
+	require.Equal(t, http.StatusOK, home.Code)
+	stylesheetURL := testSrv.bundle.URL("tui.css")
+	require.Contains(t, requireBody(t, home.Body), stylesheetURL)
+
+	rec := performRequest(mux, http.MethodGet, stylesheetURL)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "public, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+	require.Contains(t, rec.Header().Get("Content-Type"), "text/css")
+	require.NotEmpty(t, requireBody(t, rec.Body))
+}
+
+func TestNotePageRendersWhenAdjacentAndRelatedNotesFail(t *testing.T) {
+	testSrv := newTestServer(t)
+
+	rec := performRequest(testSrv.handler, http.MethodGet, "/note/isolated")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, "Isolated")
+	require.NotContains(t, body, "Hello Older")
+}
+
+func TestPanickingLoaderAnswersServerError(t *testing.T) {
+	testSrv := newTestServer(t)
+
+	rec := performRequest(testSrv.handler, http.MethodGet, "/note/explode")
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	after := performRequest(testSrv.handler, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, after.Code)
+}
+
+func TestStalledLoaderAnswersGatewayTimeout(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{requestTimeout: 20 * time.Millisecond})
+
+	rec := performRequest(testSrv.handler, http.MethodGet, "/note/stalled")
+	require.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+	after := performRequest(testSrv.handler, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, after.Code)
+}
+
 func TestHTTPServerExtraRoutesHookAllowsManualRoutes(t *testing.T) {
 	testSrv := newTestServerWithOptions(t, testServerOptions{
 		mountExtraRoutes: func(mux *http.ServeMux) error {
@@ -1160,3 +1701,202 @@ func TestHTTPServerExtraRoutesHookAllowsManualRoutes(t *testing.T) {
 	recGenerated := performRequest(testSrv.handler, http.MethodGet, "/")
 	require.Equal(t, http.StatusOK, recGenerated.Code)
 }
+
+func postForm(mux http.Handler, path string, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestNotePageRendersWhenCommentsFail(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{enableComments: true})
+
+	rec := performRequest(testSrv.handler, http.MethodGet, "/note/isolated")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, "Isolated")
+	require.Contains(t, body, `<h2 id="comments-heading">Comments</h2>`)
+	require.Contains(t, body, "Comments can&#39;t be loaded right now.")
+	require.Contains(t, body, `<form class="comment-form" method="post" action="/note/isolated/comments">`)
+}
+
+func TestNotePageRendersApprovedCommentsAndForm(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{enableComments: true})
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `<section id="comments" class="panel note-comments"`)
+	require.Contains(t, body, `<h2 id="comments-heading">Comments (12)</h2>`)
+	require.Contains(t, body, `<strong class="comment-author">Reader</strong>`)
+	require.Contains(t, body, `Nice &lt;b&gt;note&lt;/b&gt;`)
+	require.Contains(t, body, `<time class="message-time" datetime="2024-01-03T10:00:00Z">2024-01-03</time>`)
+	require.Contains(t, body, `<form class="comment-form" method="post" action="/note/hello-world/comments">`)
+	require.Contains(t, body, `name="website"`)
+	require.NotContains(t, body, `class="comment-notice"`)
+
+	noticeRec := performRequest(mux, http.MethodGet, "/note/hello-world?comment=pending")
+	require.Equal(t, http.StatusOK, noticeRec.Code)
+	noticeBody := requireBody(t, noticeRec.Body)
+	require.Contains(t, noticeBody, `class="comment-notice" role="status" data-status="pending"`)
+	require.Contains(t, noticeBody, "awaiting moderation")
+
+	disabled := newTestServer(t)
+	disabledRec := performRequest(disabled.handler, http.MethodGet, "/note/hello-world")
+	require.NotContains(t, requireBody(t, disabledRec.Body), `class="panel note-comments"`)
+}
+
+func TestNotePageLoadsMoreCommentsLive(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{enableComments: true})
+	mux := testSrv.handler
+
+	rec := performRequest(mux, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `<ol id="note-comments-list" class="comment-list">`)
+	require.Contains(t, body, `id="comment-comment-10"`)
+	require.NotContains(t, body, `id="comment-comment-11"`)
+	require.Contains(t, body, `href="/note/hello-world?comments=20#comments"`)
+	require.Contains(t, body, `hx-get="/note/hello-world?__live=navigation&amp;comments=20"`)
+	require.Contains(t, body, `hx-target="#note-comments-list"`)
+	require.Contains(t, body, `hx-select-oob="#comments-more"`)
+	require.Contains(t, body, `data-live-scroll="preserve"`)
+	require.Contains(t, body, "Load more comments")
+
+	liveRec := performRequest(mux, http.MethodGet, "/note/hello-world?__live=navigation&comments=20")
+	require.Equal(t, http.StatusOK, liveRec.Code)
+	liveBody := requireBody(t, liveRec.Body)
+	require.Contains(t, liveBody, `id="comment-comment-12"`)
+	require.Contains(t, liveBody, `<div id="comments-more" class="comments-more"></div>`)
+	require.Contains(t, liveBody, `rel="canonical" href="https://revotale.com/blog/notes/note/hello-world"`)
+}
+
+func TestCommentSubmissionRedirectsWithModerationStatus(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{enableComments: true})
+	mux := testSrv.handler
+
+	pending := postForm(mux, "/note/hello-world/comments", url.Values{
+		"name": {"Reader"},
+		"body": {"Great post"},
+	})
+	require.Equal(t, http.StatusSeeOther, pending.Code)
+	require.Equal(t, "/note/hello-world?comment=pending#comments", pending.Header().Get("Location"))
+
+	approved := postForm(mux, "/uk/note/hello-world/comments", url.Values{
+		"name":  {"Trusted"},
+		"email": {"trusted@example.com"},
+		"body":  {"Great post"},
+	})
+	require.Equal(t, http.StatusSeeOther, approved.Code)
+	require.Equal(t, "/uk/note/hello-world?comment=approved#comments", approved.Header().Get("Location"))
+
+	honeypot := postForm(mux, "/note/hello-world/comments", url.Values{
+		"name":    {"bot"},
+		"body":    {"buy now"},
+		"website": {"https://spam.example"},
+	})
+	require.Equal(t, http.StatusSeeOther, honeypot.Code)
+	require.Equal(t, "/note/hello-world?comment=pending#comments", honeypot.Header().Get("Location"))
+}
+
+func TestCommentSubmissionRerendersFormWithErrors(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{enableComments: true})
+	mux := testSrv.handler
+
+	rec := postForm(mux, "/note/hello-world/comments", url.Values{
+		"name":  {"Reader"},
+		"email": {"not-an-email"},
+		"body":  {""},
+	})
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, `name="robots" content="noindex, nofollow"`)
+	require.Contains(t, body, `<h1 class="note-detail-title">Check your comment</h1>`)
+	require.Contains(t, body, `<a class="back-link" href="/note/hello-world">`)
+	require.Contains(t, body, `value="Reader"`)
+	require.Contains(t, body, `value="not-an-email"`)
+	require.Contains(t, body, "This field is required.")
+	require.Contains(t, body, "This value is not valid.")
+}
+
+func TestCommentEndpointRequiresEnabledCommentsAndKnownNote(t *testing.T) {
+	disabled := newTestServer(t)
+	rec := postForm(disabled.handler, "/note/hello-world/comments", url.Values{"name": {"Reader"}, "body": {"Hi"}})
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	testSrv := newTestServerWithOptions(t, testServerOptions{enableComments: true})
+	missing := postForm(testSrv.handler, "/note/missing/comments", url.Values{"name": {"Reader"}, "body": {"Hi"}})
+	require.Equal(t, http.StatusNotFound, missing.Code)
+
+	getRec := performRequest(testSrv.handler, http.MethodGet, "/note/hello-world/comments")
+	require.Equal(t, http.StatusMethodNotAllowed, getRec.Code)
+	require.Contains(t, getRec.Header().Get("Allow"), http.MethodPost)
+}
+
+func TestFormPostsRejectCrossSiteRequests(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{
+		enableComments: true,
+		mountExtraRoutes: func(mux *http.ServeMux) error {
+			theme.NewHandler().Register(mux)
+			return nil
+		},
+	})
+
+	form := url.Values{"name": {"Reader"}, "body": {"Hi"}}
+	req := httptest.NewRequest(http.MethodPost, "/note/hello-world/comments", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Sec-Fetch-Site", "cross-site")
+	rec := httptest.NewRecorder()
+	testSrv.handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/.theme", strings.NewReader("theme=dark"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Origin", "https://evil.example")
+	rec = httptest.NewRecorder()
+	testSrv.handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Empty(t, rec.Result().Cookies())
+}
+
+func TestMaintenanceModeServesLocalizedUnavailablePage(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{maintenance: true})
+
+	rec := performRequest(testSrv.handler, http.MethodGet, "/note/hello-world")
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Equal(t, "300", rec.Header().Get("Retry-After"))
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, "503 Under maintenance")
+	require.Contains(t, body, `<h1 class="not-found-title">Back shortly</h1>`)
+	require.Contains(t, body, `name="robots" content="noindex, nofollow"`)
+
+	rec = performRequest(testSrv.handler, http.MethodGet, "/de/")
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	body = requireBody(t, rec.Body)
+	require.Contains(t, body, `<html lang="de"`)
+	require.Contains(t, body, "Gleich wieder da")
+
+	rec = performRequest(testSrv.handler, http.MethodGet, "/healthz")
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestCMSFailuresServeBadGatewayPage(t *testing.T) {
+	testSrv := newTestServer(t)
+
+	rec := performRequest(testSrv.handler, http.MethodGet, "/note/cms-down")
+	require.Equal(t, http.StatusBadGateway, rec.Code)
+	require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+	body := requireBody(t, rec.Body)
+	require.Contains(t, body, "502 CMS unavailable")
+	require.Contains(t, body, `<h1 class="not-found-title">CMS temporarily unavailable</h1>`)
+
+	rec = performRequest(testSrv.handler, http.MethodGet, "/de/note/cms-down")
+	require.Equal(t, http.StatusBadGateway, rec.Code)
+	require.Contains(t, requireBody(t, rec.Body), `<html lang="de"`)
+
+	rec = performRequest(testSrv.handler, http.MethodGet, "/note/restricted")
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -0,0 +1,156 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// TestRenderedPagesPassHTMLLint runs the structural checks of requireValidHTML
+// over every kind of page the fake CMS can serve.
+func TestRenderedPagesPassHTMLLint(t *testing.T) {
+	testSrv := newTestServerWithOptions(t, testServerOptions{enableComments: true})
+
+	for _, path := range []string{
+		"/",
+		"/?page=2",
+		"/tales",
+		"/micro-tales",
+		"/note/hello-world",
+		"/author/l-you",
+		"/tag/go",
+		"/tags",
+		"/channels",
+		"/archive",
+		"/uk/note/hello-world",
+		"/missing-route",
+	} {
+		t.Run(path, func(t *testing.T) {
+			rec := performRequest(testSrv.handler, http.MethodGet, path)
+			require.Less(t, rec.Code, http.StatusInternalServerError)
+			requireValidHTML(t, requireBody(t, rec.Body))
+		})
+	}
+}
+
+func TestHTMLLintReportsProblems(t *testing.T) {
+	problems := htmlLintProblems(t, `<!doctype html><html><body>
+		<h1>One</h1><h1>Two</h1>
+		<p id="dup"></p><p id="dup"></p>
+		<img src="/a.png">
+		<input name="q">
+		<label for="named">Name</label><input id="named" name="name">
+		<label>Body <textarea name="body"></textarea></label>
+		<input type="hidden" name="token">
+		<input name="search" aria-label="Search">
+	</body></html>`)
+
+	require.ElementsMatch(t, []string{
+		`duplicate id "dup"`,
+		"2 h1 elements, want 1",
+		`img "/a.png" has no alt attribute`,
+		`input "q" has no label`,
+	}, problems)
+}
+
+// requireValidHTML fails when a full page breaks a structural invariant:
+// duplicate ids, images without alt, anything but one h1, or form controls
+// without an accessible label.
+func requireValidHTML(t *testing.T, body string) {
+	t.Helper()
+
+	require.Empty(t, htmlLintProblems(t, body))
+}
+
+func htmlLintProblems(t *testing.T, body string) []string {
+	t.Helper()
+
+	document, err := html.Parse(strings.NewReader(body))
+	require.NoError(t, err)
+
+	var problems []string
+	ids := map[string]int{}
+	labelled := map[string]bool{}
+	headings := 0
+	var controls []*html.Node
+	for node := range document.Descendants() {
+		if node.Type != html.ElementNode {
+			continue
+		}
+		if id, ok := attribute(node, "id"); ok {
+			ids[id]++
+			if ids[id] == 2 {
+				problems = append(problems, `duplicate id "`+id+`"`)
+			}
+		}
+
+		switch node.DataAtom {
+		case atom.H1:
+			headings++
+		case atom.Img:
+			if _, ok := attribute(node, "alt"); !ok {
+				src, _ := attribute(node, "src")
+				problems = append(problems, `img "`+src+`" has no alt attribute`)
+			}
+		case atom.Label:
+			if target, ok := attribute(node, "for"); ok {
+				labelled[target] = true
+			}
+		case atom.Input, atom.Select, atom.Textarea:
+			controls = append(controls, node)
+		}
+	}
+	if headings != 1 {
+		problems = append(problems, strconv.Itoa(headings)+" h1 elements, want 1")
+	}
+
+	for _, control := range controls {
+		if controlHasLabel(control, labelled) {
+			continue
+		}
+		name, _ := attribute(control, "name")
+		problems = append(problems, control.Data+` "`+name+`" has no label`)
+	}
+
+	return problems
+}
+
+// controlHasLabel accepts a label element pointing at the control, a wrapping
+// label, or an aria label. Hidden and button-like inputs need none.
+func controlHasLabel(control *html.Node, labelled map[string]bool) bool {
+	if inputType, _ := attribute(control, "type"); control.DataAtom == atom.Input {
+		switch strings.ToLower(inputType) {
+		case "hidden", "submit", "button", "reset", "image":
+			return true
+		}
+	}
+	if id, ok := attribute(control, "id"); ok && labelled[id] {
+		return true
+	}
+	for _, key := range []string{"aria-label", "aria-labelledby", "title"} {
+		if value, ok := attribute(control, key); ok && strings.TrimSpace(value) != "" {
+			return true
+		}
+	}
+	for parent := control.Parent; parent != nil; parent = parent.Parent {
+		if parent.DataAtom == atom.Label {
+			return true
+		}
+	}
+
+	return false
+}
+
+func attribute(node *html.Node, key string) (string, bool) {
+	for _, attr := range node.Attr {
+		if attr.Namespace == "" && attr.Key == key {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,213 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	runtime "blog/web/view"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+)
+
+const liveSwapTarget = "#notes-list"
+
+type liveMode struct {
+	name      string
+	buildURL  func(pageURL string) string
+	selectors string
+}
+
+var (
+	liveNavigationMode = liveMode{
+		name:      "navigation",
+		buildURL:  runtime.BuildHTMXNavigationURL,
+		selectors: runtime.LivePagerFragments,
+	}
+	liveFilterMode = liveMode{
+		name:      "filter",
+		buildURL:  runtime.BuildHTMXFilterURL,
+		selectors: runtime.LiveFilterFragments,
+	}
+)
+
+func TestLivePatchesMatchFullRender(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	cases := []struct {
+		path string
+		mode liveMode
+	}{
+		{path: "/", mode: liveNavigationMode},
+		{path: "/", mode: liveFilterMode},
+		{path: "/?page=2", mode: liveNavigationMode},
+		{path: "/tag/go", mode: liveFilterMode},
+		{path: "/tales", mode: liveFilterMode},
+		{path: "/micro-tales", mode: liveNavigationMode},
+		{path: "/?q=hello", mode: liveFilterMode},
+		{path: "/uk/tag/go", mode: liveFilterMode},
+		{path: "/author/l-you", mode: liveNavigationMode},
+		{path: "/author/l-you/tales", mode: liveNavigationMode},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.mode.name+" "+tc.path, func(t *testing.T) {
+			requireLivePatchParity(t, mux, tc.path, tc.mode)
+		})
+	}
+}
+
+// TestLiveTriggersPatchEveryFragmentFromOneResponse follows every htmx trigger
+// on a page and checks that the single live response it requests carries each
+// fragment named in the trigger's hx-select and hx-select-oob exactly once.
+func TestLiveTriggersPatchEveryFragmentFromOneResponse(t *testing.T) {
+	testSrv := newTestServer(t)
+	mux := testSrv.handler
+
+	for _, path := range []string{"/", "/?page=2", "/tag/go"} {
+		full := performRequest(mux, http.MethodGet, path)
+		require.Equal(t, http.StatusOK, full.Code, path)
+		document, err := html.Parse(strings.NewReader(requireBody(t, full.Body)))
+		require.NoError(t, err)
+
+		triggers := 0
+		for node := range document.Descendants() {
+			liveURL, selectors, ok := liveTrigger(node)
+			if !ok {
+				continue
+			}
+			triggers++
+
+			ids := liveFragmentIDs(selectors)
+			require.Greater(t, len(ids), 2, "%s on %s patches a single fragment", liveURL, path)
+
+			live := performRequestWithHeaders(mux, http.MethodGet, liveURL, map[string]string{
+				"HX-Request": "true",
+			})
+			require.Equal(t, http.StatusOK, live.Code, liveURL)
+			liveDocument, err := html.Parse(strings.NewReader(requireBody(t, live.Body)))
+			require.NoError(t, err)
+			for _, id := range ids {
+				require.Equal(t, 1, countElementsByID(liveDocument, id), "#%s in %s", id, liveURL)
+			}
+		}
+		require.Positive(t, triggers, "no live triggers on %s", path)
+	}
+}
+
+func liveTrigger(node *html.Node) (string, string, bool) {
+	if node.Type != html.ElementNode {
+		return "", "", false
+	}
+
+	liveURL, selectors := "", ""
+	for _, attr := range node.Attr {
+		switch attr.Key {
+		case "hx-get":
+			liveURL = attr.Val
+		case "hx-select-oob":
+			selectors = attr.Val
+		}
+	}
+
+	return liveURL, selectors, liveURL != "" && selectors != ""
+}
+
+func countElementsByID(document *html.Node, id string) int {
+	count := 0
+	for node := range document.Descendants() {
+		if node.Type == html.ElementNode && hasAttribute(node, "id", id) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestExtractElementByID(t *testing.T) {
+	body := `<main><div id="outer" class="a"><div><span>x</span></div><div id="inner">y</div></div><p>z</p></main>`
+
+	outer, ok := extractElementByID(body, "outer")
+	require.True(t, ok)
+	require.Equal(t, `<div id="outer" class="a"><div><span>x</span></div><div id="inner">y</div></div>`, outer)
+
+	inner, ok := extractElementByID(body, "inner")
+	require.True(t, ok)
+	require.Equal(t, `<div id="inner">y</div>`, inner)
+
+	_, ok = extractElementByID(body, "missing")
+	require.False(t, ok)
+}
+
+// requireLivePatchParity renders path as a full document and as the live patch
+// the client would request for mode, then asserts that every fragment swapped by
+// the patch is byte-for-byte identical to the same region of the full page.
+func requireLivePatchParity(t *testing.T, mux http.Handler, path string, mode liveMode) {
+	t.Helper()
+
+	full := performRequest(mux, http.MethodGet, path)
+	require.Equal(t, http.StatusOK, full.Code, "full render of %s", path)
+	fullBody := requireBody(t, full.Body)
+
+	liveURL := mode.buildURL(path)
+	live := performRequestWithHeaders(mux, http.MethodGet, liveURL, map[string]string{
+		"HX-Request": "true",
+	})
+	require.Equal(t, http.StatusOK, live.Code, "live render of %s", liveURL)
+	liveBody := requireBody(t, live.Body)
+
+	for _, id := range liveFragmentIDs(mode.selectors) {
+		fullFragment, ok := extractElementByID(fullBody, id)
+		require.True(t, ok, "full render of %s is missing #%s", path, id)
+
+		liveFragment, ok := extractElementByID(liveBody, id)
+		require.True(t, ok, "live render of %s is missing #%s", liveURL, id)
+
+		require.Equal(t, fullFragment, liveFragment, "#%s drifted between %s and %s", id, path, liveURL)
+	}
+}
+
+func liveFragmentIDs(selectors string) []string {
+	ids := []string{strings.TrimPrefix(liveSwapTarget, "#")}
+	for _, selector := range strings.Split(selectors, ",") {
+		selector = strings.TrimSpace(selector)
+		if !strings.HasPrefix(selector, "#") {
+			continue
+		}
+		ids = append(ids, strings.TrimPrefix(selector, "#"))
+	}
+
+	return ids
+}
+
+func extractElementByID(body string, id string) (string, bool) {
+	document, err := html.Parse(strings.NewReader(body))
+	if err != nil {
+		return "", false
+	}
+
+	for node := range document.Descendants() {
+		if node.Type != html.ElementNode || !hasAttribute(node, "id", id) {
+			continue
+		}
+
+		var rendered strings.Builder
+		if err := html.Render(&rendered, node); err != nil {
+			return "", false
+		}
+		return rendered.String(), true
+	}
+
+	return "", false
+}
+
+func hasAttribute(node *html.Node, key string, value string) bool {
+	for _, attr := range node.Attr {
+		if attr.Namespace == "" && attr.Key == key && attr.Val == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,66 @@
+package web
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"blog/internal/notes"
+	"blog/web/components"
+	messages "blog/web/generated/i18n/messages"
+)
+
+// BenchmarkRenderPages measures whole requests: route match, loaders against
+// the fake CMS, markdown rendering and the templ output. Run with
+// task go:bench to get allocation counts.
+func BenchmarkRenderPages(b *testing.B) {
+	testSrv := newTestServer(b)
+
+	for _, bench := range []struct {
+		name string
+		path string
+	}{
+		{name: "home", path: "/"},
+		{name: "note", path: "/note/hello-world"},
+		{name: "author", path: "/author/l-you"},
+		{name: "tags", path: "/tags"},
+		{name: "archive", path: "/archive"},
+		{name: "feed", path: "/feed.xml"},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				rec := performRequest(testSrv.handler, http.MethodGet, bench.path)
+				if rec.Code != http.StatusOK {
+					b.Fatalf("GET %s: status %d", bench.path, rec.Code)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkRenderNoteCard isolates the templ output of the component every
+// listing repeats.
+func BenchmarkRenderNoteCard(b *testing.B) {
+	i18nCtx := messages.NewContext(nil, nil)
+	note := notes.NoteSummary{
+		ID:             "note-1",
+		Slug:           "hello-world",
+		Title:          "Hello World",
+		Excerpt:        "A short note about rendering pages without a client bundle.",
+		PublishedAt:    "January 2, 2024",
+		PublishedAtISO: "2024-01-02T00:00:00Z",
+		WordCount:      420,
+		ReadingMinutes: 2,
+		Authors:        []notes.Author{{Name: "L You", Slug: "l-you"}},
+		Tags:           []notes.Tag{{Name: "go", Title: "Go"}},
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := components.NoteCard(i18nCtx, note).Render(context.Background(), io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
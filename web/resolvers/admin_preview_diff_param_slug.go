@@ -0,0 +1,29 @@
+package resolvers
+
+import (
+	"context"
+	"net/http"
+
+	"blog/web/seo"
+	"blog/web/view"
+	"github.com/RevoTale/no-js/framework"
+	"github.com/RevoTale/no-js/framework/metagen"
+)
+
+func (Resolver) MetaGenAdminPreviewDiffParamSlugPage(
+	meta framework.MetaContext[*runtime.Context],
+	params AdminPreviewDiffParamSlugParams,
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
+	return seo.MetaGenNoteDiffPage(meta, params.Slug)
+}
+
+func (Resolver) ResolveAdminPreviewDiffParamSlugPage(
+	ctx context.Context,
+	appCtx *runtime.Context,
+	r *http.Request,
+	params AdminPreviewDiffParamSlugParams,
+) (_ runtime.NoteDiffPageView, err error) {
+	defer recoverResolverPanic(&err)
+	return runtime.LoadNoteDiffPage(ctx, appCtx, r, framework.SlugParams{Slug: params.Slug})
+}
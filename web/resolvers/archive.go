@@ -0,0 +1,29 @@
+package resolvers
+
+import (
+	"context"
+	"net/http"
+
+	"blog/web/seo"
+	"blog/web/view"
+	"github.com/RevoTale/no-js/framework"
+	"github.com/RevoTale/no-js/framework/metagen"
+)
+
+func (Resolver) MetaGenArchivePage(
+	meta framework.MetaContext[*runtime.Context],
+	_ ArchiveParams,
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
+	return seo.MetaGenArchivePage(meta)
+}
+
+func (Resolver) ResolveArchivePage(
+	ctx context.Context,
+	appCtx *runtime.Context,
+	r *http.Request,
+	_ ArchiveParams,
+) (_ runtime.ArchivePageView, err error) {
+	defer recoverResolverPanic(&err)
+	return runtime.LoadArchivePage(ctx, appCtx, r, framework.EmptyParams{})
+}
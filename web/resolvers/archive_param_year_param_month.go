@@ -0,0 +1,34 @@
+package resolvers
+
+import (
+	"context"
+	"net/http"
+
+	"blog/web/seo"
+	"blog/web/view"
+	"github.com/RevoTale/no-js/framework"
+	"github.com/RevoTale/no-js/framework/metagen"
+)
+
+func (Resolver) MetaGenArchiveParamYearParamMonthPage(
+	meta framework.MetaContext[*runtime.Context],
+	params ArchiveParamYearParamMonthParams,
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
+	return seo.MetaGenArchiveMonthPage(meta, runtime.ArchivePeriodParams{Year: params.Year, Month: params.Month})
+}
+
+func (Resolver) ResolveArchiveParamYearParamMonthPage(
+	ctx context.Context,
+	appCtx *runtime.Context,
+	r *http.Request,
+	params ArchiveParamYearParamMonthParams,
+) (_ runtime.ArchivePageView, err error) {
+	defer recoverResolverPanic(&err)
+	return runtime.LoadArchiveMonthPage(
+		ctx,
+		appCtx,
+		r,
+		runtime.ArchivePeriodParams{Year: params.Year, Month: params.Month},
+	)
+}
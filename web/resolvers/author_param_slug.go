@@ -13,7 +13,8 @@ import (
 func (Resolver) MetaGenAuthorParamSlugLayout(
 	meta framework.MetaContext[*runtime.Context],
 	_ AuthorParamSlugParams,
-) (metagen.Metadata, error) {
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
 	_ = meta
 	return metagen.Metadata{}, nil
 }
@@ -21,7 +22,8 @@ func (Resolver) MetaGenAuthorParamSlugLayout(
 func (Resolver) MetaGenAuthorParamSlugPage(
 	meta framework.MetaContext[*runtime.Context],
 	params AuthorParamSlugParams,
-) (metagen.Metadata, error) {
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
 	return seo.MetaGenAuthorPage(meta, params.Slug)
 }
 
@@ -30,6 +32,7 @@ func (Resolver) ResolveAuthorParamSlugPage(
 	appCtx *runtime.Context,
 	r *http.Request,
 	params AuthorParamSlugParams,
-) (runtime.AuthorPageView, error) {
+) (_ runtime.AuthorPageView, err error) {
+	defer recoverResolverPanic(&err)
 	return runtime.LoadAuthorPage(ctx, appCtx, r, framework.SlugParams{Slug: params.Slug})
 }
@@ -0,0 +1,29 @@
+package resolvers
+
+import (
+	"context"
+	"net/http"
+
+	"blog/web/seo"
+	"blog/web/view"
+	"github.com/RevoTale/no-js/framework"
+	"github.com/RevoTale/no-js/framework/metagen"
+)
+
+func (Resolver) MetaGenAuthorParamSlugMicroTalesPage(
+	meta framework.MetaContext[*runtime.Context],
+	params AuthorParamSlugMicroTalesParams,
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
+	return seo.MetaGenAuthorMicroTalesPage(meta, params.Slug)
+}
+
+func (Resolver) ResolveAuthorParamSlugMicroTalesPage(
+	ctx context.Context,
+	appCtx *runtime.Context,
+	r *http.Request,
+	params AuthorParamSlugMicroTalesParams,
+) (_ runtime.AuthorPageView, err error) {
+	defer recoverResolverPanic(&err)
+	return runtime.LoadAuthorMicroTalesPage(ctx, appCtx, r, framework.SlugParams{Slug: params.Slug})
+}
@@ -0,0 +1,29 @@
+package resolvers
+
+import (
+	"context"
+	"net/http"
+
+	"blog/web/seo"
+	"blog/web/view"
+	"github.com/RevoTale/no-js/framework"
+	"github.com/RevoTale/no-js/framework/metagen"
+)
+
+func (Resolver) MetaGenAuthorParamSlugTalesPage(
+	meta framework.MetaContext[*runtime.Context],
+	params AuthorParamSlugTalesParams,
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
+	return seo.MetaGenAuthorTalesPage(meta, params.Slug)
+}
+
+func (Resolver) ResolveAuthorParamSlugTalesPage(
+	ctx context.Context,
+	appCtx *runtime.Context,
+	r *http.Request,
+	params AuthorParamSlugTalesParams,
+) (_ runtime.AuthorPageView, err error) {
+	defer recoverResolverPanic(&err)
+	return runtime.LoadAuthorTalesPage(ctx, appCtx, r, framework.SlugParams{Slug: params.Slug})
+}
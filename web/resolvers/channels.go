@@ -13,7 +13,8 @@ import (
 func (Resolver) MetaGenChannelsPage(
 	meta framework.MetaContext[*runtime.Context],
 	_ ChannelsParams,
-) (metagen.Metadata, error) {
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
 	return seo.MetaGenChannelsPage(meta)
 }
 
@@ -22,6 +23,7 @@ func (Resolver) ResolveChannelsPage(
 	appCtx *runtime.Context,
 	r *http.Request,
 	_ ChannelsParams,
-) (runtime.NotesPageView, error) {
+) (_ runtime.NotesPageView, err error) {
+	defer recoverResolverPanic(&err)
 	return runtime.LoadChannelsPage(ctx, appCtx, r, framework.EmptyParams{})
 }
@@ -12,10 +12,30 @@ import (
 type RootParams struct {
 }
 
+type AdminPreviewDiffParamSlugParams struct {
+	Slug string
+}
+
+type ArchiveParams struct {
+}
+
+type ArchiveParamYearParamMonthParams struct {
+	Year  string
+	Month string
+}
+
 type AuthorParamSlugParams struct {
 	Slug string
 }
 
+type AuthorParamSlugMicroTalesParams struct {
+	Slug string
+}
+
+type AuthorParamSlugTalesParams struct {
+	Slug string
+}
+
 type ChannelsParams struct {
 }
 
@@ -26,10 +46,17 @@ type NoteParamSlugParams struct {
 	Slug string
 }
 
+type SeriesParamSlugParams struct {
+	Slug string
+}
+
 type TagParamSlugParams struct {
 	Slug string
 }
 
+type TagsParams struct {
+}
+
 type TalesParams struct {
 }
 
@@ -37,18 +64,32 @@ type RouteResolver interface {
 	MetaGenRootLayout(meta framework.MetaContext[*runtime.Context]) (metagen.Metadata, error)
 	MetaGenAuthorParamSlugLayout(meta framework.MetaContext[*runtime.Context], params AuthorParamSlugParams) (metagen.Metadata, error)
 	MetaGenRootPage(meta framework.MetaContext[*runtime.Context], params RootParams) (metagen.Metadata, error)
+	MetaGenAdminPreviewDiffParamSlugPage(meta framework.MetaContext[*runtime.Context], params AdminPreviewDiffParamSlugParams) (metagen.Metadata, error)
+	MetaGenArchivePage(meta framework.MetaContext[*runtime.Context], params ArchiveParams) (metagen.Metadata, error)
+	MetaGenArchiveParamYearParamMonthPage(meta framework.MetaContext[*runtime.Context], params ArchiveParamYearParamMonthParams) (metagen.Metadata, error)
 	MetaGenAuthorParamSlugPage(meta framework.MetaContext[*runtime.Context], params AuthorParamSlugParams) (metagen.Metadata, error)
+	MetaGenAuthorParamSlugMicroTalesPage(meta framework.MetaContext[*runtime.Context], params AuthorParamSlugMicroTalesParams) (metagen.Metadata, error)
+	MetaGenAuthorParamSlugTalesPage(meta framework.MetaContext[*runtime.Context], params AuthorParamSlugTalesParams) (metagen.Metadata, error)
 	MetaGenChannelsPage(meta framework.MetaContext[*runtime.Context], params ChannelsParams) (metagen.Metadata, error)
 	MetaGenMicroTalesPage(meta framework.MetaContext[*runtime.Context], params MicroTalesParams) (metagen.Metadata, error)
 	MetaGenNoteParamSlugPage(meta framework.MetaContext[*runtime.Context], params NoteParamSlugParams) (metagen.Metadata, error)
+	MetaGenSeriesParamSlugPage(meta framework.MetaContext[*runtime.Context], params SeriesParamSlugParams) (metagen.Metadata, error)
 	MetaGenTagParamSlugPage(meta framework.MetaContext[*runtime.Context], params TagParamSlugParams) (metagen.Metadata, error)
+	MetaGenTagsPage(meta framework.MetaContext[*runtime.Context], params TagsParams) (metagen.Metadata, error)
 	MetaGenTalesPage(meta framework.MetaContext[*runtime.Context], params TalesParams) (metagen.Metadata, error)
 	ResolveRootPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params RootParams) (runtime.NotesPageView, error)
+	ResolveAdminPreviewDiffParamSlugPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params AdminPreviewDiffParamSlugParams) (runtime.NoteDiffPageView, error)
+	ResolveArchivePage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params ArchiveParams) (runtime.ArchivePageView, error)
+	ResolveArchiveParamYearParamMonthPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params ArchiveParamYearParamMonthParams) (runtime.ArchivePageView, error)
 	ResolveAuthorParamSlugPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params AuthorParamSlugParams) (runtime.AuthorPageView, error)
+	ResolveAuthorParamSlugMicroTalesPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params AuthorParamSlugMicroTalesParams) (runtime.AuthorPageView, error)
+	ResolveAuthorParamSlugTalesPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params AuthorParamSlugTalesParams) (runtime.AuthorPageView, error)
 	ResolveChannelsPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params ChannelsParams) (runtime.NotesPageView, error)
 	ResolveMicroTalesPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params MicroTalesParams) (runtime.NotesPageView, error)
 	ResolveNoteParamSlugPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params NoteParamSlugParams) (runtime.NotePageView, error)
+	ResolveSeriesParamSlugPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params SeriesParamSlugParams) (runtime.SeriesPageView, error)
 	ResolveTagParamSlugPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params TagParamSlugParams) (runtime.NotesPageView, error)
+	ResolveTagsPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params TagsParams) (runtime.TagsPageView, error)
 	ResolveTalesPage(ctx context.Context, appCtx *runtime.Context, r *http.Request, params TalesParams) (runtime.NotesPageView, error)
 }
 
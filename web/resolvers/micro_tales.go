@@ -13,7 +13,8 @@ import (
 func (Resolver) MetaGenMicroTalesPage(
 	meta framework.MetaContext[*runtime.Context],
 	_ MicroTalesParams,
-) (metagen.Metadata, error) {
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
 	return seo.MetaGenMicroTalesPage(meta)
 }
 
@@ -22,6 +23,7 @@ func (Resolver) ResolveMicroTalesPage(
 	appCtx *runtime.Context,
 	r *http.Request,
 	_ MicroTalesParams,
-) (runtime.NotesPageView, error) {
+) (_ runtime.NotesPageView, err error) {
+	defer recoverResolverPanic(&err)
 	return runtime.LoadNotesMicroTalesPage(ctx, appCtx, r, framework.EmptyParams{})
 }
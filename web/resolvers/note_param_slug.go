@@ -13,7 +13,8 @@ import (
 func (Resolver) MetaGenNoteParamSlugPage(
 	meta framework.MetaContext[*runtime.Context],
 	params NoteParamSlugParams,
-) (metagen.Metadata, error) {
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
 	return seo.MetaGenNotePage(meta, params.Slug)
 }
 
@@ -22,6 +23,7 @@ func (Resolver) ResolveNoteParamSlugPage(
 	appCtx *runtime.Context,
 	r *http.Request,
 	params NoteParamSlugParams,
-) (runtime.NotePageView, error) {
+) (_ runtime.NotePageView, err error) {
+	defer recoverResolverPanic(&err)
 	return runtime.LoadNotePage(ctx, appCtx, r, framework.SlugParams{Slug: params.Slug})
 }
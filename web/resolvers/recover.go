@@ -0,0 +1,18 @@
+package resolvers
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// recoverResolverPanic turns a panic in a resolver into its error result. The
+// framework runs metadata and Load resolvers on their own goroutines, where a
+// panic would take the whole process down instead of answering a 500.
+func recoverResolverPanic(err *error) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+
+	*err = fmt.Errorf("resolver panic: %v\n%s", recovered, debug.Stack())
+}
@@ -10,7 +10,8 @@ import (
 	"github.com/RevoTale/no-js/framework/metagen"
 )
 
-func (Resolver) MetaGenRootLayout(meta framework.MetaContext[*runtime.Context]) (metagen.Metadata, error) {
+func (Resolver) MetaGenRootLayout(meta framework.MetaContext[*runtime.Context]) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
 	_ = meta
 	return metagen.Metadata{
 		DangerRawHead: []string{runtime.ChromaStyleTag()},
@@ -20,7 +21,8 @@ func (Resolver) MetaGenRootLayout(meta framework.MetaContext[*runtime.Context])
 func (Resolver) MetaGenRootPage(
 	meta framework.MetaContext[*runtime.Context],
 	_ RootParams,
-) (metagen.Metadata, error) {
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
 	return seo.MetaGenRootPage(meta)
 }
 
@@ -29,6 +31,7 @@ func (Resolver) ResolveRootPage(
 	appCtx *runtime.Context,
 	r *http.Request,
 	_ RootParams,
-) (runtime.NotesPageView, error) {
+) (_ runtime.NotesPageView, err error) {
+	defer recoverResolverPanic(&err)
 	return runtime.LoadNotesPage(ctx, appCtx, r, framework.EmptyParams{})
 }
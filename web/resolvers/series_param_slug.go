@@ -0,0 +1,29 @@
+package resolvers
+
+import (
+	"context"
+	"net/http"
+
+	"blog/web/seo"
+	"blog/web/view"
+	"github.com/RevoTale/no-js/framework"
+	"github.com/RevoTale/no-js/framework/metagen"
+)
+
+func (Resolver) MetaGenSeriesParamSlugPage(
+	meta framework.MetaContext[*runtime.Context],
+	params SeriesParamSlugParams,
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
+	return seo.MetaGenSeriesPage(meta, params.Slug)
+}
+
+func (Resolver) ResolveSeriesParamSlugPage(
+	ctx context.Context,
+	appCtx *runtime.Context,
+	r *http.Request,
+	params SeriesParamSlugParams,
+) (_ runtime.SeriesPageView, err error) {
+	defer recoverResolverPanic(&err)
+	return runtime.LoadSeriesPage(ctx, appCtx, r, framework.SlugParams{Slug: params.Slug})
+}
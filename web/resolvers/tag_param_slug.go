@@ -13,7 +13,8 @@ import (
 func (Resolver) MetaGenTagParamSlugPage(
 	meta framework.MetaContext[*runtime.Context],
 	params TagParamSlugParams,
-) (metagen.Metadata, error) {
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
 	return seo.MetaGenTagPage(meta, params.Slug)
 }
 
@@ -22,6 +23,7 @@ func (Resolver) ResolveTagParamSlugPage(
 	appCtx *runtime.Context,
 	r *http.Request,
 	params TagParamSlugParams,
-) (runtime.NotesPageView, error) {
+) (_ runtime.NotesPageView, err error) {
+	defer recoverResolverPanic(&err)
 	return runtime.LoadTagPage(ctx, appCtx, r, framework.SlugParams{Slug: params.Slug})
 }
@@ -0,0 +1,29 @@
+package resolvers
+
+import (
+	"context"
+	"net/http"
+
+	"blog/web/seo"
+	"blog/web/view"
+	"github.com/RevoTale/no-js/framework"
+	"github.com/RevoTale/no-js/framework/metagen"
+)
+
+func (Resolver) MetaGenTagsPage(
+	meta framework.MetaContext[*runtime.Context],
+	_ TagsParams,
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
+	return seo.MetaGenTagsPage(meta)
+}
+
+func (Resolver) ResolveTagsPage(
+	ctx context.Context,
+	appCtx *runtime.Context,
+	r *http.Request,
+	_ TagsParams,
+) (_ runtime.TagsPageView, err error) {
+	defer recoverResolverPanic(&err)
+	return runtime.LoadTagsPage(ctx, appCtx, r, framework.EmptyParams{})
+}
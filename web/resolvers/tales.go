@@ -13,7 +13,8 @@ import (
 func (Resolver) MetaGenTalesPage(
 	meta framework.MetaContext[*runtime.Context],
 	_ TalesParams,
-) (metagen.Metadata, error) {
+) (_ metagen.Metadata, err error) {
+	defer recoverResolverPanic(&err)
 	return seo.MetaGenTalesPage(meta)
 }
 
@@ -22,6 +23,7 @@ func (Resolver) ResolveTalesPage(
 	appCtx *runtime.Context,
 	r *http.Request,
 	_ TalesParams,
-) (runtime.NotesPageView, error) {
+) (_ runtime.NotesPageView, err error) {
+	defer recoverResolverPanic(&err)
 	return runtime.LoadNotesTalesPage(ctx, appCtx, r, framework.EmptyParams{})
 }
@@ -0,0 +1,67 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	"blog/internal/formaction"
+	"blog/web/components"
+	r_root_root "blog/web/generated/r_root_root"
+	"blog/web/seo"
+	runtimeview "blog/web/view"
+	"github.com/RevoTale/no-js/framework"
+)
+
+var commentAction = formaction.ActionModule[
+	*runtimeview.Context,
+	NoteParamSlugCommentsParams,
+	runtimeview.CommentForm,
+]{
+	MaxFormBytes: runtimeview.CommentFormMaxBytes,
+	Validate:     runtimeview.ValidateCommentForm,
+	Run: func(
+		ctx context.Context,
+		appCtx *runtimeview.Context,
+		r *http.Request,
+		params NoteParamSlugCommentsParams,
+		form runtimeview.CommentForm,
+	) (string, error) {
+		return runtimeview.SubmitComment(ctx, appCtx, r, params.Slug, form)
+	},
+	Render: renderCommentForm,
+}
+
+func POST(
+	runtime framework.RuntimeContext[*runtimeview.Context],
+	w http.ResponseWriter,
+	r *http.Request,
+	params NoteParamSlugCommentsParams,
+) error {
+	if !runtime.AppContext().CommentsEnabled() {
+		return framework.ErrNotFound
+	}
+
+	return commentAction.Handle(runtime, w, r, params)
+}
+
+func renderCommentForm(
+	w http.ResponseWriter,
+	submission formaction.Submission[*runtimeview.Context, NoteParamSlugCommentsParams, runtimeview.CommentForm],
+) error {
+	r := submission.Request
+	view, err := runtimeview.LoadCommentFormPage(
+		r.Context(),
+		submission.Runtime.AppContext(),
+		r,
+		submission.Params.Slug,
+		submission.Form,
+		submission.Errors,
+	)
+	if err != nil {
+		return err
+	}
+
+	meta := seo.CommentFormPageMetadata(view)
+	page := r_root_root.RootLayout(meta, view.LocaleCode(), components.CommentFormPage(view))
+	return submission.Runtime.RenderPage(r, w, page, meta)
+}
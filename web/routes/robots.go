@@ -13,5 +13,5 @@ func Robots(
 	runtime framework.RuntimeContext[*runtimeview.Context],
 	r *http.Request,
 ) (frameworkdiscovery.Robots, error) {
-	return blogdiscovery.BuildRobots(resolveDiscoveryRootURL(runtime, r)), nil
+	return blogdiscovery.BuildRobots(resolveDiscoveryRootURL(runtime, r), runtime.AppContext().Robots()), nil
 }
@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+
+	blogdiscovery "blog/internal/discovery"
+	"blog/internal/notes"
+	"blog/internal/surrogate"
+	runtimeview "blog/web/view"
+	"github.com/RevoTale/no-js/framework"
+	frameworkdiscovery "github.com/RevoTale/no-js/framework/discovery"
+)
+
+// Feed serves /tag/<name>/feed.xml, the root feed narrowed to one tag. The
+// other feed filters still apply from the query; like the root feed's tag
+// filter, an unknown tag yields an empty feed.
+func Feed(
+	runtime framework.RuntimeContext[*runtimeview.Context],
+	r *http.Request,
+) (frameworkdiscovery.FeedDocument, error) {
+	appCtx := runtime.AppContext()
+	service := appCtx.Notes()
+	if service == nil {
+		return frameworkdiscovery.FeedDocument{}, fmt.Errorf("notes service unavailable")
+	}
+
+	locale := appCtx.LocaleFromRequest(r.URL.Query().Get("locale"))
+	filter := blogdiscovery.FeedListFilterFromQuery(r.URL.Query())
+	filter.TagNames = []string{blogdiscovery.FeedPathSlug(r.URL.Path)}
+	filter.TagMode = notes.TagMatchAny
+	listResult, err := service.ListNotes(r.Context(), locale, filter, notes.ListOptions{})
+	if err != nil {
+		return frameworkdiscovery.FeedDocument{}, err
+	}
+	runtimeview.DeclareListSurrogateKeys(r.Context(), filter, listResult.Notes, surrogate.ListFeed)
+
+	tag := notes.Tag{Name: filter.TagNames[0]}
+	if listResult.ActiveTag != nil {
+		tag = *listResult.ActiveTag
+	}
+	rootURL := ""
+	if root := runtime.ResolveRoot(r); root != nil {
+		rootURL = root.String()
+	}
+	return blogdiscovery.BuildTagFeedDocument(rootURL, runtime.I18n().Config(), locale, tag, listResult.Notes), nil
+}
@@ -1,12 +1,15 @@
 package seo
 
 import (
+	"context"
 	"fmt"
+	"html"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
+	"blog/internal/middleware"
 	"blog/internal/notes"
 	i18n "blog/web/generated/i18n"
 	"blog/web/view"
@@ -114,11 +117,124 @@ func MetaGenChannelsPage(
 	)
 }
 
+func MetaGenArchivePage(
+	meta framework.MetaContext[*runtime.Context],
+) (metagen.Metadata, error) {
+	view, err := runtime.LoadArchivePage(meta.Context(), meta.App(), meta.Request(), framework.EmptyParams{})
+	if err != nil {
+		return metagen.Metadata{}, err
+	}
+	description := i18n.TSeoArchiveDescription(meta.App().I18n(meta.Request()))
+	return notesListingMetadata(
+		meta,
+		view.NotesPageView,
+		view.PageTitle,
+		description,
+		"website",
+		&metagen.Robots{Index: metagen.Bool(true), Follow: metagen.Bool(true)},
+		false,
+	)
+}
+
+func MetaGenArchiveMonthPage(
+	meta framework.MetaContext[*runtime.Context],
+	params runtime.ArchivePeriodParams,
+) (metagen.Metadata, error) {
+	view, err := runtime.LoadArchiveMonthPage(meta.Context(), meta.App(), meta.Request(), params)
+	if err != nil {
+		return metagen.Metadata{}, err
+	}
+	description := i18n.TSeoArchiveMonthDescription(meta.App().I18n(meta.Request()), i18n.SeoArchiveMonthDescriptionArgs{
+		Period: view.PageTitle,
+	})
+	return notesListingMetadata(
+		meta,
+		view.NotesPageView,
+		view.PageTitle,
+		description,
+		"website",
+		&metagen.Robots{Index: metagen.Bool(true), Follow: metagen.Bool(true)},
+		false,
+	)
+}
+
+func MetaGenSeriesPage(
+	meta framework.MetaContext[*runtime.Context],
+	slug string,
+) (metagen.Metadata, error) {
+	view, err := runtime.LoadSeriesPage(meta.Context(), meta.App(), meta.Request(), framework.SlugParams{Slug: slug})
+	if err != nil {
+		return metagen.Metadata{}, err
+	}
+	description := view.Series.Description
+	if description == "" {
+		description = i18n.TSeoSeriesDescription(meta.App().I18n(meta.Request()), i18n.SeoSeriesDescriptionArgs{
+			Series: view.Series.Title,
+		})
+	}
+	return notesListingMetadata(
+		meta,
+		view.NotesPageView,
+		view.PageTitle,
+		description,
+		"website",
+		&metagen.Robots{Index: metagen.Bool(true), Follow: metagen.Bool(true)},
+		false,
+	)
+}
+
+func MetaGenTagsPage(
+	meta framework.MetaContext[*runtime.Context],
+) (metagen.Metadata, error) {
+	view, err := runtime.LoadTagsPage(meta.Context(), meta.App(), meta.Request(), framework.EmptyParams{})
+	if err != nil {
+		return metagen.Metadata{}, err
+	}
+	description := i18n.TSeoTagsDescription(meta.App().I18n(meta.Request()))
+	return notesListingMetadata(
+		meta,
+		view.NotesPageView,
+		view.PageTitle,
+		description,
+		"website",
+		&metagen.Robots{Index: metagen.Bool(true), Follow: metagen.Bool(true)},
+		false,
+	)
+}
+
 func MetaGenAuthorPage(
 	meta framework.MetaContext[*runtime.Context],
 	slug string,
 ) (metagen.Metadata, error) {
-	view, err := runtime.LoadAuthorPage(meta.Context(), meta.App(), meta.Request(), framework.SlugParams{Slug: slug})
+	return metaGenAuthorListing(meta, slug, notes.NoteTypeAll, runtime.LoadAuthorPage)
+}
+
+func MetaGenAuthorTalesPage(
+	meta framework.MetaContext[*runtime.Context],
+	slug string,
+) (metagen.Metadata, error) {
+	return metaGenAuthorListing(meta, slug, notes.NoteTypeLong, runtime.LoadAuthorTalesPage)
+}
+
+func MetaGenAuthorMicroTalesPage(
+	meta framework.MetaContext[*runtime.Context],
+	slug string,
+) (metagen.Metadata, error) {
+	return metaGenAuthorListing(meta, slug, notes.NoteTypeShort, runtime.LoadAuthorMicroTalesPage)
+}
+
+func metaGenAuthorListing(
+	meta framework.MetaContext[*runtime.Context],
+	slug string,
+	noteType notes.NoteType,
+	load func(
+		ctx context.Context,
+		appCtx *runtime.Context,
+		r *http.Request,
+		params framework.SlugParams,
+	) (runtime.AuthorPageView, error),
+) (metagen.Metadata, error) {
+	view, err := load(meta.Context(), meta.App(), meta.Request(), framework.SlugParams{Slug: slug})
 	if err != nil {
 		return metagen.Metadata{}, err
 	}
@@ -143,6 +259,9 @@ func MetaGenAuthorPage(
 	} else {
 		contentTitle = "Author"
 	}
+	if typeTitle := authorListingTypeTitle(meta, noteType); typeTitle != "" {
+		contentTitle = typeTitle + " | " + contentTitle
+	}
 	title := titleWithSite(contentTitle, site.Name)
 
 	description := i18n.TSeoAuthorDescription(meta.App().I18n(meta.Request()), i18n.SeoAuthorDescriptionArgs{
@@ -156,27 +275,17 @@ func MetaGenAuthorPage(
 	if alternatesErr != nil {
 		return metagen.Metadata{}, alternatesErr
 	}
+	alternates = withViewCanonical(alternates, view)
 	canonicalURL := strings.TrimSpace(alternates.Canonical)
 
-	openGraph := &metagen.OpenGraph{
+	openGraph, twitter := socialCard{
 		Type:        "profile",
 		URL:         canonicalURL,
-		SiteName:    site.Name,
 		Title:       contentTitle,
 		Description: description,
 		Locale:      view.LocaleCode(),
-	}
-	twitter := &metagen.Twitter{
-		Card:        "summary",
-		Site:        "@RevoTale",
-		Title:       contentTitle,
-		Description: description,
-	}
-	if image != nil {
-		openGraph.Images = []metagen.OpenGraphImage{*image}
-		twitter.Card = "summary_large_image"
-		twitter.Images = []string{image.URL}
-	}
+		Image:       image,
+	}.metadata(site)
 
 	authors := []metagen.Author{}
 	if authorName != "" {
@@ -203,6 +312,17 @@ func MetaGenAuthorPage(
 	}), nil
 }
 
+func authorListingTypeTitle(meta framework.MetaContext[*runtime.Context], noteType notes.NoteType) string {
+	switch noteType {
+	case notes.NoteTypeLong:
+		return i18n.TLayoutTitleTales(meta.App().I18n(meta.Request()))
+	case notes.NoteTypeShort:
+		return i18n.TLayoutTitleMicroTales(meta.App().I18n(meta.Request()))
+	default:
+		return ""
+	}
+}
+
 func MetaGenNotePage(
 	meta framework.MetaContext[*runtime.Context],
 	slug string,
@@ -224,28 +344,18 @@ func MetaGenNotePage(
 	if alternatesErr != nil {
 		return metagen.Metadata{}, alternatesErr
 	}
+	alternates = withViewCanonical(alternates, view)
 	canonicalURL := strings.TrimSpace(alternates.Canonical)
 
 	image := noteImage(view.RootURL, view.Note.MetaImage, view.Note.Attachment)
-	openGraph := &metagen.OpenGraph{
+	openGraph, twitter := socialCard{
 		Type:        "article",
 		URL:         canonicalURL,
-		SiteName:    site.Name,
 		Title:       contentTitle,
 		Description: description,
 		Locale:      view.LocaleCode(),
-	}
-	twitter := &metagen.Twitter{
-		Card:        "summary",
-		Site:        "@RevoTale",
-		Title:       contentTitle,
-		Description: description,
-	}
-	if image != nil {
-		openGraph.Images = []metagen.OpenGraphImage{*image}
-		twitter.Card = "summary_large_image"
-		twitter.Images = []string{image.URL}
-	}
+		Image:       image,
+	}.metadata(site)
 
 	authors := make([]metagen.Author, 0, len(view.Note.Authors))
 	openGraphAuthors := make([]string, 0, len(view.Note.Authors))
@@ -295,6 +405,52 @@ func MetaGenNotePage(
 	}), nil
 }
 
+func MetaGenNoteDiffPage(
+	meta framework.MetaContext[*runtime.Context],
+	slug string,
+) (metagen.Metadata, error) {
+	view, err := runtime.LoadNoteDiffPage(meta.Context(), meta.App(), meta.Request(), framework.SlugParams{Slug: slug})
+	if err != nil {
+		return metagen.Metadata{}, err
+	}
+
+	site := siteInfo(meta.App().I18n(meta.Request()))
+	contentTitle := i18n.TNoteDiffPageTitle(view.I18n())
+	if draftTitle := strings.TrimSpace(view.Draft.Title); draftTitle != "" {
+		contentTitle = draftTitle + " | " + contentTitle
+	}
+
+	return metagen.Normalize(metagen.Metadata{
+		Title:  titleWithSite(contentTitle, site.Name),
+		Robots: &metagen.Robots{Index: metagen.Bool(false), Follow: metagen.Bool(false)},
+	}), nil
+}
+
+func CommentFormPageMetadata(view runtime.CommentFormPageView) metagen.Metadata {
+	site := siteInfo(view.I18n())
+	contentTitle := i18n.TCommentsFormPageTitle(view.I18n())
+	if noteTitle := strings.TrimSpace(view.Note.Title); noteTitle != "" {
+		contentTitle = contentTitle + " | " + noteTitle
+	}
+
+	return metagen.Normalize(metagen.Metadata{
+		Title:  titleWithSite(contentTitle, site.Name),
+		Robots: &metagen.Robots{Index: metagen.Bool(false), Follow: metagen.Bool(false)},
+	})
+}
+
+func UnavailablePageMetadata(view runtime.UnavailablePageView) metagen.Metadata {
+	site := siteInfo(view.I18n())
+	title := i18n.TMaintenancePageTitle(view.I18n())
+	if view.Reason == runtime.UnavailableUpstream {
+		title = i18n.TUpstreamPageTitle(view.I18n())
+	}
+	return metagen.Normalize(metagen.Metadata{
+		Title:  titleWithSite(title, site.Name),
+		Robots: &metagen.Robots{Index: metagen.Bool(false), Follow: metagen.Bool(false)},
+	})
+}
+
 func notesListingMetadata(
 	meta framework.MetaContext[*runtime.Context],
 	view runtime.NotesPageView,
@@ -320,40 +476,49 @@ func notesListingMetadata(
 	if err != nil {
 		return metagen.Metadata{}, err
 	}
+	alternates = withViewCanonical(alternates, view)
 	canonicalURL := strings.TrimSpace(alternates.Canonical)
 
 	image := firstListingImage(view.RootURL, view.Notes)
-	openGraph := &metagen.OpenGraph{
-		Type:        strings.TrimSpace(openGraphType),
+	openGraph, twitter := socialCard{
+		Type:        openGraphType,
 		URL:         canonicalURL,
-		SiteName:    site.Name,
 		Title:       contentTitle,
 		Description: description,
 		Locale:      view.LocaleCode(),
-	}
-	twitter := &metagen.Twitter{
-		Card:        "summary",
-		Site:        "@RevoTale",
-		Title:       contentTitle,
-		Description: description,
-	}
-	if image != nil {
-		openGraph.Images = []metagen.OpenGraphImage{*image}
-		twitter.Card = "summary_large_image"
-		twitter.Images = []string{image.URL}
-	}
+		Image:       image,
+	}.metadata(site)
 
 	return metagen.Normalize(metagen.Metadata{
-		Title:       title,
-		Description: description,
-		Alternates:  alternates,
-		Robots:      notesListingRobots(meta.Request(), view.Filter, robots),
-		OpenGraph:   openGraph,
-		Twitter:     twitter,
-		Publisher:   site.Publisher,
+		Title:         title,
+		Description:   description,
+		Alternates:    alternates,
+		Robots:        notesListingRobots(meta.Request(), view.Filter, robots),
+		OpenGraph:     openGraph,
+		Twitter:       twitter,
+		Publisher:     site.Publisher,
+		DangerRawHead: paginationLinks(meta.Context(), view),
 	}), nil
 }
 
+// paginationLinks renders rel="prev"/"next" link tags for a listing page and
+// declares the same links for the Link response header.
+func paginationLinks(ctx context.Context, view runtime.RootLayoutView) []string {
+	var tags []string
+	for _, link := range []struct{ rel, href string }{
+		{rel: "prev", href: view.PrevPageURL()},
+		{rel: "next", href: view.NextPageURL()},
+	} {
+		if link.href == "" {
+			continue
+		}
+		middleware.AddLink(ctx, link.href, link.rel)
+		tags = append(tags, `<link rel="`+link.rel+`" href="`+html.EscapeString(link.href)+`">`)
+	}
+
+	return tags
+}
+
 func notesListingRobots(r *http.Request, filter notes.ListFilter, base *metagen.Robots) *metagen.Robots {
 	robots := base
 	if robots == nil {
@@ -371,7 +536,10 @@ func notesListingRobots(r *http.Request, filter notes.ListFilter, base *metagen.
 }
 
 func shouldNoIndexListingRequest(r *http.Request, filter notes.ListFilter) bool {
-	if strings.TrimSpace(filter.Query) != "" || activeListingFilterCount(filter) > 1 {
+	if strings.TrimSpace(filter.Query) != "" {
+		return true
+	}
+	if activeListingFilterCount(filter) > 1 && !isAuthorTypeListing(filter) {
 		return true
 	}
 
@@ -380,10 +548,10 @@ func shouldNoIndexListingRequest(r *http.Request, filter notes.ListFilter) bool
 
 func activeListingFilterCount(filter notes.ListFilter) int {
 	count := 0
-	if strings.TrimSpace(filter.AuthorSlug) != "" {
+	if len(filter.AuthorSlugs) > 0 {
 		count++
 	}
-	if strings.TrimSpace(filter.TagName) != "" {
+	if len(filter.TagNames) > 0 {
 		count++
 	}
 	if notes.ParseNoteType(string(filter.Type)) != notes.NoteTypeAll {
@@ -393,6 +561,12 @@ func activeListingFilterCount(filter notes.ListFilter) int {
 	return count
 }
 
+func isAuthorTypeListing(filter notes.ListFilter) bool {
+	return len(filter.AuthorSlugs) == 1 &&
+		len(filter.TagNames) == 0 &&
+		notes.ParseNoteType(string(filter.Type)) != notes.NoteTypeAll
+}
+
 func requestHasUnknownListingQuery(r *http.Request) bool {
 	if r == nil || r.URL == nil {
 		return false
@@ -431,6 +605,10 @@ func requestHasQuery(r *http.Request) bool {
 	return strings.TrimSpace(r.URL.RawQuery) != ""
 }
 
+const twitterSiteHandle = "@RevoTale"
+const twitterCardSummary = "summary"
+const twitterCardLargeImage = "summary_large_image"
+
 type siteMetadata struct {
 	Name        string
 	Description string
@@ -449,6 +627,41 @@ func siteInfo(i18nCtx frameworki18n.Context[i18n.Key]) siteMetadata {
 	}
 }
 
+// socialCard is the shared OpenGraph/Twitter view-model; pages fill it and the
+// layout metadata renders both tag families from the same values.
+type socialCard struct {
+	Type        string
+	URL         string
+	Title       string
+	Description string
+	Locale      string
+	Image       *metagen.OpenGraphImage
+}
+
+func (card socialCard) metadata(site siteMetadata) (*metagen.OpenGraph, *metagen.Twitter) {
+	openGraph := &metagen.OpenGraph{
+		Type:        strings.TrimSpace(card.Type),
+		URL:         strings.TrimSpace(card.URL),
+		SiteName:    site.Name,
+		Title:       card.Title,
+		Description: card.Description,
+		Locale:      card.Locale,
+	}
+	twitter := &metagen.Twitter{
+		Card:        twitterCardSummary,
+		Site:        twitterSiteHandle,
+		Title:       card.Title,
+		Description: card.Description,
+	}
+	if card.Image != nil && strings.TrimSpace(card.Image.URL) != "" {
+		openGraph.Images = []metagen.OpenGraphImage{*card.Image}
+		twitter.Card = twitterCardLargeImage
+		twitter.Images = []string{card.Image.URL}
+	}
+
+	return openGraph, twitter
+}
+
 func titleWithSite(pageTitle string, siteName string) string {
 	trimmedPage := strings.TrimSpace(pageTitle)
 	trimmedSite := strings.TrimSpace(siteName)
@@ -472,6 +685,17 @@ func buildAlternates(
 	return meta.Alternates(locale, alternateTypes)
 }
 
+func withViewCanonical(alternates metagen.Alternates, view runtime.RootLayoutView) metagen.Alternates {
+	if view == nil {
+		return alternates
+	}
+	if canonical := strings.TrimSpace(view.CanonicalURL()); canonical != "" {
+		alternates.Canonical = canonical
+	}
+
+	return alternates
+}
+
 func notesRSSAlternateTypes(meta framework.MetaContext[*runtime.Context], locale string) map[string]string {
 	if meta == nil {
 		return nil
@@ -69,11 +69,18 @@ func BuildAuthorJSONLD(view runtime.AuthorPageView) map[string]any {
 	if image := authorAvatarImageObject(view.RootURL, author.Avatar); image != nil {
 		doc["image"] = image
 	}
+	if view.AuthorProfile != nil && len(view.AuthorProfile.Links) > 0 {
+		sameAs := make([]string, 0, len(view.AuthorProfile.Links))
+		for _, link := range view.AuthorProfile.Links {
+			sameAs = append(sameAs, link.URL)
+		}
+		doc["sameAs"] = sameAs
+	}
 	return doc
 }
 
 func BuildNoteJSONLD(view runtime.NotePageView) map[string]any {
-	canonicalURL := strings.TrimSpace(view.CanonicalURL)
+	canonicalURL := strings.TrimSpace(view.CanonicalURL())
 	if canonicalURL == "" {
 		canonicalURL = absoluteLocalizedURLForRoot(
 			view.RootURL,
@@ -130,7 +137,7 @@ func BuildNoteJSONLD(view runtime.NotePageView) map[string]any {
 }
 
 func BuildNotesBlogJSONLD(view runtime.NotesPageView) map[string]any {
-	canonicalURL := strings.TrimSpace(view.CanonicalURL)
+	canonicalURL := strings.TrimSpace(view.CanonicalURL())
 	if canonicalURL == "" {
 		canonicalURL = absoluteLocalizedURLForRoot(view.RootURL, view.I18n(), "/")
 	}
@@ -354,7 +361,7 @@ func localizeStructuredDataPath(
 }
 
 func authorCanonicalURL(view runtime.AuthorPageView, authorSlug string) string {
-	if canonical := strings.TrimSpace(view.CanonicalURL); canonical != "" {
+	if canonical := strings.TrimSpace(view.CanonicalURL()); canonical != "" {
 		return canonical
 	}
 	return absoluteLocalizedURLForRoot(view.RootURL, view.I18n(), "/author/"+strings.TrimSpace(authorSlug))
@@ -0,0 +1 @@
+(()=>{const a=new WeakMap,l='[data-metagen-managed="true"]',i=t=>{const e=document.createElement("textarea");e.value=t,e.setAttribute("readonly",""),e.style.position="fixed",e.style.left="-9999px",e.style.opacity="0",document.body.appendChild(e),e.focus(),e.select(),e.setSelectionRange(0,e.value.length);try{return document.execCommand("copy")}catch{return!1}finally{document.body.removeChild(e)}},d=async t=>{if(navigator.clipboard&&typeof navigator.clipboard.writeText=="function")try{return await navigator.clipboard.writeText(t),!0}catch{return i(t)}return i(t)},s=(t,e)=>{const o=e==="copied"?t.dataset.copiedLabel||"copied":t.dataset.copyLabel||"copy",n=t.querySelector(".code-copy-button-label");n&&(n.textContent=o),t.dataset.copyState=e};document.addEventListener("click",async t=>{const e=t.target;if(!(e instanceof Element))return;const o=e.closest(".code-copy-button");if(!(o instanceof HTMLButtonElement))return;const n=o.closest(".code-block");if(!(n instanceof HTMLElement))return;const r=n.querySelector(".code-copy-source");if(!(r instanceof HTMLTextAreaElement)||!await d(r.value))return;s(o,"copied");const c=a.get(o);typeof c=="number"&&window.clearTimeout(c);const f=window.setTimeout(()=>{s(o,"idle"),a.delete(o)},2e3);a.set(o,f)});const u=t=>{const e=t.requestConfig&&t.requestConfig.elt;if(e instanceof HTMLElement&&e.dataset.liveScroll)return e.dataset.liveScroll;const o=t.target;return o instanceof HTMLElement&&o.id==="notes-list"?"top":"preserve"};document.addEventListener("htmx:afterSettle",t=>{const e=t&&t.detail;if(!e)return;const o=u(e);if(o==="top"){window.scrollTo({top:0,left:0,behavior:"smooth"});return}if(!o.startsWith("#"))return;const n=document.getElementById(o.slice(1));n&&n.scrollIntoView({block:"start",behavior:"smooth"})}),document.addEventListener("htmx:historyRestore",()=>{const t=document.getElementById("notes-list"),e=document.getElementById("channel-list");!t||!e||t.dataset.filterState!==e.dataset.filterState&&window.location.reload()}),document.addEventListener("metagen:patch",t=>{const e=t&&t.detail;if(!e||typeof e!="object"||(typeof e.title=="string"&&e.title.trim()!==""&&(document.title=e.title),typeof e.head!="string"))return;document.head.querySelectorAll(l).forEach(c=>c.remove());const n=e.head.trim();if(n==="")return;const r=document.createElement("template");r.innerHTML=n,Array.from(r.content.childNodes).forEach(c=>{c.nodeType===Node.ELEMENT_NODE&&document.head.appendChild(c)})})})();
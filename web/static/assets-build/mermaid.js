@@ -0,0 +1 @@
+(()=>{const e=document.currentScript,t=e instanceof HTMLScriptElement?e.dataset.mermaidSrc:"";if(!t)return;const r=document.documentElement.classList,n=r.contains("theme-dark")||!r.contains("theme-light")&&window.matchMedia("(prefers-color-scheme: dark)").matches;import(t).then(a=>{const c=a.default;return c.initialize({startOnLoad:!1,securityLevel:"strict",theme:n?"dark":"default"}),c.run({querySelector:"pre.mermaid:not([data-processed])"})}).catch(()=>{})})();
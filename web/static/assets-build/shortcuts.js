@@ -0,0 +1 @@
+(()=>{const s='[data-shortcut="feed-item"]',a='[data-shortcut="newer-note"]',i='[data-shortcut="older-note"]',u=e=>e instanceof HTMLElement?e.isContentEditable?!0:e.closest("input, textarea, select, [contenteditable]")!==null:!1,o=e=>{const t=Array.from(document.querySelectorAll(s));if(t.length===0)return!1;const l=t.indexOf(document.activeElement);let r=l+e;if(l<0&&(r=e>0?0:t.length-1),r<0||r>=t.length)return!0;const n=t[r];return n.focus({preventScroll:!0}),(n.closest("article")||n).scrollIntoView({block:"center",behavior:"smooth"}),!0},c=e=>{const t=document.querySelector(e);return t instanceof HTMLAnchorElement?(t.click(),!0):!1};document.addEventListener("keydown",e=>{if(e.defaultPrevented||e.altKey||e.ctrlKey||e.metaKey||e.shiftKey||u(e.target))return;let t=!1;switch(e.key){case"j":t=o(1);break;case"k":t=o(-1);break;case"ArrowLeft":t=c(a);break;case"ArrowRight":t=c(i);break}t&&e.preventDefault()})})();
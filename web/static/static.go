@@ -0,0 +1,18 @@
+// Package static embeds the hashed asset bundle written by
+// `go tool no-js gen assets`, for binaries that run with BLOG_EMBED_STATIC.
+package static
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// BuildDir is where the asset bundle is written, relative to the module root.
+const BuildDir = "web/static/assets-build"
+
+//go:embed all:assets-build
+var assetsBuild embed.FS
+
+func Assets() (fs.FS, error) {
+	return fs.Sub(assetsBuild, "assets-build")
+}
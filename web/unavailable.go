@@ -0,0 +1,35 @@
+package web
+
+import (
+	"io"
+	"net/http"
+
+	"blog/web/components"
+	r_root_root "blog/web/generated/r_root_root"
+	"blog/web/seo"
+	runtime "blog/web/view"
+)
+
+// RenderMaintenancePage renders the 503 page for middleware.WithMaintenance.
+func RenderMaintenancePage(appContext *runtime.Context) func(io.Writer, *http.Request) error {
+	return renderUnavailablePage(appContext, runtime.UnavailableMaintenance)
+}
+
+// RenderUpstreamErrorPage renders the 502 page for
+// middleware.WithUpstreamErrors.
+func RenderUpstreamErrorPage(appContext *runtime.Context) func(io.Writer, *http.Request) error {
+	return renderUnavailablePage(appContext, runtime.UnavailableUpstream)
+}
+
+// renderUnavailablePage renders the page in the root layout. It loads no CMS
+// data, so it works while the CMS is down or being migrated.
+func renderUnavailablePage(
+	appContext *runtime.Context,
+	reason runtime.UnavailableReason,
+) func(io.Writer, *http.Request) error {
+	return func(w io.Writer, r *http.Request) error {
+		view := runtime.LoadUnavailablePage(appContext, r, reason)
+		page := r_root_root.RootLayout(seo.UnavailablePageMetadata(view), view.LocaleCode(), components.UnavailablePage(view))
+		return page.Render(r.Context(), w)
+	}
+}
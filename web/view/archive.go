@@ -0,0 +1,250 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog/internal/middleware"
+	"blog/internal/notes"
+	"blog/internal/surrogate"
+	i18n "blog/web/generated/i18n"
+	"github.com/RevoTale/no-js/framework"
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
+)
+
+const archivePath = "/archive"
+const minArchiveYear = 1970
+const maxArchiveYear = 9999
+
+type ArchivePeriodParams struct {
+	Year  string
+	Month string
+}
+
+type ArchivePageView struct {
+	NotesPageView
+	Archive notes.ArchiveIndex
+	Period  notes.ArchiveMonth
+}
+
+func (v ArchivePageView) HasPeriod() bool {
+	return v.Period.Year > 0
+}
+
+func (v ArchivePageView) IsPeriod(month notes.ArchiveMonth) bool {
+	return v.HasPeriod() && v.Period.Year == month.Year && v.Period.Month == month.Month
+}
+
+func LoadArchivePage(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	_ framework.EmptyParams,
+) (ArchivePageView, error) {
+	locale := localeFromRequest(appCtx, r)
+	cacheKey := loaderCacheKey("LoadArchivePage", locale, r)
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (ArchivePageView, error) {
+		service, err := notesService(appCtx)
+		if err != nil {
+			return ArchivePageView{}, err
+		}
+
+		index, err := service.GetArchiveIndex(runCtx)
+		if err != nil {
+			return ArchivePageView{}, err
+		}
+		middleware.AddSurrogateKeys(runCtx, surrogate.ListArchive)
+
+		view := newArchivePageView(appCtx, r, locale, index)
+		view.PageTitle = i18n.TArchiveTitle(view.I18n())
+		view.ContextTitle = view.PageTitle
+		return view, nil
+	})
+}
+
+func LoadArchiveMonthPage(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	params ArchivePeriodParams,
+) (ArchivePageView, error) {
+	year, month, ok := ParseArchivePeriod(params.Year, params.Month)
+	if !ok {
+		return ArchivePageView{}, notes.ErrNotFound
+	}
+
+	locale := localeFromRequest(appCtx, r)
+	cacheKey := loaderCacheKey("LoadArchiveMonthPage", locale, r, strconv.Itoa(year), strconv.Itoa(int(month)))
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (ArchivePageView, error) {
+		service, err := notesService(appCtx)
+		if err != nil {
+			return ArchivePageView{}, err
+		}
+
+		index, err := service.GetArchiveIndex(runCtx)
+		if err != nil {
+			return ArchivePageView{}, err
+		}
+		period, ok := index.Find(year, month)
+		if !ok {
+			return ArchivePageView{}, notes.ErrNotFound
+		}
+
+		page := 1
+		if r != nil && r.URL != nil {
+			page = parsePage(r.URL.Query().Get("page"))
+		}
+		items, totalPages, err := service.ListArchiveNotes(runCtx, locale, year, month, page)
+		if err != nil {
+			return ArchivePageView{}, err
+		}
+		if len(items) == 0 {
+			return ArchivePageView{}, notes.ErrNotFound
+		}
+		DeclareListSurrogateKeys(runCtx, notes.ListFilter{}, items, surrogate.ListArchive)
+
+		view := newArchivePageView(appCtx, r, locale, index)
+		view.Period = period
+		view.Notes = items
+		view.Filter.Page = page
+		view.Pagination = newArchivePaginationView(view.I18n(), period, page, totalPages)
+		view.Authors = uniqueSortedAuthors(collectNoteAuthors(items))
+		view.Tags = uniqueSortedTags(collectNoteTags(items))
+		view.PageTitle = ArchivePeriodLabel(view.I18n(), period)
+		view.ContextTitle = view.PageTitle
+		view.ContextSubtitle = i18n.TArchiveTitle(view.I18n())
+		return view, nil
+	})
+}
+
+func ParseArchivePeriod(rawYear string, rawMonth string) (int, time.Month, bool) {
+	rawYear = strings.TrimSpace(rawYear)
+	rawMonth = strings.TrimSpace(rawMonth)
+	if len(rawYear) != 4 || len(rawMonth) != 2 {
+		return 0, 0, false
+	}
+
+	year, err := strconv.Atoi(rawYear)
+	if err != nil || year < minArchiveYear || year > maxArchiveYear {
+		return 0, 0, false
+	}
+	month, err := strconv.Atoi(rawMonth)
+	if err != nil || month < int(time.January) || month > int(time.December) {
+		return 0, 0, false
+	}
+
+	return year, time.Month(month), true
+}
+
+func BuildArchiveURL(i18nCtx frameworki18n.Context[i18n.Key]) string {
+	return localizePath(i18nCtx, archivePath)
+}
+
+func BuildArchiveMonthURL(i18nCtx frameworki18n.Context[i18n.Key], year int, month time.Month) string {
+	return localizePath(i18nCtx, fmt.Sprintf("%s/%04d/%02d", archivePath, year, int(month)))
+}
+
+// BuildArchiveMonthPageURL links to one page of a month; the first page is the
+// plain month URL.
+func BuildArchiveMonthPageURL(i18nCtx frameworki18n.Context[i18n.Key], year int, month time.Month, page int) string {
+	if page <= 1 {
+		return BuildArchiveMonthURL(i18nCtx, year, month)
+	}
+
+	q := make(url.Values)
+	q.Set("page", strconv.Itoa(page))
+	return buildLocalizedPathWithQuery(i18nCtx, fmt.Sprintf("%s/%04d/%02d", archivePath, year, int(month)), q)
+}
+
+func newArchivePaginationView(
+	i18nCtx frameworki18n.Context[i18n.Key],
+	period notes.ArchiveMonth,
+	page int,
+	totalPages int,
+) PaginationView {
+	return newPaginationViewWithURLs(page, totalPages, func(page int) string {
+		return BuildArchiveMonthPageURL(i18nCtx, period.Year, period.Month, page)
+	})
+}
+
+func ArchivePeriodLabel(i18nCtx frameworki18n.Context[i18n.Key], period notes.ArchiveMonth) string {
+	return ArchiveMonthLabel(i18nCtx, period.Month) + " " + strconv.Itoa(period.Year)
+}
+
+func ArchiveMonthLabel(i18nCtx frameworki18n.Context[i18n.Key], month time.Month) string {
+	switch month {
+	case time.January:
+		return i18n.TArchiveMonthJanuary(i18nCtx)
+	case time.February:
+		return i18n.TArchiveMonthFebruary(i18nCtx)
+	case time.March:
+		return i18n.TArchiveMonthMarch(i18nCtx)
+	case time.April:
+		return i18n.TArchiveMonthApril(i18nCtx)
+	case time.May:
+		return i18n.TArchiveMonthMay(i18nCtx)
+	case time.June:
+		return i18n.TArchiveMonthJune(i18nCtx)
+	case time.July:
+		return i18n.TArchiveMonthJuly(i18nCtx)
+	case time.August:
+		return i18n.TArchiveMonthAugust(i18nCtx)
+	case time.September:
+		return i18n.TArchiveMonthSeptember(i18nCtx)
+	case time.October:
+		return i18n.TArchiveMonthOctober(i18nCtx)
+	case time.November:
+		return i18n.TArchiveMonthNovember(i18nCtx)
+	case time.December:
+		return i18n.TArchiveMonthDecember(i18nCtx)
+	default:
+		return strconv.Itoa(int(month))
+	}
+}
+
+func SidebarArchiveActive(view RootLayoutView) bool {
+	_, ok := view.(ArchivePageView)
+	return ok
+}
+
+func newArchivePageView(appCtx *Context, r *http.Request, locale string, index notes.ArchiveIndex) ArchivePageView {
+	view := ArchivePageView{
+		NotesPageView: NotesPageView{
+			Locale:      locale,
+			I18nCtx:     appCtx.I18n(r),
+			SidebarMode: SidebarModeRoot,
+			Filter: notes.ListFilter{
+				Page: 1,
+				Type: notes.NoteTypeAll,
+			},
+			Notes:   []notes.NoteSummary{},
+			Authors: []notes.Author{},
+			Tags:    []notes.Tag{},
+		},
+		Archive: index,
+	}
+	applyStructuredDataContextForNotesView(&view.NotesPageView, appCtx, r, locale)
+	view.IncludeStructuredData = false
+	return view
+}
+
+func collectNoteAuthors(items []notes.NoteSummary) []notes.Author {
+	out := make([]notes.Author, 0, len(items))
+	for _, item := range items {
+		out = append(out, item.Authors...)
+	}
+	return out
+}
+
+func collectNoteTags(items []notes.NoteSummary) []notes.Tag {
+	out := make([]notes.Tag, 0, len(items))
+	for _, item := range items {
+		out = append(out, item.Tags...)
+	}
+	return out
+}
@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	"blog/internal/imageloader"
+	"blog/internal/markdown"
+	"blog/internal/navigation"
 )
 
 type BootstrapConfig struct {
@@ -11,11 +13,17 @@ type BootstrapConfig struct {
 	ImageLoader         imageloader.Loader
 	LovelyEyeScriptURL  string
 	LovelyEyeSiteID     string
+	MermaidScriptURL    string
+	CodeHighlight       markdown.CodeHighlight
+	Navigation          *navigation.Model
 }
 
 func Initialize(cfg BootstrapConfig) {
 	SetStaticAssetBasePath(cfg.StaticAssetBasePath)
 	SetImageLoader(cfg.ImageLoader)
+	SetNavigation(cfg.Navigation)
+	SetMermaidScriptURL(cfg.MermaidScriptURL)
+	SetCodeHighlight(cfg.CodeHighlight)
 
 	SetLovelyEye(
 		strings.TrimSpace(cfg.LovelyEyeScriptURL),
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strings"
 
+	"blog/internal/notes"
 	webi18n "blog/web/i18n"
 	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
 )
@@ -31,10 +32,25 @@ func WithCanonicalNotesRedirects(next http.Handler) http.Handler {
 			return
 		}
 
-		http.Redirect(w, r, target, http.StatusPermanentRedirect)
+		http.Redirect(w, r, target, canonicalNotesRedirectStatus(strippedPath))
 	})
 }
 
+func canonicalNotesRedirectStatus(strippedPath string) int {
+	normalizedPath := frameworki18n.NormalizePath(strippedPath)
+	for _, prefix := range []string{"/author/", "/tag/"} {
+		if !strings.HasPrefix(normalizedPath, prefix) {
+			continue
+		}
+		slug, _, _ := strings.Cut(strings.TrimPrefix(normalizedPath, prefix), "/")
+		if slug != notes.NormalizeSlug(slug) {
+			return http.StatusMovedPermanently
+		}
+	}
+
+	return http.StatusPermanentRedirect
+}
+
 func canonicalNotesConfig() frameworki18n.Config {
 	cfg, err := frameworki18n.NormalizeConfig(webi18n.Config())
 	if err == nil {
@@ -0,0 +1,18 @@
+package runtime
+
+import (
+	"sync/atomic"
+
+	"blog/internal/markdown"
+)
+
+var codeHighlightValue atomic.Value
+
+func SetCodeHighlight(highlight markdown.CodeHighlight) {
+	codeHighlightValue.Store(highlight)
+}
+
+func ChromaStyleTag() string {
+	highlight, _ := codeHighlightValue.Load().(markdown.CodeHighlight)
+	return "<style>" + string(markdown.ChromaCSS(highlight)) + "</style>"
+}
@@ -0,0 +1,269 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"blog/internal/comments"
+	"blog/internal/formaction"
+	"blog/internal/notes"
+	i18n "blog/web/generated/i18n"
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
+)
+
+const CommentFormMaxBytes = 16 << 10
+const CommentStatusQueryKey = "comment"
+const CommentsAnchor = "comments"
+const CommentsShownQueryKey = "comments"
+const CommentsPageSize = 10
+const LiveCommentsFragments = "#comments-more"
+
+type CommentForm struct {
+	AuthorName  string `form:"name"`
+	AuthorEmail string `form:"email"`
+	Body        string `form:"body"`
+	Website     string `form:"website"`
+}
+
+type CommentsView struct {
+	Enabled bool
+	// Unavailable is set when the comments could not be loaded. The note
+	// still renders, with a notice in place of the list.
+	Unavailable bool
+	Items       []comments.Comment
+	Total       int
+	MoreURL     string
+	Notice      comments.Status
+	ActionURL   string
+	Form        CommentForm
+	Errors      formaction.FieldErrors
+}
+
+type CommentFormPageView struct {
+	Locale   string
+	I18nCtx  frameworki18n.Context[i18n.Key]
+	Note     notes.NoteDetail
+	NoteURL  string
+	Comments CommentsView
+}
+
+func (view CommentFormPageView) I18n() frameworki18n.Context[i18n.Key] {
+	return view.I18nCtx
+}
+
+func (view CommentFormPageView) LocaleCode() string {
+	return localeCode(view.I18nCtx, view.Locale)
+}
+
+func (form CommentForm) IsSpam() bool {
+	return strings.TrimSpace(form.Website) != ""
+}
+
+func (form CommentForm) Submission(noteID string) comments.Submission {
+	return comments.Submission{
+		NoteID:      noteID,
+		AuthorName:  form.AuthorName,
+		AuthorEmail: form.AuthorEmail,
+		Body:        form.Body,
+	}.Normalize()
+}
+
+func ValidateCommentForm(_ context.Context, _ *Context, form CommentForm) formaction.FieldErrors {
+	if form.IsSpam() {
+		return nil
+	}
+
+	problems := form.Submission("").Validate()
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return formaction.FieldErrors(problems)
+}
+
+func SubmitComment(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	slug string,
+	form CommentForm,
+) (string, error) {
+	service, err := commentsService(appCtx)
+	if err != nil {
+		return "", err
+	}
+
+	i18nCtx := appCtx.I18n(r)
+	if form.IsSpam() {
+		return BuildNoteCommentResultURL(i18nCtx, slug, comments.StatusPending), nil
+	}
+
+	note, err := findCommentNote(ctx, appCtx, r, slug)
+	if err != nil {
+		return "", err
+	}
+
+	status, err := service.Submit(ctx, form.Submission(note.ID))
+	if err != nil {
+		return "", err
+	}
+
+	return BuildNoteCommentResultURL(i18nCtx, note.Slug, status), nil
+}
+
+func LoadCommentFormPage(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	slug string,
+	form CommentForm,
+	errs formaction.FieldErrors,
+) (CommentFormPageView, error) {
+	note, err := findCommentNote(ctx, appCtx, r, slug)
+	if err != nil {
+		return CommentFormPageView{}, err
+	}
+
+	i18nCtx := appCtx.I18n(r)
+	return CommentFormPageView{
+		Locale:  localeFromRequest(appCtx, r),
+		I18nCtx: i18nCtx,
+		Note:    *note,
+		NoteURL: localizePath(i18nCtx, "/note/"+note.Slug),
+		Comments: CommentsView{
+			Enabled:   true,
+			ActionURL: BuildNoteCommentsActionURL(i18nCtx, note.Slug),
+			Form:      form,
+			Errors:    errs,
+		},
+	}, nil
+}
+
+func BuildNoteCommentsActionURL(i18nCtx frameworki18n.Context[i18n.Key], slug string) string {
+	return localizePath(i18nCtx, "/note/"+strings.TrimSpace(slug)+"/comments")
+}
+
+func BuildNoteCommentResultURL(
+	i18nCtx frameworki18n.Context[i18n.Key],
+	slug string,
+	status comments.Status,
+) string {
+	q := make(url.Values)
+	if status = comments.ParseStatus(string(status)); status != "" {
+		q.Set(CommentStatusQueryKey, string(status))
+	}
+
+	return buildLocalizedPathWithQuery(i18nCtx, "/note/"+strings.TrimSpace(slug), q) + "#" + CommentsAnchor
+}
+
+func CommentFieldError(i18nCtx frameworki18n.Context[i18n.Key], errs formaction.FieldErrors, field string) string {
+	switch errs[field] {
+	case "":
+		return ""
+	case comments.ProblemRequired:
+		return i18n.TCommentsErrorRequired(i18nCtx)
+	case comments.ProblemTooLong:
+		return i18n.TCommentsErrorTooLong(i18nCtx)
+	default:
+		return i18n.TCommentsErrorInvalid(i18nCtx)
+	}
+}
+
+func CommentNoticeMessage(i18nCtx frameworki18n.Context[i18n.Key], status comments.Status) string {
+	switch status {
+	case comments.StatusApproved:
+		return i18n.TCommentsNoticeApproved(i18nCtx)
+	case comments.StatusPending:
+		return i18n.TCommentsNoticePending(i18nCtx)
+	case comments.StatusRejected, comments.StatusSpam:
+		return i18n.TCommentsNoticeRejected(i18nCtx)
+	default:
+		return ""
+	}
+}
+
+// loadCommentsView loads the comments under note. Comments are secondary to
+// the note, so a failed lookup yields an unavailable view rather than an error.
+func loadCommentsView(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	note notes.NoteDetail,
+) CommentsView {
+	if appCtx == nil || appCtx.comments == nil {
+		return CommentsView{}
+	}
+
+	i18nCtx := appCtx.I18n(r)
+	items, err := appCtx.comments.ListApproved(ctx, note.ID)
+	if err != nil {
+		return CommentsView{
+			Enabled:     true,
+			Unavailable: true,
+			ActionURL:   BuildNoteCommentsActionURL(i18nCtx, note.Slug),
+		}
+	}
+
+	notice := comments.Status("")
+	shown := CommentsPageSize
+	if r != nil && r.URL != nil {
+		query := r.URL.Query()
+		notice = comments.ParseStatus(query.Get(CommentStatusQueryKey))
+		shown = parseCommentsShown(query.Get(CommentsShownQueryKey))
+	}
+
+	view := CommentsView{
+		Enabled:   true,
+		Items:     items,
+		Total:     len(items),
+		Notice:    notice,
+		ActionURL: BuildNoteCommentsActionURL(i18nCtx, note.Slug),
+	}
+	if len(items) > shown {
+		view.Items = items[:shown]
+		view.MoreURL = BuildNoteCommentsMoreURL(i18nCtx, note.Slug, shown+CommentsPageSize)
+	}
+
+	return view
+}
+
+// BuildNoteCommentsMoreURL links to the note with the first shown comments
+// expanded. It doubles as the htmx live navigation target that swaps the
+// comment list in place.
+func BuildNoteCommentsMoreURL(i18nCtx frameworki18n.Context[i18n.Key], slug string, shown int) string {
+	q := make(url.Values)
+	if shown > CommentsPageSize {
+		q.Set(CommentsShownQueryKey, strconv.Itoa(shown))
+	}
+
+	return buildLocalizedPathWithQuery(i18nCtx, "/note/"+strings.TrimSpace(slug), q)
+}
+
+func parseCommentsShown(raw string) int {
+	shown, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || shown < CommentsPageSize {
+		return CommentsPageSize
+	}
+
+	return shown
+}
+
+func findCommentNote(ctx context.Context, appCtx *Context, r *http.Request, slug string) (*notes.NoteDetail, error) {
+	service, err := notesService(appCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	rootURL := resolvedRootURL(appCtx, r)
+	return service.GetNoteBySlug(ctx, localeFromRequest(appCtx, r), slug, noteSiteRootURLs(appCtx, rootURL))
+}
+
+func commentsService(appCtx *Context) (*comments.Service, error) {
+	if appCtx == nil || appCtx.comments == nil {
+		return nil, notes.ErrNotFound
+	}
+	return appCtx.comments, nil
+}
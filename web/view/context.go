@@ -8,8 +8,14 @@ import (
 	"slices"
 	"strings"
 
+	"blog/internal/analytics"
+	"blog/internal/comments"
+	"blog/internal/discovery"
 	"blog/internal/imageloader"
+	"blog/internal/markdown"
+	"blog/internal/navigation"
 	"blog/internal/notes"
+	"blog/internal/preview"
 	i18n "blog/web/generated/i18n"
 	messages "blog/web/generated/i18n/messages"
 	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
@@ -19,18 +25,30 @@ import (
 var errNotesServiceUnavailable = errors.New("notes service unavailable")
 
 type Context struct {
-	service            *notes.Service
+	service            notes.Source
+	comments           *comments.Service
 	siteResolver       frameworksite.Resolver
 	lovelyEyeScriptURL string
 	lovelyEyeSiteID    string
+	robots             discovery.RobotsConfig
+	preview            *preview.Signer
+	views              *analytics.ViewCounter
 }
 
 type Config struct {
-	Notes              *notes.Service
+	Notes              notes.Source
+	Comments           *comments.Service
 	SiteResolver       frameworksite.Resolver
 	ImageLoader        imageloader.Loader
 	LovelyEyeScriptURL string
 	LovelyEyeSiteID    string
+	MermaidScriptURL   string
+	CodeHighlight      markdown.CodeHighlight
+	Navigation         *navigation.Model
+	Robots             discovery.RobotsConfig
+	Preview            *preview.Signer
+	// Views counts note views for the popular notes list; nil leaves both out.
+	Views *analytics.ViewCounter
 }
 
 func NewContext(cfg Config) (*Context, error) {
@@ -45,13 +63,20 @@ func NewContext(cfg Config) (*Context, error) {
 		ImageLoader:        cfg.ImageLoader,
 		LovelyEyeScriptURL: cfg.LovelyEyeScriptURL,
 		LovelyEyeSiteID:    cfg.LovelyEyeSiteID,
+		MermaidScriptURL:   cfg.MermaidScriptURL,
+		CodeHighlight:      cfg.CodeHighlight,
+		Navigation:         cfg.Navigation,
 	})
 
 	return &Context{
 		service:            cfg.Notes,
+		comments:           cfg.Comments,
 		siteResolver:       cfg.SiteResolver,
 		lovelyEyeScriptURL: strings.TrimSpace(cfg.LovelyEyeScriptURL),
 		lovelyEyeSiteID:    strings.TrimSpace(cfg.LovelyEyeSiteID),
+		robots:             cfg.Robots,
+		preview:            cfg.Preview,
+		views:              cfg.Views,
 	}, nil
 }
 
@@ -74,13 +99,36 @@ func (ctx *Context) ResolveRoot(r *http.Request) *url.URL {
 	return frameworksite.ResolveRoot(ctx.siteResolver, r)
 }
 
-func (ctx *Context) Notes() *notes.Service {
+func (ctx *Context) Notes() notes.Source {
 	if ctx == nil {
 		return nil
 	}
 	return ctx.service
 }
 
+func (ctx *Context) Robots() discovery.RobotsConfig {
+	if ctx == nil {
+		return discovery.RobotsConfig{}
+	}
+	return ctx.robots
+}
+
+func (ctx *Context) PreviewEnabled() bool {
+	return ctx != nil && ctx.preview != nil
+}
+
+// Views returns the note view counter, or nil when views are not counted.
+func (ctx *Context) Views() *analytics.ViewCounter {
+	if ctx == nil {
+		return nil
+	}
+	return ctx.views
+}
+
+func (ctx *Context) CommentsEnabled() bool {
+	return ctx != nil && ctx.comments != nil
+}
+
 func (ctx *Context) LovelyEyeEnabled() bool {
 	return strings.TrimSpace(ctx.LovelyEyeScriptURL()) != "" &&
 		strings.TrimSpace(ctx.LovelyEyeSiteID()) != ""
@@ -8,6 +8,7 @@ import (
 	"sync/atomic"
 
 	"blog/internal/imageloader"
+	"blog/internal/notes"
 )
 
 var imageLoaderValue atomic.Value
@@ -39,6 +40,50 @@ func ImageResponsiveSrcSet(src string, maxWidth int) string {
 	return srcset
 }
 
+// ResponsiveImage carries the resolved <img> attributes so templates compute the
+// target width, srcset and sizes once per image.
+type ResponsiveImage struct {
+	Src    string
+	SrcSet string
+	Sizes  string
+	Width  int
+	Height int
+}
+
+const AttachmentImageSizes = "(max-width: 768px) 100vw, 672px"
+
+func ResponsiveImageFor(src string, sizes string, width int, height int) ResponsiveImage {
+	src = strings.TrimSpace(src)
+	image := ResponsiveImage{
+		Src:    src,
+		Width:  width,
+		Height: height,
+	}
+	loader := currentImageLoader()
+	if !loader.Enabled() || src == "" {
+		return image
+	}
+
+	image.Sizes = ImageResponsiveSizes(sizes, width)
+	targetWidth := ImageResponsiveTargetWidth(width, image.Sizes)
+	image.Src = loader.URL(src, targetWidth)
+	variants, err := loader.Variants(src, targetWidth)
+	if err != nil {
+		image.SrcSet = fmt.Sprintf("server_error:%s", err.Error())
+		return image
+	}
+	image.SrcSet = imageloader.SrcSet(variants)
+	return image
+}
+
+func AttachmentResponsiveImage(attachment *notes.Attachment) ResponsiveImage {
+	if attachment == nil {
+		return ResponsiveImage{}
+	}
+
+	return ResponsiveImageFor(attachment.URL, AttachmentImageSizes, attachment.Width, attachment.Height)
+}
+
 func ImageThumb(src string, originalWidth int, originalHeight int) (string, int, int) {
 	return currentImageLoader().Thumb(strings.TrimSpace(src), originalWidth, originalHeight)
 }
@@ -3,6 +3,7 @@ package runtime
 import (
 	"testing"
 
+	"blog/internal/notes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,3 +38,11 @@ func TestImageResponsiveTargetWidth_DoesNotExceedIntrinsicWidth(t *testing.T) {
 
 	assert.Equal(t, 40, ImageResponsiveTargetWidth(40, "100vw"))
 }
+
+func TestAttachmentResponsiveImage_DisabledLoaderKeepsOriginal(t *testing.T) {
+	t.Parallel()
+
+	image := AttachmentResponsiveImage(&notes.Attachment{URL: " /images/pic.webp ", Width: 1200, Height: 630})
+	assert.Equal(t, ResponsiveImage{Src: "/images/pic.webp", Width: 1200, Height: 630}, image)
+	assert.Equal(t, ResponsiveImage{}, AttachmentResponsiveImage(nil))
+}
@@ -2,12 +2,20 @@ package runtime
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"runtime/debug"
 	"strconv"
 	"strings"
+	"time"
 
+	"blog/internal/analytics"
+	"blog/internal/discovery"
+	"blog/internal/middleware"
 	"blog/internal/notes"
+	"blog/internal/surrogate"
 	i18n "blog/web/generated/i18n"
 	messages "blog/web/generated/i18n/messages"
 	"github.com/RevoTale/no-js/framework"
@@ -17,7 +25,17 @@ import (
 
 const liveNavigationQueryKey = "__live"
 const liveNavigationQueryValue = "navigation"
+const liveFilterQueryValue = "filter"
+
+// liveAppendQueryValue marks infinite scroll requests. They fetch the next page
+// without navigating, so pageview tracking must not count them.
+const liveAppendQueryValue = "append"
 const rssEndpointPath = "/feed.xml"
+const relatedNotesLimit = 3
+const featuredNotesLimit = 3
+const popularNotesLimit = 5
+const dateRangeMonthLayout = "2006-01"
+const dateRangeDayLayout = "2006-01-02"
 
 func LoadNotesPage(
 	ctx context.Context,
@@ -28,7 +46,7 @@ func LoadNotesPage(
 	locale := localeFromRequest(appCtx, r)
 	filter := listFilterFromQuery(r, notes.ListFilter{})
 	cacheKey := loaderCacheKey("LoadNotesPage", locale, r)
-	return framework.CachedCall(ctx, cacheKey, func(runCtx context.Context) (NotesPageView, error) {
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (NotesPageView, error) {
 		view, err := loadNotesListPage(
 			runCtx,
 			appCtx,
@@ -41,7 +59,11 @@ func LoadNotesPage(
 		if err != nil {
 			return NotesPageView{}, err
 		}
+		if showsFeaturedNotes(filter) {
+			view.Featured = loadFeaturedNotes(runCtx, appCtx, locale)
+		}
 		applyStructuredDataContextForNotesView(&view, appCtx, r, locale)
+		applyLiveFilterContext(&view, r)
 		view.EmptyStateMessage = i18n.TEmptyRoot(view.I18n())
 		return view, nil
 	})
@@ -52,13 +74,45 @@ func LoadAuthorPage(
 	appCtx *Context,
 	r *http.Request,
 	params framework.SlugParams,
+) (AuthorPageView, error) {
+	return loadAuthorListingPage(ctx, appCtx, r, params, notes.NoteTypeAll, "LoadAuthorPage")
+}
+
+func LoadAuthorTalesPage(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	params framework.SlugParams,
+) (AuthorPageView, error) {
+	return loadAuthorListingPage(ctx, appCtx, r, params, notes.NoteTypeLong, "LoadAuthorTalesPage")
+}
+
+func LoadAuthorMicroTalesPage(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	params framework.SlugParams,
+) (AuthorPageView, error) {
+	return loadAuthorListingPage(ctx, appCtx, r, params, notes.NoteTypeShort, "LoadAuthorMicroTalesPage")
+}
+
+func loadAuthorListingPage(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	params framework.SlugParams,
+	noteType notes.NoteType,
+	loaderName string,
 ) (AuthorPageView, error) {
 	locale := localeFromRequest(appCtx, r)
-	defaults := notes.ListFilter{AuthorSlug: params.Slug}
+	defaults := notes.ListFilter{AuthorSlugs: []string{params.Slug}, Type: noteType}
 	filter := listFilterFromQuery(r, defaults)
-	filter.AuthorSlug = strings.TrimSpace(params.Slug)
-	cacheKey := loaderCacheKey("LoadAuthorPage", locale, r, filter.AuthorSlug)
-	return framework.CachedCall(ctx, cacheKey, func(runCtx context.Context) (AuthorPageView, error) {
+	filter.AuthorSlugs = []string{notes.NormalizeSlug(params.Slug)}
+	if noteType != notes.NoteTypeAll {
+		filter.Type = noteType
+	}
+	cacheKey := loaderCacheKey(loaderName, locale, r, authorQueryValue(filter))
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (AuthorPageView, error) {
 		view, err := loadNotesListPage(
 			runCtx,
 			appCtx,
@@ -72,6 +126,7 @@ func LoadAuthorPage(
 			return AuthorPageView{}, err
 		}
 		applyStructuredDataContextForNotesView(&view, appCtx, r, locale)
+		applyLiveFilterContext(&view, r)
 		view.EmptyStateMessage = i18n.TEmptyAuthor(view.I18n())
 		return AuthorPageView(view), nil
 	})
@@ -84,11 +139,13 @@ func LoadTagPage(
 	params framework.SlugParams,
 ) (NotesPageView, error) {
 	locale := localeFromRequest(appCtx, r)
-	defaults := notes.ListFilter{TagName: params.Slug}
+	tagName := notes.NormalizeSlug(params.Slug)
+	defaults := notes.ListFilter{TagNames: []string{tagName}}
 	filter := listFilterFromQuery(r, defaults)
-	filter.TagName = strings.TrimSpace(params.Slug)
-	cacheKey := loaderCacheKey("LoadTagPage", locale, r, filter.TagName)
-	return framework.CachedCall(ctx, cacheKey, func(runCtx context.Context) (NotesPageView, error) {
+	filter.TagNames = []string{tagName}
+	filter.TagMode = notes.TagMatchAny
+	cacheKey := loaderCacheKey("LoadTagPage", locale, r, tagName)
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (NotesPageView, error) {
 		view, err := loadNotesListPage(
 			runCtx,
 			appCtx,
@@ -102,6 +159,7 @@ func LoadTagPage(
 			return NotesPageView{}, err
 		}
 		applyStructuredDataContextForNotesView(&view, appCtx, r, locale)
+		applyLiveFilterContext(&view, r)
 		view.EmptyStateMessage = i18n.TEmptyTag(view.I18n())
 		return view, nil
 	})
@@ -118,12 +176,13 @@ func LoadNotesTalesPage(
 	filter := listFilterFromQuery(r, defaults)
 	filter.Type = notes.NoteTypeLong
 	cacheKey := loaderCacheKey("LoadNotesTalesPage", locale, r)
-	return framework.CachedCall(ctx, cacheKey, func(runCtx context.Context) (NotesPageView, error) {
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (NotesPageView, error) {
 		view, err := loadNotesListPage(runCtx, appCtx, r, locale, filter, notes.ListOptions{}, SidebarModeFiltered)
 		if err != nil {
 			return NotesPageView{}, err
 		}
 		applyStructuredDataContextForNotesView(&view, appCtx, r, locale)
+		applyLiveFilterContext(&view, r)
 		view.EmptyStateMessage = i18n.TEmptyTales(view.I18n())
 		return view, nil
 	})
@@ -140,12 +199,13 @@ func LoadNotesMicroTalesPage(
 	filter := listFilterFromQuery(r, defaults)
 	filter.Type = notes.NoteTypeShort
 	cacheKey := loaderCacheKey("LoadNotesMicroTalesPage", locale, r)
-	return framework.CachedCall(ctx, cacheKey, func(runCtx context.Context) (NotesPageView, error) {
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (NotesPageView, error) {
 		view, err := loadNotesListPage(runCtx, appCtx, r, locale, filter, notes.ListOptions{}, SidebarModeFiltered)
 		if err != nil {
 			return NotesPageView{}, err
 		}
 		applyStructuredDataContextForNotesView(&view, appCtx, r, locale)
+		applyLiveFilterContext(&view, r)
 		view.EmptyStateMessage = i18n.TEmptyMicro(view.I18n())
 		return view, nil
 	})
@@ -160,7 +220,7 @@ func LoadChannelsPage(
 	locale := localeFromRequest(appCtx, r)
 	filter := listFilterFromQuery(r, notes.ListFilter{})
 	cacheKey := loaderCacheKey("LoadChannelsPage", locale, r)
-	return framework.CachedCall(ctx, cacheKey, func(runCtx context.Context) (NotesPageView, error) {
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (NotesPageView, error) {
 		view, err := loadNotesListPage(runCtx, appCtx, r, locale, filter, notes.ListOptions{}, sidebarModeForFilter(filter))
 		if err != nil {
 			return NotesPageView{}, err
@@ -190,8 +250,17 @@ func loadNotesListPage(
 	if err != nil {
 		return NotesPageView{}, err
 	}
+	DeclareListSurrogateKeys(ctx, filter, result.Notes, surrogate.ListNotes)
 
-	return newNotesPageView(locale, appCtx.I18n(r), result, mode), nil
+	view := newNotesPageView(locale, appCtx.I18n(r), result, mode)
+	// Counts only decorate the sidebar, so a failed scan leaves them out.
+	if counts, err := service.ChannelCounts(ctx, locale); err == nil {
+		view.applyChannelCounts(counts)
+	}
+	if popular, err := appCtx.Views().PopularThisWeek(ctx, locale, popularNotesLimit); err == nil {
+		view.applyPopularNotes(popular)
+	}
+	return view, nil
 }
 
 func LoadNotePage(
@@ -203,28 +272,60 @@ func LoadNotePage(
 	locale := localeFromRequest(appCtx, r)
 	slug := strings.TrimSpace(params.Slug)
 	cacheKey := loaderCacheKey("LoadNotePage", locale, r, slug)
-	return framework.CachedCall(ctx, cacheKey, func(runCtx context.Context) (NotePageView, error) {
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (NotePageView, error) {
 		service, err := notesService(appCtx)
 		if err != nil {
 			return NotePageView{}, err
 		}
 
+		draftPreview := isDraftPreviewRequest(appCtx, r, slug)
+		if draftPreview {
+			runCtx = notes.WithDrafts(runCtx)
+		}
+
 		rootURL := resolvedRootURL(appCtx, r)
 		note, err := service.GetNoteBySlug(runCtx, locale, slug, noteSiteRootURLs(appCtx, rootURL))
 		if err != nil {
 			return NotePageView{}, err
 		}
+		// Neighbour, series and related links are optional, so a failed
+		// lookup leaves them out instead of failing the note itself.
+		adjacent, err := service.GetAdjacentNotes(runCtx, locale, *note)
+		if err != nil {
+			adjacent = notes.AdjacentNotes{}
+		}
+		series, err := service.GetNoteSeries(runCtx, locale, note.ID)
+		if err != nil {
+			series = nil
+		}
+		related, err := service.GetRelatedNotes(runCtx, locale, note.ID, relatedNotesLimit)
+		if err != nil {
+			related = nil
+		}
+		commentsView := loadCommentsView(runCtx, appCtx, r, *note)
+		declareNoteSurrogateKeys(runCtx, *note)
+		if series != nil {
+			middleware.AddSurrogateKeys(runCtx, surrogate.ListSeries)
+		}
 		i18n := appCtx.I18n(r)
 		pageTitle := strings.TrimSpace(note.Title)
+		if countsAsNoteView(r, draftPreview) {
+			appCtx.Views().Count(analytics.View{Locale: locale, Slug: note.Slug, Title: pageTitle})
+		}
 
 		return NotePageView{
 			Locale:                locale,
 			RootURL:               rootURL,
-			CanonicalURL:          canonicalURLFromRequest(appCtx, r, locale),
-			IncludeStructuredData: shouldIncludeStructuredData(r),
+			Canonical:             canonicalURLFromRequest(appCtx, r, locale),
+			IncludeStructuredData: shouldIncludeStructuredData(r) && !draftPreview,
 			I18nCtx:               i18n,
 			PageTitle:             pageTitle,
 			Note:                  *note,
+			DraftPreview:          draftPreview,
+			Adjacent:              adjacent,
+			Series:                series,
+			Related:               related,
+			Comments:              commentsView,
 			SidebarAuthorItems:    uniqueSortedAuthors(note.Authors),
 			SidebarTagItems:       uniqueSortedTags(note.Tags),
 			AnalyticsEnabled:      appCtx != nil && appCtx.LovelyEyeEnabled(),
@@ -232,6 +333,55 @@ func LoadNotePage(
 	})
 }
 
+func LoadNoteDiffPage(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	params framework.SlugParams,
+) (NoteDiffPageView, error) {
+	locale := localeFromRequest(appCtx, r)
+	slug := strings.TrimSpace(params.Slug)
+	cacheKey := loaderCacheKey("LoadNoteDiffPage", locale, r, slug)
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (NoteDiffPageView, error) {
+		service, err := notesService(appCtx)
+		if err != nil {
+			return NoteDiffPageView{}, err
+		}
+
+		rootURL := resolvedRootURL(appCtx, r)
+		siteRootURLs := noteSiteRootURLs(appCtx, rootURL)
+		draft, err := service.GetNoteDraftBySlug(runCtx, locale, slug, siteRootURLs)
+		if err != nil {
+			return NoteDiffPageView{}, err
+		}
+
+		view := NoteDiffPageView{
+			NotePageView: NotePageView{
+				Locale:             locale,
+				RootURL:            rootURL,
+				Canonical:          canonicalURLFromRequest(appCtx, r, locale),
+				I18nCtx:            appCtx.I18n(r),
+				PageTitle:          strings.TrimSpace(draft.Title),
+				SidebarAuthorItems: uniqueSortedAuthors(draft.Authors),
+				SidebarTagItems:    uniqueSortedTags(draft.Tags),
+			},
+			Draft:      *draft,
+			PreviewURL: buildDraftPreviewURL(appCtx, appCtx.I18n(r), draft.Slug),
+		}
+
+		published, err := service.GetNoteBySlug(runCtx, locale, slug, siteRootURLs)
+		switch {
+		case err == nil:
+			view.Note = *published
+			view.HasPublished = true
+		case !errors.Is(err, notes.ErrNotFound):
+			return NoteDiffPageView{}, err
+		}
+
+		return view, nil
+	})
+}
+
 func listFilterFromQuery(r *http.Request, defaults notes.ListFilter) notes.ListFilter {
 	if defaults.Page < 1 {
 		defaults.Page = 1
@@ -246,22 +396,26 @@ func listFilterFromQuery(r *http.Request, defaults notes.ListFilter) notes.ListF
 }
 
 func listFilterFromValues(query url.Values, defaults notes.ListFilter) notes.ListFilter {
+	tagNames, tagMode := notes.ParseTagFilter(query.Get("tag"))
 	filter := notes.ListFilter{
-		Page:       parsePage(query.Get("page")),
-		AuthorSlug: strings.TrimSpace(query.Get("author")),
-		TagName:    strings.TrimSpace(query.Get("tag")),
-		Type:       notes.ParseNoteType(query.Get("type")),
-		Query:      strings.TrimSpace(query.Get("q")),
+		Page:        parsePage(query.Get("page")),
+		AuthorSlugs: notes.ParseAuthorFilter(query.Get("author")),
+		TagNames:    tagNames,
+		TagMode:     tagMode,
+		Type:        notes.ParseNoteType(query.Get("type")),
+		Query:       strings.TrimSpace(query.Get("q")),
+		From:        parseDateRangeBound(query.Get("from"), false),
+		To:          parseDateRangeBound(query.Get("to"), true),
 	}
 
 	if filter.Page < 1 {
 		filter.Page = defaults.Page
 	}
-	if filter.AuthorSlug == "" {
-		filter.AuthorSlug = strings.TrimSpace(defaults.AuthorSlug)
+	if len(filter.AuthorSlugs) == 0 {
+		filter.AuthorSlugs = notes.ParseAuthorFilter(authorQueryValue(defaults))
 	}
-	if filter.TagName == "" {
-		filter.TagName = strings.TrimSpace(defaults.TagName)
+	if len(filter.TagNames) == 0 {
+		filter.TagNames, filter.TagMode = notes.ParseTagFilter(tagQueryValue(defaults))
 	}
 	if filter.Type == notes.NoteTypeAll {
 		filter.Type = notes.ParseNoteType(string(defaults.Type))
@@ -269,10 +423,85 @@ func listFilterFromValues(query url.Values, defaults notes.ListFilter) notes.Lis
 	if filter.Query == "" {
 		filter.Query = strings.TrimSpace(defaults.Query)
 	}
+	if filter.From.IsZero() {
+		filter.From = defaults.From
+	}
+	if filter.To.IsZero() {
+		filter.To = defaults.To
+	}
 
 	return filter
 }
 
+// parseDateRangeBound reads a from/to query value given as a month
+// (2024-06) or a day (2024-06-15). A to bound covers the whole month or day
+// it names, so it resolves to the start of the next one.
+func parseDateRangeBound(raw string, end bool) time.Time {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}
+	}
+
+	if bound, err := time.Parse(dateRangeMonthLayout, raw); err == nil {
+		if end {
+			return bound.AddDate(0, 1, 0)
+		}
+		return bound
+	}
+	if bound, err := time.Parse(dateRangeDayLayout, raw); err == nil {
+		if end {
+			return bound.AddDate(0, 0, 1)
+		}
+		return bound
+	}
+
+	return time.Time{}
+}
+
+// formatDateRangeBound is the inverse of parseDateRangeBound, preferring the
+// month form whenever the bound falls on a month boundary.
+func formatDateRangeBound(bound time.Time, end bool) string {
+	if bound.IsZero() {
+		return ""
+	}
+
+	bound = bound.UTC()
+	if bound.Day() == 1 {
+		if end {
+			return bound.AddDate(0, -1, 0).Format(dateRangeMonthLayout)
+		}
+		return bound.Format(dateRangeMonthLayout)
+	}
+	if end {
+		return bound.AddDate(0, 0, -1).Format(dateRangeDayLayout)
+	}
+
+	return bound.Format(dateRangeDayLayout)
+}
+
+// withDateRangeQuery carries the filter's publish date range over to a notes
+// listing URL built from the other filters.
+func withDateRangeQuery(pageURL string, filter notes.ListFilter) string {
+	if filter.From.IsZero() && filter.To.IsZero() {
+		return pageURL
+	}
+
+	parsed, err := url.Parse(pageURL)
+	if err != nil {
+		return pageURL
+	}
+	query := parsed.Query()
+	if from := formatDateRangeBound(filter.From, false); from != "" {
+		query.Set("from", from)
+	}
+	if to := formatDateRangeBound(filter.To, true); to != "" {
+		query.Set("to", to)
+	}
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String()
+}
+
 func BuildRSSFeedURL(
 	locale string,
 	page int,
@@ -286,8 +515,8 @@ func BuildRSSFeedURL(
 	}
 
 	noteType = notes.ParseNoteType(string(noteType))
-	authorSlug = strings.TrimSpace(authorSlug)
-	tagName = strings.TrimSpace(tagName)
+	authorSlug = normalizeAuthorQueryValue(authorSlug)
+	tagName = normalizeTagQueryValue(tagName)
 	searchQuery = strings.TrimSpace(searchQuery)
 	locale = normalizeLocaleCode(locale)
 
@@ -312,6 +541,14 @@ func BuildRSSFeedURL(
 	return rssEndpointPath + "?" + q.Encode()
 }
 
+// BuildChannelsOPMLURL links the OPML outline of every author and tag feed in
+// locale.
+func BuildChannelsOPMLURL(locale string) string {
+	q := make(url.Values)
+	q.Set("locale", normalizeLocaleCode(locale))
+	return discovery.ChannelsOPMLPath + "?" + q.Encode()
+}
+
 func BuildNotesFilterURL(
 	i18n frameworki18n.Context[i18n.Key],
 	page int,
@@ -373,8 +610,8 @@ func buildNotesFilterURLWithLocalizer(
 	}
 
 	noteType = notes.ParseNoteType(string(noteType))
-	authorSlug = strings.TrimSpace(authorSlug)
-	tagName = strings.TrimSpace(tagName)
+	authorSlug = normalizeAuthorQueryValue(authorSlug)
+	tagName = normalizeTagQueryValue(tagName)
 	searchQuery = strings.TrimSpace(searchQuery)
 
 	canonicalPath := canonicalNotesListingPath(authorSlug, tagName, noteType)
@@ -429,11 +666,20 @@ func CanonicalNotesRedirectURL(
 
 	filter := listFilterFromValues(query, defaults)
 	enforceCanonicalNotesRouteFilters(strippedPath, &filter)
-	if strings.TrimSpace(filter.Query) != "" || activeNotesListingFilterCount(filter) > 1 {
+	if strings.TrimSpace(filter.Query) != "" {
+		return "", false
+	}
+	if len(filter.AuthorSlugs) > 1 || len(filter.TagNames) > 1 {
+		return "", false
+	}
+	authorValue := authorQueryValue(filter)
+	tagValue := tagQueryValue(filter)
+	if activeNotesListingFilterCount(filter) > 1 &&
+		canonicalNotesListingPath(authorValue, tagValue, filter.Type) == "" {
 		return "", false
 	}
 
-	return buildNotesFilterURLForConfig(cfg, locale, filter.Page, filter.AuthorSlug, filter.TagName, filter.Type, ""), true
+	return buildNotesFilterURLForConfig(cfg, locale, filter.Page, authorValue, tagValue, filter.Type, ""), true
 }
 
 func BuildChannelsURL(
@@ -444,8 +690,8 @@ func BuildChannelsURL(
 	searchQuery string,
 ) string {
 	noteType = notes.ParseNoteType(string(noteType))
-	authorSlug = strings.TrimSpace(authorSlug)
-	tagName = strings.TrimSpace(tagName)
+	authorSlug = normalizeAuthorQueryValue(authorSlug)
+	tagName = normalizeTagQueryValue(tagName)
 	searchQuery = strings.TrimSpace(searchQuery)
 
 	q := make(url.Values)
@@ -470,7 +716,7 @@ func BuildChannelsURL(
 }
 
 func BuildAuthorURL(i18n frameworki18n.Context[i18n.Key], slug string, page int) string {
-	slug = strings.TrimSpace(slug)
+	slug = notes.NormalizeSlug(slug)
 	if slug == "" {
 		return localizePath(i18n, "/")
 	}
@@ -488,9 +734,31 @@ func BuildAuthorURL(i18n frameworki18n.Context[i18n.Key], slug string, page int)
 	return buildLocalizedPathWithQuery(i18n, "/author/"+slug, q)
 }
 
+// BuildAuthorsNotesURL lists the notes written by any of authors.
+func BuildAuthorsNotesURL(i18n frameworki18n.Context[i18n.Key], authors []notes.Author) string {
+	slugs := make([]string, 0, len(authors))
+	for _, author := range authors {
+		slugs = append(slugs, author.Slug)
+	}
+
+	return BuildNotesFilterURL(i18n, 1, notes.AuthorFilterQueryValue(slugs), "", notes.NoteTypeAll, "")
+}
+
 func BuildHTMXNavigationURL(pageURL string) string {
+	return buildLiveURL(pageURL, liveNavigationQueryValue)
+}
+
+func BuildHTMXFilterURL(pageURL string) string {
+	return buildLiveURL(pageURL, liveFilterQueryValue)
+}
+
+func BuildHTMXAppendURL(pageURL string) string {
+	return buildLiveURL(pageURL, liveAppendQueryValue)
+}
+
+func buildLiveURL(pageURL string, liveValue string) string {
 	canonicalPath, query := normalizePageURL(pageURL)
-	query.Set(liveNavigationQueryKey, liveNavigationQueryValue)
+	query.Set(liveNavigationQueryKey, liveValue)
 
 	encoded := query.Encode()
 	if encoded == "" {
@@ -501,7 +769,7 @@ func BuildHTMXNavigationURL(pageURL string) string {
 }
 
 func BuildTagURL(i18n frameworki18n.Context[i18n.Key], tagSlug string) string {
-	tagSlug = strings.TrimSpace(tagSlug)
+	tagSlug = notes.NormalizeSlug(tagSlug)
 	if tagSlug == "" {
 		return localizePath(i18n, "/")
 	}
@@ -523,11 +791,11 @@ func BuildTalesURL(
 	if page > 1 {
 		q.Set("page", strconv.Itoa(page))
 	}
-	if strings.TrimSpace(authorSlug) != "" {
-		q.Set("author", strings.TrimSpace(authorSlug))
+	if authorSlug = notes.NormalizeSlug(authorSlug); authorSlug != "" {
+		q.Set("author", authorSlug)
 	}
-	if strings.TrimSpace(tagName) != "" {
-		q.Set("tag", strings.TrimSpace(tagName))
+	if tagName = normalizeTagQueryValue(tagName); tagName != "" {
+		q.Set("tag", tagName)
 	}
 
 	if q.Encode() == "" {
@@ -551,11 +819,11 @@ func BuildMicroTalesURL(
 	if page > 1 {
 		q.Set("page", strconv.Itoa(page))
 	}
-	if strings.TrimSpace(authorSlug) != "" {
-		q.Set("author", strings.TrimSpace(authorSlug))
+	if authorSlug = notes.NormalizeSlug(authorSlug); authorSlug != "" {
+		q.Set("author", authorSlug)
 	}
-	if strings.TrimSpace(tagName) != "" {
-		q.Set("tag", strings.TrimSpace(tagName))
+	if tagName = normalizeTagQueryValue(tagName); tagName != "" {
+		q.Set("tag", tagName)
 	}
 
 	if q.Encode() == "" {
@@ -566,14 +834,14 @@ func BuildMicroTalesURL(
 }
 
 func canonicalNotesListingPath(authorSlug string, tagName string, noteType notes.NoteType) string {
-	authorSlug = strings.TrimSpace(authorSlug)
-	tagName = strings.TrimSpace(tagName)
+	authorSlug = normalizeAuthorQueryValue(authorSlug)
+	tagName = normalizeTagQueryValue(tagName)
 	noteType = notes.ParseNoteType(string(noteType))
 
-	if authorSlug != "" && tagName == "" && noteType == notes.NoteTypeAll {
-		return "/author/" + authorSlug
+	if authorSlug != "" && tagName == "" && !strings.Contains(authorSlug, ",") {
+		return "/author/" + authorSlug + authorTypeRouteSuffix(noteType)
 	}
-	if tagName != "" && authorSlug == "" && noteType == notes.NoteTypeAll {
+	if tagName != "" && authorSlug == "" && noteType == notes.NoteTypeAll && !strings.ContainsAny(tagName, ", ") {
 		return "/tag/" + tagName
 	}
 	if noteType == notes.NoteTypeLong && authorSlug == "" && tagName == "" {
@@ -586,12 +854,56 @@ func canonicalNotesListingPath(authorSlug string, tagName string, noteType notes
 	return ""
 }
 
+func authorTypeRouteSuffix(noteType notes.NoteType) string {
+	switch noteType {
+	case notes.NoteTypeLong:
+		return "/tales"
+	case notes.NoteTypeShort:
+		return "/micro-tales"
+	default:
+		return ""
+	}
+}
+
+func authorTypeFromRouteSuffix(suffix string) (notes.NoteType, bool) {
+	switch suffix {
+	case "", "/":
+		return notes.NoteTypeAll, true
+	case "/tales":
+		return notes.NoteTypeLong, true
+	case "/micro-tales":
+		return notes.NoteTypeShort, true
+	default:
+		return notes.NoteTypeAll, false
+	}
+}
+
+// tagQueryValue writes the filter's tags the way the tag query parameter
+// reads them.
+func tagQueryValue(filter notes.ListFilter) string {
+	return notes.TagFilterQueryValue(filter.TagNames, filter.TagMode)
+}
+
+func normalizeTagQueryValue(raw string) string {
+	return notes.TagFilterQueryValue(notes.ParseTagFilter(raw))
+}
+
+// authorQueryValue writes the filter's authors the way the author query
+// parameter reads them.
+func authorQueryValue(filter notes.ListFilter) string {
+	return notes.AuthorFilterQueryValue(filter.AuthorSlugs)
+}
+
+func normalizeAuthorQueryValue(raw string) string {
+	return notes.AuthorFilterQueryValue(notes.ParseAuthorFilter(raw))
+}
+
 func activeNotesListingFilterCount(filter notes.ListFilter) int {
 	count := 0
-	if strings.TrimSpace(filter.AuthorSlug) != "" {
+	if len(filter.AuthorSlugs) > 0 {
 		count++
 	}
-	if strings.TrimSpace(filter.TagName) != "" {
+	if len(filter.TagNames) > 0 {
 		count++
 	}
 	if notes.ParseNoteType(string(filter.Type)) != notes.NoteTypeAll {
@@ -626,11 +938,11 @@ func canonicalNotesDefaultsForPath(pathValue string) (notes.ListFilter, bool) {
 		return notes.ListFilter{Type: notes.NoteTypeShort}, true
 	}
 
-	if slug, ok := canonicalNotesSlugForPath(normalizedPath, "/author/"); ok {
-		return notes.ListFilter{AuthorSlug: slug}, true
+	if slug, noteType, ok := canonicalAuthorListingForPath(normalizedPath); ok {
+		return notes.ListFilter{AuthorSlugs: []string{slug}, Type: noteType}, true
 	}
 	if slug, ok := canonicalNotesSlugForPath(normalizedPath, "/tag/"); ok {
-		return notes.ListFilter{TagName: slug}, true
+		return notes.ListFilter{TagNames: []string{slug}}, true
 	}
 
 	return notes.ListFilter{}, false
@@ -646,7 +958,27 @@ func canonicalNotesSlugForPath(pathValue string, prefix string) (string, bool) {
 		return "", false
 	}
 
-	return slug, true
+	return notes.NormalizeSlug(slug), true
+}
+
+func canonicalAuthorListingForPath(pathValue string) (string, notes.NoteType, bool) {
+	const prefix = "/author/"
+	if !strings.HasPrefix(pathValue, prefix) {
+		return "", notes.NoteTypeAll, false
+	}
+
+	rawSlug, suffix, _ := strings.Cut(strings.TrimPrefix(pathValue, prefix), "/")
+	noteType, ok := authorTypeFromRouteSuffix("/" + suffix)
+	if !ok {
+		return "", notes.NoteTypeAll, false
+	}
+
+	slug, ok := canonicalNotesSlugForPath(prefix+rawSlug, prefix)
+	if !ok {
+		return "", notes.NoteTypeAll, false
+	}
+
+	return slug, noteType, true
 }
 
 func enforceCanonicalNotesRouteFilters(pathValue string, filter *notes.ListFilter) {
@@ -664,12 +996,16 @@ func enforceCanonicalNotesRouteFilters(pathValue string, filter *notes.ListFilte
 		return
 	}
 
-	if slug, ok := canonicalNotesSlugForPath(normalizedPath, "/author/"); ok {
-		filter.AuthorSlug = slug
+	if slug, noteType, ok := canonicalAuthorListingForPath(normalizedPath); ok {
+		filter.AuthorSlugs = []string{slug}
+		if noteType != notes.NoteTypeAll {
+			filter.Type = noteType
+		}
 		return
 	}
 	if slug, ok := canonicalNotesSlugForPath(normalizedPath, "/tag/"); ok {
-		filter.TagName = slug
+		filter.TagNames = []string{slug}
+		filter.TagMode = notes.TagMatchAny
 	}
 }
 
@@ -702,10 +1038,30 @@ func applyStructuredDataContextForNotesView(
 
 	view.RootURL = resolvedRootURL(appCtx, r)
 	view.AnalyticsEnabled = appCtx != nil && appCtx.LovelyEyeEnabled()
-	view.CanonicalURL = canonicalURLFromRequest(appCtx, r, locale)
+	view.Canonical = canonicalURLFromRequest(appCtx, r, locale)
 	view.IncludeStructuredData = shouldIncludeStructuredData(r)
 }
 
+func applyLiveFilterContext(view *NotesPageView, r *http.Request) {
+	if view == nil {
+		return
+	}
+
+	view.LiveFilters = true
+	view.FilterFragments = isLiveFilterRequest(r)
+}
+
+func isLiveFilterRequest(r *http.Request) bool {
+	if r == nil || r.URL == nil {
+		return false
+	}
+	if !strings.EqualFold(strings.TrimSpace(r.Header.Get("HX-Request")), "true") {
+		return false
+	}
+
+	return strings.TrimSpace(r.URL.Query().Get(liveNavigationQueryKey)) == liveFilterQueryValue
+}
+
 func shouldIncludeStructuredData(r *http.Request) bool {
 	if r == nil {
 		return true
@@ -722,6 +1078,17 @@ func shouldIncludeStructuredData(r *http.Request) bool {
 	return strings.TrimSpace(r.URL.Query().Get(liveNavigationQueryKey)) != liveNavigationQueryValue
 }
 
+// countsAsNoteView reports whether serving the note page is a read of the note.
+// Previews are the author's own reads and HEAD requests are not reads. Live
+// requests re-fetch a page already counted, e.g. to load more comments.
+func countsAsNoteView(r *http.Request, draftPreview bool) bool {
+	if draftPreview || r == nil || r.Method != http.MethodGet || r.URL == nil {
+		return false
+	}
+
+	return strings.TrimSpace(r.URL.Query().Get(liveNavigationQueryKey)) == ""
+}
+
 func canonicalURLFromRequest(appCtx *Context, r *http.Request, locale string) string {
 	if appCtx == nil || r == nil {
 		return ""
@@ -743,8 +1110,8 @@ func canonicalURLFromRequest(appCtx *Context, r *http.Request, locale string) st
 		if pathValue == "" {
 			pathValue = "/"
 		}
-		if strings.TrimSpace(r.URL.RawQuery) != "" {
-			pathValue += "?" + strings.TrimSpace(r.URL.RawQuery)
+		if query := canonicalFilterQuery(r.URL.Query()).Encode(); query != "" {
+			pathValue += "?" + query
 		}
 	}
 
@@ -756,6 +1123,23 @@ func canonicalURLFromRequest(appCtx *Context, r *http.Request, locale string) st
 	return strings.TrimSpace(alternates.Canonical)
 }
 
+// canonicalFilterQuery keeps only the filters that select different content, so
+// paginated, searched and live variants collapse onto one canonical listing.
+func canonicalFilterQuery(query url.Values) url.Values {
+	out := make(url.Values)
+	if authorSlug := notes.NormalizeSlug(query.Get("author")); authorSlug != "" {
+		out.Set("author", authorSlug)
+	}
+	if tagName := strings.TrimSpace(query.Get("tag")); tagName != "" {
+		out.Set("tag", tagName)
+	}
+	if noteType := notes.ParseNoteType(query.Get("type")).QueryValue(); noteType != "" {
+		out.Set("type", noteType)
+	}
+
+	return out
+}
+
 func resolvedRootURL(appCtx *Context, r *http.Request) string {
 	if appCtx == nil {
 		return ""
@@ -810,12 +1194,35 @@ func localeFromRequest(appCtx *Context, r *http.Request) string {
 	return appCtx.LocaleFromRequest(requestLocale)
 }
 
+// loadFeaturedNotes treats the pinned section as optional, so a failed lookup
+// leaves it out instead of failing the feed.
+func loadFeaturedNotes(ctx context.Context, appCtx *Context, locale string) []notes.NoteSummary {
+	service, err := notesService(appCtx)
+	if err != nil {
+		return nil
+	}
+	featured, err := service.ListFeatured(ctx, locale, featuredNotesLimit)
+	if err != nil {
+		return nil
+	}
+	DeclareListSurrogateKeys(ctx, notes.ListFilter{}, featured)
+
+	return featured
+}
+
+// showsFeaturedNotes pins featured notes above the first page of the
+// unfiltered feed only.
+func showsFeaturedNotes(filter notes.ListFilter) bool {
+	return filter.Page <= 1 && sidebarModeForFilter(filter) == SidebarModeRoot &&
+		filter.From.IsZero() && filter.To.IsZero()
+}
+
 func sidebarModeForFilter(filter notes.ListFilter) SidebarMode {
 	if strings.TrimSpace(filter.Query) != "" {
 		return SidebarModeFiltered
 	}
 
-	if strings.TrimSpace(filter.AuthorSlug) != "" || strings.TrimSpace(filter.TagName) != "" {
+	if len(filter.AuthorSlugs) > 0 || len(filter.TagNames) > 0 {
 		return SidebarModeFiltered
 	}
 
@@ -826,7 +1233,7 @@ func sidebarModeForFilter(filter notes.ListFilter) SidebarMode {
 	return SidebarModeRoot
 }
 
-func notesService(appCtx *Context) (*notes.Service, error) {
+func notesService(appCtx *Context) (notes.Source, error) {
 	if appCtx == nil || appCtx.service == nil {
 		return nil, errNotesServiceUnavailable
 	}
@@ -851,3 +1258,27 @@ func loaderCacheKey(loaderName string, locale string, r *http.Request, fragments
 
 	return strings.Join(keyParts, "|")
 }
+
+// cachedLoad is framework.CachedCall that reports a panic in load as an error.
+// Metadata and page loaders share cache entries from separate goroutines; an
+// entry whose load panicked would otherwise never complete and block the other
+// goroutine for the rest of the request. Errors the CMS rejected as forbidden
+// or invalid set the status of the error response, and CMS failures mark it
+// as an upstream failure.
+func cachedLoad[T any](ctx context.Context, cacheKey string, load func(context.Context) (T, error)) (T, error) {
+	return framework.CachedCall(ctx, cacheKey, func(runCtx context.Context) (view T, err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = fmt.Errorf("panic in loader %q: %v\n%s", cacheKey, recovered, debug.Stack())
+			}
+			if err != nil {
+				middleware.SetErrorStatus(runCtx, notes.HTTPStatus(err))
+				if errors.Is(err, notes.ErrUpstream) {
+					middleware.MarkUpstreamFailure(runCtx)
+				}
+			}
+		}()
+
+		return load(runCtx)
+	})
+}
@@ -0,0 +1,72 @@
+package runtime
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"blog/internal/notes"
+	"github.com/RevoTale/no-js/framework"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedLoadReportsPanicToEveryCaller(t *testing.T) {
+	t.Parallel()
+
+	ctx := framework.WithRequestCache(context.Background())
+	_, err := cachedLoad(ctx, "panicking", func(context.Context) (int, error) {
+		panic("boom")
+	})
+	require.ErrorContains(t, err, "boom")
+	require.ErrorContains(t, err, "goroutine")
+
+	_, err = cachedLoad(ctx, "panicking", func(context.Context) (int, error) {
+		return 1, nil
+	})
+	require.ErrorContains(t, err, "boom")
+}
+
+func TestListFilterDateRangeRoundTripsThroughQuery(t *testing.T) {
+	t.Parallel()
+
+	filter := listFilterFromValues(url.Values{"from": {"2024-01"}, "to": {"2024-06-15"}}, notes.ListFilter{})
+	require.Equal(t, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), filter.From)
+	require.Equal(t, time.Date(2024, time.June, 16, 0, 0, 0, 0, time.UTC), filter.To)
+
+	filter.To = time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, "/tag/go?from=2024-01&page=2&to=2024-06", withDateRangeQuery("/tag/go?page=2", filter))
+	require.Equal(t, "/", withDateRangeQuery("/", notes.ListFilter{}))
+
+	filter = listFilterFromValues(url.Values{"from": {"January"}}, notes.ListFilter{})
+	require.True(t, filter.From.IsZero())
+}
+
+func TestMultiTagFiltersStayOnTheNotesListing(t *testing.T) {
+	t.Parallel()
+
+	filter := listFilterFromValues(url.Values{"tag": {"Go rust"}}, notes.ListFilter{})
+	require.Equal(t, []string{"go", "rust"}, filter.TagNames)
+	require.Equal(t, notes.TagMatchAll, filter.TagMode)
+	require.Equal(t, "go rust", tagQueryValue(filter))
+
+	require.Equal(t, "/tag/go", canonicalNotesListingPath("", "go", notes.NoteTypeAll))
+	require.Empty(t, canonicalNotesListingPath("", "go,rust", notes.NoteTypeAll))
+
+	_, ok := CanonicalNotesRedirectURL(canonicalNotesConfig(), "en", "/", url.Values{"tag": {"go,rust"}})
+	require.False(t, ok)
+}
+
+func TestMultiAuthorFiltersStayOnTheNotesListing(t *testing.T) {
+	t.Parallel()
+
+	filter := listFilterFromValues(url.Values{"author": {"Zed,l-you"}}, notes.ListFilter{})
+	require.Equal(t, []string{"zed", "l-you"}, filter.AuthorSlugs)
+	require.Equal(t, "zed,l-you", authorQueryValue(filter))
+
+	require.Equal(t, "/author/zed", canonicalNotesListingPath("zed", "", notes.NoteTypeAll))
+	require.Empty(t, canonicalNotesListingPath("zed,l-you", "", notes.NoteTypeAll))
+
+	_, ok := CanonicalNotesRedirectURL(canonicalNotesConfig(), "en", "/", url.Values{"author": {"zed,l-you"}})
+	require.False(t, ok)
+}
@@ -0,0 +1,19 @@
+package runtime
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+var mermaidScriptURLValue atomic.Value
+
+func SetMermaidScriptURL(scriptURL string) {
+	mermaidScriptURLValue.Store(strings.TrimSpace(scriptURL))
+}
+
+// MermaidScriptURL is the ES module mermaid.js is imported from on pages with
+// diagrams. Empty leaves diagrams as their source text.
+func MermaidScriptURL() string {
+	value, _ := mermaidScriptURLValue.Load().(string)
+	return value
+}
@@ -0,0 +1,60 @@
+package runtime
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"blog/internal/navigation"
+	i18n "blog/web/generated/i18n"
+	messages "blog/web/generated/i18n/messages"
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
+)
+
+var navigationValue atomic.Value
+
+func init() {
+	navigationValue.Store(navigation.Default())
+}
+
+func SetNavigation(model *navigation.Model) {
+	if model == nil {
+		navigationValue.Store(navigation.Default())
+		return
+	}
+
+	navigationValue.Store(*model)
+}
+
+func Navigation() navigation.Model {
+	model, _ := navigationValue.Load().(navigation.Model)
+	return model
+}
+
+func NavLabel(i18nCtx frameworki18n.Context[i18n.Key], label navigation.Label) string {
+	locale := localeCode(i18nCtx, "")
+	if text := strings.TrimSpace(label.Text[locale]); text != "" {
+		return text
+	}
+	if key := strings.TrimSpace(label.Key); key != "" && i18nCtx != nil {
+		return i18nCtx.T(i18n.Key(key), nil)
+	}
+
+	return strings.TrimSpace(label.Text[messages.Config().DefaultLocale])
+}
+
+func VisibleFooterLinks(view RootLayoutView) []navigation.FooterLink {
+	if view == nil {
+		return nil
+	}
+
+	model := view.LayoutNavigation()
+	links := make([]navigation.FooterLink, 0, len(model.Footer))
+	for _, link := range model.Footer {
+		if strings.TrimSpace(link.If) == navigation.ConditionAnalytics && !view.LovelyEyeEnabled() {
+			continue
+		}
+		links = append(links, link)
+	}
+
+	return links
+}
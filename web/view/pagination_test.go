@@ -0,0 +1,36 @@
+package runtime
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginationWindowElidesDistantPages(t *testing.T) {
+	t.Parallel()
+
+	pageURL := func(page int) string { return "/?page=" + strconv.Itoa(page) }
+	layout := func(pages []PageLink) []string {
+		items := make([]string, 0, len(pages))
+		for _, page := range pages {
+			switch {
+			case page.Ellipsis:
+				items = append(items, "…")
+			case page.Current:
+				items = append(items, "["+strconv.Itoa(page.Number)+"]")
+			default:
+				items = append(items, strconv.Itoa(page.Number))
+			}
+		}
+		return items
+	}
+
+	require.Equal(t, []string{"1", "…", "4", "5", "[6]", "7", "8", "…", "20"}, layout(paginationWindow(6, 20, pageURL)))
+	require.Equal(t, []string{"[1]", "2", "3", "…", "20"}, layout(paginationWindow(1, 20, pageURL)))
+	require.Equal(t, []string{"1", "2", "3", "[4]", "5", "6", "…", "20"}, layout(paginationWindow(4, 20, pageURL)))
+	require.Equal(t, []string{"1", "…", "18", "19", "[20]"}, layout(paginationWindow(20, 20, pageURL)))
+	require.Equal(t, []string{"1", "[2]", "3"}, layout(paginationWindow(2, 3, pageURL)))
+	require.Empty(t, paginationWindow(1, 1, pageURL))
+	require.Equal(t, "/?page=20", paginationWindow(6, 20, pageURL)[8].URL)
+}
@@ -0,0 +1,34 @@
+package runtime
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"blog/internal/preview"
+	i18n "blog/web/generated/i18n"
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
+)
+
+func isDraftPreviewRequest(appCtx *Context, r *http.Request, slug string) bool {
+	if !appCtx.PreviewEnabled() || r == nil || r.URL == nil {
+		return false
+	}
+
+	token := r.URL.Query().Get(preview.QueryKey)
+	return token != "" && appCtx.preview.Valid(slug, token, time.Now())
+}
+
+// buildDraftPreviewURL signs a link that renders the latest draft of the note
+// on its public URL until the preview TTL runs out.
+func buildDraftPreviewURL(appCtx *Context, i18nCtx frameworki18n.Context[i18n.Key], slug string) string {
+	slug = strings.TrimSpace(slug)
+	if !appCtx.PreviewEnabled() || slug == "" {
+		return ""
+	}
+
+	q := make(url.Values)
+	q.Set(preview.QueryKey, appCtx.preview.Token(slug, time.Now()))
+	return buildLocalizedPathWithQuery(i18nCtx, "/note/"+slug, q)
+}
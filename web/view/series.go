@@ -0,0 +1,78 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"blog/internal/notes"
+	"blog/internal/surrogate"
+	i18n "blog/web/generated/i18n"
+	"github.com/RevoTale/no-js/framework"
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
+)
+
+const seriesPath = "/series/"
+
+type SeriesPageView struct {
+	NotesPageView
+	Series notes.Series
+}
+
+func LoadSeriesPage(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	params framework.SlugParams,
+) (SeriesPageView, error) {
+	locale := localeFromRequest(appCtx, r)
+	slug := strings.TrimSpace(params.Slug)
+	cacheKey := loaderCacheKey("LoadSeriesPage", locale, r, slug)
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (SeriesPageView, error) {
+		service, err := notesService(appCtx)
+		if err != nil {
+			return SeriesPageView{}, err
+		}
+
+		series, err := service.GetSeries(runCtx, locale, slug)
+		if err != nil {
+			return SeriesPageView{}, err
+		}
+		if len(series.Notes) == 0 {
+			return SeriesPageView{}, notes.ErrNotFound
+		}
+		DeclareListSurrogateKeys(runCtx, notes.ListFilter{}, series.Notes, surrogate.ListSeries)
+
+		view := SeriesPageView{
+			NotesPageView: NotesPageView{
+				Locale:      locale,
+				I18nCtx:     appCtx.I18n(r),
+				SidebarMode: SidebarModeRoot,
+				Filter: notes.ListFilter{
+					Page: 1,
+					Type: notes.NoteTypeAll,
+				},
+				Notes:   series.Notes,
+				Authors: uniqueSortedAuthors(collectNoteAuthors(series.Notes)),
+				Tags:    uniqueSortedTags(collectNoteTags(series.Notes)),
+			},
+			Series: *series,
+		}
+		applyStructuredDataContextForNotesView(&view.NotesPageView, appCtx, r, locale)
+		view.IncludeStructuredData = false
+		view.PageTitle = series.Title
+		view.ContextTitle = series.Title
+		view.ContextSubtitle = i18n.TSeriesLabel(view.I18n())
+		view.ContextDescription = series.Description
+		return view, nil
+	})
+}
+
+func BuildSeriesURL(i18nCtx frameworki18n.Context[i18n.Key], slug string) string {
+	return localizePath(i18nCtx, seriesPath+notes.NormalizeSlug(slug))
+}
+
+// SeriesPartLabel reads "Part 2 of 5" for a note's place in its series.
+func SeriesPartLabel(i18nCtx frameworki18n.Context[i18n.Key], position notes.SeriesPosition) string {
+	return i18n.TSeriesPart(i18nCtx, i18n.SeriesPartArgs{Part: position.Part, Total: position.Total})
+}
@@ -3,10 +3,42 @@ package runtime
 import (
 	"strings"
 
-	"blog/internal/markdown"
 	"blog/internal/notes"
 )
 
+const LivePagerFragments = "#notes-context,#notes-pagination,#notes-more"
+const LiveFilterFragments = LivePagerFragments + ",#channel-list,#topbar-context,#topbar-search"
+
+// LiveAppendFragments re-arms the infinite scroll trigger with the following
+// page and keeps the classic pager in step with what has been loaded.
+const LiveAppendFragments = "#notes-more,#notes-pagination"
+
+// Live swap modes map onto hx-swap. Replace swaps the selected fragment in
+// place and inner swaps only its children; append and prepend add the
+// selected fragment's markup to the end or the start of the target.
+const LiveSwapReplace = "outerHTML"
+const LiveSwapInner = "innerHTML"
+const LiveSwapAppend = "beforeend"
+const LiveSwapPrepend = "afterbegin"
+
+// Live scroll modes are read by app.js from the data-live-scroll attribute of
+// the element that triggered an htmx swap. A "#id" value scrolls that element
+// into view instead.
+const LiveScrollTop = "top"
+const LiveScrollPreserve = "preserve"
+
+func SidebarFilterState(view RootLayoutView) string {
+	if view == nil {
+		return ""
+	}
+
+	return strings.Join([]string{
+		view.SidebarCurrentAuthorSlug(),
+		view.SidebarCurrentTagName(),
+		string(view.SidebarCurrentType()),
+	}, "|")
+}
+
 func ChannelLinkClass(active bool) string {
 	if active {
 		return "channel-link active"
@@ -82,6 +114,16 @@ func FirstAuthorAvatarAlt(authors []notes.Author) string {
 	return strings.TrimSpace(avatar.Alt)
 }
 
+// CoAuthors are the authors after the first, who is the one credited on note
+// cards.
+func CoAuthors(authors []notes.Author) []notes.Author {
+	if len(authors) < 2 {
+		return nil
+	}
+
+	return authors[1:]
+}
+
 func TagChannelLabel(tag notes.Tag) string {
 	label := strings.TrimSpace(tag.Title)
 	if label == "" {
@@ -95,6 +137,9 @@ func SidebarAllActive(view RootLayoutView) bool {
 	if view == nil {
 		return true
 	}
+	if SidebarArchiveActive(view) || SidebarTagsActive(view) {
+		return false
+	}
 
 	return strings.TrimSpace(view.SidebarCurrentAuthorSlug()) == "" &&
 		strings.TrimSpace(view.SidebarCurrentTagName()) == "" &&
@@ -125,7 +170,3 @@ func AttachmentLabel(filename string) string {
 	}
 	return "open file"
 }
-
-func ChromaStyleTag() string {
-	return "<style>" + string(markdown.ChromaCSS()) + "</style>"
-}
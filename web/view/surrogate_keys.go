@@ -0,0 +1,37 @@
+package runtime
+
+import (
+	"context"
+
+	"blog/internal/middleware"
+	"blog/internal/notes"
+	"blog/internal/surrogate"
+)
+
+func declareNoteSurrogateKeys(ctx context.Context, note notes.NoteDetail) {
+	keys := []string{surrogate.Note(note.Slug)}
+	for _, author := range note.Authors {
+		keys = append(keys, surrogate.Author(author.Slug))
+	}
+	for _, tag := range note.Tags {
+		keys = append(keys, surrogate.Tag(tag.Name))
+	}
+
+	middleware.AddSurrogateKeys(ctx, keys...)
+}
+
+// DeclareListSurrogateKeys tags a listing with the filter it applies and every
+// note it shows, so editing a note also refreshes the pages it is listed on.
+func DeclareListSurrogateKeys(ctx context.Context, filter notes.ListFilter, items []notes.NoteSummary, keys ...string) {
+	for _, authorSlug := range filter.AuthorSlugs {
+		keys = append(keys, surrogate.Author(authorSlug))
+	}
+	for _, tagName := range filter.TagNames {
+		keys = append(keys, surrogate.Tag(tagName))
+	}
+	for _, item := range items {
+		keys = append(keys, surrogate.Note(item.Slug))
+	}
+
+	middleware.AddSurrogateKeys(ctx, keys...)
+}
@@ -0,0 +1,94 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"blog/internal/middleware"
+	"blog/internal/notes"
+	"blog/internal/surrogate"
+	i18n "blog/web/generated/i18n"
+	"github.com/RevoTale/no-js/framework"
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
+)
+
+const tagsIndexPath = "/tags"
+const tagCloudWeights = 4
+
+type TagsPageView struct {
+	NotesPageView
+	TagCounts []notes.TagCount
+}
+
+func (v TagsPageView) MaxTagCount() int {
+	maxCount := 0
+	for _, tag := range v.TagCounts {
+		maxCount = max(maxCount, tag.Count)
+	}
+
+	return maxCount
+}
+
+func LoadTagsPage(
+	ctx context.Context,
+	appCtx *Context,
+	r *http.Request,
+	_ framework.EmptyParams,
+) (TagsPageView, error) {
+	locale := localeFromRequest(appCtx, r)
+	cacheKey := loaderCacheKey("LoadTagsPage", locale, r)
+	return cachedLoad(ctx, cacheKey, func(runCtx context.Context) (TagsPageView, error) {
+		service, err := notesService(appCtx)
+		if err != nil {
+			return TagsPageView{}, err
+		}
+
+		tagCounts, err := service.ListTagsWithCounts(runCtx, locale)
+		if err != nil {
+			return TagsPageView{}, err
+		}
+		middleware.AddSurrogateKeys(runCtx, surrogate.ListTags)
+
+		view := TagsPageView{
+			NotesPageView: NotesPageView{
+				Locale:      locale,
+				I18nCtx:     appCtx.I18n(r),
+				SidebarMode: SidebarModeRoot,
+				Filter: notes.ListFilter{
+					Page: 1,
+					Type: notes.NoteTypeAll,
+				},
+				Notes:   []notes.NoteSummary{},
+				Authors: []notes.Author{},
+				Tags:    []notes.Tag{},
+			},
+			TagCounts: tagCounts,
+		}
+		applyStructuredDataContextForNotesView(&view.NotesPageView, appCtx, r, locale)
+		view.IncludeStructuredData = false
+		view.PageTitle = i18n.TTagsTitle(view.I18n())
+		view.ContextTitle = view.PageTitle
+		return view, nil
+	})
+}
+
+func BuildTagsIndexURL(i18nCtx frameworki18n.Context[i18n.Key]) string {
+	return localizePath(i18nCtx, tagsIndexPath)
+}
+
+// TagCloudClass buckets a tag's note count relative to the most used tag so the
+// index can scale labels without inline styles.
+func TagCloudClass(count int, maxCount int) string {
+	weight := 1
+	if maxCount > 1 && count > 1 {
+		weight = 1 + (count-1)*(tagCloudWeights-1)/(maxCount-1)
+	}
+
+	return "tag-cloud-link weight-" + strconv.Itoa(min(weight, tagCloudWeights))
+}
+
+func SidebarTagsActive(view RootLayoutView) bool {
+	_, ok := view.(TagsPageView)
+	return ok
+}
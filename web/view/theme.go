@@ -0,0 +1,34 @@
+package runtime
+
+import (
+	"context"
+
+	"blog/internal/theme"
+	i18n "blog/web/generated/i18n"
+)
+
+// ThemeChoice is one button of the footer theme switch.
+type ThemeChoice struct {
+	Value  string
+	Label  string
+	Active bool
+}
+
+// ThemePreference is the color theme the visitor picked, as stored in the
+// context of the request being rendered.
+func ThemePreference(ctx context.Context) theme.Preference {
+	return theme.FromContext(ctx)
+}
+
+func ThemeSwitchURL() string {
+	return theme.Path
+}
+
+func ThemeChoices(ctx context.Context, view RootLayoutView) []ThemeChoice {
+	current := ThemePreference(ctx)
+	return []ThemeChoice{
+		{Value: "system", Label: i18n.TLayoutFooterThemeSystem(view.I18n()), Active: current == theme.System},
+		{Value: string(theme.Light), Label: i18n.TLayoutFooterThemeLight(view.I18n()), Active: current == theme.Light},
+		{Value: string(theme.Dark), Label: i18n.TLayoutFooterThemeDark(view.I18n()), Active: current == theme.Dark},
+	}
+}
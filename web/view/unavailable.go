@@ -0,0 +1,69 @@
+package runtime
+
+import (
+	"net/http"
+
+	i18n "blog/web/generated/i18n"
+	messages "blog/web/generated/i18n/messages"
+	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
+)
+
+// UnavailableReason tells the unavailable page why no content could be shown.
+type UnavailableReason string
+
+const (
+	// UnavailableMaintenance is the 503 served while maintenance mode is on.
+	UnavailableMaintenance UnavailableReason = "maintenance"
+	// UnavailableUpstream is the 502 served when the CMS failed a request.
+	UnavailableUpstream UnavailableReason = "upstream"
+)
+
+// UnavailablePageView backs the pages served instead of content. It needs no
+// CMS data, so it renders even when the CMS is down or being migrated.
+type UnavailablePageView struct {
+	Locale  string
+	I18nCtx frameworki18n.Context[i18n.Key]
+	Reason  UnavailableReason
+}
+
+func (view UnavailablePageView) I18n() frameworki18n.Context[i18n.Key] {
+	return view.I18nCtx
+}
+
+func (view UnavailablePageView) LocaleCode() string {
+	return localeCode(view.I18nCtx, view.Locale)
+}
+
+// LoadUnavailablePage builds the view for r. The maintenance gate runs in
+// front of the framework's i18n middleware, so without locale info on the
+// request the locale prefix is resolved here.
+func LoadUnavailablePage(appCtx *Context, r *http.Request, reason UnavailableReason) UnavailablePageView {
+	if r != nil && r.URL != nil {
+		if _, ok := frameworki18n.RequestInfoFromContext(r.Context()); !ok {
+			r = withPathLocale(r)
+		}
+	}
+
+	return UnavailablePageView{
+		Locale:  localeFromRequest(appCtx, r),
+		I18nCtx: appCtx.I18n(r),
+		Reason:  reason,
+	}
+}
+
+func withPathLocale(r *http.Request) *http.Request {
+	resolver, err := frameworki18n.NewResolver(messages.Config())
+	if err != nil {
+		return r
+	}
+	decision := resolver.Resolve(r.URL.Path)
+	if decision.NotFound {
+		return r
+	}
+
+	return r.WithContext(frameworki18n.WithRequestInfo(r.Context(), frameworki18n.RequestInfo{
+		Locale:       decision.Locale,
+		OriginalPath: decision.OriginalPath,
+		StrippedPath: decision.StrippedPath,
+	}))
+}
@@ -1,9 +1,14 @@
 package runtime
 
 import (
+	"cmp"
+	"net/url"
+	"slices"
 	"sort"
 	"strings"
 
+	"blog/internal/analytics"
+	"blog/internal/navigation"
 	"blog/internal/notes"
 	i18n "blog/web/generated/i18n"
 	frameworki18n "github.com/RevoTale/no-js/framework/i18n"
@@ -20,14 +25,23 @@ type RootLayoutView interface {
 	LocaleCode() string
 	I18n() frameworki18n.Context[i18n.Key]
 	LayoutPageTitle() string
+	CanonicalURL() string
 	LayoutSearchQuery() string
 	LovelyEyeEnabled() bool
 	RSSFeedURL() string
 	SidebarAuthors() []notes.Author
 	SidebarTags() []notes.Tag
 	SidebarCurrentAuthorSlug() string
+	SidebarAuthorActive(authorSlug string) bool
 	SidebarCurrentTagName() string
+	SidebarTagActive(tagName string) bool
 	SidebarCurrentType() notes.NoteType
+	// SidebarTypeCount is the number of notes behind a note type channel, or 0
+	// when the page shows no counts.
+	SidebarTypeCount(noteType notes.NoteType) int
+	// SidebarPopularNotes are the notes read most this week, or nil where the
+	// page does not list them.
+	SidebarPopularNotes() []PopularNoteLink
 	SidebarChannelsURL() string
 	SidebarAllURL() string
 	SidebarAnyAuthorURL() string
@@ -36,6 +50,12 @@ type RootLayoutView interface {
 	SidebarAuthorURL(authorSlug string) string
 	SidebarTagURL(tagName string) string
 	SidebarTypeURL(noteType notes.NoteType) string
+	SidebarLiveFilters() bool
+	LayoutNavigation() navigation.Model
+	// PrevPageURL and NextPageURL are the absolute URLs of the neighbouring
+	// pages of a paginated listing, or "" where there is none.
+	PrevPageURL() string
+	NextPageURL() string
 }
 
 type PaginationView struct {
@@ -51,12 +71,28 @@ type PaginationView struct {
 	LastURL    string
 	PrevURL    string
 	NextURL    string
+	// Pages is the numbered window around the current page, with the first and
+	// last page always present and gaps marked as ellipses.
+	Pages []PageLink
 }
 
+// PageLink is one entry of a numbered pager. Ellipsis entries have no number
+// or URL.
+type PageLink struct {
+	Number   int
+	URL      string
+	Current  bool
+	Ellipsis bool
+}
+
+// paginationWindowRadius is how many pages either side of the current page get
+// their own link.
+const paginationWindowRadius = 2
+
 type NotesPageView struct {
 	Locale                string
 	RootURL               string
-	CanonicalURL          string
+	Canonical             string
 	IncludeStructuredData bool
 	I18nCtx               frameworki18n.Context[i18n.Key]
 	PageTitle             string
@@ -66,28 +102,69 @@ type NotesPageView struct {
 	Authors               []notes.Author
 	Tags                  []notes.Tag
 	ActiveAuthor          *notes.Author
+	ActiveAuthors         []notes.Author
 	ActiveTag             *notes.Tag
+	ActiveTags            []notes.Tag
+	Featured              []notes.NoteSummary
 	Pagination            PaginationView
+	ChannelCounts         notes.ChannelCounts
+	PopularNotes          []PopularNoteLink
 	ContextTitle          string
 	ContextSubtitle       string
 	ContextDescription    string
+	AuthorProfile         *AuthorProfileView
 	EmptyStateMessage     string
 	AnalyticsEnabled      bool
+	LiveFilters           bool
+	FilterFragments       bool
 }
 
 type AuthorPageView = NotesPageView
 
+// PopularNoteLink is a note in the "popular this week" sidebar list.
+type PopularNoteLink struct {
+	Title string
+	URL   string
+	Views int
+}
+
+// AuthorProfileView is the profile block in the header of an author listing.
+type AuthorProfileView struct {
+	Location string
+	Links    []ProfileLink
+}
+
+// ProfileLink points at the author's website or a social profile. It renders
+// with rel="me" so the other site can verify the link back.
+type ProfileLink struct {
+	Label string
+	Title string
+	URL   string
+}
+
 type NotePageView struct {
 	Locale                string
 	RootURL               string
-	CanonicalURL          string
+	Canonical             string
 	IncludeStructuredData bool
 	I18nCtx               frameworki18n.Context[i18n.Key]
 	PageTitle             string
 	Note                  notes.NoteDetail
+	Adjacent              notes.AdjacentNotes
+	Series                *notes.SeriesPosition
+	Related               []notes.NoteSummary
+	Comments              CommentsView
 	SidebarAuthorItems    []notes.Author
 	SidebarTagItems       []notes.Tag
 	AnalyticsEnabled      bool
+	DraftPreview          bool
+}
+
+type NoteDiffPageView struct {
+	NotePageView
+	Draft        notes.NoteDetail
+	HasPublished bool
+	PreviewURL   string
 }
 
 func newFallbackView(i18nCtx frameworki18n.Context[i18n.Key]) RootLayoutView {
@@ -122,6 +199,10 @@ func (v NotesPageView) LayoutPageTitle() string {
 	return v.PageTitle
 }
 
+func (v NotesPageView) CanonicalURL() string {
+	return v.Canonical
+}
+
 func (v NotesPageView) LayoutSearchQuery() string {
 	return strings.TrimSpace(v.Filter.Query)
 }
@@ -130,12 +211,34 @@ func (v NotesPageView) LovelyEyeEnabled() bool {
 	return v.AnalyticsEnabled
 }
 
+func (v NotesPageView) LayoutNavigation() navigation.Model {
+	return Navigation()
+}
+
+func (v NotesPageView) SidebarLiveFilters() bool {
+	return v.LiveFilters
+}
+
+func (v NotesPageView) PrevPageURL() string {
+	if !v.Pagination.HasPrev {
+		return ""
+	}
+	return absolutePageURL(v.RootURL, v.Pagination.PrevURL)
+}
+
+func (v NotesPageView) NextPageURL() string {
+	if !v.Pagination.HasNext {
+		return ""
+	}
+	return absolutePageURL(v.RootURL, v.Pagination.NextURL)
+}
+
 func (v NotesPageView) RSSFeedURL() string {
 	return BuildRSSFeedURL(
 		v.LocaleCode(),
 		v.Filter.Page,
-		v.Filter.AuthorSlug,
-		v.Filter.TagName,
+		authorQueryValue(v.Filter),
+		tagQueryValue(v.Filter),
 		v.Filter.Type,
 		v.Filter.Query,
 	)
@@ -150,19 +253,35 @@ func (v NotesPageView) SidebarTags() []notes.Tag {
 }
 
 func (v NotesPageView) SidebarCurrentAuthorSlug() string {
-	return v.Filter.AuthorSlug
+	return authorQueryValue(v.Filter)
+}
+
+func (v NotesPageView) SidebarAuthorActive(authorSlug string) bool {
+	return slices.Contains(v.Filter.AuthorSlugs, notes.NormalizeSlug(authorSlug))
 }
 
 func (v NotesPageView) SidebarCurrentTagName() string {
-	return v.Filter.TagName
+	return tagQueryValue(v.Filter)
+}
+
+func (v NotesPageView) SidebarTagActive(tagName string) bool {
+	return slices.Contains(v.Filter.TagNames, notes.NormalizeSlug(tagName))
 }
 
 func (v NotesPageView) SidebarCurrentType() notes.NoteType {
 	return v.Filter.Type
 }
 
+func (v NotesPageView) SidebarTypeCount(noteType notes.NoteType) int {
+	return v.ChannelCounts.Type(noteType)
+}
+
+func (v NotesPageView) SidebarPopularNotes() []PopularNoteLink {
+	return v.PopularNotes
+}
+
 func (v NotesPageView) SidebarChannelsURL() string {
-	return BuildChannelsURL(v.I18n(), v.Filter.AuthorSlug, v.Filter.TagName, v.Filter.Type, v.Filter.Query)
+	return BuildChannelsURL(v.I18n(), authorQueryValue(v.Filter), tagQueryValue(v.Filter), v.Filter.Type, v.Filter.Query)
 }
 
 func (v NotesPageView) SidebarAllURL() string {
@@ -174,7 +293,7 @@ func (v NotesPageView) SidebarAnyAuthorURL() string {
 		return BuildNotesFilterURL(v.I18n(), 1, "", "", notes.NoteTypeAll, v.Filter.Query)
 	}
 
-	return BuildNotesFilterURL(v.I18n(), 1, "", v.Filter.TagName, v.Filter.Type, v.Filter.Query)
+	return BuildNotesFilterURL(v.I18n(), 1, "", tagQueryValue(v.Filter), v.Filter.Type, v.Filter.Query)
 }
 
 func (v NotesPageView) SidebarAnyTagURL() string {
@@ -182,7 +301,7 @@ func (v NotesPageView) SidebarAnyTagURL() string {
 		return BuildNotesFilterURL(v.I18n(), 1, "", "", notes.NoteTypeAll, v.Filter.Query)
 	}
 
-	return BuildNotesFilterURL(v.I18n(), 1, v.Filter.AuthorSlug, "", v.Filter.Type, v.Filter.Query)
+	return BuildNotesFilterURL(v.I18n(), 1, authorQueryValue(v.Filter), "", v.Filter.Type, v.Filter.Query)
 }
 
 func (v NotesPageView) SidebarAnyTypeURL() string {
@@ -190,11 +309,18 @@ func (v NotesPageView) SidebarAnyTypeURL() string {
 		return BuildNotesFilterURL(v.I18n(), 1, "", "", notes.NoteTypeAll, v.Filter.Query)
 	}
 
-	return BuildNotesFilterURL(v.I18n(), 1, v.Filter.AuthorSlug, v.Filter.TagName, notes.NoteTypeAll, v.Filter.Query)
+	return BuildNotesFilterURL(
+		v.I18n(),
+		1,
+		authorQueryValue(v.Filter),
+		tagQueryValue(v.Filter),
+		notes.NoteTypeAll,
+		v.Filter.Query,
+	)
 }
 
 func (v NotesPageView) SidebarAuthorURL(authorSlug string) string {
-	authorSlug = strings.TrimSpace(authorSlug)
+	authorSlug = notes.NormalizeSlug(authorSlug)
 	if authorSlug == "" {
 		return v.SidebarAnyAuthorURL()
 	}
@@ -203,11 +329,24 @@ func (v NotesPageView) SidebarAuthorURL(authorSlug string) string {
 		return BuildAuthorURL(v.I18n(), authorSlug, 1)
 	}
 
-	return BuildNotesFilterURL(v.I18n(), 1, authorSlug, v.Filter.TagName, v.Filter.Type, v.Filter.Query)
+	authorValue := authorSlug
+	if len(v.Filter.AuthorSlugs) > 1 {
+		authorSlugs := slices.DeleteFunc(slices.Clone(v.Filter.AuthorSlugs), func(slug string) bool {
+			return slug == authorSlug
+		})
+		if len(authorSlugs) == len(v.Filter.AuthorSlugs) {
+			authorSlugs = append(authorSlugs, authorSlug)
+		}
+		authorValue = notes.AuthorFilterQueryValue(authorSlugs)
+	}
+
+	return BuildNotesFilterURL(v.I18n(), 1, authorValue, tagQueryValue(v.Filter), v.Filter.Type, v.Filter.Query)
 }
 
+// SidebarTagURL switches to the tag, or, on a multi-tag channel, adds it to or
+// removes it from the selection while keeping the any/all mode.
 func (v NotesPageView) SidebarTagURL(tagName string) string {
-	tagName = strings.TrimSpace(tagName)
+	tagName = notes.NormalizeSlug(tagName)
 	if tagName == "" {
 		return v.SidebarAnyTagURL()
 	}
@@ -216,7 +355,18 @@ func (v NotesPageView) SidebarTagURL(tagName string) string {
 		return BuildTagURL(v.I18n(), tagName)
 	}
 
-	return BuildNotesFilterURL(v.I18n(), 1, v.Filter.AuthorSlug, tagName, v.Filter.Type, v.Filter.Query)
+	tagValue := tagName
+	if len(v.Filter.TagNames) > 1 {
+		tagNames := slices.DeleteFunc(slices.Clone(v.Filter.TagNames), func(name string) bool {
+			return name == tagName
+		})
+		if len(tagNames) == len(v.Filter.TagNames) {
+			tagNames = append(tagNames, tagName)
+		}
+		tagValue = notes.TagFilterQueryValue(tagNames, v.Filter.TagMode)
+	}
+
+	return BuildNotesFilterURL(v.I18n(), 1, authorQueryValue(v.Filter), tagValue, v.Filter.Type, v.Filter.Query)
 }
 
 func (v NotesPageView) SidebarTypeURL(noteType notes.NoteType) string {
@@ -235,7 +385,7 @@ func (v NotesPageView) SidebarTypeURL(noteType notes.NoteType) string {
 		}
 	}
 
-	return BuildNotesFilterURL(v.I18n(), 1, v.Filter.AuthorSlug, v.Filter.TagName, noteType, v.Filter.Query)
+	return BuildNotesFilterURL(v.I18n(), 1, authorQueryValue(v.Filter), tagQueryValue(v.Filter), noteType, v.Filter.Query)
 }
 
 func (v NotePageView) LocaleCode() string {
@@ -250,6 +400,10 @@ func (v NotePageView) LayoutPageTitle() string {
 	return v.PageTitle
 }
 
+func (v NotePageView) CanonicalURL() string {
+	return v.Canonical
+}
+
 func (v NotePageView) LayoutSearchQuery() string {
 	return ""
 }
@@ -274,14 +428,30 @@ func (v NotePageView) SidebarCurrentAuthorSlug() string {
 	return ""
 }
 
+func (v NotePageView) SidebarAuthorActive(string) bool {
+	return false
+}
+
 func (v NotePageView) SidebarCurrentTagName() string {
 	return ""
 }
 
+func (v NotePageView) SidebarTagActive(string) bool {
+	return false
+}
+
 func (v NotePageView) SidebarCurrentType() notes.NoteType {
 	return notes.NoteTypeAll
 }
 
+func (v NotePageView) SidebarTypeCount(notes.NoteType) int {
+	return 0
+}
+
+func (v NotePageView) SidebarPopularNotes() []PopularNoteLink {
+	return nil
+}
+
 func (v NotePageView) SidebarChannelsURL() string {
 	return localizePath(v.I18n(), "/channels")
 }
@@ -310,6 +480,22 @@ func (v NotePageView) SidebarTagURL(tagName string) string {
 	return BuildTagURL(v.I18n(), tagName)
 }
 
+func (v NotePageView) LayoutNavigation() navigation.Model {
+	return Navigation()
+}
+
+func (v NotePageView) SidebarLiveFilters() bool {
+	return false
+}
+
+func (v NotePageView) PrevPageURL() string {
+	return ""
+}
+
+func (v NotePageView) NextPageURL() string {
+	return ""
+}
+
 func (v NotePageView) SidebarTypeURL(noteType notes.NoteType) string {
 	noteType = notes.ParseNoteType(string(noteType))
 	if noteType == notes.NoteTypeLong {
@@ -351,19 +537,44 @@ func newNotesPageView(
 			copy := *result.ActiveTag
 			return &copy
 		}(),
-		Pagination: newPaginationView(i18n, result.ActiveFilter, result.TotalPages),
+		ActiveAuthors: slices.Clone(result.ActiveAuthors),
+		ActiveTags:    slices.Clone(result.ActiveTags),
+		Pagination:    newPaginationView(i18n, result.ActiveFilter, result.TotalPages),
 	}
 
 	applyContext(&view)
 	return view
 }
 
+// applyChannelCounts fills the note counts shown next to sidebar channels.
+func (v *NotesPageView) applyChannelCounts(counts notes.ChannelCounts) {
+	v.ChannelCounts = counts
+	for i := range v.Authors {
+		v.Authors[i].NoteCount = counts.Author(v.Authors[i].Slug)
+	}
+	for i := range v.Tags {
+		v.Tags[i].NoteCount = counts.Tag(v.Tags[i].Name)
+	}
+}
+
+// applyPopularNotes fills the "popular this week" sidebar list.
+func (v *NotesPageView) applyPopularNotes(popular []analytics.NoteViews) {
+	v.PopularNotes = make([]PopularNoteLink, 0, len(popular))
+	for _, note := range popular {
+		v.PopularNotes = append(v.PopularNotes, PopularNoteLink{
+			Title: note.Title,
+			URL:   localizePath(v.I18n(), "/note/"+note.Slug),
+			Views: note.Views,
+		})
+	}
+}
+
 func notesPageTitle(i18nCtx frameworki18n.Context[i18n.Key], result notes.NotesListResult) string {
 	if result.ActiveAuthor != nil {
-		return result.ActiveAuthor.Name
+		return activeAuthorsTitle(result.ActiveAuthors, *result.ActiveAuthor)
 	}
 	if result.ActiveTag != nil {
-		return "#" + result.ActiveTag.Title
+		return activeTagsTitle(result.ActiveTags, *result.ActiveTag, result.ActiveFilter.TagMode)
 	}
 	if result.ActiveFilter.Type == notes.NoteTypeLong {
 		return i18n.TLayoutTitleTales(i18nCtx)
@@ -375,18 +586,61 @@ func notesPageTitle(i18nCtx frameworki18n.Context[i18n.Key], result notes.NotesL
 	return i18n.TLayoutTitleNotes(i18nCtx)
 }
 
+// activeAuthorsTitle names an author channel by the author, or "L You, Zed"
+// for the notes of several authors.
+func activeAuthorsTitle(authors []notes.Author, first notes.Author) string {
+	if len(authors) < 2 {
+		return first.Name
+	}
+
+	names := make([]string, 0, len(authors))
+	for _, author := range authors {
+		names = append(names, author.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// activeTagsTitle names a tag channel: "#go" for one tag, "#go, #rust" for
+// notes with any of several and "#go + #rust" for notes with all of them.
+func activeTagsTitle(tags []notes.Tag, first notes.Tag, mode notes.TagMatchMode) string {
+	if len(tags) < 2 {
+		return "#" + first.Title
+	}
+
+	separator := ", "
+	if mode == notes.TagMatchAll {
+		separator = " + "
+	}
+	titles := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		titles = append(titles, "#"+tag.Title)
+	}
+
+	return strings.Join(titles, separator)
+}
+
 func applyContext(view *NotesPageView) {
 	if view == nil {
 		return
 	}
 
 	switch {
+	case len(view.ActiveAuthors) > 1:
+		view.ContextTitle = activeAuthorsTitle(view.ActiveAuthors, *view.ActiveAuthor)
+		handles := make([]string, 0, len(view.ActiveAuthors))
+		for _, author := range view.ActiveAuthors {
+			handles = append(handles, "@"+author.Slug)
+		}
+		view.ContextSubtitle = strings.Join(handles, ", ")
+		view.ContextDescription = ""
 	case view.ActiveAuthor != nil:
 		view.ContextTitle = view.ActiveAuthor.Name
 		view.ContextSubtitle = "@" + view.ActiveAuthor.Slug
 		view.ContextDescription = view.ActiveAuthor.Bio
+		view.AuthorProfile = newAuthorProfileView(view.I18nCtx, *view.ActiveAuthor)
 	case view.ActiveTag != nil:
-		view.ContextTitle = "#" + view.ActiveTag.Title
+		view.ContextTitle = activeTagsTitle(view.ActiveTags, *view.ActiveTag, view.Filter.TagMode)
 		view.ContextSubtitle = i18n.TContextTagSubtitle(view.I18nCtx)
 		view.ContextDescription = i18n.TContextTagDescription(view.I18nCtx)
 	case view.Filter.Type == notes.NoteTypeLong:
@@ -404,16 +658,55 @@ func applyContext(view *NotesPageView) {
 	}
 }
 
+// newAuthorProfileView lists the website first, labelled by its host, then
+// the social profiles by handle. It returns nil when there is nothing to show.
+func newAuthorProfileView(i18nCtx frameworki18n.Context[i18n.Key], author notes.Author) *AuthorProfileView {
+	profile := AuthorProfileView{Location: strings.TrimSpace(author.Location)}
+	if author.Website != "" {
+		profile.Links = append(profile.Links, ProfileLink{
+			Label: websiteLabel(author.Website),
+			Title: i18n.TContextAuthorWebsite(i18nCtx),
+			URL:   author.Website,
+		})
+	}
+	for _, social := range author.Socials {
+		label := cmp.Or(social.Handle, social.Platform, websiteLabel(social.URL))
+		profile.Links = append(profile.Links, ProfileLink{Label: label, Title: social.Platform, URL: social.URL})
+	}
+
+	if profile.Location == "" && len(profile.Links) == 0 {
+		return nil
+	}
+	return &profile
+}
+
+func websiteLabel(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return rawURL
+	}
+	return strings.TrimPrefix(parsed.Host, "www.")
+}
+
 func newPaginationView(
 	i18n frameworki18n.Context[i18n.Key],
 	filter notes.ListFilter,
 	totalPages int,
 ) PaginationView {
+	pageURL := func(page int) string {
+		return withDateRangeQuery(
+			BuildNotesFilterURL(i18n, page, authorQueryValue(filter), tagQueryValue(filter), filter.Type, filter.Query),
+			filter,
+		)
+	}
+
+	return newPaginationViewWithURLs(filter.Page, totalPages, pageURL)
+}
+
+func newPaginationViewWithURLs(page int, totalPages int, pageURL func(page int) string) PaginationView {
 	if totalPages < 1 {
 		totalPages = 1
 	}
-
-	page := filter.Page
 	if page < 1 {
 		page = 1
 	}
@@ -440,11 +733,62 @@ func newPaginationView(
 		LastPage:   totalPages,
 		PrevPage:   prevPage,
 		NextPage:   nextPage,
-		FirstURL:   BuildNotesFilterURL(i18n, 1, filter.AuthorSlug, filter.TagName, filter.Type, filter.Query),
-		LastURL:    BuildNotesFilterURL(i18n, totalPages, filter.AuthorSlug, filter.TagName, filter.Type, filter.Query),
-		PrevURL:    BuildNotesFilterURL(i18n, prevPage, filter.AuthorSlug, filter.TagName, filter.Type, filter.Query),
-		NextURL:    BuildNotesFilterURL(i18n, nextPage, filter.AuthorSlug, filter.TagName, filter.Type, filter.Query),
+		FirstURL:   pageURL(1),
+		LastURL:    pageURL(totalPages),
+		PrevURL:    pageURL(prevPage),
+		NextURL:    pageURL(nextPage),
+		Pages:      paginationWindow(page, totalPages, pageURL),
+	}
+}
+
+// paginationWindow lays out 1 … 4 5 [6] 7 8 … 20. A gap of a single page shows
+// that page instead of an ellipsis, since the marker would take the same room.
+func paginationWindow(page int, totalPages int, pageURL func(page int) string) []PageLink {
+	if totalPages <= 1 {
+		return nil
+	}
+
+	from := max(page-paginationWindowRadius, 1)
+	to := min(page+paginationWindowRadius, totalPages)
+	if from <= 3 {
+		from = 1
+	}
+	if to >= totalPages-2 {
+		to = totalPages
+	}
+
+	pages := make([]PageLink, 0, to-from+5)
+	if from > 1 {
+		pages = append(pages, PageLink{Number: 1, URL: pageURL(1)}, PageLink{Ellipsis: true})
+	}
+	for number := from; number <= to; number++ {
+		pages = append(pages, PageLink{Number: number, URL: pageURL(number), Current: number == page})
 	}
+	if to < totalPages {
+		pages = append(pages, PageLink{Ellipsis: true}, PageLink{Number: totalPages, URL: pageURL(totalPages)})
+	}
+
+	return pages
+}
+
+// absolutePageURL places a pager URL under the site root, which may carry a
+// base path. It returns "" when the root is unknown, since rel links must be
+// absolute.
+func absolutePageURL(rootURL string, pageURL string) string {
+	root, err := url.Parse(strings.TrimSpace(rootURL))
+	if err != nil || !root.IsAbs() || strings.TrimSpace(pageURL) == "" {
+		return ""
+	}
+	page, err := url.Parse(strings.TrimSpace(pageURL))
+	if err != nil {
+		return ""
+	}
+
+	root.Path = strings.TrimSuffix(root.Path, "/") + "/" + strings.TrimPrefix(page.Path, "/")
+	root.RawPath = ""
+	root.RawQuery = page.RawQuery
+	root.Fragment = ""
+	return root.String()
 }
 
 func uniqueSortedAuthors(authors []notes.Author) []notes.Author {